@@ -0,0 +1,158 @@
+package floats
+
+// Sum returns the sum of x.
+// It uses compensated summation (the Kahan-Babuška-Neumaier algorithm),
+// so the error is about one rounding of the exact sum unless it cancels heavily.
+// The sum of an empty slice is +0.
+//
+// If x contains NaN or infinities, or the sum overflows,
+// the result is the same as the naive summation.
+func Sum[T Float[T]](x []T) T {
+	var s, c T
+	if len(x) == 0 {
+		return s
+	}
+
+	s = x[0]
+	for _, v := range x[1:] {
+		t := s.Add(v)
+		c = c.Add(twoSumErr(s, v, t))
+		s = t
+	}
+	if s.IsNaN() || s.IsInf(0) || c.IsZero() {
+		return s
+	}
+	return s.Add(c)
+}
+
+// Dot returns the dot product of x and y.
+// It computes the products with [FMA] and sums them with compensation (the Dot2 algorithm of Ogita, Rump and Oishi),
+// so the result is about as accurate as if it was computed in twice the precision of T and then rounded.
+// It panics if the lengths of x and y are different.
+func Dot[T Float[T]](x, y []T) T {
+	if len(x) != len(y) {
+		panic("floats: Dot of slices with different lengths")
+	}
+
+	var s, c T
+	if len(x) == 0 {
+		return s
+	}
+
+	s = x[0].Mul(y[0])
+	c = FMA(x[0], y[0], s.Neg())
+	for i := 1; i < len(x); i++ {
+		p := x[i].Mul(y[i])
+		e := FMA(x[i], y[i], p.Neg())
+		t := s.Add(p)
+		c = c.Add(twoSumErr(s, p, t).Add(e))
+		s = t
+	}
+	if s.IsNaN() || s.IsInf(0) || c.IsZero() {
+		return s
+	}
+	return s.Add(c)
+}
+
+// twoSumErr returns the rounding error of t = a + b, so that t + err = a + b exactly.
+func twoSumErr[T Float[T]](a, b, t T) T {
+	if a.Abs().Ge(b.Abs()) {
+		return a.Sub(t).Add(b)
+	}
+	return b.Sub(t).Add(a)
+}
+
+// Horner returns the value of the polynomial
+// coeffs[0] + coeffs[1]×x + coeffs[2]×x² + ... + coeffs[n]×xⁿ,
+// evaluated by Horner's method with [FMA].
+// The value of the empty polynomial is +0.
+func Horner[T Float[T]](x T, coeffs ...T) T {
+	var y T
+	if len(coeffs) == 0 {
+		return y
+	}
+
+	y = coeffs[len(coeffs)-1]
+	for i := len(coeffs) - 2; i >= 0; i-- {
+		y = FMA(y, x, coeffs[i])
+	}
+	return y
+}
+
+// Newton finds a root of f by Newton's method, starting from x0.
+// f returns the value of the function and its derivative at x.
+//
+// It stops when f(x) is zero, or when the iteration reaches a fixed point of T
+// or oscillates between two neighboring values, and reports ok = true.
+// It reports ok = false if the derivative is zero or the iteration diverges,
+// or if it doesn't converge in maxIter iterations.
+func Newton[T Float[T]](f func(x T) (y, dy T), x0 T, maxIter int) (x T, ok bool) {
+	x = x0
+	prev := x0
+	for range maxIter {
+		y, dy := f(x)
+		if y.IsZero() {
+			return x, true
+		}
+
+		next := x.Sub(y.Quo(dy))
+		if next.IsNaN() || next.IsInf(0) {
+			return x, false
+		}
+		if next.Eq(x) || next.Eq(prev) {
+			return x, true
+		}
+		prev, x = x, next
+	}
+	return x, false
+}
+
+// Min returns the smallest value of x and y.
+//
+// Special cases are the same as [Float64.Min]:
+// if any of the values is NaN, the result is NaN, and -0 is smaller than +0.
+func Min[T Float[T]](x T, y ...T) T {
+	m := x
+	if m.IsNaN() {
+		return m
+	}
+	for _, v := range y {
+		if v.IsNaN() {
+			return v
+		}
+		if v.Lt(m) || v.IsZero() && m.IsZero() && v.Signbit() {
+			m = v
+		}
+	}
+	return m
+}
+
+// Max returns the largest value of x and y.
+//
+// Special cases are the same as [Float64.Max]:
+// if any of the values is NaN, the result is NaN, and +0 is larger than -0.
+func Max[T Float[T]](x T, y ...T) T {
+	m := x
+	if m.IsNaN() {
+		return m
+	}
+	for _, v := range y {
+		if v.IsNaN() {
+			return v
+		}
+		if v.Gt(m) || v.IsZero() && m.IsZero() && !v.Signbit() {
+			m = v
+		}
+	}
+	return m
+}
+
+// Clamp returns x limited to the range [lo, hi].
+// The result is NaN if any of x, lo and hi is NaN.
+// It panics if lo > hi.
+func Clamp[T Float[T]](x, lo, hi T) T {
+	if lo.Gt(hi) {
+		panic("floats: Clamp with lo > hi")
+	}
+	return Max(lo, Min(x, hi))
+}
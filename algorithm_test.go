@@ -0,0 +1,252 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+// epsilon returns the difference between 1 and the next larger value of T.
+func epsilon[T Float[T]]() T {
+	one, two := FromFloat64[T](1), FromFloat64[T](2)
+	e := one
+	for one.Add(e.Quo(two)).Ne(one) {
+		e = e.Quo(two)
+	}
+	return e
+}
+
+func testSum[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+	tests := []struct {
+		x    []T
+		want T
+	}{
+		{nil, f(0)},
+		{[]T{f(math.Copysign(0, -1))}, f(math.Copysign(0, -1))},
+		{[]T{f(1), f(2), f(3)}, f(6)},
+
+		// the naive summation loses the small values.
+		{[]T{f(1), f(0x1p-300), f(-1)}, f(0x1p-300)},
+		{[]T{f(1).Quo(epsilon[T]()), f(0.5), f(0.5), f(-1).Quo(epsilon[T]())}, f(1)},
+
+		{[]T{f(1), f(math.Inf(1))}, f(math.Inf(1))},
+		{[]T{f(math.Inf(-1)), f(1)}, f(math.Inf(-1))},
+	}
+	for _, tt := range tests {
+		got := Sum(tt.x)
+		if !eq256(got.Float256(), tt.want.Float256()) {
+			t.Errorf("Sum(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	if got := Sum([]T{f(math.Inf(1)), f(math.Inf(-1))}); !got.IsNaN() {
+		t.Errorf("Sum(+Inf, -Inf) = %v; want NaN", got)
+	}
+}
+
+func TestSum(t *testing.T) {
+	t.Run("Float16", testSum[Float16])
+	t.Run("BFloat16", testSum[BFloat16])
+	t.Run("Float32", testSum[Float32])
+	t.Run("Float64", testSum[Float64])
+	t.Run("Float80", testSum[Float80])
+	t.Run("Float128", testSum[Float128])
+	t.Run("Float256", testSum[Float256])
+}
+
+func testDot[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+
+	// (1+e)(1-e) - 1 = -e², which is lost if the products are rounded.
+	e := epsilon[T]()
+	x := []T{f(1).Add(e), f(-1)}
+	y := []T{f(1).Sub(e), f(1)}
+	want := e.Mul(e).Neg()
+	if got := Dot(x, y); !eq256(got.Float256(), want.Float256()) {
+		t.Errorf("Dot(%v, %v) = %v; want %v", x, y, got, want)
+	}
+
+	if got := Dot([]T{}, []T{}); !got.IsZero() || got.Signbit() {
+		t.Errorf("Dot of empty slices = %v; want +0", got)
+	}
+
+	x = []T{f(1), f(2), f(3)}
+	y = []T{f(4), f(5), f(6)}
+	if got, want := Dot(x, y), f(32); !eq256(got.Float256(), want.Float256()) {
+		t.Errorf("Dot(%v, %v) = %v; want %v", x, y, got, want)
+	}
+}
+
+func TestDot(t *testing.T) {
+	t.Run("Float16", testDot[Float16])
+	t.Run("BFloat16", testDot[BFloat16])
+	t.Run("Float32", testDot[Float32])
+	t.Run("Float64", testDot[Float64])
+	t.Run("Float80", testDot[Float80])
+	t.Run("Float128", testDot[Float128])
+	t.Run("Float256", testDot[Float256])
+}
+
+func TestDot_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Dot of slices with different lengths doesn't panic")
+		}
+	}()
+	Dot([]Float32{1, 2}, []Float32{1})
+}
+
+func testHorner[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+	tests := []struct {
+		x      T
+		coeffs []T
+		want   T
+	}{
+		{f(2), nil, f(0)},
+		{f(2), []T{f(3)}, f(3)},
+		// 1 + 2x + 3x²
+		{f(2), []T{f(1), f(2), f(3)}, f(17)},
+		{f(-0.5), []T{f(1), f(2), f(3)}, f(0.75)},
+		// (x-1)³ = -1 + 3x - 3x² + x³
+		{f(1), []T{f(-1), f(3), f(-3), f(1)}, f(0)},
+		{f(math.Inf(1)), []T{f(1), f(1)}, f(math.Inf(1))},
+	}
+	for _, tt := range tests {
+		got := Horner(tt.x, tt.coeffs...)
+		if !eq256(got.Float256(), tt.want.Float256()) {
+			t.Errorf("Horner(%v, %v) = %v; want %v", tt.x, tt.coeffs, got, tt.want)
+		}
+	}
+}
+
+func TestHorner(t *testing.T) {
+	t.Run("Float16", testHorner[Float16])
+	t.Run("BFloat16", testHorner[BFloat16])
+	t.Run("Float32", testHorner[Float32])
+	t.Run("Float64", testHorner[Float64])
+	t.Run("Float80", testHorner[Float80])
+	t.Run("Float128", testHorner[Float128])
+	t.Run("Float256", testHorner[Float256])
+}
+
+func testNewton[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+
+	// the root of x² - 2 is sqrt(2).
+	two := f(2)
+	sq := func(x T) (T, T) {
+		return x.Mul(x).Sub(two), x.Mul(two)
+	}
+	got, ok := Newton(sq, f(1), 100)
+	want := FromFloat256[T](Float256Sqrt2())
+	if !ok {
+		t.Errorf("Newton(x²-2) doesn't converge: %v", got)
+	}
+	// the iteration may stop at a neighbor of the correctly rounded value.
+	if got.Sub(want).Abs().Gt(want.Mul(epsilon[T]())) {
+		t.Errorf("Newton(x²-2) = %v; want %v", got, want)
+	}
+
+	// the derivative of x² + 1 is zero at 0, and it has no real root.
+	sq1 := func(x T) (T, T) {
+		return x.Mul(x).Add(f(1)), x.Mul(two)
+	}
+	if got, ok := Newton(sq1, f(0), 100); ok {
+		t.Errorf("Newton(x²+1) = %v, true; want false", got)
+	}
+
+	// the root is found exactly.
+	lin := func(x T) (T, T) {
+		return x.Sub(f(3)), f(1)
+	}
+	if got, ok := Newton(lin, f(0), 10); !ok || !got.Eq(f(3)) {
+		t.Errorf("Newton(x-3) = %v, %t; want 3, true", got, ok)
+	}
+}
+
+func TestNewton(t *testing.T) {
+	t.Run("Float16", testNewton[Float16])
+	t.Run("BFloat16", testNewton[BFloat16])
+	t.Run("Float32", testNewton[Float32])
+	t.Run("Float64", testNewton[Float64])
+	t.Run("Float80", testNewton[Float80])
+	t.Run("Float128", testNewton[Float128])
+	t.Run("Float256", testNewton[Float256])
+}
+
+func testMinMax[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+	negZero := f(math.Copysign(0, -1))
+	tests := []struct {
+		x        []T
+		min, max T
+	}{
+		{[]T{f(1)}, f(1), f(1)},
+		{[]T{f(3), f(1), f(2)}, f(1), f(3)},
+		{[]T{f(0), negZero}, negZero, f(0)},
+		{[]T{negZero, f(0)}, negZero, f(0)},
+		{[]T{f(math.Inf(-1)), f(math.Inf(1))}, f(math.Inf(-1)), f(math.Inf(1))},
+		{[]T{f(1), f(math.NaN()), f(2)}, f(math.NaN()), f(math.NaN())},
+		{[]T{f(math.NaN()), f(math.Inf(1))}, f(math.NaN()), f(math.NaN())},
+	}
+	for _, tt := range tests {
+		if got := Min(tt.x[0], tt.x[1:]...); !eq256(got.Float256(), tt.min.Float256()) {
+			t.Errorf("Min(%v) = %v; want %v", tt.x, got, tt.min)
+		}
+		if got := Max(tt.x[0], tt.x[1:]...); !eq256(got.Float256(), tt.max.Float256()) {
+			t.Errorf("Max(%v) = %v; want %v", tt.x, got, tt.max)
+		}
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("Float16", testMinMax[Float16])
+	t.Run("BFloat16", testMinMax[BFloat16])
+	t.Run("Float32", testMinMax[Float32])
+	t.Run("Float64", testMinMax[Float64])
+	t.Run("Float80", testMinMax[Float80])
+	t.Run("Float128", testMinMax[Float128])
+	t.Run("Float256", testMinMax[Float256])
+}
+
+func testClamp[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+	tests := []struct {
+		x, lo, hi T
+		want      T
+	}{
+		{f(0.5), f(0), f(1), f(0.5)},
+		{f(-1), f(0), f(1), f(0)},
+		{f(2), f(0), f(1), f(1)},
+		{f(math.Copysign(0, -1)), f(0), f(1), f(0)},
+		{f(math.Inf(1)), f(0), f(1), f(1)},
+		{f(math.NaN()), f(0), f(1), f(math.NaN())},
+		{f(0.5), f(math.NaN()), f(1), f(math.NaN())},
+	}
+	for _, tt := range tests {
+		got := Clamp(tt.x, tt.lo, tt.hi)
+		if !eq256(got.Float256(), tt.want.Float256()) {
+			t.Errorf("Clamp(%v, %v, %v) = %v; want %v", tt.x, tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
+
+func TestClamp(t *testing.T) {
+	t.Run("Float16", testClamp[Float16])
+	t.Run("BFloat16", testClamp[BFloat16])
+	t.Run("Float32", testClamp[Float32])
+	t.Run("Float64", testClamp[Float64])
+	t.Run("Float80", testClamp[Float80])
+	t.Run("Float128", testClamp[Float128])
+	t.Run("Float256", testClamp[Float256])
+}
+
+func TestClamp_Panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Clamp with lo > hi doesn't panic")
+		}
+	}()
+	Clamp(Float64(0), 1, -1)
+}
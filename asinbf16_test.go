@@ -0,0 +1,204 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Asin(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-1), math.Asin(-1)},
+		{exactBF16(-0.75), math.Asin(-0.75)},
+		{exactBF16(-0.5), math.Asin(-0.5)},
+		{exactBF16(-0.25), math.Asin(-0.25)},
+		{exactBF16(0.25), math.Asin(0.25)},
+		{exactBF16(0.5), math.Asin(0.5)},
+		{exactBF16(0.75), math.Asin(0.75)},
+		{exactBF16(1), math.Asin(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asin()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Asin(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asin()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Asin(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Acos(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-1), math.Acos(-1)},
+		{exactBF16(-0.75), math.Acos(-0.75)},
+		{exactBF16(-0.5), math.Acos(-0.5)},
+		{exactBF16(-0.25), math.Acos(-0.25)},
+		{exactBF16(0.25), math.Acos(0.25)},
+		{exactBF16(0.5), math.Acos(0.5)},
+		{exactBF16(0.75), math.Acos(0.75)},
+		{exactBF16(1), math.Acos(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acos()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Acos(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acos()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Acos(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Atan(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-0.5), math.Atan(-0.5)},
+		{exactBF16(-0.25), math.Atan(-0.25)},
+		{exactBF16(-0.125), math.Atan(-0.125)},
+		{exactBF16(0.125), math.Atan(0.125)},
+		{exactBF16(0.25), math.Atan(0.25)},
+		{exactBF16(0.5), math.Atan(0.5)},
+		{exactBF16(0.75), math.Atan(0.75)},
+		{exactBF16(1), math.Atan(1)},
+		{exactBF16(2), math.Atan(2)},
+		{exactBF16(math.Inf(-1)), math.Atan(math.Inf(-1))},
+		{exactBF16(math.Inf(1)), math.Atan(math.Inf(1))},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atan()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Atan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atan()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Atan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Atan2(t *testing.T) {
+	tests := []struct {
+		y, x BFloat16
+		want float64
+	}{
+		{exactBF16(1), exactBF16(1), math.Pi / 4},
+		{exactBF16(1), exactBF16(-1), 3 * math.Pi / 4},
+		{exactBF16(-1), exactBF16(-1), -3 * math.Pi / 4},
+		{exactBF16(-1), exactBF16(1), -math.Pi / 4},
+
+		// special cases
+		// +0.Atan2(x<=-0) = +Pi
+		{exactBF16(0), exactBF16(-1), math.Pi},
+		// -0.Atan2(x<=-0) = -Pi
+		{exactBF16(math.Copysign(0, -1)), exactBF16(-1), -math.Pi},
+		// y>0.Atan2(0) = +Pi/2
+		{exactBF16(1), exactBF16(0), math.Pi / 2},
+		{exactBF16(1), exactBF16(math.Copysign(0, -1)), math.Pi / 2},
+		// y<0.Atan2(0) = -Pi/2
+		{exactBF16(-1), exactBF16(0), -math.Pi / 2},
+		{exactBF16(-1), exactBF16(math.Copysign(0, -1)), -math.Pi / 2},
+		// +Inf.Atan2(+Inf) = +Pi/4
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1)), math.Pi / 4},
+		// -Inf.Atan2(+Inf) = -Pi/4
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(1)), -math.Pi / 4},
+		// +Inf.Atan2(-Inf) = 3*Pi/4
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(-1)), 3 * math.Pi / 4},
+		// -Inf.Atan2(-Inf) = -3*Pi/4
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1)), -3 * math.Pi / 4},
+		// y.Atan2(+Inf) = 0
+		{exactBF16(1), exactBF16(math.Inf(1)), 0},
+		{exactBF16(-1), exactBF16(math.Inf(1)), 0},
+		// (y>0).Atan2(-Inf) = +Pi
+		{exactBF16(1), exactBF16(math.Inf(-1)), math.Pi},
+		// (y<0).Atan2(-Inf) = -Pi
+		{exactBF16(-1), exactBF16(math.Inf(-1)), -math.Pi},
+		// +Inf.Atan2(x) = +Pi/2
+		{exactBF16(math.Inf(1)), exactBF16(1), math.Pi / 2},
+		// -Inf.Atan2(x) = -Pi/2
+		{exactBF16(math.Inf(-1)), exactBF16(1), -math.Pi / 2},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2(tt.x)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Atan2(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y, x BFloat16
+		want BFloat16
+	}{
+		// special cases
+		// y.Atan2(NaN) = NaN
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		// NaN.Atan2(x) = NaN
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+		// +0.Atan2(x>=0) = +0
+		{exactBF16(0), exactBF16(1), exactBF16(0)},
+		// -0.Atan2(x>=0) = -0
+		{exactBF16(math.Copysign(0, -1)), exactBF16(1), exactBF16(math.Copysign(0, -1))},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2(tt.x)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Atan2(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
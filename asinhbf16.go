@@ -0,0 +1,38 @@
+package floats
+
+import "math"
+
+// Asinh returns the inverse hyperbolic sine of a.
+//
+// Special cases are:
+//
+//	±0.Asinh() = ±0
+//	±Inf.Asinh() = ±Inf
+//	NaN.Asinh() = NaN
+func (a BFloat16) Asinh() BFloat16 {
+	return NewBFloat16(math.Asinh(a.Float64().BuiltIn()))
+}
+
+// Acosh returns the inverse hyperbolic cosine of a.
+//
+// Special cases are:
+//
+//	+Inf.Acosh() = +Inf
+//	x.Acosh() = NaN if x < 1
+//	NaN.Acosh() = NaN
+func (a BFloat16) Acosh() BFloat16 {
+	return NewBFloat16(math.Acosh(a.Float64().BuiltIn()))
+}
+
+// Atanh returns the inverse hyperbolic tangent of a.
+//
+// Special cases are:
+//
+//	1.Atanh() = +Inf
+//	±0.Atanh() = ±0
+//	-1.Atanh() = -Inf
+//	x.Atanh() = NaN if x < -1 or x > 1
+//	NaN.Atanh() = NaN
+func (a BFloat16) Atanh() BFloat16 {
+	return NewBFloat16(math.Atanh(a.Float64().BuiltIn()))
+}
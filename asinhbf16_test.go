@@ -0,0 +1,128 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Asinh(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Asinh(0)},
+		{exactBF16(0.25), math.Asinh(0.25)},
+		{exactBF16(0.5), math.Asinh(0.5)},
+		{exactBF16(1), math.Asinh(1)},
+		{exactBF16(21), math.Asinh(21)},
+		{exactBF16(22), math.Asinh(22)},
+
+		{exactBF16(-0), -math.Asinh(0)},
+		{exactBF16(-0.25), -math.Asinh(0.25)},
+		{exactBF16(-0.5), -math.Asinh(0.5)},
+		{exactBF16(-1), -math.Asinh(1)},
+		{exactBF16(-21), -math.Asinh(21)},
+		{exactBF16(-22), -math.Asinh(22)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asinh()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Asinh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asinh()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Asinh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Acosh(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Acosh(1)},
+		{exactBF16(21), math.Acosh(21)},
+		{exactBF16(22), math.Acosh(22)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acosh()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Acosh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acosh()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Acosh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Atanh(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.25), math.Atanh(0.25)},
+		{exactBF16(0.5), math.Atanh(0.5)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atanh()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Atanh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(-1), exactBF16(math.Inf(-1))},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atanh()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Atanh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
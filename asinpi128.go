@@ -0,0 +1,43 @@
+package floats
+
+// Asinpi returns asin(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Asinpi() = ±0
+//	±1.Asinpi() = ±0.5
+//	x.Asinpi() = NaN if x < -1 or x > 1
+func (a Float128) Asinpi() Float128 {
+	return a.Float256().Asinpi().Float128()
+}
+
+// Acospi returns acos(a)/Pi.
+//
+// Special cases are:
+//
+//	1.Acospi() = +0
+//	0.Acospi() = 0.5
+//	-1.Acospi() = 1
+//	x.Acospi() = NaN if x < -1 or x > 1
+func (a Float128) Acospi() Float128 {
+	return a.Float256().Acospi().Float128()
+}
+
+// Atanpi returns atan(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Atanpi() = ±0
+//	±1.Atanpi() = ±0.25
+//	±Inf.Atanpi() = ±0.5
+func (a Float128) Atanpi() Float128 {
+	return a.Float256().Atanpi().Float128()
+}
+
+// Atan2pi returns Atan2(a, b)/Pi.
+//
+// Special cases are the same as [Float128.Atan2] with Pi replaced by 1,
+// and the result is exactly ±0.25 or ±0.75 if |a| = |b|.
+func (a Float128) Atan2pi(b Float128) Float128 {
+	return a.Float256().Atan2pi(b.Float256()).Float128()
+}
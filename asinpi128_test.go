@@ -0,0 +1,183 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat128_Asinpi(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.25), "0.080430623255166243770950193328484255584064431247536758200278433382507186559596953"},
+		{exact128(-0.75), "-0.26994654383738411478621943229485710699008860472928589794412501255447300633307071"},
+		{exact128(0.1), "0.031884280429259925734292676338808827674646719529393314052254450291641956501821878"},
+		{exact128(0.999), "0.48576356259376033860589131420793176257169307591169395239645243386685784745113694"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asinpi()
+		if !close128(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(0.5)},
+		{exact128(-1), exact128(-0.5)},
+		{exact128(2), exact128(math.NaN())},
+		{exact128(-2), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asinpi()
+		if !eq128(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Acospi(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.25), "0.41956937674483375622904980667151574441593556875246324179972156661749281344040305"},
+		{exact128(-0.75), "0.76994654383738411478621943229485710699008860472928589794412501255447300633307071"},
+		{exact128(0.1), "0.46811571957074007426570732366119117232535328047060668594774554970835804349817812"},
+		{exact128(0.999), "0.014236437406239661394108685792068237428306924088306047603547566133142152548863058"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acospi()
+		if !close128(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(1), exact128(0)},
+		{exact128(0), exact128(0.5)},
+		{exact128(-1), exact128(1)},
+		{exact128(2), exact128(math.NaN())},
+		{exact128(-2), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acospi()
+		if !eq128(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Atanpi(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.5), "0.14758361765043327417540107622474052595113452388691789459992231286271147678602634"},
+		{exact128(-3), "-0.39758361765043327417540107622474052595113452388691789459992231286271147678602634"},
+		{exact128(0.1), "0.031725517430553571264457141408516882662488186773622748177278304245900478547616882"},
+		{exact128(1e10), "0.49999999996816901138162093284632935062089172448362002340771801891047976663094488"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atanpi()
+		if !close128(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(0.25)},
+		{exact128(-1), exact128(-0.25)},
+		{exact128(math.Inf(1)), exact128(0.5)},
+		{exact128(math.Inf(-1)), exact128(-0.5)},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atanpi()
+		if !eq128(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Atan2pi(t *testing.T) {
+	tests := []struct {
+		y    Float128
+		x    Float128
+		want string
+	}{
+		{exact128(1), exact128(2), "0.14758361765043327417540107622474052595113452388691789459992231286271147678602634"},
+		{exact128(-1), exact128(-2), "-0.85241638234956672582459892377525947404886547611308210540007768713728852321397366"},
+		{exact128(3), exact128(-0.5), "0.55256845671125342995077816967634455453187332042707895060587232791347418865298554"},
+		{exact128(-0.1), exact128(0.7), "-0.045167235300866553651726621555342471092973736413460070645181234930909140139825407"},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2pi(tt.x)
+		if !close128(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y    Float128
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(math.NaN()), exact128(1), exact128(math.NaN())},
+		{exact128(1), exact128(math.NaN()), exact128(math.NaN())},
+		{exact128(0), exact128(1), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(1), exact128(math.Copysign(0, -1))},
+		{exact128(0), exact128(-1), exact128(1)},
+		{exact128(math.Copysign(0, -1)), exact128(-1), exact128(-1)},
+		{exact128(0), exact128(0), exact128(0)},
+		{exact128(0), exact128(math.Copysign(0, -1)), exact128(1)},
+		{exact128(1), exact128(0), exact128(0.5)},
+		{exact128(-1), exact128(0), exact128(-0.5)},
+		{exact128(math.Inf(1)), exact128(math.Inf(1)), exact128(0.25)},
+		{exact128(math.Inf(-1)), exact128(math.Inf(1)), exact128(-0.25)},
+		{exact128(math.Inf(1)), exact128(math.Inf(-1)), exact128(0.75)},
+		{exact128(math.Inf(-1)), exact128(math.Inf(-1)), exact128(-0.75)},
+		{exact128(1), exact128(math.Inf(1)), exact128(0)},
+		{exact128(-1), exact128(math.Inf(1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(math.Inf(-1)), exact128(1)},
+		{exact128(-1), exact128(math.Inf(-1)), exact128(-1)},
+		{exact128(math.Inf(1)), exact128(1), exact128(0.5)},
+		{exact128(math.Inf(-1)), exact128(1), exact128(-0.5)},
+		{exact128(3), exact128(3), exact128(0.25)},
+		{exact128(3), exact128(-3), exact128(0.75)},
+		{exact128(-3), exact128(-3), exact128(-0.75)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2pi(tt.x)
+		if !eq128(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
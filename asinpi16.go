@@ -0,0 +1,55 @@
+package floats
+
+// Asinpi returns asin(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Asinpi() = ±0
+//	±1.Asinpi() = ±0.5
+//	x.Asinpi() = NaN if x < -1 or x > 1
+func (a Float16) Asinpi() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Asinpi().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Asinpi().Float16()
+}
+
+// Acospi returns acos(a)/Pi.
+//
+// Special cases are:
+//
+//	1.Acospi() = +0
+//	0.Acospi() = 0.5
+//	-1.Acospi() = 1
+//	x.Acospi() = NaN if x < -1 or x > 1
+func (a Float16) Acospi() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Acospi().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Acospi().Float16()
+}
+
+// Atanpi returns atan(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Atanpi() = ±0
+//	±1.Atanpi() = ±0.25
+//	±Inf.Atanpi() = ±0.5
+func (a Float16) Atanpi() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Atanpi().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Atanpi().Float16()
+}
+
+// Atan2pi returns Atan2(a, b)/Pi.
+//
+// Special cases are the same as [Float16.Atan2] with Pi replaced by 1,
+// and the result is exactly ±0.25 or ±0.75 if |a| = |b|.
+func (a Float16) Atan2pi(b Float16) Float16 {
+	if ret, ok := roundFloat16(a.Float64().Atan2pi(b.Float64()).BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Atan2pi(b.Float256()).Float16()
+}
@@ -0,0 +1,202 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16_Asinpi(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.25), 0.08043062325516624},
+		{exact16(-0.75), -0.2699465438373841},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asinpi()
+		if !close16(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(0.5)},
+		{exact16(-1), exact16(-0.5)},
+		{exact16(2), exact16(math.NaN())},
+		{exact16(-2), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asinpi()
+		if !eq16(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Acospi(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.25), 0.41956937674483374},
+		{exact16(-0.75), 0.7699465438373841},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acospi()
+		if !close16(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(1), exact16(0)},
+		{exact16(0), exact16(0.5)},
+		{exact16(-1), exact16(1)},
+		{exact16(2), exact16(math.NaN())},
+		{exact16(-2), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acospi()
+		if !eq16(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Atanpi(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.5), 0.14758361765043326},
+		{exact16(-3), -0.39758361765043326},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atanpi()
+		if !close16(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(0.25)},
+		{exact16(-1), exact16(-0.25)},
+		{exact16(math.Inf(1)), exact16(0.5)},
+		{exact16(math.Inf(-1)), exact16(-0.5)},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atanpi()
+		if !eq16(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Atan2pi(t *testing.T) {
+	tests := []struct {
+		y    Float16
+		x    Float16
+		want float64
+	}{
+		{exact16(1), exact16(2), 0.14758361765043326},
+		{exact16(-1), exact16(-2), -0.8524163823495667},
+		{exact16(3), exact16(-0.5), 0.5525684567112534},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2pi(tt.x)
+		if !close16(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y    Float16
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(math.NaN()), exact16(1), exact16(math.NaN())},
+		{exact16(1), exact16(math.NaN()), exact16(math.NaN())},
+		{exact16(0), exact16(1), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(1), exact16(math.Copysign(0, -1))},
+		{exact16(0), exact16(-1), exact16(1)},
+		{exact16(math.Copysign(0, -1)), exact16(-1), exact16(-1)},
+		{exact16(0), exact16(0), exact16(0)},
+		{exact16(0), exact16(math.Copysign(0, -1)), exact16(1)},
+		{exact16(1), exact16(0), exact16(0.5)},
+		{exact16(-1), exact16(0), exact16(-0.5)},
+		{exact16(math.Inf(1)), exact16(math.Inf(1)), exact16(0.25)},
+		{exact16(math.Inf(-1)), exact16(math.Inf(1)), exact16(-0.25)},
+		{exact16(math.Inf(1)), exact16(math.Inf(-1)), exact16(0.75)},
+		{exact16(math.Inf(-1)), exact16(math.Inf(-1)), exact16(-0.75)},
+		{exact16(1), exact16(math.Inf(1)), exact16(0)},
+		{exact16(-1), exact16(math.Inf(1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(math.Inf(-1)), exact16(1)},
+		{exact16(-1), exact16(math.Inf(-1)), exact16(-1)},
+		{exact16(math.Inf(1)), exact16(1), exact16(0.5)},
+		{exact16(math.Inf(-1)), exact16(1), exact16(-0.5)},
+		{exact16(3), exact16(3), exact16(0.25)},
+		{exact16(3), exact16(-3), exact16(0.75)},
+		{exact16(-3), exact16(-3), exact16(-0.75)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2pi(tt.x)
+		if !eq16(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Asinpi_CorrectlyRounded(t *testing.T) {
+	for i := 0; i < 1<<16; i += 211 {
+		x := NewFloat16FromBits(uint16(i))
+		if x.IsNaN() || x.IsInf(0) {
+			continue
+		}
+		x256 := x.Float256()
+		if got, want := x.Asinpi(), x256.Asinpi().Float16(); !eq16(got, want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Acospi(), x256.Acospi().Float16(); !eq16(got, want) {
+			t.Errorf("Acospi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Atanpi(), x256.Atanpi().Float16(); !eq16(got, want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", x, got, want)
+		}
+		y := NewFloat16FromBits(uint16(i * 7))
+		if y.IsNaN() || y.IsInf(0) {
+			continue
+		}
+		if got, want := y.Atan2pi(x), y.Float256().Atan2pi(x256).Float16(); !eq16(got, want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", y, x, got, want)
+		}
+	}
+}
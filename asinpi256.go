@@ -0,0 +1,102 @@
+package floats
+
+// Asinpi returns asin(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Asinpi() = ±0
+//	±1.Asinpi() = ±0.5
+//	x.Asinpi() = NaN if x < -1 or x > 1
+func (a Float256) Asinpi() Float256 {
+	var One = Float256(uvone256)
+
+	// special cases
+	switch {
+	case a.IsZero():
+		return a
+	case a.IsNaN() || a.Abs().Gt(One):
+		return NewFloat256NaN()
+	case a.Abs().Eq(One):
+		return One.Ldexp(-1).Copysign(a)
+	}
+	return quoPi256(a.Asin())
+}
+
+// Acospi returns acos(a)/Pi.
+//
+// Special cases are:
+//
+//	1.Acospi() = +0
+//	0.Acospi() = 0.5
+//	-1.Acospi() = 1
+//	x.Acospi() = NaN if x < -1 or x > 1
+func (a Float256) Acospi() Float256 {
+	var One = Float256(uvone256)
+
+	// special cases
+	switch {
+	case a.IsNaN() || a.Abs().Gt(One):
+		return NewFloat256NaN()
+	case a.Eq(One):
+		return Float256{}
+	case a.IsZero():
+		return One.Ldexp(-1)
+	case a.Eq(One.Neg()):
+		return One
+	}
+	return quoPi256(a.Acos())
+}
+
+// Atanpi returns atan(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Atanpi() = ±0
+//	±1.Atanpi() = ±0.25
+//	±Inf.Atanpi() = ±0.5
+func (a Float256) Atanpi() Float256 {
+	var One = Float256(uvone256)
+
+	// special cases
+	switch {
+	case a.IsZero():
+		return a
+	case a.IsNaN():
+		return NewFloat256NaN()
+	case a.IsInf(0):
+		return One.Ldexp(-1).Copysign(a)
+	case a.Abs().Eq(One):
+		return One.Ldexp(-2).Copysign(a)
+	}
+	return quoPi256(a.Atan())
+}
+
+// Atan2pi returns Atan2(a, b)/Pi.
+//
+// Special cases are the same as [Float256.Atan2] with Pi replaced by 1,
+// and the result is exactly ±0.25 or ±0.75 if |a| = |b|.
+func (a Float256) Atan2pi(b Float256) Float256 {
+	var One = Float256(uvone256)
+
+	// special cases
+	switch {
+	case a.IsNaN() || b.IsNaN():
+		return NewFloat256NaN()
+	case a.IsZero() || a.IsInf(0) || b.IsZero() || b.IsInf(0):
+		return NewFloat256(atan2PiQuarters(a.Float64().BuiltIn(), b.Float64().BuiltIn()))
+	case a.Abs().Eq(b.Abs()):
+		y, x := One.Copysign(a), One.Copysign(b)
+		return NewFloat256(atan2PiQuarters(y.Float64().BuiltIn(), x.Float64().BuiltIn()))
+	}
+	return quoPi256(a.Atan2(b))
+}
+
+// quoPi256 returns x/Pi.
+func quoPi256(x Float256) Float256 {
+	// InvPi = 1/Pi
+	var InvPi = Float256{
+		0x3fff_d45f_306d_c9c8, 0x82a5_3f84_eafa_3ea6,
+		0x9bb8_1b6c_52b3_2788, 0x7208_3fca_2c75_7bd7,
+	}
+	return x.Mul(InvPi)
+}
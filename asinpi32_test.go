@@ -0,0 +1,201 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat32_Asinpi(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.25), 0.08043062325516624},
+		{exact32(-0.75), -0.2699465438373841},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asinpi()
+		if !close32(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(0.5)},
+		{exact32(-1), exact32(-0.5)},
+		{exact32(2), exact32(math.NaN())},
+		{exact32(-2), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asinpi()
+		if !eq32(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Acospi(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.25), 0.41956937674483374},
+		{exact32(-0.75), 0.7699465438373841},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acospi()
+		if !close32(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(1), exact32(0)},
+		{exact32(0), exact32(0.5)},
+		{exact32(-1), exact32(1)},
+		{exact32(2), exact32(math.NaN())},
+		{exact32(-2), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acospi()
+		if !eq32(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Atanpi(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.5), 0.14758361765043326},
+		{exact32(-3), -0.39758361765043326},
+		{exact32(1e10), 0.499999999968169},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atanpi()
+		if !close32(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(0.25)},
+		{exact32(-1), exact32(-0.25)},
+		{exact32(math.Inf(1)), exact32(0.5)},
+		{exact32(math.Inf(-1)), exact32(-0.5)},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atanpi()
+		if !eq32(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Atan2pi(t *testing.T) {
+	tests := []struct {
+		y    Float32
+		x    Float32
+		want float64
+	}{
+		{exact32(1), exact32(2), 0.14758361765043326},
+		{exact32(-1), exact32(-2), -0.8524163823495667},
+		{exact32(3), exact32(-0.5), 0.5525684567112534},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2pi(tt.x)
+		if !close32(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y    Float32
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(math.NaN()), exact32(1), exact32(math.NaN())},
+		{exact32(1), exact32(math.NaN()), exact32(math.NaN())},
+		{exact32(0), exact32(1), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(1), exact32(math.Copysign(0, -1))},
+		{exact32(0), exact32(-1), exact32(1)},
+		{exact32(math.Copysign(0, -1)), exact32(-1), exact32(-1)},
+		{exact32(0), exact32(0), exact32(0)},
+		{exact32(0), exact32(math.Copysign(0, -1)), exact32(1)},
+		{exact32(1), exact32(0), exact32(0.5)},
+		{exact32(-1), exact32(0), exact32(-0.5)},
+		{exact32(math.Inf(1)), exact32(math.Inf(1)), exact32(0.25)},
+		{exact32(math.Inf(-1)), exact32(math.Inf(1)), exact32(-0.25)},
+		{exact32(math.Inf(1)), exact32(math.Inf(-1)), exact32(0.75)},
+		{exact32(math.Inf(-1)), exact32(math.Inf(-1)), exact32(-0.75)},
+		{exact32(1), exact32(math.Inf(1)), exact32(0)},
+		{exact32(-1), exact32(math.Inf(1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(math.Inf(-1)), exact32(1)},
+		{exact32(-1), exact32(math.Inf(-1)), exact32(-1)},
+		{exact32(math.Inf(1)), exact32(1), exact32(0.5)},
+		{exact32(math.Inf(-1)), exact32(1), exact32(-0.5)},
+		{exact32(3), exact32(3), exact32(0.25)},
+		{exact32(3), exact32(-3), exact32(0.75)},
+		{exact32(-3), exact32(-3), exact32(-0.75)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2pi(tt.x)
+		if !eq32(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Asinpi_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(16, 32))
+	for range 200 {
+		x := NewFloat32(2*r.Float64() - 1)
+		x256 := x.Float256()
+		if got, want := x.Asinpi(), x256.Asinpi().Float32(); !eq32(got, want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Acospi(), x256.Acospi().Float32(); !eq32(got, want) {
+			t.Errorf("Acospi(%v) = %v; want %v", x, got, want)
+		}
+
+		y := NewFloat32(math.Ldexp(r.Float64()-0.5, r.IntN(60)-30))
+		y256 := y.Float256()
+		if got, want := y.Atanpi(), y256.Atanpi().Float32(); !eq32(got, want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", y, got, want)
+		}
+		if got, want := y.Atan2pi(x), y256.Atan2pi(x256).Float32(); !eq32(got, want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", y, x, got, want)
+		}
+	}
+}
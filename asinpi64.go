@@ -0,0 +1,99 @@
+package floats
+
+import "math"
+
+// Asinpi returns asin(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Asinpi() = ±0
+//	±1.Asinpi() = ±0.5
+//	x.Asinpi() = NaN if x < -1 or x > 1
+func (a Float64) Asinpi() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0:
+		return a
+	case math.IsNaN(x) || math.Abs(x) > 1:
+		return NewFloat64NaN()
+	case math.Abs(x) == 1:
+		return Float64(math.Copysign(0.5, x))
+	}
+
+	// asin(x) = atan2(x, sqrt((1-x)(1+x)))
+	one := NewDoubleDouble(1)
+	d := NewDoubleDouble(x)
+	c := one.Sub(d).Mul(one.Add(d)).Sqrt()
+	return d.Atan2(c).Quo(piDD).Float64()
+}
+
+// Acospi returns acos(a)/Pi.
+//
+// Special cases are:
+//
+//	1.Acospi() = +0
+//	0.Acospi() = 0.5
+//	-1.Acospi() = 1
+//	x.Acospi() = NaN if x < -1 or x > 1
+func (a Float64) Acospi() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case math.IsNaN(x) || math.Abs(x) > 1:
+		return NewFloat64NaN()
+	case x == 1:
+		return 0
+	case x == -1:
+		return 1
+	}
+
+	// acos(x) = atan2(sqrt((1-x)(1+x)), x)
+	one := NewDoubleDouble(1)
+	d := NewDoubleDouble(x)
+	s := one.Sub(d).Mul(one.Add(d)).Sqrt()
+	return s.Atan2(d).Quo(piDD).Float64()
+}
+
+// Atanpi returns atan(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Atanpi() = ±0
+//	±1.Atanpi() = ±0.25
+//	±Inf.Atanpi() = ±0.5
+func (a Float64) Atanpi() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0:
+		return a
+	case math.IsNaN(x):
+		return NewFloat64NaN()
+	case math.IsInf(x, 0):
+		return Float64(math.Copysign(0.5, x))
+	case math.Abs(x) == 1:
+		return Float64(math.Copysign(0.25, x))
+	}
+	return NewDoubleDouble(x).Atan().Quo(piDD).Float64()
+}
+
+// Atan2pi returns Atan2(a, b)/Pi.
+//
+// Special cases are the same as [Float64.Atan2] with Pi replaced by 1,
+// and the result is exactly ±0.25 or ±0.75 if |a| = |b|.
+func (a Float64) Atan2pi(b Float64) Float64 {
+	y, x := a.BuiltIn(), b.BuiltIn()
+
+	// special cases
+	switch {
+	case math.IsNaN(y) || math.IsNaN(x):
+		return NewFloat64NaN()
+	case y == 0 || math.IsInf(y, 0) || x == 0 || math.IsInf(x, 0) || math.Abs(y) == math.Abs(x):
+		return Float64(atan2PiQuarters(y, x))
+	}
+	return NewDoubleDouble(y).Atan2(NewDoubleDouble(x)).Quo(piDD).Float64()
+}
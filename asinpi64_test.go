@@ -0,0 +1,207 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat64_Asinpi(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.25), 0.08043062325516624},
+		{exact64(-0.75), -0.2699465438373841},
+		{exact64(0.1), 0.03188428042925993},
+		{exact64(0.999), 0.48576356259376036},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asinpi()
+		if !close64(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(0.5)},
+		{exact64(-1), exact64(-0.5)},
+		{exact64(2), exact64(math.NaN())},
+		{exact64(-2), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asinpi()
+		if !eq64(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Acospi(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.25), 0.41956937674483374},
+		{exact64(-0.75), 0.7699465438373841},
+		{exact64(0.1), 0.46811571957074005},
+		{exact64(0.999), 0.014236437406239661},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acospi()
+		if !close64(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(1), exact64(0)},
+		{exact64(0), exact64(0.5)},
+		{exact64(-1), exact64(1)},
+		{exact64(2), exact64(math.NaN())},
+		{exact64(-2), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acospi()
+		if !eq64(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Atanpi(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.5), 0.14758361765043326},
+		{exact64(-3), -0.39758361765043326},
+		{exact64(0.1), 0.031725517430553574},
+		{exact64(1e10), 0.499999999968169},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atanpi()
+		if !close64(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(0.25)},
+		{exact64(-1), exact64(-0.25)},
+		{exact64(math.Inf(1)), exact64(0.5)},
+		{exact64(math.Inf(-1)), exact64(-0.5)},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atanpi()
+		if !eq64(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Atan2pi(t *testing.T) {
+	tests := []struct {
+		y    Float64
+		x    Float64
+		want float64
+	}{
+		{exact64(1), exact64(2), 0.14758361765043326},
+		{exact64(-1), exact64(-2), -0.8524163823495667},
+		{exact64(3), exact64(-0.5), 0.5525684567112534},
+		{exact64(-0.1), exact64(0.7), -0.045167235300866554},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2pi(tt.x)
+		if !close64(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y    Float64
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(math.NaN()), exact64(1), exact64(math.NaN())},
+		{exact64(1), exact64(math.NaN()), exact64(math.NaN())},
+		{exact64(0), exact64(1), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(1), exact64(math.Copysign(0, -1))},
+		{exact64(0), exact64(-1), exact64(1)},
+		{exact64(math.Copysign(0, -1)), exact64(-1), exact64(-1)},
+		{exact64(0), exact64(0), exact64(0)},
+		{exact64(0), exact64(math.Copysign(0, -1)), exact64(1)},
+		{exact64(1), exact64(0), exact64(0.5)},
+		{exact64(-1), exact64(0), exact64(-0.5)},
+		{exact64(math.Inf(1)), exact64(math.Inf(1)), exact64(0.25)},
+		{exact64(math.Inf(-1)), exact64(math.Inf(1)), exact64(-0.25)},
+		{exact64(math.Inf(1)), exact64(math.Inf(-1)), exact64(0.75)},
+		{exact64(math.Inf(-1)), exact64(math.Inf(-1)), exact64(-0.75)},
+		{exact64(1), exact64(math.Inf(1)), exact64(0)},
+		{exact64(-1), exact64(math.Inf(1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(math.Inf(-1)), exact64(1)},
+		{exact64(-1), exact64(math.Inf(-1)), exact64(-1)},
+		{exact64(math.Inf(1)), exact64(1), exact64(0.5)},
+		{exact64(math.Inf(-1)), exact64(1), exact64(-0.5)},
+		{exact64(3), exact64(3), exact64(0.25)},
+		{exact64(3), exact64(-3), exact64(0.75)},
+		{exact64(-3), exact64(-3), exact64(-0.75)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2pi(tt.x)
+		if !eq64(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Asinpi_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(16, 64))
+	for range 200 {
+		x := NewFloat64(2*r.Float64() - 1)
+		x256 := x.Float256()
+		if got, want := x.Asinpi(), x256.Asinpi().Float64(); !eq64(got, want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Acospi(), x256.Acospi().Float64(); !eq64(got, want) {
+			t.Errorf("Acospi(%v) = %v; want %v", x, got, want)
+		}
+
+		y := NewFloat64(math.Ldexp(r.Float64()-0.5, r.IntN(60)-30))
+		y256 := y.Float256()
+		if got, want := y.Atanpi(), y256.Atanpi().Float64(); !eq64(got, want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", y, got, want)
+		}
+		if got, want := y.Atan2pi(x), y256.Atan2pi(x256).Float64(); !eq64(got, want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", y, x, got, want)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package floats
+
+// Asinpi returns asin(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Asinpi() = ±0
+//	±1.Asinpi() = ±0.5
+//	x.Asinpi() = NaN if x < -1 or x > 1
+func (a BFloat16) Asinpi() BFloat16 {
+	return NewBFloat16(a.Float64().Asinpi().BuiltIn())
+}
+
+// Acospi returns acos(a)/Pi.
+//
+// Special cases are:
+//
+//	1.Acospi() = +0
+//	0.Acospi() = 0.5
+//	-1.Acospi() = 1
+//	x.Acospi() = NaN if x < -1 or x > 1
+func (a BFloat16) Acospi() BFloat16 {
+	return NewBFloat16(a.Float64().Acospi().BuiltIn())
+}
+
+// Atanpi returns atan(a)/Pi.
+//
+// Special cases are:
+//
+//	±0.Atanpi() = ±0
+//	±1.Atanpi() = ±0.25
+//	±Inf.Atanpi() = ±0.5
+func (a BFloat16) Atanpi() BFloat16 {
+	return NewBFloat16(a.Float64().Atanpi().BuiltIn())
+}
+
+// Atan2pi returns Atan2(a, b)/Pi.
+//
+// Special cases are the same as [BFloat16.Atan2] with Pi replaced by 1,
+// and the result is exactly ±0.25 or ±0.75 if |a| = |b|.
+func (a BFloat16) Atan2pi(b BFloat16) BFloat16 {
+	return NewBFloat16(a.Float64().Atan2pi(b.Float64()).BuiltIn())
+}
@@ -0,0 +1,176 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Asinpi(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.25), 0.08043062325516624},
+		{exactBF16(-0.75), -0.2699465438373841},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Asinpi()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(0.5)},
+		{exactBF16(-1), exactBF16(-0.5)},
+		{exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Asinpi()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Asinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Acospi(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.25), 0.41956937674483374},
+		{exactBF16(-0.75), 0.7699465438373841},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Acospi()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(1), exactBF16(0)},
+		{exactBF16(0), exactBF16(0.5)},
+		{exactBF16(-1), exactBF16(1)},
+		{exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Acospi()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Acospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Atanpi(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.5), 0.14758361765043326},
+		{exactBF16(-3), -0.39758361765043326},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atanpi()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(0.25)},
+		{exactBF16(-1), exactBF16(-0.25)},
+		{exactBF16(math.Inf(1)), exactBF16(0.5)},
+		{exactBF16(math.Inf(-1)), exactBF16(-0.5)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atanpi()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Atanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Atan2pi(t *testing.T) {
+	tests := []struct {
+		y    BFloat16
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), exactBF16(2), 0.14758361765043326},
+		{exactBF16(-1), exactBF16(-2), -0.8524163823495667},
+		{exactBF16(3), exactBF16(-0.5), 0.5525684567112534},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2pi(tt.x)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y    BFloat16
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(0), exactBF16(1), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(1), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(0), exactBF16(-1), exactBF16(1)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(-1), exactBF16(-1)},
+		{exactBF16(0), exactBF16(0), exactBF16(0)},
+		{exactBF16(0), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(1), exactBF16(0), exactBF16(0.5)},
+		{exactBF16(-1), exactBF16(0), exactBF16(-0.5)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1)), exactBF16(0.25)},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(1)), exactBF16(-0.25)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(-1)), exactBF16(0.75)},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1)), exactBF16(-0.75)},
+		{exactBF16(1), exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(-1), exactBF16(math.Inf(1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(math.Inf(-1)), exactBF16(1)},
+		{exactBF16(-1), exactBF16(math.Inf(-1)), exactBF16(-1)},
+		{exactBF16(math.Inf(1)), exactBF16(1), exactBF16(0.5)},
+		{exactBF16(math.Inf(-1)), exactBF16(1), exactBF16(-0.5)},
+		{exactBF16(3), exactBF16(3), exactBF16(0.25)},
+		{exactBF16(3), exactBF16(-3), exactBF16(0.75)},
+		{exactBF16(-3), exactBF16(-3), exactBF16(-0.75)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2pi(tt.x)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Atan2pi(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
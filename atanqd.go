@@ -0,0 +1,68 @@
+package floats
+
+import "math"
+
+// Atan returns the arctangent, in radians, of a.
+//
+// Special cases are:
+//
+//	±0.Atan() = ±0
+//	±Inf.Atan() = ±Pi/2
+func (a QuadDouble) Atan() QuadDouble {
+	return a.Atan2(NewQuadDouble(1))
+}
+
+// Atan2 returns the arc tangent of a/b, using
+// the signs of the two to determine the quadrant
+// of the return value.
+//
+// Special cases are (in order):
+//
+//	y.Atan2(NaN) = NaN
+//	NaN.Atan2(x) = NaN
+//	+0.Atan2(x>=0) = +0
+//	-0.Atan2(x>=0) = -0
+//	+0.Atan2(x<=-0) = +Pi
+//	-0.Atan2(x<=-0) = -Pi
+//	(y>0).Atan2(0) = +Pi/2
+//	(y<0).Atan2(0) = -Pi/2
+//	+Inf.Atan2(+Inf) = +Pi/4
+//	-Inf.Atan2(+Inf) = -Pi/4
+//	+Inf.Atan2(-Inf) = 3Pi/4
+//	-Inf.Atan2(-Inf) = -3Pi/4
+//	y.Atan2(+Inf) = 0
+//	(y>0).Atan2(-Inf) = +Pi
+//	(y<0).Atan2(-Inf) = -Pi
+//	+Inf.Atan2(x) = +Pi/2
+//	-Inf.Atan2(x) = -Pi/2
+func (a QuadDouble) Atan2(b QuadDouble) QuadDouble {
+	// special cases
+	switch {
+	case a.IsNaN() || b.IsNaN():
+		return NewQuadDoubleNaN()
+	case a.IsZero() || a.IsInf(0) || b.IsZero() || b.IsInf(0):
+		// the result is a multiple of Pi/4, which depends only on the signs and the quadrant.
+		return piQD.mulFloat64(atan2PiQuarters(a[0], b[0]))
+	}
+
+	// scale to avoid overflow and underflow.
+	_, exp := math.Frexp(math.Max(math.Abs(a[0]), math.Abs(b[0])))
+	y, x := a.ldexp(-exp), b.ldexp(-exp)
+
+	// (x, y) on the unit circle
+	r := x.Mul(x).Add(y.Mul(y)).Sqrt()
+	x, y = x.Quo(r), y.Quo(r)
+
+	// Newton's iteration to solve sin(z) = y or cos(z) = x.
+	// It doubles the number of correct bits, so two iterations are enough from float64.
+	z := NewQuadDouble(math.Atan2(a[0], b[0]))
+	for range 2 {
+		sin, cos := z.Sincos()
+		if math.Abs(x[0]) > math.Abs(y[0]) {
+			z = z.Add(y.Sub(sin).Quo(cos))
+		} else {
+			z = z.Sub(x.Sub(cos).Quo(sin))
+		}
+	}
+	return z
+}
@@ -0,0 +1,85 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestQuadDouble_Atan(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0.5), "0.463647609000806116214256231461214402028537054286120263810933088720197864165741705300600288"},
+		{NewQuadDouble(1), "0.78539816339744830961566084581987572104929234984377645524373614807695410157155224965700870"},
+		{NewQuadDouble(10), "1.47112767430373459185287557176173085185530637718323826247196351934388045569555384489340479"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Atan()
+		if !closeQD(got, tt.want) {
+			t.Errorf("Atan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want QuadDouble
+	}{
+		{NewQuadDouble(0), NewQuadDouble(0)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Atan()
+		if !eqQD(got, tt.want) {
+			t.Errorf("Atan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Atan(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Atan())
+	}
+}
+
+func TestQuadDouble_Atan2(t *testing.T) {
+	tests := []struct {
+		y, x QuadDouble
+		want string
+	}{
+		{NewQuadDouble(3), NewQuadDouble(4), "0.643501108793284386802809228717322638041510591115312382865606118713512474811621088712816844"},
+		{NewQuadDouble(-1), NewQuadDouble(-1), "-2.35619449019234492884698253745962716314787704953132936573120844423086230471465674897102610"},
+		{NewQuadDouble(2.608203298023386), NewQuadDouble(4.15988297102796), "0.560028749048809812121059919810741072006375396612359580258695709339594665676641289990775302"},
+		{NewQuadDoubleInf(1), NewQuadDoubleInf(-1), "2.35619449019234492884698253745962716314787704953132936573120844423086230471465674897102610"},
+		{NewQuadDouble(1), NewQuadDouble(0), "1.5707963267948966192313216916397514420985846996875529104874722961539082031431044993140174"},
+		{NewQuadDouble(0), NewQuadDouble(-1), "3.1415926535897932384626433832795028841971693993751058209749445923078164062862089986280348"},
+	}
+
+	for _, tt := range tests {
+		got := tt.y.Atan2(tt.x)
+		if !closeQD(got, tt.want) {
+			t.Errorf("Atan2(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		y, x, want QuadDouble
+	}{
+		{NewQuadDouble(0), NewQuadDouble(1), NewQuadDouble(0)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(1), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDouble(1), NewQuadDoubleInf(1), NewQuadDouble(0)},
+		{NewQuadDoubleNaN(), NewQuadDouble(1), NewQuadDoubleNaN()},
+		{NewQuadDouble(1), NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.y.Atan2(tt.x)
+		if !eqQD(got, tt.want) {
+			t.Errorf("Atan2(%v, %v) = %v; want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
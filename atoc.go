@@ -0,0 +1,110 @@
+package floats
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	fnParseComplex32  = "ParseComplex32"
+	fnParseComplex256 = "ParseComplex256"
+	fnParseComplex512 = "ParseComplex512"
+)
+
+// parseComplex parses s as a complex number in the syntax of [strconv.ParseComplex],
+// using atof to parse each part.
+func parseComplex[T any](fn, s string, atof func(s string) (T, int, error)) (re, im T, err error) {
+	var zero T
+	orig := s
+
+	convErr := func(err error) error {
+		return &strconv.NumError{
+			Func: fn,
+			Num:  strings.Clone(orig),
+			Err:  err.(*strconv.NumError).Err,
+		}
+	}
+
+	// Remove parentheses, if any.
+	if len(s) >= 2 && s[0] == '(' && s[len(s)-1] == ')' {
+		s = s[1 : len(s)-1]
+	}
+
+	var pending error // pending range error, or nil
+
+	// Read real part (possibly imaginary part if followed by 'i').
+	re, n, err := atof(s)
+	if err != nil {
+		if err.(*strconv.NumError).Err != strconv.ErrRange {
+			return zero, zero, convErr(err)
+		}
+		pending = convErr(err)
+	}
+	s = s[n:]
+
+	// If we have nothing left, we're done.
+	if len(s) == 0 {
+		return re, zero, pending
+	}
+
+	// Otherwise, look at the next character.
+	switch s[0] {
+	case '+':
+		// Consume the '+' to avoid an error if we have "+NaNi", but
+		// do this only if we don't have a "++" (don't hide that error).
+		if len(s) > 1 && s[1] != '+' {
+			s = s[1:]
+		}
+	case '-':
+		// ok
+	case 'i':
+		// If 'i' is the last character, we only have an imaginary part.
+		if len(s) == 1 {
+			return zero, re, pending
+		}
+		fallthrough
+	default:
+		return zero, zero, syntaxError(fn, orig)
+	}
+
+	// Read imaginary part.
+	im, n, err = atof(s)
+	if err != nil {
+		if err.(*strconv.NumError).Err != strconv.ErrRange {
+			return zero, zero, convErr(err)
+		}
+		pending = convErr(err)
+	}
+	s = s[n:]
+	if s != "i" {
+		return zero, zero, syntaxError(fn, orig)
+	}
+	return re, im, pending
+}
+
+// ParseComplex32 parses s as a Complex32.
+// The syntax is the same as [strconv.ParseComplex]:
+// s must be of the form N, Ni, or N±Ni, optionally parenthesized,
+// where N is a floating-point number as recognized by [ParseFloat16].
+func ParseComplex32(s string) (Complex32, error) {
+	re, im, err := parseComplex(fnParseComplex32, s, atof16)
+	return Complex32{re: re, im: im}, err
+}
+
+// ParseComplex256 parses s as a Complex256.
+// The syntax is the same as [strconv.ParseComplex]:
+// s must be of the form N, Ni, or N±Ni, optionally parenthesized,
+// where N is a floating-point number as recognized by [ParseFloat128].
+func ParseComplex256(s string) (Complex256, error) {
+	re, im, err := parseComplex(fnParseComplex256, s, atof128)
+	return Complex256{re: re, im: im}, err
+}
+
+// ParseComplex512 parses s as a Complex512.
+// The syntax is the same as [strconv.ParseComplex]:
+// s must be of the form N, Ni, or N±Ni, optionally parenthesized,
+// where N is a floating-point number as recognized by [ParseFloat256].
+func ParseComplex512(s string) (Complex512, error) {
+	re, im, err := parseComplex(fnParseComplex512, s, atof256)
+	return Complex512{re: re, im: im}, err
+}
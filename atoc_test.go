@@ -0,0 +1,141 @@
+package floats
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestParseComplex512(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	tests := []struct {
+		s    string
+		want complex128
+	}{
+		{"0", 0},
+		{"0i", 0},
+		{"(0)", 0},
+		{"1", 1},
+		{"-1.5", -1.5},
+		{"2i", 2i},
+		{"-2i", -2i},
+		{"+2i", 2i},
+		{"1+2i", 1 + 2i},
+		{"(1+2i)", 1 + 2i},
+		{"1-2i", 1 - 2i},
+		{"1+-2i", 1 - 2i},
+		{"-1e3-0.5i", -1e3 - 0.5i},
+		{"0x1p-2+0x1.8p1i", 0.25 + 3i},
+		{"1_000+2_000i", 1000 + 2000i},
+		{"inf", complex(inf, 0)},
+		{"-Inf+infi", complex(-inf, inf)},
+		{"1-infi", complex(1, -inf)},
+		{"NaN", complex(nan, 0)},
+		{"NaNi", complex(0, nan)},
+		{"1+NaNi", complex(1, nan)},
+		{"NaN+NaNi", complex(nan, nan)},
+	}
+	for _, tt := range tests {
+		got, err := ParseComplex512(tt.s)
+		if err != nil {
+			t.Errorf("ParseComplex512(%q) returns error: %v", tt.s, err)
+			continue
+		}
+		if !closeComplex128(got.Complex128(), tt.want, 0) {
+			t.Errorf("ParseComplex512(%q) = %v; want %v", tt.s, got, tt.want)
+		}
+	}
+
+	// the parts are parsed in the precision of Float256.
+	got, err := ParseComplex512("0.1+0.2i")
+	if err != nil {
+		t.Fatal(err)
+	}
+	re, _ := ParseFloat256("0.1")
+	im, _ := ParseFloat256("0.2")
+	if !got.Eq(NewComplex512(re, im)) {
+		t.Errorf("ParseComplex512(%q) = %v; want %v", "0.1+0.2i", got, NewComplex512(re, im))
+	}
+}
+
+func TestParseComplex_Error(t *testing.T) {
+	tests := []string{
+		"",
+		" ",
+		"(",
+		")",
+		"i",
+		"+i",
+		"-i",
+		"1i1",
+		"1+",
+		"1-",
+		"1++2i",
+		"+NaNi",
+		"1+2",
+		"1+2j",
+		"1+2ii",
+		"(1+2i",
+		"1+2i)",
+		"1 + 2i",
+		"1-NaNi",
+		"1+NaN",
+		"0x1p0x1p0i",
+	}
+	for _, s := range tests {
+		if _, err := ParseComplex32(s); !isSyntaxError(err, fnParseComplex32, s) {
+			t.Errorf("ParseComplex32(%q) returns %v; want syntax error", s, err)
+		}
+		if _, err := ParseComplex256(s); !isSyntaxError(err, fnParseComplex256, s) {
+			t.Errorf("ParseComplex256(%q) returns %v; want syntax error", s, err)
+		}
+		if _, err := ParseComplex512(s); !isSyntaxError(err, fnParseComplex512, s) {
+			t.Errorf("ParseComplex512(%q) returns %v; want syntax error", s, err)
+		}
+	}
+}
+
+func isSyntaxError(err error, fn, s string) bool {
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		return false
+	}
+	return numErr.Func == fn && numErr.Num == s && numErr.Err == strconv.ErrSyntax
+}
+
+func TestParseComplex32_Range(t *testing.T) {
+	tests := []struct {
+		s    string
+		want complex128
+	}{
+		{"1e5+1i", complex(math.Inf(1), 1)},
+		{"1-1e5i", complex(1, math.Inf(-1))},
+		{"(-1e5+1e5i)", complex(math.Inf(-1), math.Inf(1))},
+		{"1e5i", complex(0, math.Inf(1))},
+	}
+	for _, tt := range tests {
+		got, err := ParseComplex32(tt.s)
+		var numErr *strconv.NumError
+		if !errors.As(err, &numErr) || numErr.Func != fnParseComplex32 || numErr.Num != tt.s || numErr.Err != strconv.ErrRange {
+			t.Errorf("ParseComplex32(%q) returns %v; want range error", tt.s, err)
+		}
+		if !closeComplex128(got.Complex128(), tt.want, 0) {
+			t.Errorf("ParseComplex32(%q) = %v; want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestParseComplex256(t *testing.T) {
+	got, err := ParseComplex256("(3.14159265358979323846264338327950288-2.71828182845904523536028747135266250i)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := NewComplex256(Float128Pi(), Float128E().Neg()); !got.Eq(want) {
+		t.Errorf("ParseComplex256() = %v; want %v", got, want)
+	}
+
+	if _, err := ParseComplex256("1e5000+1i"); err == nil || err.(*strconv.NumError).Err != strconv.ErrRange {
+		t.Errorf("ParseComplex256(%q) returns %v; want range error", "1e5000+1i", err)
+	}
+}
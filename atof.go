@@ -4,8 +4,13 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"unicode/utf8"
+	"unsafe"
 )
 
+// fnParseFloat is the function name in the errors of [strconv.ParseFloat].
+const fnParseFloat = "ParseFloat"
+
 func syntaxError(fn, str string) *strconv.NumError {
 	return &strconv.NumError{
 		Func: fn,
@@ -360,6 +365,34 @@ func special(s string) (f float64, n int, ok bool) {
 	return 0, 0, false
 }
 
+// prefixLen returns the length of the longest prefix of s
+// that is a floating-point number accepted by special or readFloat.
+func prefixLen(s string) (n int, ok bool) {
+	if _, n, ok := special(s); ok {
+		return n, true
+	}
+	_, _, _, _, _, n, ok = readFloat(s)
+	return n, ok
+}
+
+// tokenEnd returns the end of the token that the parser attempted in s:
+// the n bytes consumed by the parser, and the character that stopped it.
+// The errors of the prefix parsers contain only the token,
+// so that their allocations don't depend on the length of the rest of the input.
+func tokenEnd(s string, n int) int {
+	if n >= len(s) {
+		return len(s)
+	}
+	_, size := utf8.DecodeRuneInString(s[n:])
+	return n + size
+}
+
+// unsafeString returns the string that shares the memory with b.
+// The parsers don't retain the string; syntaxError and rangeError clone it.
+func unsafeString(b []byte) string {
+	return unsafe.String(unsafe.SliceData(b), len(b))
+}
+
 // commonPrefixLenIgnoreCase returns the length of the common
 // prefix of s and prefix, with the character case of s ignored.
 // The prefix argument must be all lower-case.
@@ -17,7 +17,7 @@ func atof128(s string) (f Float128, n int, err error) {
 
 	mantissa, exp, neg, trunc, hex, n, ok := readFloat128(s)
 	if !ok {
-		return Float128{}, n, syntaxError(fnParseFloat128, s)
+		return Float128{}, n, syntaxError(fnParseFloat128, s[:tokenEnd(s, n)])
 	}
 
 	if hex {
@@ -28,11 +28,11 @@ func atof128(s string) (f Float128, n int, err error) {
 	var buf [decimalDigits128]byte
 	d := decimal{d: buf[:]}
 	if !d.set(s[:n]) {
-		return Float128{}, n, syntaxError(fnParseFloat128, s)
+		return Float128{}, n, syntaxError(fnParseFloat128, s[:tokenEnd(s, n)])
 	}
 	f, ovf := d.float128()
 	if ovf {
-		err = rangeError(fnParseFloat128, s)
+		err = rangeError(fnParseFloat128, s[:n])
 	}
 	return f, n, err
 }
@@ -362,22 +362,38 @@ func (d *decimal) RoundedUint128() ints.Uint128 {
 // ParseFloat128 parses s as a Float128.
 func ParseFloat128(s string) (Float128, error) {
 	f, n, err := atof128(s)
-	if n != len(s) && (err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax) {
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
 		return NewFloat128(0), syntaxError(fnParseFloat128, s)
 	}
 	return f, err
 }
 
+// ParseFloat128Prefix parses the longest prefix of s that is a floating-point number as a Float128.
+// Unlike [ParseFloat128], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat128Prefix(s string) (f Float128, n int, err error) {
+	f, n, err = atof128(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat128(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseFloat128PrefixBytes is like [ParseFloat128Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat128PrefixBytes(b []byte) (f Float128, n int, err error) {
+	return ParseFloat128Prefix(unsafeString(b))
+}
+
 var _ json.Unmarshaler = (*Float128)(nil)
 
 // UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseFloat128],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
 func (a *Float128) UnmarshalJSON(data []byte) error {
-	ret, err := ParseFloat128(string(data))
-	if err != nil {
-		return err
-	}
-	*a = ret
-	return nil
+	return unmarshalJSON(a, data)
 }
 
 var _ encoding.TextUnmarshaler = (*Float128)(nil)
@@ -15,7 +15,7 @@ func atof16(s string) (f Float16, n int, err error) {
 
 	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
 	if !ok {
-		return 0, n, syntaxError(fnParseFloat16, s)
+		return 0, n, syntaxError(fnParseFloat16, s[:tokenEnd(s, n)])
 	}
 
 	if hex {
@@ -26,11 +26,11 @@ func atof16(s string) (f Float16, n int, err error) {
 	var buf [decimalDigits16]byte
 	d := decimal{d: buf[:]}
 	if !d.set(s[:n]) {
-		return 0, n, syntaxError(fnParseFloat16, s)
+		return 0, n, syntaxError(fnParseFloat16, s[:tokenEnd(s, n)])
 	}
 	f, ovf := d.float16()
 	if ovf {
-		err = rangeError(fnParseFloat16, s)
+		err = rangeError(fnParseFloat16, s[:n])
 	}
 	return f, n, err
 }
@@ -220,22 +220,38 @@ func (d *decimal) RoundedUint16() uint16 {
 // ParseFloat16 parses s as a Float16.
 func ParseFloat16(s string) (Float16, error) {
 	f, n, err := atof16(s)
-	if n != len(s) && (err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax) {
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
 		return NewFloat16(0), syntaxError(fnParseFloat16, s)
 	}
 	return f, err
 }
 
+// ParseFloat16Prefix parses the longest prefix of s that is a floating-point number as a Float16.
+// Unlike [ParseFloat16], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat16Prefix(s string) (f Float16, n int, err error) {
+	f, n, err = atof16(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat16(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseFloat16PrefixBytes is like [ParseFloat16Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat16PrefixBytes(b []byte) (f Float16, n int, err error) {
+	return ParseFloat16Prefix(unsafeString(b))
+}
+
 var _ json.Unmarshaler = (*Float16)(nil)
 
 // UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseFloat16],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
 func (a *Float16) UnmarshalJSON(data []byte) error {
-	ret, err := ParseFloat16(string(data))
-	if err != nil {
-		return err
-	}
-	*a = ret
-	return nil
+	return unmarshalJSON(a, data)
 }
 
 var _ encoding.TextUnmarshaler = (*Float16)(nil)
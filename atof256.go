@@ -17,7 +17,7 @@ func atof256(s string) (f Float256, n int, err error) {
 
 	mantissa, exp, neg, trunc, hex, n, ok := readFloat256(s)
 	if !ok {
-		return Float256{}, n, syntaxError(fnParseFloat256, s)
+		return Float256{}, n, syntaxError(fnParseFloat256, s[:tokenEnd(s, n)])
 	}
 
 	if hex {
@@ -29,11 +29,11 @@ func atof256(s string) (f Float256, n int, err error) {
 	defer decimalPool256.Put(bufp)
 	d := decimal{d: bufp[:]}
 	if !d.set(s[:n]) {
-		return Float256{}, n, syntaxError(fnParseFloat256, s)
+		return Float256{}, n, syntaxError(fnParseFloat256, s[:tokenEnd(s, n)])
 	}
 	f, ovf := d.float256()
 	if ovf {
-		err = rangeError(fnParseFloat256, s)
+		err = rangeError(fnParseFloat256, s[:n])
 	}
 	return f, n, err
 }
@@ -363,22 +363,38 @@ func (d *decimal) RoundedUint256() ints.Uint256 {
 // ParseFloat256 parses s as a Float256.
 func ParseFloat256(s string) (Float256, error) {
 	f, n, err := atof256(s)
-	if n != len(s) && (err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax) {
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
 		return NewFloat256(0), syntaxError(fnParseFloat256, s)
 	}
 	return f, err
 }
 
+// ParseFloat256Prefix parses the longest prefix of s that is a floating-point number as a Float256.
+// Unlike [ParseFloat256], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat256Prefix(s string) (f Float256, n int, err error) {
+	f, n, err = atof256(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat256(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseFloat256PrefixBytes is like [ParseFloat256Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat256PrefixBytes(b []byte) (f Float256, n int, err error) {
+	return ParseFloat256Prefix(unsafeString(b))
+}
+
 var _ json.Unmarshaler = (*Float256)(nil)
 
 // UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseFloat256],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
 func (a *Float256) UnmarshalJSON(data []byte) error {
-	ret, err := ParseFloat256(string(data))
-	if err != nil {
-		return err
-	}
-	*a = ret
-	return nil
+	return unmarshalJSON(a, data)
 }
 
 var _ encoding.TextUnmarshaler = (*Float256)(nil)
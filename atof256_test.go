@@ -3,6 +3,7 @@ package floats
 import (
 	"math"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -189,6 +190,68 @@ func TestParseFloat256(t *testing.T) {
 	}
 }
 
+func TestParseFloat256Prefix(t *testing.T) {
+	for _, tt := range parseFloat256Tests {
+		if tt.err == strconv.ErrSyntax {
+			continue
+		}
+		input := tt.input + ";1"
+
+		got, n, err := ParseFloat256Prefix(input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq256(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat256Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+
+		got, n, err = ParseFloat256PrefixBytes([]byte(input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq256(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat256PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "-", "1e+", "0x1"} {
+		_, n, err := ParseFloat256Prefix(input)
+		if n != 0 || err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax {
+			t.Errorf("ParseFloat256Prefix(%q) = (_, %d, %v) want (_, 0, ErrSyntax)", input, n, err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseFloat256Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseFloat256Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseFloat256PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseFloat256PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
 func FuzzParseFloat256(f *testing.F) {
 	for _, tt := range parseFloat256Tests {
 		f.Add(tt.input)
@@ -12,16 +12,33 @@ func ParseFloat32(s string) (Float32, error) {
 	return Float32(f), err
 }
 
+// ParseFloat32Prefix parses the longest prefix of s that is a floating-point number as a Float32.
+// Unlike [ParseFloat32], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat32Prefix(s string) (f Float32, n int, err error) {
+	n, ok := prefixLen(s)
+	if !ok {
+		return 0, 0, syntaxError(fnParseFloat, s[:tokenEnd(s, n)])
+	}
+	g, err := strconv.ParseFloat(s[:n], 32)
+	return Float32(g), n, err
+}
+
+// ParseFloat32PrefixBytes is like [ParseFloat32Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat32PrefixBytes(b []byte) (f Float32, n int, err error) {
+	return ParseFloat32Prefix(unsafeString(b))
+}
+
 var _ json.Unmarshaler = (*Float32)(nil)
 
 // UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseFloat32],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
 func (a *Float32) UnmarshalJSON(data []byte) error {
-	ret, err := ParseFloat32(string(data))
-	if err != nil {
-		return err
-	}
-	*a = ret
-	return nil
+	return unmarshalJSON(a, data)
 }
 
 var _ encoding.TextUnmarshaler = (*Float32)(nil)
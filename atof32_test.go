@@ -2,6 +2,8 @@ package floats
 
 import (
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -32,6 +34,75 @@ func TestParseFloat32(t *testing.T) {
 	}
 }
 
+func TestParseFloat32Prefix(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float32
+		n     int
+		err   error
+	}{
+		{"1.5;1", NewFloat32(1.5), 3, nil},
+		{"-0x1.8p+1,", NewFloat32(-3), 9, nil},
+		{"1e2e3", NewFloat32(100), 3, nil},
+		{"1.2.3", NewFloat32(1.2), 3, nil},
+		{"Infinity!", NewFloat32(math.Inf(1)), 8, nil},
+		{"-infx", NewFloat32(math.Inf(-1)), 4, nil},
+		{"NaN1", NewFloat32(math.NaN()), 3, nil},
+		{"1e1000 ", NewFloat32(math.Inf(1)), 6, strconv.ErrRange},
+		{"abc", 0, 0, strconv.ErrSyntax},
+		{"1e+", 0, 0, strconv.ErrSyntax},
+		{"", 0, 0, strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		got, n, err := ParseFloat32Prefix(tt.input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq32(got, tt.want) || n != tt.n || err != tt.err {
+			t.Errorf("ParseFloat32Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", tt.input, got, n, err, tt.want, tt.n, tt.err)
+		}
+
+		got, n, err = ParseFloat32PrefixBytes([]byte(tt.input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq32(got, tt.want) || n != tt.n || err != tt.err {
+			t.Errorf("ParseFloat32PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", tt.input, got, n, err, tt.want, tt.n, tt.err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseFloat32Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseFloat32Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseFloat32PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseFloat32PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
 func BenchmarkParseFloat32_Decimal(b *testing.B) {
 	for b.Loop() {
 		_, err := ParseFloat32("33909")
@@ -12,16 +12,33 @@ func ParseFloat64(s string) (Float64, error) {
 	return Float64(f), err
 }
 
+// ParseFloat64Prefix parses the longest prefix of s that is a floating-point number as a Float64.
+// Unlike [ParseFloat64], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat64Prefix(s string) (f Float64, n int, err error) {
+	n, ok := prefixLen(s)
+	if !ok {
+		return 0, 0, syntaxError(fnParseFloat, s[:tokenEnd(s, n)])
+	}
+	g, err := strconv.ParseFloat(s[:n], 64)
+	return Float64(g), n, err
+}
+
+// ParseFloat64PrefixBytes is like [ParseFloat64Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat64PrefixBytes(b []byte) (f Float64, n int, err error) {
+	return ParseFloat64Prefix(unsafeString(b))
+}
+
 var _ json.Unmarshaler = (*Float64)(nil)
 
 // UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseFloat64],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
 func (a *Float64) UnmarshalJSON(data []byte) error {
-	ret, err := ParseFloat64(string(data))
-	if err != nil {
-		return err
-	}
-	*a = ret
-	return nil
+	return unmarshalJSON(a, data)
 }
 
 var _ encoding.TextUnmarshaler = (*Float64)(nil)
@@ -2,6 +2,8 @@ package floats
 
 import (
 	"math"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -33,6 +35,75 @@ func TestParseFloat64(t *testing.T) {
 	}
 }
 
+func TestParseFloat64Prefix(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float64
+		n     int
+		err   error
+	}{
+		{"1.5;1", NewFloat64(1.5), 3, nil},
+		{"-0x1.8p+1,", NewFloat64(-3), 9, nil},
+		{"1e2e3", NewFloat64(100), 3, nil},
+		{"1.2.3", NewFloat64(1.2), 3, nil},
+		{"Infinity!", NewFloat64(math.Inf(1)), 8, nil},
+		{"-infx", NewFloat64(math.Inf(-1)), 4, nil},
+		{"NaN1", NewFloat64(math.NaN()), 3, nil},
+		{"1e1000 ", NewFloat64(math.Inf(1)), 6, strconv.ErrRange},
+		{"abc", 0, 0, strconv.ErrSyntax},
+		{"1e+", 0, 0, strconv.ErrSyntax},
+		{"", 0, 0, strconv.ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		got, n, err := ParseFloat64Prefix(tt.input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq64(got, tt.want) || n != tt.n || err != tt.err {
+			t.Errorf("ParseFloat64Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", tt.input, got, n, err, tt.want, tt.n, tt.err)
+		}
+
+		got, n, err = ParseFloat64PrefixBytes([]byte(tt.input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq64(got, tt.want) || n != tt.n || err != tt.err {
+			t.Errorf("ParseFloat64PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", tt.input, got, n, err, tt.want, tt.n, tt.err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseFloat64Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseFloat64Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseFloat64PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseFloat64PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
 func BenchmarkParseFloat64_Decimal(b *testing.B) {
 	for b.Loop() {
 		_, err := ParseFloat64("33909")
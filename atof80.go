@@ -0,0 +1,255 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+
+	"github.com/shogo82148/ints"
+)
+
+const fnParseFloat80 = "ParseFloat80"
+
+func atof80(s string) (f Float80, n int, err error) {
+	if val, n, ok := special(s); ok {
+		return NewFloat80(val), n, nil
+	}
+
+	mantissa, exp, neg, trunc, hex, n, ok := readFloat128(s)
+	if !ok {
+		return Float80{}, n, syntaxError(fnParseFloat80, s[:tokenEnd(s, n)])
+	}
+
+	if hex {
+		f, err := atof80Hex(s[:n], mantissa, exp, neg, trunc)
+		return f, n, err
+	}
+
+	var buf [decimalDigits80]byte
+	d := decimal{d: buf[:]}
+	if !d.set(s[:n]) {
+		return Float80{}, n, syntaxError(fnParseFloat80, s[:tokenEnd(s, n)])
+	}
+	f, ovf := d.float80()
+	if ovf {
+		err = rangeError(fnParseFloat80, s[:n])
+	}
+	return f, n, err
+}
+
+// atof80Hex converts the hex floating-point string s
+// to a rounded Float80 value and returns it.
+// The string s has already been parsed into a mantissa, exponent, and sign (neg==true for negative).
+// If trunc is true, trailing non-zero bits have been omitted from the mantissa.
+func atof80Hex(s string, mantissa ints.Uint128, exp int, neg, trunc bool) (Float80, error) {
+	one := ints.Uint128{0, 1}
+	const maxExp = mask80 - bias80 - 1
+	const minExp = -bias80 + 1
+	exp += shift80 // mantissa now implicitly divided by 2^shift80.
+
+	// Shift mantissa and exponent to bring representation into float range.
+	// Eventually we want a mantissa with a leading 1-bit followed by mantbits other bits.
+	// For rounding, we need two more, where the bottom bit represents
+	// whether that bit or any later bit was non-zero.
+	// (If the mantissa has already lost non-zero bits, trunc is true,
+	// and we OR in a 1 below after shifting left appropriately.)
+	for !mantissa.IsZero() && mantissa.Rsh(shift80+2).IsZero() {
+		mantissa = mantissa.Lsh(1)
+		exp--
+	}
+	if trunc {
+		mantissa[1] |= 1
+	}
+	for !mantissa.Rsh(1 + shift80 + 2).IsZero() {
+		mantissa = mantissa.Rsh(1).Or(mantissa.And(one))
+		exp++
+	}
+
+	// If exponent is too negative,
+	// denormalize in hopes of making it representable.
+	// (The -2 is for the rounding bits.)
+	for mantissa.Cmp(one) > 0 && exp < minExp-2 {
+		mantissa = mantissa.Rsh(1).Or(mantissa.And(one))
+		exp++
+	}
+
+	// Round using two bottom bits.
+	round := mantissa[1] & 3
+	mantissa = mantissa.Rsh(2)
+	round |= mantissa[1] & 1 // round to even (round up if mantissa is odd)
+	exp += 2
+	if round == 3 {
+		mantissa = mantissa.Add(one)
+		if mantissa.Cmp(ints.Uint128{1, 0}) == 0 {
+			mantissa = mantissa.Rsh(1)
+			exp++
+		}
+	}
+
+	if mantissa.Rsh(shift80).IsZero() { // Denormal or zero.
+		exp = -bias80
+	}
+	var err error
+	if exp > maxExp { // infinity and range error
+		mantissa = ints.Uint128{0, intBit80}
+		exp = maxExp + 1
+		err = rangeError(fnParseFloat80, s)
+	}
+
+	f := Float80{
+		se:   uint16((exp + bias80) & mask80),
+		frac: mantissa[1],
+	}
+	if neg {
+		f.se |= signMask80
+	}
+	return f, err
+}
+
+func (d *decimal) float80() (f Float80, overflow bool) {
+	var exp int
+	var mant ints.Uint128
+
+	// Zero is always a special case.
+	if d.nd == 0 {
+		mant = ints.Uint128{0, 0}
+		exp = -bias80
+		goto out
+	}
+
+	// Obvious overflow/underflow.
+	if d.dp > 4933 {
+		goto overflow
+	}
+	if d.dp < -4951 {
+		// underflow to zero
+		mant = ints.Uint128{0, 0}
+		exp = -bias80
+		goto out
+	}
+
+	// Scale by powers of two until in range [0.5, 1.0)
+	exp = 0
+	for d.dp > 0 {
+		var n int
+		if d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[d.dp]
+		}
+		d.Shift(-n)
+		exp += n
+	}
+	for d.dp < 0 || d.dp == 0 && d.d[0] < '5' {
+		var n int
+		if -d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[-d.dp]
+		}
+		d.Shift(n)
+		exp -= n
+	}
+
+	// Our range is [0.5,1) but floating point range is [1,2).
+	exp--
+
+	// Minimum representable exponent is -bias80+1.
+	// If the exponent is smaller, move it up and
+	// adjust d accordingly.
+	if exp < -bias80+1 {
+		n := (-bias80 + 1) - exp
+		d.Shift(-n)
+		exp += n
+	}
+
+	// Check for overflow.
+	if exp >= mask80-bias80 {
+		goto overflow
+	}
+
+	// Extract 1+shift80 bits of mantissa.
+	d.Shift(1 + shift80)
+	mant = d.RoundedUint128()
+
+	// Rounding might have added a bit; shift down.
+	if mant.Cmp(ints.Uint128{1, 0}) == 0 {
+		mant = mant.Rsh(1)
+		exp++
+		if exp >= mask80-bias80 {
+			goto overflow
+		}
+	}
+
+	// Denormalized?
+	if mant[1]&intBit80 == 0 {
+		exp = -bias80
+	}
+	goto out
+
+overflow:
+	// ±Inf
+	mant = ints.Uint128{0, intBit80}
+	exp = mask80 - bias80
+	overflow = true
+
+out:
+	// Assemble bits.
+	f = Float80{
+		se:   uint16((exp + bias80) & mask80),
+		frac: mant[1],
+	}
+	if d.neg {
+		f.se |= signMask80
+	}
+	return f, overflow
+}
+
+// ParseFloat80 parses s as a Float80.
+func ParseFloat80(s string) (Float80, error) {
+	f, n, err := atof80(s)
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat80(0), syntaxError(fnParseFloat80, s)
+	}
+	return f, err
+}
+
+// ParseFloat80Prefix parses the longest prefix of s that is a floating-point number as a Float80.
+// Unlike [ParseFloat80], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat80Prefix(s string) (f Float80, n int, err error) {
+	f, n, err = atof80(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat80(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseFloat80PrefixBytes is like [ParseFloat80Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat80PrefixBytes(b []byte) (f Float80, n int, err error) {
+	return ParseFloat80Prefix(unsafeString(b))
+}
+
+var _ json.Unmarshaler = (*Float80)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseFloat80],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
+func (a *Float80) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data)
+}
+
+var _ encoding.TextUnmarshaler = (*Float80)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Float80) UnmarshalText(data []byte) error {
+	ret, err := ParseFloat80(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
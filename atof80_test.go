@@ -0,0 +1,249 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var parseFloat80Tests = []struct {
+	input string
+	want  Float80
+	err   error
+}{
+	{"0", exact80(0), nil},
+	{"-0", exact80(math.Copysign(0, -1)), nil},
+	{"1", exact80(1.0), nil},
+	{"1.5", exact80(1.5), nil},
+	{"-2.75", exact80(-2.75), nil},
+	{"1000", exact80(1000), nil},
+	{"0.1", Float80{0x3ffb, 0xcccc_cccc_cccc_cccd}, nil},
+	{"1.18973149535723176502e+4932", Float80{0x7ffe, 0xffff_ffff_ffff_ffff}, nil}, // max finite value
+	{"0x1.fffffffffffffffep+16383", Float80{0x7ffe, 0xffff_ffff_ffff_ffff}, nil},  // max finite value (hex)
+	{"1.18973149535723176505e+4932", Float80{0x7ffe, 0xffff_ffff_ffff_ffff}, nil}, // just below the half way to overflow
+	{"3.3621031431120935063e-4932", Float80{0x0001, 0x8000_0000_0000_0000}, nil},  // min normal value
+	{"4e-4951", Float80{0x0000, 0x0000_0000_0000_0001}, nil},                      // min positive denormal
+	{"1.9e-4951", Float80{0x0000, 0x0000_0000_0000_0001}, nil},                    // rounds up to min positive denormal
+	{"1.8e-4951", exact80(0), nil},                                                // rounds down to zero
+	{"0x1p-16445", Float80{0x0000, 0x0000_0000_0000_0001}, nil},                   // min positive denormal (hex)
+	{"0x1p-16446", exact80(0), nil},                                               // ties to even
+	{"0x1.8p-16446", Float80{0x0000, 0x0000_0000_0000_0001}, nil},                 // round up
+	{"0x1.fffffffffffffffcp-16383", Float80{0x0000, 0x7fff_ffff_ffff_ffff}, nil},  // max denormal
+	{"0x1.fffffffffffffffep-16383", Float80{0x0001, 0x8000_0000_0000_0000}, nil},  // ties to even, min normal
+
+	// next float80 - too large
+	{"+1.18973149535723176506e+4932", NewFloat80Inf(1), strconv.ErrRange},
+	{"-1.18973149535723176506e+4932", NewFloat80Inf(-1), strconv.ErrRange},
+	{"+0x1.ffffffffffffffffp+16383", NewFloat80Inf(1), strconv.ErrRange},
+	{"-0x1.ffffffffffffffffp+16383", NewFloat80Inf(-1), strconv.ErrRange},
+
+	// Hexadecimal floating-point.
+	{"0x1p+0", exact80(1.0), nil},
+	{"0x1.8p+1", exact80(3.0), nil},
+	{"-0x2p3", exact80(-16), nil},
+	{"0x0.fp4", exact80(15), nil},
+	{"0x1e2", exact80(0), strconv.ErrSyntax}, // missing 'p' exponent
+	{"1p2", exact80(0), strconv.ErrSyntax},   // missing '0x' prefix
+
+	// Rounding
+	{"0x1.0000000000000001p+00", exact80(1.0), nil},                                              // round down
+	{"0x1.00000000000000010001p+00", Float80{0x3fff, 0x8000_0000_0000_0001}, nil},                // round up
+	{"0x1.0000000000000002ffffp+00", Float80{0x3fff, 0x8000_0000_0000_0001}, nil},                // round down
+	{"0x1.0000000000000003p+00", Float80{0x3fff, 0x8000_0000_0000_0002}, nil},                    // round up
+	{"0x1.ffffffffffffffffp+00", exact80(2.0), nil},                                              // round up
+	{"1.00000000000000000005421010862427522170037", exact80(1.0), nil},                           // 1 + 2**-64, round down
+	{"1.00000000000000000005421010862427522170038", Float80{0x3fff, 0x8000_0000_0000_0001}, nil}, // round up
+
+	// NaNs
+	{"nan", NewFloat80NaN(), nil},
+	{"NaN", NewFloat80NaN(), nil},
+
+	// Infs
+	{"Inf", NewFloat80Inf(1), nil},
+	{"-Inf", NewFloat80Inf(-1), nil},
+	{"+INFINITY", NewFloat80Inf(1), nil},
+
+	// try to overflow exponent
+	{"1e-4294967296", exact80(0), nil},
+	{"1e+4294967296", NewFloat80Inf(1), strconv.ErrRange},
+	{"0x1p-4294967296", exact80(0), nil},
+	{"0x1p+4294967296", NewFloat80Inf(1), strconv.ErrRange},
+
+	// Parse errors
+	{"1e", exact80(0), strconv.ErrSyntax},
+	{".e-1", exact80(0), strconv.ErrSyntax},
+	{"0x", exact80(0), strconv.ErrSyntax},
+	{"0x1p", exact80(0), strconv.ErrSyntax},
+	{"0x.1p-2", exact80(0.015625), nil},
+}
+
+func TestParseFloat80(t *testing.T) {
+	for _, tt := range parseFloat80Tests {
+		got, err := ParseFloat80(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseFloat80(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseFloat80" {
+				t.Errorf("ParseFloat80(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseFloat80")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseFloat80(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if !eq80(got, tt.want) || err != tt.err {
+			t.Errorf("ParseFloat80(%q) = (%v, %v) want (%v, %v)", tt.input, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseFloat80Prefix(t *testing.T) {
+	for _, tt := range parseFloat80Tests {
+		if tt.err == strconv.ErrSyntax {
+			continue
+		}
+		input := tt.input + ";1"
+
+		got, n, err := ParseFloat80Prefix(input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq80(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat80Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+
+		got, n, err = ParseFloat80PrefixBytes([]byte(input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eq80(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat80PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "-", "1e+", "0x1"} {
+		_, n, err := ParseFloat80Prefix(input)
+		if n != 0 || err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax {
+			t.Errorf("ParseFloat80Prefix(%q) = (_, %d, %v) want (_, 0, ErrSyntax)", input, n, err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseFloat80Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseFloat80Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseFloat80PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseFloat80PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
+func FuzzParseFloat80(f *testing.F) {
+	for _, tt := range parseFloat80Tests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseFloat80(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseFloat80(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eq80(f0, f1) {
+			t.Fatalf("ParseFloat80(%q) = %v; after String() = %q and ParseFloat80 = %v", input, f0, s, f1)
+		}
+	})
+}
+
+func BenchmarkParseFloat80_Float(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseFloat80("339.778")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestFloat80_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float80
+	}{
+		{"0", exact80(0)},
+		{"1.5", exact80(1.5)},
+		{"-2.75", exact80(-2.75)},
+	}
+
+	for _, tt := range tests {
+		var f Float80
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Float80.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eq80(f, tt.want) {
+			t.Errorf("Float80.UnmarshalJSON(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// the quoted forms are accepted.
+	var f Float80
+	if err := f.UnmarshalJSON([]byte(`"1"`)); err != nil || !eq80(f, exact80(1)) {
+		t.Errorf("Float80.UnmarshalJSON(%q) = %v, %v; want 1", `"1"`, f, err)
+	}
+	if err := f.UnmarshalJSON([]byte(`"x"`)); err == nil {
+		t.Errorf("Float80.UnmarshalJSON(%q) expected error", `"x"`)
+	}
+}
+
+func TestFloat80_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float80
+	}{
+		{"0", exact80(0)},
+		{"1.5", exact80(1.5)},
+		{"-Inf", NewFloat80Inf(-1)},
+		{"NaN", NewFloat80NaN()},
+	}
+
+	for _, tt := range tests {
+		var f Float80
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Float80.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eq80(f, tt.want) {
+			t.Errorf("Float80.UnmarshalText(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+}
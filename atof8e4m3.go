@@ -0,0 +1,257 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"strconv"
+)
+
+const fnParseFloat8E4M3 = "ParseFloat8E4M3"
+
+func atofE4M3(s string) (f Float8E4M3, n int, err error) {
+	if val, n, ok := special(s); ok {
+		f := NewFloat8E4M3(val)
+		if math.IsInf(val, 0) {
+			// Float8E4M3 has no infinities.
+			err = rangeError(fnParseFloat8E4M3, s[:n])
+		}
+		return f, n, err
+	}
+
+	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
+	if !ok {
+		return 0, n, syntaxError(fnParseFloat8E4M3, s[:tokenEnd(s, n)])
+	}
+
+	if hex {
+		f, err := atofE4M3Hex(s[:n], mantissa, exp, neg, trunc)
+		return f, n, err
+	}
+
+	var buf [decimalDigitsE4M3]byte
+	d := decimal{d: buf[:]}
+	if !d.set(s[:n]) {
+		return 0, n, syntaxError(fnParseFloat8E4M3, s[:tokenEnd(s, n)])
+	}
+	f, ovf := d.float8E4M3()
+	if ovf {
+		err = rangeError(fnParseFloat8E4M3, s[:n])
+	}
+	return f, n, err
+}
+
+// atofE4M3Hex converts the hex floating-point string s
+// to a rounded Float8E4M3 value.
+// The string s has already been parsed into a mantissa, exponent, and sign (neg==true for negative).
+// If trunc is true, trailing non-zero bits have been omitted from the mantissa.
+func atofE4M3Hex(s string, mantissa uint64, exp int, neg, trunc bool) (Float8E4M3, error) {
+	const maxExp = maskE4M3 - biasE4M3
+	const minExp = -biasE4M3 + 1
+	exp += shiftE4M3 // mantissa now implicitly divided by 2^shiftE4M3.
+
+	// Shift mantissa and exponent to bring representation into float range.
+	// Eventually we want a mantissa with a leading 1-bit followed by mantbits other bits.
+	// For rounding, we need two more, where the bottom bit represents
+	// whether that bit or any later bit was non-zero.
+	// (If the mantissa has already lost non-zero bits, trunc is true,
+	// and we OR in a 1 below after shifting left appropriately.)
+	for mantissa != 0 && mantissa>>(shiftE4M3+2) == 0 {
+		mantissa <<= 1
+		exp--
+	}
+	if trunc {
+		mantissa |= 1
+	}
+	for mantissa>>(1+shiftE4M3+2) != 0 {
+		mantissa = mantissa>>1 | mantissa&1
+		exp++
+	}
+
+	// If exponent is too negative,
+	// denormalize in hopes of making it representable.
+	// (The -2 is for the rounding bits.)
+	for mantissa > 1 && exp < minExp-2 {
+		mantissa = mantissa>>1 | mantissa&1
+		exp++
+	}
+
+	// Round using two bottom bits.
+	round := mantissa & 3
+	mantissa >>= 2
+	round |= mantissa & 1 // round to even (round up if mantissa is odd)
+	exp += 2
+	if round == 3 {
+		mantissa++
+		if mantissa == 1<<(1+shiftE4M3) {
+			mantissa >>= 1
+			exp++
+		}
+	}
+
+	if mantissa>>shiftE4M3 == 0 { // Denormal or zero.
+		exp = -biasE4M3
+	}
+	if exp > maxExp || exp == maxExp && mantissa&fracMaskE4M3 == fracMaskE4M3 { // NaN and range error
+		return nanE4M3(neg), rangeError(fnParseFloat8E4M3, s)
+	}
+
+	bits := mantissa & fracMaskE4M3
+	bits |= uint64((exp+biasE4M3)&maskE4M3) << shiftE4M3
+	if neg {
+		bits |= signMaskE4M3
+	}
+
+	return Float8E4M3(bits), nil
+}
+
+func (d *decimal) float8E4M3() (f Float8E4M3, overflow bool) {
+	var exp int
+	var mant uint8
+
+	// Zero is always a special case.
+	if d.nd == 0 {
+		mant = 0
+		exp = -biasE4M3
+		goto out
+	}
+
+	// Obvious overflow/underflow.
+	if d.dp > 3 {
+		goto overflow
+	}
+	if d.dp < -3 {
+		// underflow to zero
+		mant = 0
+		exp = -biasE4M3
+		goto out
+	}
+
+	// Scale by powers of two until in range [0.5, 1.0)
+	exp = 0
+	for d.dp > 0 {
+		var n int
+		if d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[d.dp]
+		}
+		d.Shift(-n)
+		exp += n
+	}
+	for d.dp < 0 || d.dp == 0 && d.d[0] < '5' {
+		var n int
+		if -d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[-d.dp]
+		}
+		d.Shift(n)
+		exp -= n
+	}
+
+	// Our range is [0.5,1) but floating point range is [1,2).
+	exp--
+
+	// Minimum representable exponent is -biasE4M3+1.
+	// If the exponent is smaller, move it up and
+	// adjust d accordingly.
+	if exp < -biasE4M3+1 {
+		n := (-biasE4M3 + 1) - exp
+		d.Shift(-n)
+		exp += n
+	}
+
+	// Check for overflow.
+	if exp > maskE4M3-biasE4M3 {
+		goto overflow
+	}
+
+	// Extract 1+shiftE4M3 bits of mantissa.
+	d.Shift(1 + shiftE4M3)
+	mant = uint8(d.RoundedUint16())
+
+	// Rounding might have added a bit; shift down.
+	if mant == 2<<shiftE4M3 {
+		mant >>= 1
+		exp++
+	}
+	// The all-ones exponent is valid, except for the NaN encoding.
+	if exp > maskE4M3-biasE4M3 || exp == maskE4M3-biasE4M3 && mant&fracMaskE4M3 == fracMaskE4M3 {
+		goto overflow
+	}
+
+	// Denormalized?
+	if mant&(1<<shiftE4M3) == 0 {
+		exp = -biasE4M3
+	}
+	goto out
+
+overflow:
+	// NaN, because Float8E4M3 has no infinities
+	mant = fracMaskE4M3
+	exp = maskE4M3 - biasE4M3
+	overflow = true
+
+out:
+	// Assemble bits.
+	bits := mant & fracMaskE4M3
+	bits |= uint8((exp+biasE4M3)&maskE4M3) << shiftE4M3
+	if d.neg {
+		bits |= signMaskE4M3
+	}
+	return Float8E4M3(bits), overflow
+}
+
+// ParseFloat8E4M3 parses s as a Float8E4M3.
+// Float8E4M3 has no infinities, so if s is out of range, including "Inf",
+// ParseFloat8E4M3 returns NaN and err.Err = ErrRange.
+func ParseFloat8E4M3(s string) (Float8E4M3, error) {
+	f, n, err := atofE4M3(s)
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat8E4M3(0), syntaxError(fnParseFloat8E4M3, s)
+	}
+	return f, err
+}
+
+// ParseFloat8E4M3Prefix parses the longest prefix of s that is a floating-point number as a Float8E4M3.
+// Unlike [ParseFloat8E4M3], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat8E4M3Prefix(s string) (f Float8E4M3, n int, err error) {
+	f, n, err = atofE4M3(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat8E4M3(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseFloat8E4M3PrefixBytes is like [ParseFloat8E4M3Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat8E4M3PrefixBytes(b []byte) (f Float8E4M3, n int, err error) {
+	return ParseFloat8E4M3Prefix(unsafeString(b))
+}
+
+var _ json.Unmarshaler = (*Float8E4M3)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *Float8E4M3) UnmarshalJSON(data []byte) error {
+	ret, err := ParseFloat8E4M3(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*Float8E4M3)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Float8E4M3) UnmarshalText(data []byte) error {
+	ret, err := ParseFloat8E4M3(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
@@ -0,0 +1,251 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var parseFloat8E4M3Tests = []struct {
+	input string
+	want  Float8E4M3
+	err   error
+}{
+	{"0", exactE4M3(0), nil},
+	{"-0", exactE4M3(math.Copysign(0, -1)), nil},
+	{"1", exactE4M3(1.0), nil},
+	{"1.5", exactE4M3(1.5), nil},
+	{"-2.75", exactE4M3(-2.75), nil},
+	{"10", exactE4M3(10), nil},
+	{"100", exactE4M3(96), nil},
+	{"448", exactE4M3(448), nil},       // max finite value
+	{"0x1.cp+08", exactE4M3(448), nil}, // max finite value (hex)
+	{"464", exactE4M3(448), nil},
+
+	// next float8 e4m3 - too large
+	{"464.01", uvnanE4M3, strconv.ErrRange},
+	{"-464.01", uvnanE4M3 | signMaskE4M3, strconv.ErrRange},
+	{"1e10", uvnanE4M3, strconv.ErrRange},
+	{"+0x1.ep+08", uvnanE4M3, strconv.ErrRange},
+	{"-0x1.ep+08", uvnanE4M3 | signMaskE4M3, strconv.ErrRange},
+
+	// no infinities
+	{"Inf", uvnanE4M3, strconv.ErrRange},
+	{"-Inf", uvnanE4M3 | signMaskE4M3, strconv.ErrRange},
+
+	// denormalized
+	{"0.002", exactE4M3(0x1p-9), nil}, // min positive denormalized
+	{"0.004", exactE4M3(0x2p-9), nil},
+	{"0.014", exactE4M3(0x7p-9), nil}, // max denormalized
+	{"0.016", exactE4M3(0x1p-6), nil}, // min positive normalized
+	{"0x1p-9", exactE4M3(0x1p-9), nil},
+	{"0x1p-10", exactE4M3(0), nil},                    // round down
+	{"0x1.000001p-10", exactE4M3(0x1p-9), nil},        // round up
+	{"0.0009765625", exactE4M3(0), nil},               // round to even
+	{"0.0009765625000000001", exactE4M3(0x1p-9), nil}, // round up
+	{"1e-10", exactE4M3(0), nil},                      // underflow
+
+	// round to nearest even
+	{"1.0625", exactE4M3(1), nil},
+	{"1.0625000000000000001", exactE4M3(1.125), nil},
+	{"1.1875", exactE4M3(1.25), nil},
+
+	// NaNs
+	{"nan", uvnanE4M3, nil},
+	{"NaN", uvnanE4M3, nil},
+
+	// syntax errors
+	{"", 0, strconv.ErrSyntax},
+	{"1x", 0, strconv.ErrSyntax},
+	{"0x", 0, strconv.ErrSyntax},
+}
+
+func TestParseFloat8E4M3(t *testing.T) {
+	for _, tt := range parseFloat8E4M3Tests {
+		got, err := ParseFloat8E4M3(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseFloat8E4M3(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseFloat8E4M3" {
+				t.Errorf("ParseFloat8E4M3(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseFloat8E4M3")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseFloat8E4M3(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if got != tt.want || err != tt.err {
+			t.Errorf("ParseFloat8E4M3(%q) = (%v, %v) want (%v, %v)", tt.input, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseFloat8E4M3Prefix(t *testing.T) {
+	for _, tt := range parseFloat8E4M3Tests {
+		if tt.err == strconv.ErrSyntax {
+			continue
+		}
+		input := tt.input + ";1"
+
+		got, n, err := ParseFloat8E4M3Prefix(input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eqE4M3(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat8E4M3Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+
+		got, n, err = ParseFloat8E4M3PrefixBytes([]byte(input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eqE4M3(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat8E4M3PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "-", "1e+", "0x1"} {
+		_, n, err := ParseFloat8E4M3Prefix(input)
+		if n != 0 || err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax {
+			t.Errorf("ParseFloat8E4M3Prefix(%q) = (_, %d, %v) want (_, 0, ErrSyntax)", input, n, err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseFloat8E4M3Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseFloat8E4M3Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseFloat8E4M3PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseFloat8E4M3PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
+func FuzzParseFloat8E4M3(f *testing.F) {
+	for _, tt := range parseFloat8E4M3Tests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseFloat8E4M3(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseFloat8E4M3(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqE4M3(f0, f1) {
+			t.Fatalf("ParseFloat8E4M3(%q) = %v; after String() = %q and ParseFloat8E4M3 = %v", input, f0, s, f1)
+		}
+	})
+}
+
+func BenchmarkParseFloat8E4M3_Decimal(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseFloat8E4M3("339")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseFloat8E4M3_Float(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseFloat8E4M3("3.39778")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestFloat8E4M3_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float8E4M3
+	}{
+		{"0", exactE4M3(0)},
+		{"1.5", exactE4M3(1.5)},
+		{"-2.75", exactE4M3(-2.75)},
+	}
+
+	for _, tt := range tests {
+		var f Float8E4M3
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Float8E4M3.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqE4M3(f, tt.want) {
+			t.Errorf("Float8E4M3.UnmarshalJSON(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// invalid input does not modify the receiver
+	f := exactE4M3(1.5)
+	err := f.UnmarshalJSON([]byte("invalid"))
+	if err == nil {
+		t.Errorf("Float8E4M3.UnmarshalJSON(%q) expected error, got nil", "invalid")
+	}
+	if !eqE4M3(f, exactE4M3(1.5)) {
+		t.Errorf("Float8E4M3.UnmarshalJSON(%q) modified receiver on error: got %v, want %v", "invalid", f, exactE4M3(1.5))
+	}
+}
+
+func TestFloat8E4M3_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float8E4M3
+	}{
+		{"0", exactE4M3(0)},
+		{"1.5", exactE4M3(1.5)},
+		{"-2.75", exactE4M3(-2.75)},
+	}
+
+	for _, tt := range tests {
+		var f Float8E4M3
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Float8E4M3.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqE4M3(f, tt.want) {
+			t.Errorf("Float8E4M3.UnmarshalText(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// invalid input does not modify the receiver
+	f := exactE4M3(1.5)
+	err := f.UnmarshalText([]byte("invalid"))
+	if err == nil {
+		t.Errorf("Float8E4M3.UnmarshalText(%q) expected error, got nil", "invalid")
+	}
+	if !eqE4M3(f, exactE4M3(1.5)) {
+		t.Errorf("Float8E4M3.UnmarshalText(%q) modified receiver on error: got %v, want %v", "invalid", f, exactE4M3(1.5))
+	}
+}
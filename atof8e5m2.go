@@ -0,0 +1,251 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+)
+
+const fnParseFloat8E5M2 = "ParseFloat8E5M2"
+
+func atofE5M2(s string) (f Float8E5M2, n int, err error) {
+	if val, n, ok := special(s); ok {
+		return NewFloat8E5M2(val), n, nil
+	}
+
+	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
+	if !ok {
+		return 0, n, syntaxError(fnParseFloat8E5M2, s[:tokenEnd(s, n)])
+	}
+
+	if hex {
+		f, err := atofE5M2Hex(s[:n], mantissa, exp, neg, trunc)
+		return f, n, err
+	}
+
+	var buf [decimalDigitsE5M2]byte
+	d := decimal{d: buf[:]}
+	if !d.set(s[:n]) {
+		return 0, n, syntaxError(fnParseFloat8E5M2, s[:tokenEnd(s, n)])
+	}
+	f, ovf := d.float8E5M2()
+	if ovf {
+		err = rangeError(fnParseFloat8E5M2, s[:n])
+	}
+	return f, n, err
+}
+
+// atofE5M2Hex converts the hex floating-point string s
+// to a rounded Float8E5M2 value.
+// The string s has already been parsed into a mantissa, exponent, and sign (neg==true for negative).
+// If trunc is true, trailing non-zero bits have been omitted from the mantissa.
+func atofE5M2Hex(s string, mantissa uint64, exp int, neg, trunc bool) (Float8E5M2, error) {
+	const maxExp = maskE5M2 - biasE5M2 - 1
+	const minExp = -biasE5M2 + 1
+	exp += shiftE5M2 // mantissa now implicitly divided by 2^shiftE5M2.
+
+	// Shift mantissa and exponent to bring representation into float range.
+	// Eventually we want a mantissa with a leading 1-bit followed by mantbits other bits.
+	// For rounding, we need two more, where the bottom bit represents
+	// whether that bit or any later bit was non-zero.
+	// (If the mantissa has already lost non-zero bits, trunc is true,
+	// and we OR in a 1 below after shifting left appropriately.)
+	for mantissa != 0 && mantissa>>(shiftE5M2+2) == 0 {
+		mantissa <<= 1
+		exp--
+	}
+	if trunc {
+		mantissa |= 1
+	}
+	for mantissa>>(1+shiftE5M2+2) != 0 {
+		mantissa = mantissa>>1 | mantissa&1
+		exp++
+	}
+
+	// If exponent is too negative,
+	// denormalize in hopes of making it representable.
+	// (The -2 is for the rounding bits.)
+	for mantissa > 1 && exp < minExp-2 {
+		mantissa = mantissa>>1 | mantissa&1
+		exp++
+	}
+
+	// Round using two bottom bits.
+	round := mantissa & 3
+	mantissa >>= 2
+	round |= mantissa & 1 // round to even (round up if mantissa is odd)
+	exp += 2
+	if round == 3 {
+		mantissa++
+		if mantissa == 1<<(1+shiftE5M2) {
+			mantissa >>= 1
+			exp++
+		}
+	}
+
+	if mantissa>>shiftE5M2 == 0 { // Denormal or zero.
+		exp = -biasE5M2
+	}
+	var err error
+	if exp > maxExp { // infinity and range error
+		mantissa = 1 << shiftE5M2
+		exp = maxExp + 1
+		err = rangeError(fnParseFloat8E5M2, s)
+	}
+
+	bits := mantissa & fracMaskE5M2
+	bits |= uint64((exp+biasE5M2)&maskE5M2) << shiftE5M2
+	if neg {
+		bits |= signMaskE5M2
+	}
+
+	return Float8E5M2(bits), err
+}
+
+func (d *decimal) float8E5M2() (f Float8E5M2, overflow bool) {
+	var exp int
+	var mant uint8
+
+	// Zero is always a special case.
+	if d.nd == 0 {
+		mant = 0
+		exp = -biasE5M2
+		goto out
+	}
+
+	// Obvious overflow/underflow.
+	if d.dp > 5 {
+		goto overflow
+	}
+	if d.dp < -5 {
+		// underflow to zero
+		mant = 0
+		exp = -biasE5M2
+		goto out
+	}
+
+	// Scale by powers of two until in range [0.5, 1.0)
+	exp = 0
+	for d.dp > 0 {
+		var n int
+		if d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[d.dp]
+		}
+		d.Shift(-n)
+		exp += n
+	}
+	for d.dp < 0 || d.dp == 0 && d.d[0] < '5' {
+		var n int
+		if -d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[-d.dp]
+		}
+		d.Shift(n)
+		exp -= n
+	}
+
+	// Our range is [0.5,1) but floating point range is [1,2).
+	exp--
+
+	// Minimum representable exponent is -biasE5M2+1.
+	// If the exponent is smaller, move it up and
+	// adjust d accordingly.
+	if exp < -biasE5M2+1 {
+		n := (-biasE5M2 + 1) - exp
+		d.Shift(-n)
+		exp += n
+	}
+
+	// Check for overflow.
+	if exp >= maskE5M2-biasE5M2 {
+		goto overflow
+	}
+
+	// Extract 1+shiftE5M2 bits of mantissa.
+	d.Shift(1 + shiftE5M2)
+	mant = uint8(d.RoundedUint16())
+
+	// Rounding might have added a bit; shift down.
+	if mant == 2<<shiftE5M2 {
+		mant >>= 1
+		exp++
+		if exp >= maskE5M2-biasE5M2 {
+			goto overflow
+		}
+	}
+
+	// Denormalized?
+	if mant&(1<<shiftE5M2) == 0 {
+		exp = -biasE5M2
+	}
+	goto out
+
+overflow:
+	// ±Inf
+	mant = 0
+	exp = maskE5M2 - biasE5M2
+	overflow = true
+
+out:
+	// Assemble bits.
+	bits := mant & fracMaskE5M2
+	bits |= uint8((exp+biasE5M2)&maskE5M2) << shiftE5M2
+	if d.neg {
+		bits |= signMaskE5M2
+	}
+	return Float8E5M2(bits), overflow
+}
+
+// ParseFloat8E5M2 parses s as a Float8E5M2.
+func ParseFloat8E5M2(s string) (Float8E5M2, error) {
+	f, n, err := atofE5M2(s)
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat8E5M2(0), syntaxError(fnParseFloat8E5M2, s)
+	}
+	return f, err
+}
+
+// ParseFloat8E5M2Prefix parses the longest prefix of s that is a floating-point number as a Float8E5M2.
+// Unlike [ParseFloat8E5M2], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseFloat8E5M2Prefix(s string) (f Float8E5M2, n int, err error) {
+	f, n, err = atofE5M2(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewFloat8E5M2(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseFloat8E5M2PrefixBytes is like [ParseFloat8E5M2Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseFloat8E5M2PrefixBytes(b []byte) (f Float8E5M2, n int, err error) {
+	return ParseFloat8E5M2Prefix(unsafeString(b))
+}
+
+var _ json.Unmarshaler = (*Float8E5M2)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *Float8E5M2) UnmarshalJSON(data []byte) error {
+	ret, err := ParseFloat8E5M2(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*Float8E5M2)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Float8E5M2) UnmarshalText(data []byte) error {
+	ret, err := ParseFloat8E5M2(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
@@ -0,0 +1,249 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var parseFloat8E5M2Tests = []struct {
+	input string
+	want  Float8E5M2
+	err   error
+}{
+	{"0", exactE5M2(0), nil},
+	{"-0", exactE5M2(math.Copysign(0, -1)), nil},
+	{"1", exactE5M2(1.0), nil},
+	{"1.5", exactE5M2(1.5), nil},
+	{"-2.5", exactE5M2(-2.5), nil},
+	{"10", exactE5M2(10), nil},
+	{"100", exactE5M2(96), nil},
+	{"1000", exactE5M2(1024), nil},
+	{"57344", exactE5M2(57344), nil},     // max finite value
+	{"0x1.cp+15", exactE5M2(57344), nil}, // max finite value (hex)
+	{"61439", exactE5M2(57344), nil},
+
+	// next float8 e5m2 - too large
+	{"+61440", uvinfE5M2, strconv.ErrRange},
+	{"-61440", uvneginfE5M2, strconv.ErrRange},
+	{"+0x1.ep+15", uvinfE5M2, strconv.ErrRange},
+	{"-0x1.ep+15", uvneginfE5M2, strconv.ErrRange},
+
+	// infinities
+	{"Inf", uvinfE5M2, nil},
+	{"-Inf", uvneginfE5M2, nil},
+
+	// denormalized
+	{"2e-05", exactE5M2(0x1p-16), nil}, // min positive denormalized
+	{"3e-05", exactE5M2(0x2p-16), nil},
+	{"5e-05", exactE5M2(0x3p-16), nil}, // max denormalized
+	{"6e-05", exactE5M2(0x1p-14), nil}, // min positive normalized
+	{"0x1p-16", exactE5M2(0x1p-16), nil},
+	{"0x1p-17", exactE5M2(0), nil},              // round down
+	{"0x1.000001p-17", exactE5M2(0x1p-16), nil}, // round up
+	{"1e-10", exactE5M2(0), nil},                // underflow
+
+	// round to nearest even
+	{"1.125", exactE5M2(1), nil},
+	{"1.1250000000000000001", exactE5M2(1.25), nil},
+	{"1.375", exactE5M2(1.5), nil},
+
+	// NaNs
+	{"nan", uvnanE5M2, nil},
+	{"NaN", uvnanE5M2, nil},
+
+	// syntax errors
+	{"", 0, strconv.ErrSyntax},
+	{"1x", 0, strconv.ErrSyntax},
+	{"0x", 0, strconv.ErrSyntax},
+}
+
+func TestParseFloat8E5M2(t *testing.T) {
+	for _, tt := range parseFloat8E5M2Tests {
+		got, err := ParseFloat8E5M2(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseFloat8E5M2(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseFloat8E5M2" {
+				t.Errorf("ParseFloat8E5M2(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseFloat8E5M2")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseFloat8E5M2(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if !eqE5M2(got, tt.want) || err != tt.err {
+			t.Errorf("ParseFloat8E5M2(%q) = (%v, %v) want (%v, %v)", tt.input, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseFloat8E5M2Prefix(t *testing.T) {
+	for _, tt := range parseFloat8E5M2Tests {
+		if tt.err == strconv.ErrSyntax {
+			continue
+		}
+		input := tt.input + ";1"
+
+		got, n, err := ParseFloat8E5M2Prefix(input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eqE5M2(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat8E5M2Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+
+		got, n, err = ParseFloat8E5M2PrefixBytes([]byte(input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eqE5M2(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseFloat8E5M2PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "-", "1e+", "0x1"} {
+		_, n, err := ParseFloat8E5M2Prefix(input)
+		if n != 0 || err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax {
+			t.Errorf("ParseFloat8E5M2Prefix(%q) = (_, %d, %v) want (_, 0, ErrSyntax)", input, n, err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseFloat8E5M2Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseFloat8E5M2Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseFloat8E5M2PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseFloat8E5M2PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
+func FuzzParseFloat8E5M2(f *testing.F) {
+	for _, tt := range parseFloat8E5M2Tests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseFloat8E5M2(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseFloat8E5M2(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqE5M2(f0, f1) {
+			t.Fatalf("ParseFloat8E5M2(%q) = %v; after String() = %q and ParseFloat8E5M2 = %v", input, f0, s, f1)
+		}
+	})
+}
+
+func BenchmarkParseFloat8E5M2_Decimal(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseFloat8E5M2("339")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseFloat8E5M2_Float(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseFloat8E5M2("3.39778")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestFloat8E5M2_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float8E5M2
+	}{
+		{"0", exactE5M2(0)},
+		{"1.5", exactE5M2(1.5)},
+		{"-2.5", exactE5M2(-2.5)},
+	}
+
+	for _, tt := range tests {
+		var f Float8E5M2
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Float8E5M2.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqE5M2(f, tt.want) {
+			t.Errorf("Float8E5M2.UnmarshalJSON(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// invalid input does not modify the receiver
+	f := exactE5M2(1.5)
+	err := f.UnmarshalJSON([]byte("invalid"))
+	if err == nil {
+		t.Errorf("Float8E5M2.UnmarshalJSON(%q) expected error, got nil", "invalid")
+	}
+	if !eqE5M2(f, exactE5M2(1.5)) {
+		t.Errorf("Float8E5M2.UnmarshalJSON(%q) modified receiver on error: got %v, want %v", "invalid", f, exactE5M2(1.5))
+	}
+}
+
+func TestFloat8E5M2_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Float8E5M2
+	}{
+		{"0", exactE5M2(0)},
+		{"1.5", exactE5M2(1.5)},
+		{"-2.5", exactE5M2(-2.5)},
+	}
+
+	for _, tt := range tests {
+		var f Float8E5M2
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Float8E5M2.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqE5M2(f, tt.want) {
+			t.Errorf("Float8E5M2.UnmarshalText(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// invalid input does not modify the receiver
+	f := exactE5M2(1.5)
+	err := f.UnmarshalText([]byte("invalid"))
+	if err == nil {
+		t.Errorf("Float8E5M2.UnmarshalText(%q) expected error, got nil", "invalid")
+	}
+	if !eqE5M2(f, exactE5M2(1.5)) {
+		t.Errorf("Float8E5M2.UnmarshalText(%q) modified receiver on error: got %v, want %v", "invalid", f, exactE5M2(1.5))
+	}
+}
@@ -0,0 +1,249 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+)
+
+const fnParseBFloat16 = "ParseBFloat16"
+
+func atofBF16(s string) (f BFloat16, n int, err error) {
+	if val, n, ok := special(s); ok {
+		return NewBFloat16(val), n, nil
+	}
+
+	mantissa, exp, neg, trunc, hex, n, ok := readFloat(s)
+	if !ok {
+		return 0, n, syntaxError(fnParseBFloat16, s[:tokenEnd(s, n)])
+	}
+
+	if hex {
+		f, err := atofBF16Hex(s[:n], mantissa, exp, neg, trunc)
+		return f, n, err
+	}
+
+	var buf [decimalDigitsBF16]byte
+	d := decimal{d: buf[:]}
+	if !d.set(s[:n]) {
+		return 0, n, syntaxError(fnParseBFloat16, s[:tokenEnd(s, n)])
+	}
+	f, ovf := d.bfloat16()
+	if ovf {
+		err = rangeError(fnParseBFloat16, s[:n])
+	}
+	return f, n, err
+}
+
+// atofHex converts the hex floating-point string s
+// to a rounded bfloat16 value and returns it as a bfloat16.
+// The string s has already been parsed into a mantissa, exponent, and sign (neg==true for negative).
+// If trunc is true, trailing non-zero bits have been omitted from the mantissa.
+func atofBF16Hex(s string, mantissa uint64, exp int, neg, trunc bool) (BFloat16, error) {
+	const maxExp = maskBF16 - biasBF16 - 1
+	const minExp = -biasBF16 + 1
+	exp += shiftBF16 // mantissa now implicitly divided by 2^shiftBF16.
+
+	// Shift mantissa and exponent to bring representation into float range.
+	// Eventually we want a mantissa with a leading 1-bit followed by mantbits other bits.
+	// For rounding, we need two more, where the bottom bit represents
+	// whether that bit or any later bit was non-zero.
+	// (If the mantissa has already lost non-zero bits, trunc is true,
+	// and we OR in a 1 below after shifting left appropriately.)
+	for mantissa != 0 && mantissa>>(shiftBF16+2) == 0 {
+		mantissa <<= 1
+		exp--
+	}
+	if trunc {
+		mantissa |= 1
+	}
+	for mantissa>>(1+shiftBF16+2) != 0 {
+		mantissa = mantissa>>1 | mantissa&1
+		exp++
+	}
+
+	// If exponent is too negative,
+	// denormalize in hopes of making it representable.
+	// (The -2 is for the rounding bits.)
+	for mantissa > 1 && exp < minExp-2 {
+		mantissa = mantissa>>1 | mantissa&1
+		exp++
+	}
+
+	// Round using two bottom bits.
+	round := mantissa & 3
+	mantissa >>= 2
+	round |= mantissa & 1 // round to even (round up if mantissa is odd)
+	exp += 2
+	if round == 3 {
+		mantissa++
+		if mantissa == 1<<(1+shiftBF16) {
+			mantissa >>= 1
+			exp++
+		}
+	}
+
+	if mantissa>>shiftBF16 == 0 { // Denormal or zero.
+		exp = -biasBF16
+	}
+	var err error
+	if exp > maxExp { // infinity and range error
+		mantissa = 1 << shiftBF16
+		exp = maxExp + 1
+		err = rangeError(fnParseBFloat16, s)
+	}
+
+	bits := mantissa & fracMaskBF16
+	bits |= uint64((exp+biasBF16)&maskBF16) << shiftBF16
+	if neg {
+		bits |= signMaskBF16
+	}
+
+	return BFloat16(bits), err
+}
+
+func (d *decimal) bfloat16() (f BFloat16, overflow bool) {
+	var exp int
+	var mant uint16
+
+	// Zero is always a special case.
+	if d.nd == 0 {
+		mant = 0
+		exp = -biasBF16
+		goto out
+	}
+
+	// Obvious overflow/underflow.
+	if d.dp > 39 {
+		goto overflow
+	}
+	if d.dp < -42 {
+		// underflow to zero
+		mant = 0
+		exp = -biasBF16
+		goto out
+	}
+
+	// Scale by powers of two until in range [0.5, 1.0)
+	exp = 0
+	for d.dp > 0 {
+		var n int
+		if d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[d.dp]
+		}
+		d.Shift(-n)
+		exp += n
+	}
+	for d.dp < 0 || d.dp == 0 && d.d[0] < '5' {
+		var n int
+		if -d.dp >= len(powtab) {
+			n = 27
+		} else {
+			n = powtab[-d.dp]
+		}
+		d.Shift(n)
+		exp -= n
+	}
+
+	// Our range is [0.5,1) but floating point range is [1,2).
+	exp--
+
+	// Minimum representable exponent is -biasBF16+1.
+	// If the exponent is smaller, move it up and
+	// adjust d accordingly.
+	if exp < -biasBF16+1 {
+		n := (-biasBF16 + 1) - exp
+		d.Shift(-n)
+		exp += n
+	}
+
+	// Check for overflow.
+	if exp >= maskBF16-biasBF16 {
+		goto overflow
+	}
+
+	// Extract 1+shiftBF16 bits of mantissa.
+	d.Shift(1 + shiftBF16)
+	mant = d.RoundedUint16()
+
+	// Rounding might have added a bit; shift down.
+	if mant == 2<<shiftBF16 {
+		mant >>= 1
+		exp++
+		if exp >= maskBF16-biasBF16 {
+			goto overflow
+		}
+	}
+
+	// Denormalized?
+	if mant&(1<<shiftBF16) == 0 {
+		exp = -biasBF16
+	}
+	goto out
+
+overflow:
+	// ±Inf
+	mant = 0
+	exp = maskBF16 - biasBF16
+	overflow = true
+
+out:
+	// Assemble bits.
+	bits := mant & fracMaskBF16
+	bits |= uint16((exp+biasBF16)&maskBF16) << shiftBF16
+	if d.neg {
+		bits |= signMaskBF16
+	}
+	return BFloat16(bits), overflow
+}
+
+// ParseBFloat16 parses s as a BFloat16.
+func ParseBFloat16(s string) (BFloat16, error) {
+	f, n, err := atofBF16(s)
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewBFloat16(0), syntaxError(fnParseBFloat16, s)
+	}
+	return f, err
+}
+
+// ParseBFloat16Prefix parses the longest prefix of s that is a floating-point number as a BFloat16.
+// Unlike [ParseBFloat16], it accepts trailing characters, and reports the number of bytes consumed in n.
+// If s doesn't start with a number, n is 0 and err.Err = ErrSyntax.
+// The Num field of err is the attempted token, not the rest of s.
+func ParseBFloat16Prefix(s string) (f BFloat16, n int, err error) {
+	f, n, err = atofBF16(s)
+	if err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewBFloat16(0), 0, err
+	}
+	return f, n, err
+}
+
+// ParseBFloat16PrefixBytes is like [ParseBFloat16Prefix], but parses a byte slice.
+// It doesn't convert b to a string, and doesn't allocate unless it returns an error.
+func ParseBFloat16PrefixBytes(b []byte) (f BFloat16, n int, err error) {
+	return ParseBFloat16Prefix(unsafeString(b))
+}
+
+var _ json.Unmarshaler = (*BFloat16)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// JSON strings are parsed by [ParseJSON], and the others by [ParseBFloat16],
+// so NaN and hexadecimal numbers without quotes are accepted too.
+// The JSON null is an error.
+func (a *BFloat16) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON(a, data)
+}
+
+var _ encoding.TextUnmarshaler = (*BFloat16)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *BFloat16) UnmarshalText(data []byte) error {
+	ret, err := ParseBFloat16(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
@@ -0,0 +1,343 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var parseBFloat16Tests = []struct {
+	input string
+	want  BFloat16
+	err   error
+}{
+	{"0", exactBF16(0), nil},
+	{"-0", exactBF16(math.Copysign(0, -1)), nil},
+	{"1", exactBF16(1.0), nil},
+	{"1.5", exactBF16(1.5), nil},
+	{"-2.75", exactBF16(-2.75), nil},
+	{"10", exactBF16(10), nil},
+	{"100", exactBF16(100), nil},
+	{"1000", exactBF16(1000), nil},
+	{"10000", exactBF16(9984), nil},
+	{"3.39e+38", exactBF16(0x1.fep127), nil},    // max finite value
+	{"0x1.fep+127", exactBF16(0x1.fep127), nil}, // max finite value (hex)
+	{"8190", exactBF16(8192), nil},
+
+	// next bfloat16 - too large
+	{"+3.41e+38", exactBF16(math.Inf(1)), strconv.ErrRange},
+	{"-3.41e+38", exactBF16(math.Inf(-1)), strconv.ErrRange},
+	{"+0x1.ffp+127", exactBF16(math.Inf(1)), strconv.ErrRange},
+	{"-0x1.ffp+127", exactBF16(math.Inf(-1)), strconv.ErrRange},
+
+	// denormalized
+	{"1e-40", exactBF16(0x1p-133), nil}, // min positive denormalized
+	{"2e-40", exactBF16(0x2p-133), nil},
+	{"3e-40", exactBF16(0x3p-133), nil},
+	{"1.17e-38", exactBF16(0x7fp-133), nil}, // max denormalized
+	{"1.18e-38", exactBF16(0x1p-126), nil},  // min positive normalized
+	{"0x1p-133", exactBF16(0x1p-133), nil},
+	{"0x1p-134", exactBF16(0), nil},               // round down
+	{"0x1.000001p-134", exactBF16(0x1p-133), nil}, // round up
+
+	// Hexadecimal floating-point.
+	{"0x1p+0", exactBF16(1.0), nil},
+	{"0x1p1", exactBF16(2.0), nil},
+	{"0x1.8p+1", exactBF16(3.0), nil},
+	{"0x1p-1", exactBF16(0.5), nil},
+	{"-0x2p3", exactBF16(-16), nil},
+	{"0x0.fp4", exactBF16(15), nil},
+	{"0x1e2", exactBF16(0), strconv.ErrSyntax}, // missing 'p' exponent
+	{"1p2", exactBF16(0), strconv.ErrSyntax},   // missing '0x' prefix
+
+	// Rounding
+	{"0x1.01p+00", exactBF16(0x1.00p00), nil},      // round down
+	{"0x1.0100001p+00", exactBF16(0x1.02p00), nil}, // round up
+	{"0x1.02fffffp+00", exactBF16(0x1.02p00), nil}, // round down
+	{"0x1.03p+00", exactBF16(0x1.04p00), nil},      // round up
+	{"0x1.ffp00", exactBF16(2), nil},               // round up
+	{"0x12.3__45p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345_p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p_+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p+_12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p_-12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p-_12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p+1__2", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p+12_", exactBF16(0), strconv.ErrSyntax},
+
+	// NaNs
+	{"nan", exactBF16(math.NaN()), nil},
+	{"NaN", exactBF16(math.NaN()), nil},
+	{"NAN", exactBF16(math.NaN()), nil},
+
+	// Infs
+	{"Inf", exactBF16(math.Inf(1)), nil},
+	{"-Inf", exactBF16(math.Inf(-1)), nil},
+	{"+INF", exactBF16(math.Inf(1)), nil},
+	{"-Infinity", exactBF16(math.Inf(-1)), nil},
+	{"+INFINITY", exactBF16(math.Inf(1)), nil},
+	{"Infinity", exactBF16(math.Inf(1)), nil},
+
+	// try to overflow exponent
+	{"1e-4294967296", exactBF16(0), nil},
+	{"1e+4294967296", exactBF16(math.Inf(1)), strconv.ErrRange},
+	{"1e-18446744073709551616", exactBF16(0), nil},
+	{"1e+18446744073709551616", exactBF16(math.Inf(1)), strconv.ErrRange},
+	{"0x1p-4294967296", exactBF16(0), nil},
+	{"0x1p+4294967296", exactBF16(math.Inf(1)), strconv.ErrRange},
+	{"0x1p-18446744073709551616", exactBF16(0), nil},
+	{"0x1p+18446744073709551616", exactBF16(math.Inf(1)), strconv.ErrRange},
+
+	// Parse errors
+	{"1e", exactBF16(0), strconv.ErrSyntax},
+	{"1e-", exactBF16(0), strconv.ErrSyntax},
+	{".e-1", exactBF16(0), strconv.ErrSyntax},
+	{"1\x00.2", exactBF16(0), strconv.ErrSyntax},
+	{"0x", exactBF16(0), strconv.ErrSyntax},
+	{"0x.", exactBF16(0), strconv.ErrSyntax},
+	{"0x1", exactBF16(0), strconv.ErrSyntax},
+	{"0x.1", exactBF16(0), strconv.ErrSyntax},
+	{"0x1p", exactBF16(0), strconv.ErrSyntax},
+	{"0x.1p", exactBF16(0), strconv.ErrSyntax},
+	{"0x1p+", exactBF16(0), strconv.ErrSyntax},
+	{"0x.1p+", exactBF16(0), strconv.ErrSyntax},
+	{"0x1p-", exactBF16(0), strconv.ErrSyntax},
+	{"0x.1p-", exactBF16(0), strconv.ErrSyntax},
+	{"0x1p+2", exactBF16(4), nil},
+	{"0x.1p+2", exactBF16(0.25), nil},
+	{"0x1p-2", exactBF16(0.25), nil},
+	{"0x.1p-2", exactBF16(0.015625), nil},
+
+	// Underscores.
+	{"1_00.00_0_0e+0_2", exactBF16(9984), nil},
+	{"-_123.5e+12", exactBF16(0), strconv.ErrSyntax},
+	{"+_123.5e+12", exactBF16(0), strconv.ErrSyntax},
+	{"_123.5e+12", exactBF16(0), strconv.ErrSyntax},
+	{"1__23.5e+12", exactBF16(0), strconv.ErrSyntax},
+	{"123_.5e+12", exactBF16(0), strconv.ErrSyntax},
+	{"123._5e+12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5_e+12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5__0e+12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5e_+12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5e+_12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5e_-12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5e-_12", exactBF16(0), strconv.ErrSyntax},
+	{"123.5e+1__2", exactBF16(0), strconv.ErrSyntax},
+	{"123.5e+12_", exactBF16(0), strconv.ErrSyntax},
+
+	{"0x_0_1.2_3_4p+1_2", exactBF16(4672), nil},
+	{"-_0x12.345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"+_0x12.345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"_0x12.345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x__12.345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x1__2.345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12_.345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12._345p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.3__45p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345_p+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p_+12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p+_12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p_-12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p-_12", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p+1__2", exactBF16(0), strconv.ErrSyntax},
+	{"0x12.345p+12_", exactBF16(0), strconv.ErrSyntax},
+}
+
+func TestParseBFloat16(t *testing.T) {
+	for _, tt := range parseBFloat16Tests {
+		got, err := ParseBFloat16(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseBFloat16(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseBFloat16" {
+				t.Errorf("ParseBFloat16(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseBFloat16")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseBFloat16(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if !eqBF16(got, tt.want) || err != tt.err {
+			t.Errorf("ParseBFloat16(%q) = (%v, %v) want (%v, %v)", tt.input, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseBFloat16Prefix(t *testing.T) {
+	for _, tt := range parseBFloat16Tests {
+		if tt.err == strconv.ErrSyntax {
+			continue
+		}
+		input := tt.input + ";1"
+
+		got, n, err := ParseBFloat16Prefix(input)
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eqBF16(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseBFloat16Prefix(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+
+		got, n, err = ParseBFloat16PrefixBytes([]byte(input))
+		if err != nil {
+			err = err.(*strconv.NumError).Err
+		}
+		if !eqBF16(got, tt.want) || n != len(tt.input) || err != tt.err {
+			t.Errorf("ParseBFloat16PrefixBytes(%q) = (%v, %d, %v) want (%v, %d, %v)", input, got, n, err, tt.want, len(tt.input), tt.err)
+		}
+	}
+
+	for _, input := range []string{"", "abc", "-", "1e+", "0x1"} {
+		_, n, err := ParseBFloat16Prefix(input)
+		if n != 0 || err == nil || err.(*strconv.NumError).Err != strconv.ErrSyntax {
+			t.Errorf("ParseBFloat16Prefix(%q) = (_, %d, %v) want (_, 0, ErrSyntax)", input, n, err)
+		}
+	}
+
+	// the errors contain only the attempted token, not the rest of the input.
+	tail := strings.Repeat(";1", 1000)
+	for _, tt := range []struct{ input, num string }{
+		{"abc", "a"},
+		{"-", "-;"},
+		{"1e+", "1e+;"},
+		{"0x1", "0x1;"},
+		{"-é", "-é"},
+		{"1e100000", "1e100000"},
+	} {
+		_, _, err := ParseBFloat16Prefix(tt.input + tail)
+		if numErr, ok := err.(*strconv.NumError); !ok || numErr.Num != tt.num {
+			t.Errorf("ParseBFloat16Prefix(%q + tail) returns %v; want Num = %q", tt.input, err, tt.num)
+		}
+	}
+
+	buf := []byte("0x1.8p-1 1.25e-1 ")
+	allocs := testing.AllocsPerRun(100, func() {
+		for b := buf; len(b) > 0; {
+			_, n, err := ParseBFloat16PrefixBytes(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b = b[n+1:]
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("ParseBFloat16PrefixBytes allocates %v times; want 0", allocs)
+	}
+}
+
+func FuzzParseBFloat16(f *testing.F) {
+	for _, tt := range parseBFloat16Tests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseBFloat16(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseBFloat16(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqBF16(f0, f1) {
+			t.Fatalf("ParseBFloat16(%q) = %v; after String() = %q and ParseBFloat16 = %v", input, f0, s, f1)
+		}
+	})
+}
+
+func BenchmarkParseBFloat16_Decimal(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseBFloat16("33909")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseBFloat16_Float(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseBFloat16("339.778")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseBFloat16_FloatExp(b *testing.B) {
+	for b.Loop() {
+		_, err := ParseBFloat16("-5.09e-3")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestBFloat16_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  BFloat16
+	}{
+		{"0", exactBF16(0)},
+		{"1.5", exactBF16(1.5)},
+		{"-2.75", exactBF16(-2.75)},
+	}
+
+	for _, tt := range tests {
+		var f BFloat16
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("BFloat16.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqBF16(f, tt.want) {
+			t.Errorf("BFloat16.UnmarshalJSON(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// invalid input does not modify the receiver
+	f := exactBF16(1.5)
+	err := f.UnmarshalJSON([]byte("invalid"))
+	if err == nil {
+		t.Errorf("BFloat16.UnmarshalJSON(%q) expected error, got nil", "invalid")
+	}
+	if !eqBF16(f, exactBF16(1.5)) {
+		t.Errorf("BFloat16.UnmarshalJSON(%q) modified receiver on error: got %v, want %v", "invalid", f, exactBF16(1.5))
+	}
+}
+
+func TestBFloat16_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  BFloat16
+	}{
+		{"0", exactBF16(0)},
+		{"1.5", exactBF16(1.5)},
+		{"-2.75", exactBF16(-2.75)},
+	}
+
+	for _, tt := range tests {
+		var f BFloat16
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("BFloat16.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqBF16(f, tt.want) {
+			t.Errorf("BFloat16.UnmarshalText(%q) = %v; want %v", tt.input, f, tt.want)
+		}
+	}
+
+	// invalid input does not modify the receiver
+	f := exactBF16(1.5)
+	err := f.UnmarshalText([]byte("invalid"))
+	if err == nil {
+		t.Errorf("BFloat16.UnmarshalText(%q) expected error, got nil", "invalid")
+	}
+	if !eqBF16(f, exactBF16(1.5)) {
+		t.Errorf("BFloat16.UnmarshalText(%q) modified receiver on error: got %v, want %v", "invalid", f, exactBF16(1.5))
+	}
+}
@@ -0,0 +1,47 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+)
+
+const fnParseDecimal128 = "ParseDecimal128"
+
+// ParseDecimal128 parses s as a Decimal128, rounding ties to even.
+// All the digits of s are significant, so the exponent of the result follows s,
+// e.g. "1.20" is parsed as 120×10^-2, and "1e3" is parsed as 1×10^3.
+func ParseDecimal128(s string) (Decimal128, error) {
+	x, flags, ok := decForm128.parse(s)
+	if !ok {
+		return NewDecimal128(0, 0), syntaxError(fnParseDecimal128, s)
+	}
+	ret := pack128(x)
+	if flags&Overflow != 0 {
+		return ret, rangeError(fnParseDecimal128, s)
+	}
+	return ret, nil
+}
+
+var _ json.Unmarshaler = (*Decimal128)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *Decimal128) UnmarshalJSON(data []byte) error {
+	ret, err := ParseDecimal128(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*Decimal128)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Decimal128) UnmarshalText(data []byte) error {
+	ret, err := ParseDecimal128(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
@@ -0,0 +1,47 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+)
+
+const fnParseDecimal32 = "ParseDecimal32"
+
+// ParseDecimal32 parses s as a Decimal32, rounding ties to even.
+// All the digits of s are significant, so the exponent of the result follows s,
+// e.g. "1.20" is parsed as 120×10^-2, and "1e3" is parsed as 1×10^3.
+func ParseDecimal32(s string) (Decimal32, error) {
+	x, flags, ok := decForm32.parse(s)
+	if !ok {
+		return NewDecimal32(0, 0), syntaxError(fnParseDecimal32, s)
+	}
+	ret := pack32(x)
+	if flags&Overflow != 0 {
+		return ret, rangeError(fnParseDecimal32, s)
+	}
+	return ret, nil
+}
+
+var _ json.Unmarshaler = (*Decimal32)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *Decimal32) UnmarshalJSON(data []byte) error {
+	ret, err := ParseDecimal32(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*Decimal32)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Decimal32) UnmarshalText(data []byte) error {
+	ret, err := ParseDecimal32(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
@@ -0,0 +1,47 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+)
+
+const fnParseDecimal64 = "ParseDecimal64"
+
+// ParseDecimal64 parses s as a Decimal64, rounding ties to even.
+// All the digits of s are significant, so the exponent of the result follows s,
+// e.g. "1.20" is parsed as 120×10^-2, and "1e3" is parsed as 1×10^3.
+func ParseDecimal64(s string) (Decimal64, error) {
+	x, flags, ok := decForm64.parse(s)
+	if !ok {
+		return NewDecimal64(0, 0), syntaxError(fnParseDecimal64, s)
+	}
+	ret := pack64(x)
+	if flags&Overflow != 0 {
+		return ret, rangeError(fnParseDecimal64, s)
+	}
+	return ret, nil
+}
+
+var _ json.Unmarshaler = (*Decimal64)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *Decimal64) UnmarshalJSON(data []byte) error {
+	ret, err := ParseDecimal64(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*Decimal64)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *Decimal64) UnmarshalText(data []byte) error {
+	ret, err := ParseDecimal64(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
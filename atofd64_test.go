@@ -0,0 +1,151 @@
+package floats
+
+import (
+	"strconv"
+	"testing"
+)
+
+var parseDecimal64Tests = []struct {
+	input string
+	want  Decimal64
+	err   error
+}{
+	{"0", NewDecimal64(0, 0), nil},
+	{"-0", NewDecimal64FromBits(0xb1c0_0000_0000_0000), nil},
+	{"1", NewDecimal64(1, 0), nil},
+	{"1.20", NewDecimal64(120, -2), nil},
+	{"-2.75", NewDecimal64(-275, -2), nil},
+	{".5", NewDecimal64(5, -1), nil},
+	{"5.", NewDecimal64(5, 0), nil},
+	{"1e3", NewDecimal64(1, 3), nil},
+	{"1.0E+3", NewDecimal64(10, 2), nil},
+	{"0.000", NewDecimal64(0, -3), nil},
+	{"9999999999999999", NewDecimal64(9999999999999999, 0), nil},
+	{"12345678901234567", NewDecimal64(1234567890123457, 1), nil},
+	{"12345678901234565", NewDecimal64(1234567890123456, 1), nil}, // ties to even
+	{"1234567890123456500000000000000000001", NewDecimal64(1234567890123457, 21), nil},
+	{"9.999999999999999e384", NewDecimal64(9999999999999999, 369), nil}, // max
+	{"1e384", NewDecimal64(1000000000000000, 369), nil},                 // clamped
+	{"1e-398", NewDecimal64(1, -398), nil},                              // min subnormal
+	{"1e-399", NewDecimal64(0, -398), nil},                              // rounds down to zero
+	{"6e-399", NewDecimal64(1, -398), nil},                              // rounds up to min subnormal
+	{"0e-1000", NewDecimal64(0, -398), nil},
+	{"0e+1000", NewDecimal64(0, 369), nil},
+
+	// too large
+	{"1e385", NewDecimal64Inf(1), strconv.ErrRange},
+	{"-1e385", NewDecimal64Inf(-1), strconv.ErrRange},
+	{"1e+18446744073709551616", NewDecimal64Inf(1), strconv.ErrRange},
+
+	// NaNs
+	{"nan", NewDecimal64NaN(), nil},
+	{"NaN", NewDecimal64NaN(), nil},
+
+	// Infs
+	{"Inf", NewDecimal64Inf(1), nil},
+	{"-Inf", NewDecimal64Inf(-1), nil},
+	{"+INFINITY", NewDecimal64Inf(1), nil},
+
+	// Parse errors
+	{"", NewDecimal64(0, 0), strconv.ErrSyntax},
+	{"1e", NewDecimal64(0, 0), strconv.ErrSyntax},
+	{".e-1", NewDecimal64(0, 0), strconv.ErrSyntax},
+	{"0x1p0", NewDecimal64(0, 0), strconv.ErrSyntax},
+	{"1_000", NewDecimal64(0, 0), strconv.ErrSyntax},
+}
+
+func TestParseDecimal64(t *testing.T) {
+	for _, tt := range parseDecimal64Tests {
+		got, err := ParseDecimal64(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseDecimal64(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseDecimal64" {
+				t.Errorf("ParseDecimal64(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseDecimal64")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseDecimal64(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if got.Bits() != tt.want.Bits() || err != tt.err {
+			t.Errorf("ParseDecimal64(%q) = (%x, %v) want (%x, %v)", tt.input, got.Bits(), err, tt.want.Bits(), tt.err)
+		}
+	}
+}
+
+func FuzzParseDecimal64(f *testing.F) {
+	for _, tt := range parseDecimal64Tests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseDecimal64(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseDecimal64(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the formatting preserves the cohort, so the encodings must match exactly.
+		if f0.Bits() != f1.Bits() {
+			t.Fatalf("ParseDecimal64(%q) = %x; after String() = %q and ParseDecimal64 = %x", input, f0.Bits(), s, f1.Bits())
+		}
+	})
+}
+
+func TestDecimal64_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Decimal64
+	}{
+		{"0", NewDecimal64(0, 0)},
+		{"1.50", NewDecimal64(150, -2)},
+		{"-1e-5", NewDecimal64(-1, -5)},
+	}
+
+	for _, tt := range tests {
+		var f Decimal64
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Decimal64.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if f.Bits() != tt.want.Bits() {
+			t.Errorf("Decimal64.UnmarshalJSON(%q) = %x; want %x", tt.input, f.Bits(), tt.want.Bits())
+		}
+	}
+
+	var f Decimal64
+	if err := f.UnmarshalJSON([]byte(`"1"`)); err == nil {
+		t.Errorf("Decimal64.UnmarshalJSON(%q) expected error", `"1"`)
+	}
+}
+
+func TestDecimal64_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  Decimal64
+	}{
+		{"0", NewDecimal64(0, 0)},
+		{"1.50", NewDecimal64(150, -2)},
+		{"-Inf", NewDecimal64Inf(-1)},
+		{"NaN", NewDecimal64NaN()},
+	}
+
+	for _, tt := range tests {
+		var f Decimal64
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("Decimal64.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if f.Bits() != tt.want.Bits() {
+			t.Errorf("Decimal64.UnmarshalText(%q) = %x; want %x", tt.input, f.Bits(), tt.want.Bits())
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+)
+
+const fnParseDoubleDouble = "ParseDoubleDouble"
+
+// ParseDoubleDouble parses s as a DoubleDouble.
+// s is parsed as a [Float256] first, and then split into the components.
+func ParseDoubleDouble(s string) (DoubleDouble, error) {
+	f, n, err := atof256(s)
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewDoubleDouble(0), syntaxError(fnParseDoubleDouble, s)
+	}
+	if err != nil {
+		err.(*strconv.NumError).Func = fnParseDoubleDouble
+	}
+
+	ret := f.DoubleDouble()
+	if err == nil && ret.IsInf(0) && !f.IsInf(0) {
+		// f is finite, but it is out of the range of float64.
+		err = rangeError(fnParseDoubleDouble, s)
+	}
+	return ret, err
+}
+
+var _ json.Unmarshaler = (*DoubleDouble)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *DoubleDouble) UnmarshalJSON(data []byte) error {
+	ret, err := ParseDoubleDouble(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*DoubleDouble)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *DoubleDouble) UnmarshalText(data []byte) error {
+	ret, err := ParseDoubleDouble(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
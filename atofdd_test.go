@@ -0,0 +1,139 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+var parseDoubleDoubleTests = []struct {
+	input string
+	want  DoubleDouble
+	err   error
+}{
+	{"0", NewDoubleDouble(0), nil},
+	{"-0", NewDoubleDouble(math.Copysign(0, -1)), nil},
+	{"1", NewDoubleDouble(1), nil},
+	{"-2.75", NewDoubleDouble(-2.75), nil},
+	{"0.1", DoubleDouble{0x1.999999999999ap-4, -0x1.999999999999ap-58}, nil},
+	{"3.14159265358979323846264338327950288", DoubleDouble{0x1.921fb54442d18p+1, 0x1.1a62633145c07p-53}, nil},
+	{"1e308", DoubleDouble{0x1.1ccf385ebc8ap+1023, -0x1.c2a3c3d855605p+966}, nil},
+	{"0x1.00000000000000000001p+00", DoubleDouble{1, 0x1p-80}, nil},
+	{"5e-324", NewDoubleDouble(5e-324), nil}, // min positive denormal
+	{"2e-324", NewDoubleDouble(0), nil},      // rounds down to zero
+	{"1.7976931348623157e308", DoubleDouble{math.MaxFloat64, -0x1.4e53663a912b6p+966}, nil},
+
+	// too large
+	{"1e309", NewDoubleDoubleInf(1), strconv.ErrRange},
+	{"-1e309", NewDoubleDoubleInf(-1), strconv.ErrRange},
+
+	// NaNs
+	{"nan", NewDoubleDoubleNaN(), nil},
+	{"NaN", NewDoubleDoubleNaN(), nil},
+
+	// Infs
+	{"Inf", NewDoubleDoubleInf(1), nil},
+	{"-Inf", NewDoubleDoubleInf(-1), nil},
+	{"+INFINITY", NewDoubleDoubleInf(1), nil},
+
+	// Parse errors
+	{"1e", NewDoubleDouble(0), strconv.ErrSyntax},
+	{".e-1", NewDoubleDouble(0), strconv.ErrSyntax},
+	{"0x", NewDoubleDouble(0), strconv.ErrSyntax},
+	{"1x", NewDoubleDouble(0), strconv.ErrSyntax},
+}
+
+func TestParseDoubleDouble(t *testing.T) {
+	for _, tt := range parseDoubleDoubleTests {
+		got, err := ParseDoubleDouble(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseDoubleDouble(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseDoubleDouble" {
+				t.Errorf("ParseDoubleDouble(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseDoubleDouble")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseDoubleDouble(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if !eqDD(got, tt.want) || err != tt.err {
+			t.Errorf("ParseDoubleDouble(%q) = (%v, %v) want (%v, %v)", tt.input, [2]float64(got), err, [2]float64(tt.want), tt.err)
+		}
+	}
+}
+
+func FuzzParseDoubleDouble(f *testing.F) {
+	for _, tt := range parseDoubleDoubleTests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseDoubleDouble(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseDoubleDouble(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqDD(f0, f1) {
+			t.Fatalf("ParseDoubleDouble(%q) = %v; after String() = %q and ParseDoubleDouble = %v", input, [2]float64(f0), s, [2]float64(f1))
+		}
+	})
+}
+
+func TestDoubleDouble_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  DoubleDouble
+	}{
+		{"0", NewDoubleDouble(0)},
+		{"1.5", NewDoubleDouble(1.5)},
+		{"0.5000000000000000008673617379884035", DoubleDouble{0.5, 0x1p-60}},
+	}
+
+	for _, tt := range tests {
+		var f DoubleDouble
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("DoubleDouble.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqDD(f, tt.want) {
+			t.Errorf("DoubleDouble.UnmarshalJSON(%q) = %v; want %v", tt.input, [2]float64(f), [2]float64(tt.want))
+		}
+	}
+
+	var f DoubleDouble
+	if err := f.UnmarshalJSON([]byte(`"1"`)); err == nil {
+		t.Errorf("DoubleDouble.UnmarshalJSON(%q) expected error", `"1"`)
+	}
+}
+
+func TestDoubleDouble_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  DoubleDouble
+	}{
+		{"0", NewDoubleDouble(0)},
+		{"1.5", NewDoubleDouble(1.5)},
+		{"-Inf", NewDoubleDoubleInf(-1)},
+		{"NaN", NewDoubleDoubleNaN()},
+	}
+
+	for _, tt := range tests {
+		var f DoubleDouble
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("DoubleDouble.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqDD(f, tt.want) {
+			t.Errorf("DoubleDouble.UnmarshalText(%q) = %v; want %v", tt.input, [2]float64(f), [2]float64(tt.want))
+		}
+	}
+}
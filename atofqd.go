@@ -0,0 +1,52 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"strconv"
+)
+
+const fnParseQuadDouble = "ParseQuadDouble"
+
+// ParseQuadDouble parses s as a QuadDouble.
+// s is parsed as a [Float256] first, and then split into the components.
+func ParseQuadDouble(s string) (QuadDouble, error) {
+	f, n, err := atof256(s)
+	if n != len(s) || err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax {
+		return NewQuadDouble(0), syntaxError(fnParseQuadDouble, s)
+	}
+	if err != nil {
+		err.(*strconv.NumError).Func = fnParseQuadDouble
+	}
+
+	ret := f.QuadDouble()
+	if err == nil && ret.IsInf(0) && !f.IsInf(0) {
+		// f is finite, but it is out of the range of float64.
+		err = rangeError(fnParseQuadDouble, s)
+	}
+	return ret, err
+}
+
+var _ json.Unmarshaler = (*QuadDouble)(nil)
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (a *QuadDouble) UnmarshalJSON(data []byte) error {
+	ret, err := ParseQuadDouble(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+var _ encoding.TextUnmarshaler = (*QuadDouble)(nil)
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (a *QuadDouble) UnmarshalText(data []byte) error {
+	ret, err := ParseQuadDouble(string(data))
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
@@ -0,0 +1,139 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+var parseQuadDoubleTests = []struct {
+	input string
+	want  QuadDouble
+	err   error
+}{
+	{"0", NewQuadDouble(0), nil},
+	{"-0", NewQuadDouble(math.Copysign(0, -1)), nil},
+	{"1", NewQuadDouble(1), nil},
+	{"-2.75", NewQuadDouble(-2.75), nil},
+	{"0.1", QuadDouble{0x1.999999999999ap-4, -0x1.999999999999ap-58, 0x1.999999999999ap-112, -0x1.999999999999ap-166}, nil},
+	{"3.1415926535897932384626433832795028841971693993751058209749445923078164062862", QuadDouble{0x1.921fb54442d18p+1, 0x1.1a62633145c07p-53, -0x1.f1976b7ed8fbcp-109, 0x1.4cf98e804177dp-163}, nil},
+	{"1e308", QuadDouble{0x1.1ccf385ebc8ap+1023, -0x1.c2a3c3d855605p+966, -0x1.89b7a69e24af4p+912, 0x1.b4ae21b9b773p+858}, nil},
+	{"0x1.00000000000000000001p+00", QuadDouble{1, 0x1p-80, 0, 0}, nil},
+	{"5e-324", NewQuadDouble(5e-324), nil}, // min positive denormal
+	{"2e-324", NewQuadDouble(0), nil},      // rounds down to zero
+	{"1.7976931348623157e308", QuadDouble{math.MaxFloat64, -0x1.4e53663a912b6p+966, -0x1.ee0caa85462p+912, -0x1.91d37449a00a5p+858}, nil},
+
+	// too large
+	{"1e309", NewQuadDoubleInf(1), strconv.ErrRange},
+	{"-1e309", NewQuadDoubleInf(-1), strconv.ErrRange},
+
+	// NaNs
+	{"nan", NewQuadDoubleNaN(), nil},
+	{"NaN", NewQuadDoubleNaN(), nil},
+
+	// Infs
+	{"Inf", NewQuadDoubleInf(1), nil},
+	{"-Inf", NewQuadDoubleInf(-1), nil},
+	{"+INFINITY", NewQuadDoubleInf(1), nil},
+
+	// Parse errors
+	{"1e", NewQuadDouble(0), strconv.ErrSyntax},
+	{".e-1", NewQuadDouble(0), strconv.ErrSyntax},
+	{"0x", NewQuadDouble(0), strconv.ErrSyntax},
+	{"1x", NewQuadDouble(0), strconv.ErrSyntax},
+}
+
+func TestParseQuadDouble(t *testing.T) {
+	for _, tt := range parseQuadDoubleTests {
+		got, err := ParseQuadDouble(tt.input)
+		if err != nil {
+			numErr, ok := err.(*strconv.NumError)
+			if !ok {
+				t.Errorf("ParseQuadDouble(%q) unexpected error type: %v", tt.input, err)
+				continue
+			}
+			if numErr.Func != "ParseQuadDouble" {
+				t.Errorf("ParseQuadDouble(%q) unexpected Func in NumError: got %q, want %q", tt.input, numErr.Func, "ParseQuadDouble")
+			}
+			if numErr.Num != tt.input {
+				t.Errorf("ParseQuadDouble(%q) unexpected Num in NumError: got %q, want %q", tt.input, numErr.Num, tt.input)
+			}
+			err = numErr.Err
+		}
+		if !eqQD(got, tt.want) || err != tt.err {
+			t.Errorf("ParseQuadDouble(%q) = (%v, %v) want (%v, %v)", tt.input, [4]float64(got), err, [4]float64(tt.want), tt.err)
+		}
+	}
+}
+
+func FuzzParseQuadDouble(f *testing.F) {
+	for _, tt := range parseQuadDoubleTests {
+		f.Add(tt.input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		f0, err := ParseQuadDouble(input)
+		if err != nil {
+			return
+		}
+		s := f0.String()
+		f1, err := ParseQuadDouble(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqQD(f0, f1) {
+			t.Fatalf("ParseQuadDouble(%q) = %v; after String() = %q and ParseQuadDouble = %v", input, [4]float64(f0), s, [4]float64(f1))
+		}
+	})
+}
+
+func TestQuadDouble_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input string
+		want  QuadDouble
+	}{
+		{"0", NewQuadDouble(0)},
+		{"1.5", NewQuadDouble(1.5)},
+		{"0.5000000000000000008673617379884035479582786252222173748931468306220897", QuadDouble{0.5, 0x1p-60, 0x1p-120, 0x1p-180}},
+	}
+
+	for _, tt := range tests {
+		var f QuadDouble
+		err := f.UnmarshalJSON([]byte(tt.input))
+		if err != nil {
+			t.Errorf("QuadDouble.UnmarshalJSON(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqQD(f, tt.want) {
+			t.Errorf("QuadDouble.UnmarshalJSON(%q) = %v; want %v", tt.input, [4]float64(f), [4]float64(tt.want))
+		}
+	}
+
+	var f QuadDouble
+	if err := f.UnmarshalJSON([]byte(`"1"`)); err == nil {
+		t.Errorf("QuadDouble.UnmarshalJSON(%q) expected error", `"1"`)
+	}
+}
+
+func TestQuadDouble_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input string
+		want  QuadDouble
+	}{
+		{"0", NewQuadDouble(0)},
+		{"1.5", NewQuadDouble(1.5)},
+		{"-Inf", NewQuadDoubleInf(-1)},
+		{"NaN", NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range tests {
+		var f QuadDouble
+		err := f.UnmarshalText([]byte(tt.input))
+		if err != nil {
+			t.Errorf("QuadDouble.UnmarshalText(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if !eqQD(f, tt.want) {
+			t.Errorf("QuadDouble.UnmarshalText(%q) = %v; want %v", tt.input, [4]float64(f), [4]float64(tt.want))
+		}
+	}
+}
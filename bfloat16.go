@@ -0,0 +1,516 @@
+package floats
+
+import (
+	"math"
+	"math/bits"
+
+	"github.com/shogo82148/ints"
+)
+
+const (
+	uvnanBF16    = 0x7fc0     // NaN value for BFloat16
+	uvinfBF16    = 0x7f80     // Infinity value for BFloat16
+	uvneginfBF16 = 0xff80     // Negative Infinity value for BFloat16
+	uvoneBF16    = 0x3f80     // One value for BFloat16
+	maskBF16     = 0xff       // mask for exponent
+	shiftBF16    = 16 - 8 - 1 // shift for exponent
+	biasBF16     = 127        // bias for exponent
+	signMaskBF16 = 1 << 15    // mask for sign bit
+	fracMaskBF16 = 1<<shiftBF16 - 1
+
+	quietMaskBF16   = 1 << (shiftBF16 - 1) // mask for the quiet bit of NaN
+	payloadMaskBF16 = quietMaskBF16 - 1    // mask for the payload of NaN
+)
+
+// BFloat16 is a 16-bit brain floating-point number.
+// It has the same exponent range as Float32, with only 8 bits of precision.
+type BFloat16 uint16
+
+// NewBFloat16 converts f to BFloat16.
+func NewBFloat16(f float64) BFloat16 {
+	return Float64(f).BFloat16()
+}
+
+// NewBFloat16FromBits converts the binary representation b to BFloat16.
+func NewBFloat16FromBits(b uint16) BFloat16 {
+	return BFloat16(b)
+}
+
+// NewBFloat16FromInt64 returns the BFloat16 value nearest to x, rounding ties to even.
+func NewBFloat16FromInt64(x int64) BFloat16 {
+	if x < 0 {
+		return newBFloat16FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newBFloat16FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewBFloat16FromUint64 returns the BFloat16 value nearest to x, rounding ties to even.
+func NewBFloat16FromUint64(x uint64) BFloat16 {
+	return newBFloat16FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewBFloat16FromInt128 returns the BFloat16 value nearest to x, rounding ties to even.
+func NewBFloat16FromInt128(x ints.Int128) BFloat16 {
+	if x.Sign() < 0 {
+		return newBFloat16FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newBFloat16FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewBFloat16FromUint128 returns the BFloat16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in BFloat16, the result is +Inf.
+func NewBFloat16FromUint128(x ints.Uint128) BFloat16 {
+	return newBFloat16FromInt(false, x.Uint256())
+}
+
+// NewBFloat16FromInt256 returns the BFloat16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in BFloat16, the result is ±Inf.
+func NewBFloat16FromInt256(x ints.Int256) BFloat16 {
+	if x.Sign() < 0 {
+		return newBFloat16FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newBFloat16FromInt(false, ints.Uint256(x))
+}
+
+// NewBFloat16FromUint256 returns the BFloat16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in BFloat16, the result is +Inf.
+func NewBFloat16FromUint256(x ints.Uint256) BFloat16 {
+	return newBFloat16FromInt(false, x)
+}
+
+// newBFloat16FromInt returns the BFloat16 value nearest to (-1)**neg × x.
+func newBFloat16FromInt(neg bool, x ints.Uint256) BFloat16 {
+	r, _ := float256FromUint256(neg, x, ToNearestEven|toOdd)
+	ret, _ := r.bfloat16(ToNearestEven)
+	return ret
+}
+
+// NewBFloat16NaN returns a NaN BFloat16 value.
+func NewBFloat16NaN() BFloat16 {
+	return uvnanBF16
+}
+
+// NewBFloat16Inf positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewBFloat16Inf(sign int) BFloat16 {
+	if sign >= 0 {
+		return uvinfBF16
+	}
+	return uvneginfBF16
+}
+
+// Bits returns the binary representation of a.
+func (a BFloat16) Bits() uint16 {
+	return uint16(a)
+}
+
+// IsNaN reports whether a is an IEEE 754 “not-a-number” value.
+func (a BFloat16) IsNaN() bool {
+	return a&(maskBF16<<shiftBF16) == (maskBF16<<shiftBF16) && a&fracMaskBF16 != 0
+}
+
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a BFloat16) IsSignalingNaN() bool {
+	return a.IsNaN() && a&quietMaskBF16 == 0
+}
+
+// Payload returns the payload of a as a non-negative integer.
+// If a is not NaN, Payload returns -1.
+// It is the getPayload operation of IEEE 754-2019.
+func (a BFloat16) Payload() BFloat16 {
+	if !a.IsNaN() {
+		return 0xbf80 // -1
+	}
+	return Float64(a & payloadMaskBF16).BFloat16()
+}
+
+// SetPayloadBF16 returns a quiet NaN with the payload.
+// If payload is not an integer in [0, 2**6), SetPayloadBF16 returns +0.
+// It is the setPayload operation of IEEE 754-2019.
+func SetPayloadBF16(payload BFloat16) BFloat16 {
+	p, ok := payload.Float256().payloadInt(shiftBF16 - 1)
+	if !ok {
+		return 0
+	}
+	return nanBF16(false, p)
+}
+
+// SetPayloadSignalingBF16 returns a signaling NaN with the payload.
+// If payload is not an integer in [1, 2**6), SetPayloadSignalingBF16 returns +0.
+// It is the setPayloadSignaling operation of IEEE 754-2019.
+func SetPayloadSignalingBF16(payload BFloat16) BFloat16 {
+	p, ok := payload.Float256().payloadInt(shiftBF16 - 1)
+	if !ok || p.IsZero() {
+		return 0
+	}
+	return uvinfBF16 | BFloat16(p[3])
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a BFloat16) nanPayload() ints.Uint256 {
+	return ints.Uint256{0, 0, 0, uint64(a & payloadMaskBF16)}
+}
+
+// nanBF16 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in BFloat16, it is replaced by zero.
+func nanBF16(neg bool, payload ints.Uint256) BFloat16 {
+	ret := BFloat16(uvnanBF16)
+	if payload.BitLen() < shiftBF16 {
+		ret |= BFloat16(payload[3])
+	}
+	if neg {
+		ret |= signMaskBF16
+	}
+	return ret
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a BFloat16) IsInf(sign int) bool {
+	return sign >= 0 && a == uvinfBF16 || sign <= 0 && a == uvneginfBF16
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (a BFloat16) Signbit() bool {
+	return a&signMaskBF16 != 0
+}
+
+// Copysign returns a value with the magnitude of a
+// and the sign of sign.
+func (a BFloat16) Copysign(sign BFloat16) BFloat16 {
+	return (a &^ signMaskBF16) | (sign & signMaskBF16)
+}
+
+// Int64 returns the integer value of a, rounding towards zero.
+// If a cannot be represented in an int64, the result is undefined.
+func (a BFloat16) Int64() int64 {
+	return int64(a.Float64())
+}
+
+// Uint64 returns the unsigned integer value of a, rounding towards zero.
+// If a cannot be represented in a uint64, the result is undefined.
+func (a BFloat16) Uint64() uint64 {
+	return uint64(a.Float64())
+}
+
+// Int128 returns the signed 128-bit integer value of a, rounding towards zero.
+// If a cannot be represented in a int128, the result is undefined.
+func (a BFloat16) Int128() ints.Int128 {
+	// BFloat16 is a truncated Float32, so the conversion is exact.
+	return a.Float32().Int128()
+}
+
+// Uint128 returns the unsigned 128-bit integer value of a, rounding towards zero.
+// If a cannot be represented in a uint128, the result is undefined.
+func (a BFloat16) Uint128() ints.Uint128 {
+	return a.Float32().Uint128()
+}
+
+// Int256 returns the signed 256-bit integer value of a, rounding towards zero.
+// If a cannot be represented in a int256, the result is undefined.
+func (a BFloat16) Int256() ints.Int256 {
+	return a.Float32().Int256()
+}
+
+// Uint256 returns the unsigned 256-bit integer value of a, rounding towards zero.
+// If a cannot be represented in a uint256, the result is undefined.
+func (a BFloat16) Uint256() ints.Uint256 {
+	return a.Float32().Uint256()
+}
+
+// IsZero reports whether a is zero (+0 or -0).
+func (a BFloat16) IsZero() bool {
+	return a&^signMaskBF16 == 0
+}
+
+// Neg returns the negation of a.
+func (a BFloat16) Neg() BFloat16 {
+	return a ^ signMaskBF16
+}
+
+// Abs returns the absolute value of a.
+//
+// Special cases:
+//
+//	Abs(±Inf) = +Inf
+//	Abs(NaN) = NaN
+func (a BFloat16) Abs() BFloat16 {
+	return a &^ signMaskBF16
+}
+
+// The arithmetic operations of BFloat16 are evaluated in Float256 with rounding to odd,
+// and the results are rounded to BFloat16 only once.
+
+// Mul returns the product of a and b.
+func (a BFloat16) Mul(b BFloat16) BFloat16 {
+	return roundBF16(a.Float256().mul(b.Float256(), toOdd))
+}
+
+// Quo returns the quotient of a and b.
+func (a BFloat16) Quo(b BFloat16) BFloat16 {
+	return roundBF16(a.Float256().quo(b.Float256(), toOdd))
+}
+
+// Add returns the sum of a and b.
+func (a BFloat16) Add(b BFloat16) BFloat16 {
+	return roundBF16(a.Float256().add(b.Float256(), toOdd))
+}
+
+// Sub returns the difference of a and b.
+func (a BFloat16) Sub(b BFloat16) BFloat16 {
+	return roundBF16(a.Float256().sub(b.Float256(), toOdd))
+}
+
+// Sqrt returns the square root of a.
+//
+// Special cases are:
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func (a BFloat16) Sqrt() BFloat16 {
+	return roundBF16(a.Float256().sqrt(toOdd))
+}
+
+// roundBF16 rounds x, which is rounded to odd, to the nearest BFloat16.
+// The exception flags are ignored.
+func roundBF16(x Float256, _ Flags) BFloat16 {
+	ret, _ := x.bfloat16(ToNearestEven)
+	return ret
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a BFloat16) Eq(b BFloat16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	if a == b {
+		// a and b have the same bit pattern.
+		return true
+	}
+
+	// check -0 == 0
+	return (a|b)&^signMaskBF16 == 0
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a BFloat16) Ne(b BFloat16) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a BFloat16) Lt(b BFloat16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.comparable() < b.comparable()
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a BFloat16) Gt(b BFloat16) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a BFloat16) Le(b BFloat16) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.comparable() <= b.comparable()
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a BFloat16) Ge(b BFloat16) bool {
+	return b.Le(a)
+}
+
+// normalize returns the sign, exponent, and normalized fraction of a.
+func (a BFloat16) normalize() (sign uint16, exp int, frac uint16) {
+	sign = uint16(a & signMaskBF16)
+	exp = int((a>>shiftBF16)&maskBF16) - biasBF16
+	frac = uint16(a & fracMaskBF16)
+
+	if exp == -biasBF16 {
+		// a is subnormal
+		// normalize
+		l := bits.Len16(frac)
+		frac <<= uint(shiftBF16 + 1 - l)
+		exp = l - (biasBF16 + shiftBF16)
+		return
+	}
+
+	// a is normal
+	frac |= 1 << shiftBF16
+	return
+}
+
+func (a BFloat16) split() (sign uint16, exp int, frac uint16) {
+	sign = uint16(a & signMaskBF16)
+	exp = int((a>>shiftBF16)&maskBF16) - biasBF16
+	frac = uint16(a & fracMaskBF16)
+
+	if exp == -biasBF16 {
+		// a is subnormal
+		exp++
+	} else {
+		// a is normal
+		frac |= 1 << shiftBF16
+	}
+	return
+}
+
+// comparable converts a to a comparable form.
+func (a BFloat16) comparable() int16 {
+	i := int16(a)
+	i ^= (i >> 15) & 0x7fff
+	i += int16(a >> 15) // normalize -0 to 0
+	return i
+}
+
+// FMABF16 returns x * y + z, computed with only one rounding.
+// (That is, FMABF16 returns the fused multiply-add of x, y, and z.)
+func FMABF16(x, y, z BFloat16) BFloat16 {
+	return roundBF16(fma256(x.Float256(), y.Float256(), z.Float256(), toOdd))
+}
+
+// Nextafter returns the next representable bfloat16 value after a towards b.
+//
+// Special cases are:
+//
+//	a.Nextafter(a)   = a
+//	NaN.Nextafter(b) = NaN
+//	a.Nextafter(NaN) = NaN
+func (a BFloat16) Nextafter(b BFloat16) (r BFloat16) {
+	switch {
+	case a.IsNaN() || b.IsNaN(): // special case
+		r = NewBFloat16NaN()
+	case a == b: // special case
+		r = a
+	case a.IsZero():
+		r = BFloat16(1).Copysign(b)
+	case b.Gt(a) == a.Gt(0):
+		r = a + 1
+	default:
+		r = a - 1
+	}
+	return
+}
+
+// Modf returns integer and fractional floating-point numbers
+// that sum to f. Both values have the same sign as f.
+//
+// Special cases are:
+//
+//	Modf(±Inf) = ±Inf, NaN
+//	Modf(NaN) = NaN, NaN
+func (a BFloat16) Modf() (int BFloat16, frac BFloat16) {
+	if optimized {
+		fint, ffrac := math.Modf(a.Float64().BuiltIn())
+		return NewBFloat16(fint), NewBFloat16(ffrac)
+	}
+
+	if a.Lt(BFloat16(uvoneBF16)) { // a < 1
+		switch {
+		case a.Lt(BFloat16(0)): // a < 0
+			int, frac = a.Neg().Modf()
+			return int.Neg(), frac.Neg()
+		case a.IsZero(): // a == 0
+			return a, a
+		default: // 0 < a < 1
+			return BFloat16(0), a
+		}
+	}
+
+	int = a
+	e := uint(int>>shiftBF16&maskBF16) - biasBF16
+
+	// Keep the top 9+e bits, the integer part; clear the rest.
+	if e < shiftBF16 {
+		int &^= 1<<(shiftBF16-e) - 1
+	}
+	frac = a.Sub(int)
+	return
+}
+
+// Frexp breaks a into a normalized fraction
+// and an integral power of two.
+// It returns frac and exp satisfying f == frac × 2**exp,
+// with the absolute value of frac in the interval [½, 1).
+//
+// Special cases are:
+//
+//	±0.Frexp() = ±0, 0
+//	±Inf.Frexp() = ±Inf, 0
+//	NaN.Frexp() = NaN, 0
+func (a BFloat16) Frexp() (frac BFloat16, exp int) {
+	f, e := math.Frexp(a.Float64().BuiltIn())
+	return NewBFloat16(f), e
+}
+
+// Ldexp is the inverse of [Frexp].
+// It returns a × 2**exp.
+//
+// Special cases are:
+//
+//	±0.Ldexp(exp) = ±0
+//	±Inf.Ldexp(exp) = ±Inf
+//	NaN.Ldexp(exp) = NaN
+func (a BFloat16) Ldexp(exp int) BFloat16 {
+	f := math.Ldexp(a.Float64().BuiltIn(), exp)
+	return NewBFloat16(f)
+}
+
+// Mod returns the floating-point remainder of a/b.
+// The magnitude of the result is less than b and its
+// sign agrees with that of a.
+//
+// Special cases are:
+//
+//	±Inf.Mod(b) = NaN
+//	NaN.Mod(b) = NaN
+//	a.Mod(0) = NaN
+//	a.Mod(±Inf) = a
+//	a.Mod(NaN) = NaN
+func (a BFloat16) Mod(b BFloat16) BFloat16 {
+	return NewBFloat16(math.Mod(a.Float64().BuiltIn(), b.Float64().BuiltIn()))
+}
+
+// Remainder returns the IEEE 754 floating-point remainder of a/b.
+//
+// Special cases are:
+//
+//	±Inf.Remainder(b) = NaN
+//	NaN.Remainder(b) = NaN
+//	a.Remainder(0) = NaN
+//	a.Remainder(±Inf) = a
+//	a.Remainder(NaN) = NaN
+func (a BFloat16) Remainder(b BFloat16) BFloat16 {
+	return NewBFloat16(math.Remainder(a.Float64().BuiltIn(), b.Float64().BuiltIn()))
+}
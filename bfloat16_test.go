@@ -0,0 +1,1081 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+func TestBFloat16_IsNaN(t *testing.T) {
+	tests := []struct {
+		name string
+		a    BFloat16
+		want bool
+	}{
+		{
+			name: "NaN",
+			a:    0x7fc0,
+			want: true,
+		},
+		{
+			name: "not NaN",
+			a:    0x7f80,
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsNaN(); got != tt.want {
+				t.Errorf("BFloat16.IsNaN() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBFloat16_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		a    BFloat16
+		want bool
+	}{
+		{BFloat16(0x7fc0), false},
+		{BFloat16(0x7fc1), false},
+		{BFloat16(0x7f81), true},
+		{BFloat16(0xff81), true},
+		{NewBFloat16Inf(1), false},
+		{exactBF16(1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsSignalingNaN(); got != tt.want {
+			t.Errorf("BFloat16(%x).IsSignalingNaN() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Payload(t *testing.T) {
+	tests := []struct {
+		a    BFloat16
+		want BFloat16
+	}{
+		{BFloat16(0x7fc0), exactBF16(0)},
+		{BFloat16(0x7fea), exactBF16(42)},
+		{BFloat16(0xffea), exactBF16(42)},
+		{BFloat16(0x7faa), exactBF16(42)},
+		{NewBFloat16Inf(1), exactBF16(-1)},
+		{exactBF16(1), exactBF16(-1)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Payload(); !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16(%x).Payload() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadBF16(t *testing.T) {
+	tests := []struct {
+		payload BFloat16
+		want    BFloat16
+	}{
+		{exactBF16(0), BFloat16(0x7fc0)},
+		{exactBF16(42), BFloat16(0x7fea)},
+		{exactBF16(63), BFloat16(0x7fff)},
+		{exactBF16(64), exactBF16(0)},
+		{exactBF16(1.5), exactBF16(0)},
+		{exactBF16(-1), exactBF16(0)},
+		{NewBFloat16Inf(1), exactBF16(0)},
+		{NewBFloat16NaN(), exactBF16(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadBF16(tt.payload); got != tt.want {
+			t.Errorf("SetPayloadBF16(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadSignalingBF16(t *testing.T) {
+	tests := []struct {
+		payload BFloat16
+		want    BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(42), BFloat16(0x7faa)},
+		{exactBF16(63), BFloat16(0x7fbf)},
+		{exactBF16(64), exactBF16(0)},
+		{exactBF16(1.5), exactBF16(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadSignalingBF16(tt.payload); got != tt.want {
+			t.Errorf("SetPayloadSignalingBF16(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_NaNPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		got  BFloat16
+		want BFloat16
+	}{
+		{"Add(qNaN, 1)", BFloat16(0x7fea).Add(exactBF16(1)), BFloat16(0x7fea)},
+		{"Add(1, sNaN)", exactBF16(1).Add(BFloat16(0x7f87)), BFloat16(0x7fc7)},
+		{"Add(qNaN, qNaN)", BFloat16(0x7fea).Add(BFloat16(0x7fc7)), BFloat16(0x7fea)},
+		{"Add(sNaN, qNaN)", BFloat16(0x7f87).Add(BFloat16(0x7fea)), BFloat16(0x7fc7)},
+		{"Sub(1, qNaN)", exactBF16(1).Sub(BFloat16(0x7fea)), BFloat16(0x7fea)},
+		{"Mul(-qNaN, 1)", BFloat16(0xffea).Mul(exactBF16(1)), BFloat16(0xffea)},
+		{"Quo(1, qNaN)", exactBF16(1).Quo(BFloat16(0x7fea)), BFloat16(0x7fea)},
+		{"Sqrt(sNaN)", BFloat16(0x7f87).Sqrt(), BFloat16(0x7fc7)},
+		{"FMA(1, qNaN, sNaN)", FMABF16(exactBF16(1), BFloat16(0x7fea), BFloat16(0x7f87)), BFloat16(0x7fea)},
+		{"FMA(0, Inf, qNaN)", FMABF16(exactBF16(0), NewBFloat16Inf(1), BFloat16(0x7fea)), BFloat16(0x7fea)},
+		{"Quo(0, 0)", exactBF16(0).Quo(exactBF16(0)), BFloat16(0x7fc0)},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_IsInf(t *testing.T) {
+	tests := []struct {
+		in   BFloat16
+		sign int
+		want bool
+	}{
+		// infinity
+		{0x7f80, 1, true},
+		{0x7f80, -1, false},
+		{0x7f80, 0, true},
+
+		// -infinity
+		{0xff80, 1, false},
+		{0xff80, -1, true},
+		{0xff80, 0, true},
+
+		// +1.0(finite)
+		{0x3f80, 1, false},
+		{0x3f80, -1, false},
+		{0x3f80, 0, false},
+	}
+
+	for _, tt := range tests {
+		got := tt.in.IsInf(tt.sign)
+		if got != tt.want {
+			t.Errorf("BFloat16.IsInf(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_IsInf(b *testing.B) {
+	f := BFloat16(0x3f80)
+	for b.Loop() {
+		runtime.KeepAlive(f.IsInf(0))
+	}
+}
+
+func TestBFloat16_Signbit(t *testing.T) {
+	tests := []struct {
+		in   BFloat16
+		want bool
+	}{
+		{0x0000, false}, // 0.0
+		{0x8000, true},  // -0.0
+		{0x3f80, false}, // 1.0
+		{0xbf80, true},  // -1.0
+		{0x7f80, false}, // +Inf
+		{0xff80, true},  // -Inf
+	}
+	for _, test := range tests {
+		got := test.in.Signbit()
+		if got != test.want {
+			t.Errorf("BFloat16.Signbit() = %v, want %v", got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Signbit(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Signbit())
+	}
+}
+
+func TestBFloat16_Copysign(t *testing.T) {
+	tests := []struct {
+		a, sign, want BFloat16
+	}{
+		{exactBF16(10), exactBF16(-1), exactBF16(-10)},
+		{exactBF16(10), exactBF16(1), exactBF16(10)},
+		{exactBF16(0), exactBF16(-1), exactBF16(math.Copysign(0, -1))},
+	}
+	for _, tt := range tests {
+		got := tt.a.Copysign(tt.sign)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16(%x).Copysign(%x) = %x, want %x", tt.a, tt.sign, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Int64(t *testing.T) {
+	tests := []struct {
+		in  BFloat16
+		out int64
+	}{
+		{0x0000, 0},
+		{0x3f80, 1},
+	}
+	for _, test := range tests {
+		got := test.in.Int64()
+		if got != test.out {
+			t.Errorf("BFloat16.Int64() = %v, want %v", got, test.out)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Int64(b *testing.B) {
+	f := BFloat16(0x3f80)
+	for b.Loop() {
+		runtime.KeepAlive(f.Int64())
+	}
+}
+
+func TestBFloat16_Uint64(t *testing.T) {
+	tests := []struct {
+		in  BFloat16
+		out uint64
+	}{
+		{exactBF16(0), 0},
+		{exactBF16(1), 1},
+		{exactBF16(1.5), 1},
+		{exactBF16(2), 2},
+	}
+	for _, test := range tests {
+		got := test.in.Uint64()
+		if got != test.out {
+			t.Errorf("BFloat16(%v).Uint64() = %v, want %v", test.in, got, test.out)
+		}
+	}
+}
+
+func TestBFloat16_Int128(t *testing.T) {
+	tests := []struct {
+		in  BFloat16
+		out ints.Int128
+	}{
+		{exactBF16(0), ints.Int128{}},
+		{exactBF16(1), ints.Int128{0, 1}},
+		{exactBF16(1.5), ints.Int128{0, 1}},
+		{exactBF16(65280), ints.Int128{0, 65280}},
+
+		{exactBF16(-1), ints.Int128{0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}}, // -1
+		{exactBF16(-1.5), ints.Int128{0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{exactBF16(-65280), ints.Int128{0xffff_ffff_ffff_ffff, 0x1_0000_0000_0000_0000 - 65280}},
+	}
+	for _, test := range tests {
+		got := test.in.Int128()
+		if got != test.out {
+			t.Errorf("BFloat16(%v).Int128() = %v, want %v", test.in, got, test.out)
+		}
+	}
+}
+
+func TestBFloat16_Uint128(t *testing.T) {
+	tests := []struct {
+		in  BFloat16
+		out ints.Uint128
+	}{
+		{exactBF16(0), ints.Uint128{}},
+		{exactBF16(1), ints.Uint128{0, 1}},
+		{exactBF16(1.5), ints.Uint128{0, 1}},
+		{exactBF16(65280), ints.Uint128{0, 65280}},
+	}
+
+	for _, test := range tests {
+		got := test.in.Uint128()
+		if got != test.out {
+			t.Errorf("BFloat16(%v).Uint128() = %v, want %v", test.in, got, test.out)
+		}
+	}
+}
+
+func TestBFloat16_Int256(t *testing.T) {
+	tests := []struct {
+		in  BFloat16
+		out ints.Int256
+	}{
+		{exactBF16(0), ints.Int256{}},
+		{exactBF16(1), ints.Int256{0, 0, 0, 1}},
+		{exactBF16(1.5), ints.Int256{0, 0, 0, 1}},
+		{exactBF16(65280), ints.Int256{0, 0, 0, 65280}},
+
+		{
+			exactBF16(-1),
+			ints.Int256{ // -1
+				0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+				0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+			},
+		},
+		{
+			exactBF16(-1.5),
+			ints.Int256{ // -1
+				0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+				0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+			},
+		},
+		{
+			exactBF16(-65280),
+			ints.Int256{ // -65280
+				0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+				0xffff_ffff_ffff_ffff, 0x1_0000_0000_0000_0000 - 65280,
+			},
+		},
+	}
+	for _, test := range tests {
+		got := test.in.Int256()
+		if got != test.out {
+			t.Errorf("BFloat16(%v).Int256() = %v, want %v", test.in, got, test.out)
+		}
+	}
+}
+
+func TestBFloat16_Uint256(t *testing.T) {
+	tests := []struct {
+		in  BFloat16
+		out ints.Uint256
+	}{
+		{exactBF16(0), ints.Uint256{}},
+		{exactBF16(1), ints.Uint256{0, 0, 0, 1}},
+		{exactBF16(1.5), ints.Uint256{0, 0, 0, 1}},
+		{exactBF16(65280), ints.Uint256{0, 0, 0, 65280}},
+	}
+
+	for _, test := range tests {
+		got := test.in.Uint256()
+		if got != test.out {
+			t.Errorf("BFloat16(%v).Uint256() = %v, want %v", test.in, got, test.out)
+		}
+	}
+}
+
+func TestBFloat16_IsZero(t *testing.T) {
+	tests := []struct {
+		in   BFloat16
+		want bool
+	}{
+		{0x0000, true},  // +0.0
+		{0x8000, true},  // -0.0
+		{0x3f80, false}, // 1.0
+		{0xbf80, false}, // -1.0
+	}
+	for _, test := range tests {
+		got := test.in.IsZero()
+		if got != test.want {
+			t.Errorf("BFloat16.IsZero() = %v, want %v", got, test.want)
+		}
+	}
+}
+
+func TestBFloat16_Neg(t *testing.T) {
+	tests := []struct {
+		a, want BFloat16
+	}{
+		{0x3f80, 0xbf80}, // 1.0 = -1.0
+		{0x0000, 0x8000}, // 0.0 = -0.0
+		{0x8000, 0x0000}, // -0.0 = 0.0
+		{0x7f80, 0xff80}, // +Inf = -Inf
+		{0xff80, 0x7f80}, // -Inf = +Inf
+		{0x7fc0, 0x7fc0}, // NaN = NaN
+	}
+	for _, tt := range tests {
+		got := tt.a.Neg()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16(%x).Neg() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Neg(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Neg())
+	}
+}
+
+func TestBFloat16_Abs(t *testing.T) {
+	tests := []struct {
+		a, want BFloat16
+	}{
+		{exactBF16(1.0), exactBF16(1.0)},
+		{exactBF16(-1.0), exactBF16(1.0)},
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(0)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+	for _, tt := range tests {
+		got := tt.a.Abs()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16(%x).Abs() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want BFloat16
+	}{
+		{0x3f80, 0x0000, 0x0000}, // 1.0 * 0.0 = 0.0
+		{0x3f80, 0x3f80, 0x3f80}, // 1.0 * 1.0 = 1.0
+
+		// handling zero
+		{0x0000, 0x3f80, 0x0000}, //  0.0 *  1.0 =  0.0
+		{0x8000, 0x3f80, 0x8000}, // -0.0 *  1.0 = -0.0
+		{0x0000, 0xbf80, 0x8000}, //  0.0 * -1.0 = -0.0
+		{0x8000, 0xbf80, 0x0000}, // -0.0 * -1.0 =  0.0
+
+		// handling NaN
+		{0x7fc0, 0x0000, 0x7fc0}, // NaN * 0.0 = NaN
+		{0x0000, 0x7fc0, 0x7fc0}, // 0.0 * NaN = NaN
+
+		// handling infinity
+		{0x3f80, 0x7f80, 0x7f80}, //  1.0 *  Inf =  Inf
+		{0xbf80, 0x7f80, 0xff80}, // -1.0 *  Inf = -Inf
+		{0x7f80, 0x3f80, 0x7f80}, //  Inf *  1.0 =  Inf
+		{0x7f80, 0xbf80, 0xff80}, //  Inf * -1.0 = -Inf
+		{0x7f80, 0x0000, 0x7fc0}, //  Inf *  0.0 =  NaN
+		{0x0000, 0x7f80, 0x7fc0}, //  0.0 *  Inf =  NaN
+	}
+
+	for _, test := range tests {
+		got := test.a.Mul(test.b)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Mul(%x) = %x, want %x", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Mul(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Mul(f))
+	}
+}
+
+func TestBFloat16_Quo(t *testing.T) {
+	tests := []struct {
+		a, b, want BFloat16
+	}{
+		{0x0000, 0x3f80, 0x0000}, // 0.0 / 1.0 = 0.0
+		{0x3f80, 0x3f80, 0x3f80}, // 1.0 / 1.0 = 1.0
+
+		// zero division
+		{0x3f80, 0x0000, 0x7f80}, // 1.0 / 0.0 = +Inf
+		{0x0000, 0x0000, 0x7fc0}, // 0.0 / 0.0 = NaN
+
+		// overflow
+		{0x7f00, 0x3f00, 0x7f80},
+
+		// the result is subnormal.
+		{0x3e7e, 0x7e7f, 0x0020},
+		{0x3f80, 0x7f00, 0x0040},
+
+		// handling NaN
+		{0x7fc0, 0x3f80, 0x7fc0}, // NaN / 1.0 = NaN
+		{0x3f80, 0x7fc0, 0x7fc0}, // 1.0 / NaN = NaN
+
+		// handling infinity
+		{0x3f80, 0x7f80, 0x0000}, // 1.0 / Inf = 0.0
+		{0x7f80, 0x3f80, 0x7f80}, // Inf / 1.0 = Inf
+		{0x7f80, 0x7f80, 0x7fc0}, // Inf / Inf = NaN
+	}
+
+	for _, test := range tests {
+		got := test.a.Quo(test.b)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Quo(%x) = %x, want %x", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Quo(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Quo(f))
+	}
+}
+
+func TestBFloat16_Add(t *testing.T) {
+	tests := []struct {
+		a, b, want BFloat16
+	}{
+		{0x3f80, 0x3f00, 0x3fc0}, // 1.0 +  0.5 = 1.5
+		{0x3f80, 0x3f80, 0x4000}, // 1.0 +  1.0 = 2.0
+		{0x3f80, 0xbf80, 0x0000}, // 1.0 + -1.0 = 0.0
+		{0x3e0d, 0x40c5, 0x40c9},
+		{0x0030, 0x8080, 0x8050},
+		{0x0001, 0x007e, 0x007f},
+		{0x0002, 0x0002, 0x0004}, // 0x1p-132 + 0x1p-132 = 0x1p-131
+		{0x0001, 0x0001, 0x0002}, // 0x1p-133 + 0x1p-133 = 0x1p-132
+
+		// overflow
+		{0x7f70, 0x7e80, 0x7f80},
+
+		// adding zeros
+		{0x0000, 0x3f80, 0x3f80}, //  0.0 +  1.0 =  1.0
+		{0x3f80, 0x0000, 0x3f80}, //  1.0 +  0.0 =  1.0
+		{0x0000, 0x0000, 0x0000}, //  0.0 +  0.0 =  0.0
+		{0x0000, 0x8000, 0x0000}, //  0.0 + -0.0 =  0.0
+		{0x8000, 0x0000, 0x0000}, // -0.0 +  0.0 =  0.0
+		{0x8000, 0x8000, 0x8000}, // -0.0 + -0.0 = -0.0
+
+		// handling NaN
+		{uvnanBF16, 0x3f80, uvnanBF16}, // NaN + 1 = NaN
+		{0x3f80, uvnanBF16, uvnanBF16}, // 1 + NaN = NaN
+
+		// handling infinity
+		{0x7f80, 0x3f80, 0x7f80},    //  Inf +  1.0 = Inf
+		{0x3f80, 0x7f80, 0x7f80},    //  1.0 +  Inf = Inf
+		{0x7f80, 0x7f80, 0x7f80},    //  Inf +  inf = Inf
+		{0x7f80, 0xff80, uvnanBF16}, //  Inf + -inf = NaN
+		{0xff80, 0x7f80, uvnanBF16}, // -inf + Inf = NaN
+	}
+
+	for _, test := range tests {
+		got := test.a.Add(test.b)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Add(%x) = %x, want %x", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Add(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Add(f))
+	}
+}
+
+func TestBFloat16_Sub(t *testing.T) {
+	tests := []struct {
+		a, b, want BFloat16
+	}{
+		{0x3f80, 0x3f00, 0x3f00}, // 1.0 -  0.5 = 0.5
+
+		// handling zeros
+		{0x0000, 0x3f80, 0xbf80}, //  0.0 -  1.0 =  1.0
+		{0x3f80, 0x0000, 0x3f80}, //  1.0 -  0.0 =  1.0
+		{0x0000, 0x0000, 0x0000}, //  0.0 -  0.0 =  0.0
+		{0x0000, 0x8000, 0x0000}, //  0.0 - -0.0 =  0.0
+		{0x8000, 0x0000, 0x8000}, // -0.0 -  0.0 = -0.0
+		{0x8000, 0x8000, 0x0000}, // -0.0 - -0.0 =  0.0
+
+		// handling NaN
+		{uvnanBF16, 0x3f80, uvnanBF16}, // NaN - 1 = NaN
+		{0x3f80, uvnanBF16, uvnanBF16}, // 1 - NaN = NaN
+
+		// handling infinity
+		{0x7f80, 0x3f80, 0x7f80}, //  Inf -  1.0 = Inf
+		{0x3f80, 0x7f80, 0xff80}, //  1.0 -  Inf = -Inf
+		{0x7f80, 0x7f80, 0x7fc0}, //  Inf -  inf = NaN
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sub(tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16(%x).Sub(%x) = %x, want %x", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Sub(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Sub(f))
+	}
+}
+
+func TestBFloat16_Sqrt(t *testing.T) {
+	tests := []struct {
+		a, want BFloat16
+	}{
+		// normal numbers
+		{0x3f80, 0x3f80}, // sqrt(1.0) = 1.0
+		{0x4000, 0x3fb5}, // sqrt(2.0) = 1.4142
+		{0x4040, 0x3fde}, // sqrt(3.0) = 1.7321
+		{0x4080, 0x4000}, // sqrt(4.0) = 2.0
+
+		// special cases
+		{0x0000, 0x0000}, // sqrt(0.0) = 0.0
+		{0x8000, 0x8000}, // sqrt(-0.0) = -0.0
+		{0x7f80, 0x7f80}, // sqrt(+Inf) = +Inf
+		{0x7fc0, 0x7fc0}, // sqrt(NaN) = NaN
+		{0xbf80, 0x7fc0}, // sqrt(-1) = NaN
+	}
+	for _, test := range tests {
+		got := test.a.Sqrt()
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Sqrt() = %x, want %x", test.a, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Sqrt(b *testing.B) {
+	f := BFloat16(0x4000) // 2.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Sqrt())
+	}
+}
+
+func TestBFloat16_Eq(t *testing.T) {
+	tests := []struct {
+		a, b BFloat16
+		want bool
+	}{
+		{0x3f80, 0x3f80, true},        // 1.0 == 1.0
+		{0x3f80, 0x0000, false},       // 1.0 != 0.0
+		{0x0000, 0x0000, true},        // 0.0 == 0.0
+		{0x8000, 0x8000, true},        // -0.0 == -0.0
+		{0x0000, 0x8000, true},        // 0.0 == -0.0
+		{uvnanBF16, uvnanBF16, false}, // NaN != NaN
+	}
+	for _, test := range tests {
+		got := test.a.Eq(test.b)
+		if got != test.want {
+			t.Errorf("BFloat16(%x).Eq(%x) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Eq(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Eq(f))
+	}
+}
+
+func TestBFloat16_Ne(t *testing.T) {
+	tests := []struct {
+		a, b BFloat16
+		want bool
+	}{
+		{0x3f80, 0x3f80, false},      // 1.0 == 1.0
+		{0x3f80, 0x0000, true},       // 1.0 != 0.0
+		{0x0000, 0x0000, false},      // 0.0 == 0.0
+		{0x8000, 0x8000, false},      // -0.0 == -0.0
+		{0x0000, 0x8000, false},      // 0.0 == -0.0
+		{uvnanBF16, uvnanBF16, true}, // NaN != NaN
+	}
+	for _, test := range tests {
+		got := test.a.Ne(test.b)
+		if got != test.want {
+			t.Errorf("BFloat16(%x).Ne(%x) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Ne(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Ne(f))
+	}
+}
+
+func TestBFloat16_Lt(t *testing.T) {
+	tests := []struct {
+		a, b BFloat16
+		want bool
+	}{
+		{0x3f80, 0x3f80, false},       // 1.0 < 1.0
+		{0x3f80, 0x0000, false},       // 1.0 < 0.0
+		{0x0000, 0x3f80, true},        // 0.0 < 1.0
+		{0x0000, 0x0000, false},       // 0.0 < 0.0
+		{0x8000, 0x8000, false},       // -0.0 < -0.0
+		{0x0000, 0x8000, false},       // 0.0 < -0.0
+		{0x8000, 0x0000, false},       // -0.0 < 0.0
+		{uvnanBF16, uvnanBF16, false}, // NaN < NaN
+	}
+	for _, test := range tests {
+		got := test.a.Lt(test.b)
+		if got != test.want {
+			t.Errorf("BFloat16(%x).Lt(%x) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Lt(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Lt(f))
+	}
+}
+
+func TestBFloat16_Gt(t *testing.T) {
+	tests := []struct {
+		a, b BFloat16
+		want bool
+	}{
+		{0x3f80, 0x3f80, false},       // 1.0 > 1.0
+		{0x3f80, 0x0000, true},        // 1.0 > 0.0
+		{0x0000, 0x3f80, false},       // 0.0 > 1.0
+		{0x0000, 0x0000, false},       // 0.0 > 0.0
+		{0x8000, 0x8000, false},       // -0.0 > -0.0
+		{0x0000, 0x8000, false},       // 0.0 > -0.0
+		{0x8000, 0x0000, false},       // -0.0 > 0.0
+		{uvnanBF16, uvnanBF16, false}, // NaN > NaN
+	}
+	for _, test := range tests {
+		got := test.a.Gt(test.b)
+		if got != test.want {
+			t.Errorf("BFloat16(%x).Gt(%x) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Gt(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Gt(f))
+	}
+}
+
+func TestBFloat16_Le(t *testing.T) {
+	tests := []struct {
+		a, b BFloat16
+		want bool
+	}{
+		{0x3f80, 0x3f80, true},        // 1.0 <= 1.0
+		{0x3f80, 0x0000, false},       // 1.0 <= 0.0
+		{0x0000, 0x3f80, true},        // 0.0 <= 1.0
+		{0x0000, 0x0000, true},        // 0.0 <= 0.0
+		{0x8000, 0x8000, true},        // -0.0 <= -0.0
+		{0x0000, 0x8000, true},        // 0.0 <= -0.0
+		{uvnanBF16, uvnanBF16, false}, // NaN <= NaN
+	}
+	for _, test := range tests {
+		got := test.a.Le(test.b)
+		if got != test.want {
+			t.Errorf("BFloat16(%x).Le(%x) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Le(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Le(f))
+	}
+}
+
+func TestBFloat16_Ge(t *testing.T) {
+	tests := []struct {
+		a, b BFloat16
+		want bool
+	}{
+		{0x3f80, 0x3f80, true},        // 1.0 >= 1.0
+		{0x3f80, 0x0000, true},        // 1.0 >= 0.0
+		{0x0000, 0x3f80, false},       // 0.0 >= 1.0
+		{0x0000, 0x0000, true},        // 0.0 >= 0.0
+		{uvnanBF16, uvnanBF16, false}, // NaN >= NaN
+	}
+	for _, test := range tests {
+		got := test.a.Ge(test.b)
+		if got != test.want {
+			t.Errorf("BFloat16(%x).Ge(%x) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Ge(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Ge(f))
+	}
+}
+
+func TestFMABF16(t *testing.T) {
+	tests := []struct {
+		a, b, c, want BFloat16
+	}{
+		{0x3f80, 0x3f80, 0x3f80, 0x4000}, // 1.0 * 1.0 + 1.0 = 2.0
+		{0x3f80, 0x3f80, 0xc000, 0xbf80}, // 1.0 * 1.0 + -2.0 = -1.0
+		{0x0a6f, 0xb41c, 0x0100, 0x00ee},
+		{0x00df, 0x3380, 0x8080, 0x8080},
+		{0xa836, 0x0001, 0x007f, 0x007f},
+
+		// overflow
+		{0x7f10, 0x43af, 0x0001, 0x7f80},
+
+		{0x3f80, 0x3f80, 0x0000, 0x3f80}, // 1.0 * 1.0 + 0.0 = 1.0
+		{0x3f80, 0x0000, 0x0000, 0x0000}, // 1.0 * 0.0 + 0.0 = 0.0
+		{0x3f80, 0x3f80, 0xbf80, 0x0000}, // 1.0 * 1.0 + -1.0 = 0.0
+		{0x890f, 0x007f, 0x0000, 0x8000},
+		{uvnanBF16, uvnanBF16, uvnanBF16, uvnanBF16},
+	}
+	for _, test := range tests {
+		got := FMABF16(test.a, test.b, test.c)
+		if !eqBF16(got, test.want) {
+			t.Errorf("FMABF16(%x, %x, %x) = %x, want %x", test.a, test.b, test.c, got, test.want)
+		}
+	}
+}
+
+func BenchmarkFMABF16(b *testing.B) {
+	f := BFloat16(0x3f80) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(FMABF16(f, f, f))
+	}
+}
+
+func TestBFloat16_Nextafter(t *testing.T) {
+	tests := []struct {
+		x, y, want BFloat16
+	}{
+		{exactBF16(0), exactBF16(1), exactBF16(0x1p-133)},
+		{exactBF16(0), exactBF16(-1), exactBF16(-0x1p-133)},
+		{exactBF16(1), exactBF16(2), exactBF16(0x1.02p+00)},
+		{exactBF16(1), exactBF16(0), exactBF16(0x1.fep-01)},
+		{exactBF16(-1), exactBF16(-2), exactBF16(-0x1.02p+00)},
+		{exactBF16(-1), exactBF16(0), exactBF16(-0x1.fep-01)},
+
+		// special cases
+		{exactBF16(1), exactBF16(1), exactBF16(1)},
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+	for _, test := range tests {
+		got := test.x.Nextafter(test.y)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Nextafter(%x) = %x, want %x", test.x, test.y, got, test.want)
+		}
+	}
+}
+
+func TestBFloat16_Modf(t *testing.T) {
+	t.Cleanup(func() { optimized = true })
+	tests := []struct {
+		in       BFloat16
+		wantInt  BFloat16
+		wantFrac BFloat16
+	}{
+		{exactBF16(3.75), exactBF16(3.0), exactBF16(0.75)},
+
+		// a < 0
+		{exactBF16(-2.5), exactBF16(-2.0), exactBF16(-0.5)},
+		{exactBF16(-0.5), exactBF16(math.Copysign(0, -1)), exactBF16(-0.5)},
+
+		// a == 0
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(0), exactBF16(0), exactBF16(0)},
+
+		// 0 < a < 1
+		{exactBF16(0.5), exactBF16(0), exactBF16(0.5)},
+
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+	for _, test := range tests {
+		optimized = false
+		intPart1, fracPart1 := test.in.Modf()
+		optimized = true
+		intPart2, fracPart2 := test.in.Modf()
+
+		if !eqBF16(intPart1, test.wantInt) || !eqBF16(fracPart1, test.wantFrac) {
+			t.Errorf("BFloat16(%x).Modf() = (%x, %x), want (%x, %x)", test.in, intPart1, fracPart1, test.wantInt, test.wantFrac)
+		}
+		if !eqBF16(intPart2, test.wantInt) || !eqBF16(fracPart2, test.wantFrac) {
+			t.Errorf("optimized BFloat16(%x).Modf() = (%x, %x), want (%x, %x)", test.in, intPart2, fracPart2, test.wantInt, test.wantFrac)
+		}
+	}
+}
+
+func TestBFloat16_Modf_All(t *testing.T) {
+	t.Cleanup(func() { optimized = true })
+	for i := range 0x10000 {
+		f := BFloat16(i)
+		optimized = false
+		intPart1, fracPart1 := f.Modf()
+		optimized = true
+		intPart2, fracPart2 := f.Modf()
+		if !eqBF16(intPart1, intPart2) || !eqBF16(fracPart1, fracPart2) {
+			t.Errorf("BFloat16(%x).Modf() mismatch between optimized and non-optimized: (%x, %x) vs (%x, %x)", f, intPart1, fracPart1, intPart2, fracPart2)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Modf(b *testing.B) {
+	optimized = false
+	b.Cleanup(func() { optimized = true })
+	f := exactBF16(3.75)
+	for b.Loop() {
+		intPart, fracPart := f.Modf()
+		runtime.KeepAlive(intPart)
+		runtime.KeepAlive(fracPart)
+	}
+}
+
+func BenchmarkBFloat16_Modf_Optimized(b *testing.B) {
+	f := exactBF16(3.75)
+	for b.Loop() {
+		intPart, fracPart := f.Modf()
+		runtime.KeepAlive(intPart)
+		runtime.KeepAlive(fracPart)
+	}
+}
+
+func TestBFloat16_Frexp(t *testing.T) {
+	tests := []struct {
+		in       BFloat16
+		wantFrac BFloat16
+		wantExp  int
+	}{
+		{exactBF16(6.0), exactBF16(0.75), 3},
+		{exactBF16(0.5), exactBF16(0.5), 0},
+		{exactBF16(0x1p-133), exactBF16(0.5), -132},
+
+		// special cases
+		{exactBF16(0), exactBF16(0), 0},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1)), 0},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1)), 0},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1)), 0},
+		{exactBF16(math.NaN()), exactBF16(math.NaN()), 0},
+	}
+	for _, test := range tests {
+		gotFrac, gotExp := test.in.Frexp()
+		if !eqBF16(gotFrac, test.wantFrac) || gotExp != test.wantExp {
+			t.Errorf("BFloat16(%x).Frexp() = (%x, %d), want (%x, %d)", test.in, gotFrac, gotExp, test.wantFrac, test.wantExp)
+		}
+	}
+}
+
+func TestBFloat16_Ldexp(t *testing.T) {
+	tests := []struct {
+		frac BFloat16
+		exp  int
+		want BFloat16
+	}{
+		{exactBF16(0.75), 3, exactBF16(6.0)},
+		{exactBF16(0.5), 0, exactBF16(0.5)},
+		{exactBF16(0.5), -132, exactBF16(0x1p-133)},
+
+		// underflow
+		{exactBF16(0.5), -133, exactBF16(0)},
+		{exactBF16(-0.5), -133, exactBF16(math.Copysign(0, -1))},
+
+		// overflow
+		{exactBF16(1.0), 128, exactBF16(math.Inf(1))},
+		{exactBF16(-1.0), 128, exactBF16(math.Inf(-1))},
+
+		// special cases
+		{exactBF16(0), 10, exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), 10, exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), 10, exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), 10, exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), 10, exactBF16(math.NaN())},
+	}
+	for _, test := range tests {
+		got := test.frac.Ldexp(test.exp)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Ldexp(%d) = %x, want %x", test.frac, test.exp, got, test.want)
+		}
+	}
+}
+
+func TestBFloat16_Mod(t *testing.T) {
+	tests := []struct {
+		a, b, want BFloat16
+	}{
+		{exactBF16(5.5), exactBF16(2.0), exactBF16(1.5)},
+		{exactBF16(-5.5), exactBF16(2.0), exactBF16(-1.5)},
+		{exactBF16(5.5), exactBF16(-2.0), exactBF16(1.5)},
+		{exactBF16(-5.5), exactBF16(-2.0), exactBF16(-1.5)},
+
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(1.0), exactBF16(0.0), exactBF16(math.NaN())},
+		{exactBF16(1.0), exactBF16(math.Inf(1)), exactBF16(1.0)},
+		{exactBF16(1.0), exactBF16(math.Inf(-1)), exactBF16(1.0)},
+		{exactBF16(1.0), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, test := range tests {
+		got := test.a.Mod(test.b)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Mod(%x) = %x, want %x", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestBFloat16_Remainder(t *testing.T) {
+	tests := []struct {
+		a, b, want BFloat16
+	}{
+		{exactBF16(5.5), exactBF16(2.0), exactBF16(-0.5)},
+		{exactBF16(-5.5), exactBF16(2.0), exactBF16(0.5)},
+		{exactBF16(5.5), exactBF16(-2.0), exactBF16(-0.5)},
+		{exactBF16(-5.5), exactBF16(-2.0), exactBF16(0.5)},
+
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(1.0), exactBF16(0.0), exactBF16(math.NaN())},
+		{exactBF16(1.0), exactBF16(math.Inf(1)), exactBF16(1.0)},
+		{exactBF16(1.0), exactBF16(math.Inf(-1)), exactBF16(1.0)},
+		{exactBF16(1.0), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, test := range tests {
+		got := test.a.Remainder(test.b)
+		if !eqBF16(got, test.want) {
+			t.Errorf("BFloat16(%x).Remainder(%x) = %x, want %x", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestNewBFloat16FromInt(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want float64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, -1},
+		{1<<8 + 1, 1 << 8},
+		{1<<8 + 3, 1<<8 + 4},
+		{-(1<<8 + 3), -(1<<8 + 4)},
+		{math.MaxInt64, 0x1p63},
+		{math.MinInt64, -0x1p63},
+	}
+
+	for _, tt := range tests {
+		got := NewBFloat16FromInt64(tt.in)
+		if want := NewBFloat16(tt.want); got != want {
+			t.Errorf("NewBFloat16FromInt64(%d) = %v, want %v", tt.in, got, want)
+		}
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 8))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []BFloat16{
+			NewBFloat16FromInt64(v.i64),
+			NewBFloat16FromUint64(v.u64),
+			NewBFloat16FromInt128(v.i128),
+			NewBFloat16FromUint128(v.u128),
+			NewBFloat16FromInt256(v.i256),
+			NewBFloat16FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewBFloat16FromBigInt(x); got[i] != want {
+				t.Errorf("NewBFloat16FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
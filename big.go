@@ -0,0 +1,598 @@
+package floats
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+
+	"github.com/shogo82148/ints"
+)
+
+// Conversions between the binary floating-point types and [math/big].
+//
+// The values of all the binary formats are exactly representable in Float256,
+// so the conversions to math/big go through Float256.
+// The conversions from math/big round to Float256 with round-to-odd first,
+// and then round to the destination format; see [Context] for why this is correct.
+
+// bigIntFromUint256 sets z to x and returns z.
+func bigIntFromUint256(z *big.Int, x ints.Uint256) *big.Int {
+	var buf [32]byte
+	for i, w := range x {
+		binary.BigEndian.PutUint64(buf[8*i:], w)
+	}
+	return z.SetBytes(buf[:])
+}
+
+// uint512FromBigInt returns the absolute value of x, which must fit in 512 bits.
+func uint512FromBigInt(x *big.Int) ints.Uint512 {
+	var buf [64]byte
+	x.FillBytes(buf[:])
+	var ret ints.Uint512
+	for i := range ret {
+		ret[i] = binary.BigEndian.Uint64(buf[8*i:])
+	}
+	return ret
+}
+
+// bigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to prec.
+func (a Float256) bigFloat(z *big.Float, prec uint) *big.Float {
+	if z == nil {
+		z = new(big.Float)
+	}
+	if z.Prec() == 0 {
+		z.SetPrec(prec)
+	}
+	if a.IsNaN() {
+		// big.Float cannot represent NaN; let math/big panic with ErrNaN.
+		z.SetFloat64(math.NaN())
+	}
+	if a.IsInf(0) {
+		return z.SetInf(a.Signbit())
+	}
+
+	_, exp, frac := a.split()
+	var mant big.Int
+	z.SetInt(bigIntFromUint256(&mant, frac))
+	z.SetMantExp(z, exp-shift256)
+	if a.Signbit() {
+		z.Neg(z)
+	}
+	return z
+}
+
+// float256FromBig rounds (-1)**neg × mant × 2**exp to Float256 according to mode.
+// mant is the absolute value of the mantissa; it may be modified.
+func float256FromBig(neg bool, mant *big.Int, exp int, mode RoundingMode) (Float256, Flags) {
+	var sign uint64
+	if neg {
+		sign = signMask256[0]
+	}
+
+	// keep enough bits for rounding, and squash the rest into the sticky bit.
+	if l := mant.BitLen(); l > 509 {
+		s := uint(l - 509)
+		sticky := mant.TrailingZeroBits() < s
+		mant.Rsh(mant, s)
+		if sticky {
+			mant.SetBit(mant, 0, 1)
+		}
+		exp += int(s)
+	}
+	return pack256(sign, exp, uint512FromBigInt(mant), mode)
+}
+
+// float256FromBigFloat rounds x to Float256 according to mode.
+func float256FromBigFloat(x *big.Float, mode RoundingMode) (Float256, Flags) {
+	if x.IsInf() {
+		return NewFloat256Inf(x.Sign()), 0
+	}
+	if x.Sign() == 0 {
+		if x.Signbit() {
+			return Float256{signMask256[0], 0, 0, 0}, 0
+		}
+		return Float256{}, 0
+	}
+
+	// x = mant × 2**(exp-prec), where mant is an integer.
+	var m big.Float
+	exp := x.MantExp(&m)
+	prec := int(x.MinPrec())
+	m.SetMantExp(&m, prec)
+	mant, _ := m.Int(nil)
+	return float256FromBig(x.Signbit(), mant.Abs(mant), exp-prec, mode)
+}
+
+// float256FromBigInt rounds x to Float256 according to mode.
+func float256FromBigInt(x *big.Int, mode RoundingMode) (Float256, Flags) {
+	var mant big.Int
+	return float256FromBig(x.Sign() < 0, mant.Abs(x), 0, mode)
+}
+
+// float256FromRat rounds x to Float256 according to mode.
+func float256FromRat(x *big.Rat, mode RoundingMode) (Float256, Flags) {
+	if x.IsInt() {
+		return float256FromBigInt(x.Num(), mode)
+	}
+
+	// compute the quotient with at least shift256+3 bits,
+	// and squash the remainder into the sticky bit.
+	var n, d big.Int
+	n.Abs(x.Num())
+	d.Set(x.Denom())
+	s := d.BitLen() - n.BitLen() + shift256 + 3
+	if s >= 0 {
+		n.Lsh(&n, uint(s))
+	} else {
+		d.Lsh(&d, uint(-s))
+	}
+	var r big.Int
+	n.QuoRem(&n, &d, &r)
+	if r.Sign() != 0 {
+		n.SetBit(&n, 0, 1)
+	}
+	return float256FromBig(x.Sign() < 0, &n, -s, mode)
+}
+
+// bigInt sets z to a truncated toward zero and returns z.
+func (a Float256) bigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	switch {
+	case a.IsNaN():
+		return nil, big.Exact
+	case a.IsInf(1):
+		return nil, big.Below
+	case a.IsInf(-1):
+		return nil, big.Above
+	}
+	if z == nil {
+		z = new(big.Int)
+	}
+
+	_, exp, frac := a.split()
+	acc := big.Exact
+	if exp -= shift256; exp >= 0 {
+		bigIntFromUint256(z, frac).Lsh(z, uint(exp))
+	} else {
+		var q ints.Uint256
+		if s := uint(-exp); s < 256 {
+			q = frac.Rsh(s)
+			if q.Lsh(s) != frac {
+				acc = big.Below
+			}
+		} else if !frac.IsZero() {
+			acc = big.Below
+		}
+		bigIntFromUint256(z, q)
+	}
+	if a.Signbit() {
+		z.Neg(z)
+		acc = -acc
+	}
+	return z, acc
+}
+
+// rat sets z to a and returns z.
+func (a Float256) rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	switch {
+	case a.IsNaN():
+		return nil, big.Exact
+	case a.IsInf(1):
+		return nil, big.Below
+	case a.IsInf(-1):
+		return nil, big.Above
+	}
+	if z == nil {
+		z = new(big.Rat)
+	}
+
+	_, exp, frac := a.split()
+	var mant big.Int
+	bigIntFromUint256(&mant, frac)
+	exp -= shift256
+	if exp >= 0 {
+		z.SetInt(mant.Lsh(&mant, uint(exp)))
+	} else {
+		var d big.Int
+		z.SetFrac(&mant, d.Lsh(big.NewInt(1), uint(-exp)))
+	}
+	if a.Signbit() {
+		z.Neg(z)
+	}
+	return z, big.Exact
+}
+
+// bigAccuracy returns the accuracy of r as an approximation of x.
+func bigAccuracy(r Float256, x *big.Float) big.Accuracy {
+	var y big.Float
+	return big.Accuracy(r.bigFloat(&y, shift256+1).Cmp(x))
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 11,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a Float16) BigFloat(z *big.Float) *big.Float {
+	return a.Float256().bigFloat(z, shift16+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float16) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.Float256().bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float16) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.Float256().rat(z)
+}
+
+// NewFloat16FromBigFloat returns the Float16 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewFloat16FromBigFloat(x *big.Float) (Float16, big.Accuracy) {
+	mode := RoundingMode(x.Mode())
+	r, _ := float256FromBigFloat(x, mode|toOdd)
+	ret, _ := r.float16(mode)
+	return ret, bigAccuracy(ret.Float256(), x)
+}
+
+// NewFloat16FromBigInt returns the Float16 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewFloat16FromBigInt(x *big.Int) (Float16, big.Accuracy) {
+	r, _ := float256FromBigInt(x, ToNearestEven|toOdd)
+	ret, _ := r.float16(ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret.Float256(), y.SetInt(x))
+}
+
+// NewFloat16FromRat returns the Float16 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewFloat16FromRat(x *big.Rat) (f Float16, exact bool) {
+	r, f1 := float256FromRat(x, ToNearestEven|toOdd)
+	ret, f2 := r.float16(ToNearestEven)
+	return ret, (f1|f2)&Inexact == 0
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 8,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a BFloat16) BigFloat(z *big.Float) *big.Float {
+	return a.Float256().bigFloat(z, shiftBF16+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a BFloat16) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.Float256().bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a BFloat16) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.Float256().rat(z)
+}
+
+// NewBFloat16FromBigFloat returns the BFloat16 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewBFloat16FromBigFloat(x *big.Float) (BFloat16, big.Accuracy) {
+	mode := RoundingMode(x.Mode())
+	r, _ := float256FromBigFloat(x, mode|toOdd)
+	ret, _ := r.bfloat16(mode)
+	return ret, bigAccuracy(ret.Float256(), x)
+}
+
+// NewBFloat16FromBigInt returns the BFloat16 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewBFloat16FromBigInt(x *big.Int) (BFloat16, big.Accuracy) {
+	r, _ := float256FromBigInt(x, ToNearestEven|toOdd)
+	ret, _ := r.bfloat16(ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret.Float256(), y.SetInt(x))
+}
+
+// NewBFloat16FromRat returns the BFloat16 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewBFloat16FromRat(x *big.Rat) (f BFloat16, exact bool) {
+	r, f1 := float256FromRat(x, ToNearestEven|toOdd)
+	ret, f2 := r.bfloat16(ToNearestEven)
+	return ret, (f1|f2)&Inexact == 0
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 24,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a Float32) BigFloat(z *big.Float) *big.Float {
+	return a.Float256().bigFloat(z, shift32+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float32) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.Float256().bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float32) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.Float256().rat(z)
+}
+
+// NewFloat32FromBigFloat returns the Float32 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewFloat32FromBigFloat(x *big.Float) (Float32, big.Accuracy) {
+	mode := RoundingMode(x.Mode())
+	r, _ := float256FromBigFloat(x, mode|toOdd)
+	ret, _ := r.float32(mode)
+	return ret, bigAccuracy(ret.Float256(), x)
+}
+
+// NewFloat32FromBigInt returns the Float32 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewFloat32FromBigInt(x *big.Int) (Float32, big.Accuracy) {
+	r, _ := float256FromBigInt(x, ToNearestEven|toOdd)
+	ret, _ := r.float32(ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret.Float256(), y.SetInt(x))
+}
+
+// NewFloat32FromRat returns the Float32 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewFloat32FromRat(x *big.Rat) (f Float32, exact bool) {
+	r, f1 := float256FromRat(x, ToNearestEven|toOdd)
+	ret, f2 := r.float32(ToNearestEven)
+	return ret, (f1|f2)&Inexact == 0
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 53,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a Float64) BigFloat(z *big.Float) *big.Float {
+	return a.Float256().bigFloat(z, shift64+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float64) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.Float256().bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float64) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.Float256().rat(z)
+}
+
+// NewFloat64FromBigFloat returns the Float64 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewFloat64FromBigFloat(x *big.Float) (Float64, big.Accuracy) {
+	mode := RoundingMode(x.Mode())
+	r, _ := float256FromBigFloat(x, mode|toOdd)
+	ret, _ := r.float64(mode)
+	return ret, bigAccuracy(ret.Float256(), x)
+}
+
+// NewFloat64FromBigInt returns the Float64 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewFloat64FromBigInt(x *big.Int) (Float64, big.Accuracy) {
+	r, _ := float256FromBigInt(x, ToNearestEven|toOdd)
+	ret, _ := r.float64(ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret.Float256(), y.SetInt(x))
+}
+
+// NewFloat64FromRat returns the Float64 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewFloat64FromRat(x *big.Rat) (f Float64, exact bool) {
+	r, f1 := float256FromRat(x, ToNearestEven|toOdd)
+	ret, f2 := r.float64(ToNearestEven)
+	return ret, (f1|f2)&Inexact == 0
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 64,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a Float80) BigFloat(z *big.Float) *big.Float {
+	return a.Float256().bigFloat(z, shift80+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float80) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.Float256().bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float80) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.Float256().rat(z)
+}
+
+// NewFloat80FromBigFloat returns the Float80 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewFloat80FromBigFloat(x *big.Float) (Float80, big.Accuracy) {
+	mode := RoundingMode(x.Mode())
+	r, _ := float256FromBigFloat(x, mode|toOdd)
+	ret, _ := r.float80(mode)
+	return ret, bigAccuracy(ret.Float256(), x)
+}
+
+// NewFloat80FromBigInt returns the Float80 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewFloat80FromBigInt(x *big.Int) (Float80, big.Accuracy) {
+	r, _ := float256FromBigInt(x, ToNearestEven|toOdd)
+	ret, _ := r.float80(ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret.Float256(), y.SetInt(x))
+}
+
+// NewFloat80FromRat returns the Float80 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewFloat80FromRat(x *big.Rat) (f Float80, exact bool) {
+	r, f1 := float256FromRat(x, ToNearestEven|toOdd)
+	ret, f2 := r.float80(ToNearestEven)
+	return ret, (f1|f2)&Inexact == 0
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 113,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a Float128) BigFloat(z *big.Float) *big.Float {
+	return a.Float256().bigFloat(z, shift128+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float128) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.Float256().bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float128) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.Float256().rat(z)
+}
+
+// NewFloat128FromBigFloat returns the Float128 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewFloat128FromBigFloat(x *big.Float) (Float128, big.Accuracy) {
+	mode := RoundingMode(x.Mode())
+	r, _ := float256FromBigFloat(x, mode|toOdd)
+	ret, _ := r.float128(mode)
+	return ret, bigAccuracy(ret.Float256(), x)
+}
+
+// NewFloat128FromBigInt returns the Float128 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewFloat128FromBigInt(x *big.Int) (Float128, big.Accuracy) {
+	r, _ := float256FromBigInt(x, ToNearestEven|toOdd)
+	ret, _ := r.float128(ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret.Float256(), y.SetInt(x))
+}
+
+// NewFloat128FromRat returns the Float128 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewFloat128FromRat(x *big.Rat) (f Float128, exact bool) {
+	r, f1 := float256FromRat(x, ToNearestEven|toOdd)
+	ret, f2 := r.float128(ToNearestEven)
+	return ret, (f1|f2)&Inexact == 0
+}
+
+// BigFloat sets z to a and returns z.
+// If z is nil, a new [big.Float] is allocated.
+// If z's precision is 0, it is changed to 237,
+// and the result is exact; otherwise it is rounded according to z's rounding mode.
+// The sign of zero is preserved.
+// If a is NaN, BigFloat panics with [big.ErrNaN].
+func (a Float256) BigFloat(z *big.Float) *big.Float {
+	return a.bigFloat(z, shift256+1)
+}
+
+// BigInt sets z to a truncated toward zero and returns z.
+// If z is nil, a new [big.Int] is allocated.
+// The accuracy is [big.Exact] if a is an integer;
+// otherwise it is [big.Below] for a > 0, and [big.Above] for a < 0.
+// If a is an infinity, the result is nil with the accuracy of the truncation.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float256) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	return a.bigInt(z)
+}
+
+// Rat sets z to the exact value of a and returns z.
+// If z is nil, a new [big.Rat] is allocated.
+// The sign of zero is not preserved, because [big.Rat] has no negative zero.
+// If a is an infinity, the result is nil with [big.Below] for +Inf and [big.Above] for -Inf.
+// If a is NaN, the result is (nil, [big.Exact]).
+func (a Float256) Rat(z *big.Rat) (*big.Rat, big.Accuracy) {
+	return a.rat(z)
+}
+
+// NewFloat256FromBigFloat returns the Float256 value nearest to x
+// according to x's rounding mode, and the accuracy of the result.
+// The sign of zero and infinities are preserved.
+func NewFloat256FromBigFloat(x *big.Float) (Float256, big.Accuracy) {
+	ret, _ := float256FromBigFloat(x, RoundingMode(x.Mode()))
+	return ret, bigAccuracy(ret, x)
+}
+
+// NewFloat256FromBigInt returns the Float256 value nearest to x, rounding ties to even,
+// and the accuracy of the result.
+func NewFloat256FromBigInt(x *big.Int) (Float256, big.Accuracy) {
+	ret, _ := float256FromBigInt(x, ToNearestEven)
+	var y big.Float
+	return ret, bigAccuracy(ret, y.SetInt(x))
+}
+
+// NewFloat256FromRat returns the Float256 value nearest to x, rounding ties to even,
+// and a bool indicating whether the result represents x exactly.
+func NewFloat256FromRat(x *big.Rat) (f Float256, exact bool) {
+	ret, flags := float256FromRat(x, ToNearestEven)
+	return ret, flags&Inexact == 0
+}
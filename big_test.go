@@ -0,0 +1,374 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+var bigRoundingModes = []big.RoundingMode{
+	big.ToNearestEven,
+	big.ToNearestAway,
+	big.ToZero,
+	big.AwayFromZero,
+	big.ToNegativeInf,
+	big.ToPositiveInf,
+}
+
+func TestFloat16_BigFloat(t *testing.T) {
+	// all the finite Float16 values survive the round trip.
+	for i := range 1 << 16 {
+		a := Float16(i)
+		if a.IsNaN() {
+			continue
+		}
+		z := a.BigFloat(nil)
+		if z.Prec() != 11 {
+			t.Fatalf("Float16(%#04x).BigFloat(nil).Prec() = %d, want 11", i, z.Prec())
+		}
+		if want := big.NewFloat(float64(a.Float64())); z.Cmp(want) != 0 || z.Signbit() != want.Signbit() {
+			t.Errorf("Float16(%#04x).BigFloat(nil) = %s, want %s", i, z.Text('p', 0), want.Text('p', 0))
+		}
+		got, acc := NewFloat16FromBigFloat(z)
+		if got != a || acc != big.Exact {
+			t.Errorf("NewFloat16FromBigFloat(%s) = (%#04x, %v), want (%#04x, Exact)", z.Text('p', 0), got, acc, i)
+		}
+	}
+}
+
+func TestBigFloat_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(10, 1))
+	for range 1000 {
+		a64 := NewFloat64FromBits(r.Uint64())
+		if !a64.IsNaN() {
+			got, acc := NewFloat64FromBigFloat(a64.BigFloat(nil))
+			if got.Bits() != a64.Bits() || acc != big.Exact {
+				t.Errorf("round trip of Float64 %x: got (%x, %v)", a64.Bits(), got.Bits(), acc)
+			}
+		}
+
+		a80 := NewFloat80FromBits(uint16(r.Uint32()), r.Uint64()|1<<63)
+		if !a80.IsNaN() {
+			got, acc := NewFloat80FromBigFloat(a80.BigFloat(nil))
+			if got != a80 || acc != big.Exact {
+				t.Errorf("round trip of Float80 %v: got (%v, %v)", a80, got, acc)
+			}
+		}
+
+		a128 := NewFloat128FromBits(ints.Uint128{r.Uint64(), r.Uint64()})
+		if !a128.IsNaN() {
+			got, acc := NewFloat128FromBigFloat(a128.BigFloat(nil))
+			if got != a128 || acc != big.Exact {
+				t.Errorf("round trip of Float128 %x: got (%x, %v)", a128.Bits(), got.Bits(), acc)
+			}
+		}
+
+		a256 := NewFloat256FromBits(ints.Uint256{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64()})
+		if !a256.IsNaN() {
+			z := a256.BigFloat(nil)
+			if z.Prec() != 237 {
+				t.Fatalf("Float256.BigFloat(nil).Prec() = %d, want 237", z.Prec())
+			}
+			got, acc := NewFloat256FromBigFloat(z)
+			if got != a256 || acc != big.Exact {
+				t.Errorf("round trip of Float256 %x: got (%x, %v)", a256.Bits(), got.Bits(), acc)
+			}
+		}
+	}
+}
+
+func TestBigFloat_Special(t *testing.T) {
+	negZero := NewFloat128(math.Copysign(0, -1))
+	if z := negZero.BigFloat(nil); z.Sign() != 0 || !z.Signbit() {
+		t.Errorf("-0.BigFloat(nil) = %s, want -0", z.Text('g', -1))
+	}
+	if got, _ := NewFloat128FromBigFloat(new(big.Float).Neg(new(big.Float))); got != negZero {
+		t.Errorf("NewFloat128FromBigFloat(-0) = %x, want -0", got.Bits())
+	}
+	if z := NewFloat256Inf(-1).BigFloat(nil); !z.IsInf() || !z.Signbit() {
+		t.Errorf("-Inf.BigFloat(nil) = %s, want -Inf", z.Text('g', -1))
+	}
+	if got, acc := NewFloat32FromBigFloat(new(big.Float).SetInf(false)); !got.IsInf(1) || acc != big.Exact {
+		t.Errorf("NewFloat32FromBigFloat(+Inf) = (%v, %v), want (+Inf, Exact)", got, acc)
+	}
+
+	// z's precision is kept if it is set.
+	z := new(big.Float).SetPrec(10).SetMode(big.ToZero)
+	NewFloat64(1.0 / 3).BigFloat(z)
+	if z.Prec() != 10 || z.Text('b', 0) != "682p-11" {
+		t.Errorf("BigFloat with the precision 10 = %s (prec %d)", z.Text('b', 0), z.Prec())
+	}
+
+	defer func() {
+		r := recover()
+		if _, ok := r.(big.ErrNaN); !ok {
+			t.Errorf("NaN.BigFloat(nil) panics with %v, want big.ErrNaN", r)
+		}
+	}()
+	NewFloat64NaN().BigFloat(nil)
+}
+
+func TestNewFloat64FromBigFloat(t *testing.T) {
+	// the reference rounding by math/big, in the normal range of Float64.
+	round := func(x *big.Float, mode big.RoundingMode) float64 {
+		z := new(big.Float).SetPrec(53).SetMode(mode).Set(x)
+		f, _ := z.Float64()
+		return f
+	}
+
+	r := rand.New(rand.NewPCG(10, 2))
+	for range 2000 {
+		x := new(big.Float).SetPrec(uint(r.IntN(300) + 1))
+		x.SetMantExp(new(big.Float).SetInt64(r.Int64()), r.IntN(2300)-1150)
+		x.Quo(x, big.NewFloat(3))
+
+		// math/big rounds to the nearest even, including subnormals and overflows.
+		want, wantAcc := x.Float64()
+		got, acc := NewFloat64FromBigFloat(x)
+		if float64(got) != want || got.Signbit() != (math.Signbit(want)) || acc != wantAcc {
+			t.Errorf("NewFloat64FromBigFloat(%s) = (%g, %v), want (%g, %v)", x.Text('p', 0), got, acc, want, wantAcc)
+		}
+		want32, wantAcc32 := x.Float32()
+		got32, acc32 := NewFloat32FromBigFloat(x)
+		if float32(got32) != want32 || acc32 != wantAcc32 {
+			t.Errorf("NewFloat32FromBigFloat(%s) = (%g, %v), want (%g, %v)", x.Text('p', 0), got32, acc32, want32, wantAcc32)
+		}
+
+		if e := x.MantExp(nil); e < -1000 || e > 1000 {
+			continue
+		}
+		for _, mode := range bigRoundingModes {
+			y := new(big.Float).SetMode(mode).Set(x)
+			got, _ := NewFloat64FromBigFloat(y)
+			if want := round(x, mode); float64(got) != want {
+				t.Errorf("NewFloat64FromBigFloat(%s, %v) = %g, want %g", x.Text('p', 0), mode, got, want)
+			}
+		}
+	}
+}
+
+func TestNewFloat256FromBigFloat(t *testing.T) {
+	r := rand.New(rand.NewPCG(10, 3))
+	for range 500 {
+		x := new(big.Float).SetPrec(500)
+		x.SetMantExp(new(big.Float).SetInt64(r.Int64()|1), r.IntN(2000)-1000)
+		x.Quo(x, big.NewFloat(7))
+		for _, mode := range bigRoundingModes {
+			y := new(big.Float).SetMode(mode).Set(x)
+			got, acc := NewFloat256FromBigFloat(y)
+			want := new(big.Float).SetPrec(237).SetMode(mode).Set(x)
+			if got.BigFloat(nil).Cmp(want) != 0 || acc != want.Acc() {
+				t.Errorf("NewFloat256FromBigFloat(%s, %v) = (%s, %v), want (%s, %v)", x.Text('p', 0), mode, got.BigFloat(nil).Text('p', 0), acc, want.Text('p', 0), want.Acc())
+			}
+		}
+	}
+}
+
+func TestNewFloat64FromBigFloat_Edge(t *testing.T) {
+	max := new(big.Float).SetFloat64(math.MaxFloat64)
+	huge := new(big.Float).SetMantExp(big.NewFloat(1), 2000)
+	tiny := new(big.Float).SetMantExp(big.NewFloat(1), -2000)
+	half := new(big.Float).SetMantExp(big.NewFloat(1), -1075) // half of the smallest subnormal
+
+	tests := []struct {
+		x    *big.Float
+		neg  bool
+		mode big.RoundingMode
+		want float64
+		acc  big.Accuracy
+	}{
+		{max, false, big.ToNearestEven, math.MaxFloat64, big.Exact},
+		{huge, false, big.ToNearestEven, math.Inf(1), big.Above},
+		{huge, false, big.ToZero, math.MaxFloat64, big.Below},
+		{huge, true, big.ToZero, -math.MaxFloat64, big.Above},
+		{huge, false, big.ToNegativeInf, math.MaxFloat64, big.Below},
+		{huge, true, big.ToNegativeInf, math.Inf(-1), big.Below},
+		{huge, false, big.AwayFromZero, math.Inf(1), big.Above},
+		{tiny, false, big.ToNearestEven, 0, big.Below},
+		{tiny, true, big.ToNearestEven, math.Copysign(0, -1), big.Above},
+		{tiny, false, big.ToPositiveInf, 5e-324, big.Above},
+		{tiny, true, big.ToPositiveInf, math.Copysign(0, -1), big.Above},
+		{tiny, true, big.AwayFromZero, -5e-324, big.Below},
+		{half, false, big.ToNearestEven, 0, big.Below},
+		{half, false, big.ToNearestAway, 5e-324, big.Above},
+	}
+
+	for _, tt := range tests {
+		x := new(big.Float).SetMode(tt.mode).Set(tt.x)
+		if tt.neg {
+			x.Neg(x)
+		}
+		got, acc := NewFloat64FromBigFloat(x)
+		if float64(got) != tt.want || math.Signbit(float64(got)) != math.Signbit(tt.want) || acc != tt.acc {
+			t.Errorf("NewFloat64FromBigFloat(%s, %v) = (%g, %v), want (%g, %v)", x.Text('g', 10), tt.mode, got, acc, tt.want, tt.acc)
+		}
+	}
+}
+
+func TestFloat128_BigInt(t *testing.T) {
+	tests := []struct {
+		in   Float128
+		want string
+		acc  big.Accuracy
+	}{
+		{NewFloat128(0), "0", big.Exact},
+		{NewFloat128(math.Copysign(0, -1)), "0", big.Exact},
+		{NewFloat128(1.5), "1", big.Below},
+		{NewFloat128(-1.5), "-1", big.Above},
+		{NewFloat128(0.25), "0", big.Below},
+		{NewFloat128(1e30), "1000000000000000019884624838656", big.Exact},
+		{NewFloat128(0x1p200), "1606938044258990275541962092341162602522202993782792835301376", big.Exact},
+		{NewFloat128(-0x1p-1000), "0", big.Above},
+	}
+
+	for _, tt := range tests {
+		got, acc := tt.in.BigInt(nil)
+		if got.String() != tt.want || acc != tt.acc {
+			t.Errorf("%v.BigInt(nil) = (%s, %v), want (%s, %v)", tt.in, got, acc, tt.want, tt.acc)
+		}
+	}
+
+	if got, acc := NewFloat128Inf(1).BigInt(nil); got != nil || acc != big.Below {
+		t.Errorf("+Inf.BigInt(nil) = (%v, %v), want (nil, Below)", got, acc)
+	}
+	if got, acc := NewFloat128Inf(-1).BigInt(nil); got != nil || acc != big.Above {
+		t.Errorf("-Inf.BigInt(nil) = (%v, %v), want (nil, Above)", got, acc)
+	}
+	if got, acc := NewFloat128NaN().BigInt(nil); got != nil || acc != big.Exact {
+		t.Errorf("NaN.BigInt(nil) = (%v, %v), want (nil, Exact)", got, acc)
+	}
+}
+
+func TestNewFloat64FromBigInt(t *testing.T) {
+	r := rand.New(rand.NewPCG(10, 4))
+	for range 2000 {
+		x := randBigInt(r, r.IntN(1100))
+		if r.IntN(2) == 0 {
+			x.Neg(x)
+		}
+		want, wantAcc := x.Float64()
+		got, acc := NewFloat64FromBigInt(x)
+		if float64(got) != want || acc != wantAcc {
+			t.Errorf("NewFloat64FromBigInt(%s) = (%g, %v), want (%g, %v)", x, got, acc, want, wantAcc)
+		}
+	}
+
+	x := new(big.Int).Lsh(big.NewInt(1), 16384)
+	if got, acc := NewFloat128FromBigInt(x); !got.IsInf(1) || acc != big.Above {
+		t.Errorf("NewFloat128FromBigInt(2**16384) = (%v, %v), want (+Inf, Above)", got, acc)
+	}
+	x.Sub(x, big.NewInt(1))
+	if got, acc := NewFloat256FromBigInt(x); got != NewFloat256(1).Ldexp(16384) || acc != big.Above {
+		t.Errorf("NewFloat256FromBigInt(2**16384-1) = (%v, %v), want (2**16384, Above)", got, acc)
+	}
+}
+
+func TestFloat64_Rat(t *testing.T) {
+	tests := []struct {
+		in   Float64
+		want string
+	}{
+		{0, "0/1"},
+		{Float64(math.Copysign(0, -1)), "0/1"},
+		{1, "1/1"},
+		{-0.75, "-3/4"},
+		{0.1, "3602879701896397/36028797018963968"},
+		{1e20, "100000000000000000000/1"},
+	}
+
+	for _, tt := range tests {
+		got, acc := tt.in.Rat(nil)
+		if got.String() != tt.want || acc != big.Exact {
+			t.Errorf("%v.Rat(nil) = (%s, %v), want (%s, Exact)", tt.in, got, acc, tt.want)
+		}
+	}
+	if got, acc := NewFloat64Inf(-1).Rat(nil); got != nil || acc != big.Above {
+		t.Errorf("-Inf.Rat(nil) = (%v, %v), want (nil, Above)", got, acc)
+	}
+	if got, acc := NewFloat256NaN().Rat(nil); got != nil || acc != big.Exact {
+		t.Errorf("NaN.Rat(nil) = (%v, %v), want (nil, Exact)", got, acc)
+	}
+
+	// the smallest subnormal of Float256 is exact.
+	tiny := NewFloat256FromBits(ints.Uint256{0, 0, 0, 1})
+	rat, _ := tiny.Rat(nil)
+	if got, exact := NewFloat256FromRat(rat); got != tiny || !exact {
+		t.Errorf("NewFloat256FromRat(%s) = (%v, %t), want exact", rat, got, exact)
+	}
+}
+
+func TestNewFloat64FromRat(t *testing.T) {
+	r := rand.New(rand.NewPCG(10, 5))
+	for range 2000 {
+		num := randBigInt(r, r.IntN(1200))
+		den := randBigInt(r, r.IntN(1200))
+		den.Add(den, big.NewInt(1))
+		if r.IntN(2) == 0 {
+			num.Neg(num)
+		}
+		x := new(big.Rat).SetFrac(num, den)
+
+		want, wantExact := x.Float64()
+		got, exact := NewFloat64FromRat(x)
+		if float64(got) != want || exact != wantExact {
+			t.Errorf("NewFloat64FromRat(%s) = (%g, %t), want (%g, %t)", x, got, exact, want, wantExact)
+		}
+		want32, wantExact32 := x.Float32()
+		got32, exact32 := NewFloat32FromRat(x)
+		if float32(got32) != want32 || exact32 != wantExact32 {
+			t.Errorf("NewFloat32FromRat(%s) = (%g, %t), want (%g, %t)", x, got32, exact32, want32, wantExact32)
+		}
+	}
+}
+
+// randBigInt returns a random non-negative integer with at most bits bits.
+func randBigInt(r *rand.Rand, bits int) *big.Int {
+	buf := make([]byte, (bits+7)/8)
+	for i := range buf {
+		buf[i] = byte(r.Uint32())
+	}
+	x := new(big.Int).SetBytes(buf)
+	return x.Rsh(x, uint(len(buf)*8-bits))
+}
+
+// randInts holds a random integer in every width, and their values in math/big.
+type randInts struct {
+	i64  int64
+	u64  uint64
+	i128 ints.Int128
+	u128 ints.Uint128
+	i256 ints.Int256
+	u256 ints.Uint256
+	big  [6]*big.Int
+}
+
+// newRandInts returns random integers with a random bit length,
+// so that both exact and rounded conversions are tested.
+func newRandInts(r *rand.Rand) randInts {
+	u := ints.Uint256{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64()}
+	u = u.Rsh(uint(r.IntN(256)))
+	var v randInts
+	v.u256 = u
+	v.i256 = ints.Int256(u)
+	v.u128 = u.Uint128()
+	v.i128 = ints.Int128(v.u128)
+	v.u64 = uint64(u[3])
+	v.i64 = int64(u[3])
+
+	v.big[0] = big.NewInt(v.i64)
+	v.big[1] = new(big.Int).SetUint64(v.u64)
+	v.big[2] = bigIntFromUint256(new(big.Int), v.u128.Uint256())
+	if v.i128.Sign() < 0 {
+		v.big[2].Sub(v.big[2], new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	v.big[3] = bigIntFromUint256(new(big.Int), v.u128.Uint256())
+	v.big[4] = bigIntFromUint256(new(big.Int), v.u256)
+	if v.i256.Sign() < 0 {
+		v.big[4].Sub(v.big[4], new(big.Int).Lsh(big.NewInt(1), 256))
+	}
+	v.big[5] = bigIntFromUint256(new(big.Int), v.u256)
+	return v
+}
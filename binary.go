@@ -0,0 +1,80 @@
+package floats
+
+import (
+	"encoding/binary"
+	"io"
+	"unsafe"
+)
+
+// writeChunkSize is the size of the buffer used by WriteFloat16s and its friends.
+const writeChunkSize = 4096
+
+// nativeLittleEndian reports whether the host is little-endian.
+var nativeLittleEndian = func() bool {
+	x := uint16(0x0102)
+	return *(*byte)(unsafe.Pointer(&x)) == 0x02
+}()
+
+// isNativeOrder reports whether order is the byte order of the host.
+// The byte orders of encoding/binary are compared directly,
+// because probing them through the interface makes the probe escape to the heap.
+func isNativeOrder(order binary.ByteOrder) bool {
+	switch order {
+	case binary.NativeEndian:
+		return true
+	case binary.LittleEndian:
+		return nativeLittleEndian
+	case binary.BigEndian:
+		return !nativeLittleEndian
+	}
+	x := uint16(0x0102)
+	return order.Uint16(unsafe.Slice((*byte)(unsafe.Pointer(&x)), 2)) == x
+}
+
+// isLittleEndian reports whether order puts the least significant byte first.
+func isLittleEndian(order binary.ByteOrder) bool {
+	switch order {
+	case binary.LittleEndian:
+		return true
+	case binary.BigEndian:
+		return false
+	case binary.NativeEndian:
+		return nativeLittleEndian
+	}
+	return order.Uint16([]byte{0x01, 0x02}) == 0x0201
+}
+
+// isLittleEndianAppend is the same as isLittleEndian, but for [binary.AppendByteOrder].
+func isLittleEndianAppend(order binary.AppendByteOrder) bool {
+	switch order {
+	case binary.LittleEndian:
+		return true
+	case binary.BigEndian:
+		return false
+	case binary.NativeEndian:
+		return nativeLittleEndian
+	}
+	var buf [2]byte
+	return order.AppendUint16(buf[:0], 0x0102)[0] == 0x02
+}
+
+// bytesOf returns the memory of x as a byte slice.
+func bytesOf[T Float16 | Float128 | Float256](x []T) []byte {
+	var zero T
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(x))), len(x)*int(unsafe.Sizeof(zero)))
+}
+
+// writeValues encodes src with put and writes it to w, through a buffer of writeChunkSize bytes.
+func writeValues[T Float16 | Float128 | Float256](w io.Writer, src []T, size int, put func(dst []byte, src []T)) error {
+	n := min(len(src), writeChunkSize/size)
+	buf := make([]byte, n*size)
+	for len(src) > 0 {
+		m := min(len(src), n)
+		put(buf[:m*size], src[:m])
+		if _, err := w.Write(buf[:m*size]); err != nil {
+			return err
+		}
+		src = src[m:]
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var _ encoding.BinaryMarshaler = Float128{}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The encoding is the 16-byte IEEE 754 binary representation of a in big-endian byte order.
+func (a Float128) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 16))
+}
+
+var _ encoding.BinaryAppender = Float128{}
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (a Float128) AppendBinary(dst []byte) ([]byte, error) {
+	dst = binary.BigEndian.AppendUint64(dst, a[0])
+	dst = binary.BigEndian.AppendUint64(dst, a[1])
+	return dst, nil
+}
+
+var _ encoding.BinaryUnmarshaler = (*Float128)(nil)
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It decodes the encoding of [Float128.MarshalBinary].
+func (a *Float128) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return errors.New("floats: invalid length of binary Float128")
+	}
+	*a = Float128{binary.BigEndian.Uint64(data[0:]), binary.BigEndian.Uint64(data[8:])}
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+// The encoding is the same as [Float128.MarshalBinary].
+func (a Float128) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (a *Float128) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// PutFloat128s encodes the elements of src into dst in the given byte order.
+// It panics if len(dst) < 16×len(src).
+func PutFloat128s(dst []byte, order binary.ByteOrder, src []Float128) {
+	_ = dst[:16*len(src)] // early bounds check
+	le := isLittleEndian(order)
+	for i, v := range src {
+		b := dst[16*i : 16*i+16]
+		// the words of v are in the big-endian order.
+		for j, w := range v {
+			k := j
+			if le {
+				k = 1 - j
+			}
+			order.PutUint64(b[8*k:], w)
+		}
+	}
+}
+
+// AppendFloat128s appends the elements of src encoded in the given byte order to dst
+// and returns the extended buffer.
+func AppendFloat128s(dst []byte, order binary.AppendByteOrder, src []Float128) []byte {
+	le := isLittleEndianAppend(order)
+	for _, v := range src {
+		for j := range v {
+			k := j
+			if le {
+				k = 1 - j
+			}
+			dst = order.AppendUint64(dst, v[k])
+		}
+	}
+	return dst
+}
+
+// GetFloat128s decodes the elements of dst from src in the given byte order.
+// It panics if len(src) < 16×len(dst).
+func GetFloat128s(dst []Float128, order binary.ByteOrder, src []byte) {
+	_ = src[:16*len(dst)] // early bounds check
+	le := isLittleEndian(order)
+	for i := range dst {
+		b := src[16*i : 16*i+16]
+		var v Float128
+		for j := range v {
+			k := j
+			if le {
+				k = 1 - j
+			}
+			v[j] = order.Uint64(b[8*k:])
+		}
+		dst[i] = v
+	}
+}
+
+// ReadFloat128s reads exactly len(dst) values in the given byte order from r.
+// It reads directly into the memory of dst without intermediate buffers.
+// The error is [io.EOF] only if no bytes were read,
+// and [io.ErrUnexpectedEOF] if some but not all the bytes were read.
+// If an error occurs, the contents of dst are unspecified.
+func ReadFloat128s(r io.Reader, order binary.ByteOrder, dst []Float128) error {
+	b := bytesOf(dst)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	// decode in place; each element is decoded from its own memory before it is overwritten.
+	GetFloat128s(dst, order, b)
+	return nil
+}
+
+// WriteFloat128s writes the elements of src in the given byte order to w.
+func WriteFloat128s(w io.Writer, order binary.ByteOrder, src []Float128) error {
+	return writeValues(w, src, 16, func(dst []byte, src []Float128) {
+		PutFloat128s(dst, order, src)
+	})
+}
@@ -0,0 +1,155 @@
+package floats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+// float128Pattern is a Float128 with the bytes 0x01, 0x02, ..., 0x10 in big-endian byte order.
+var float128Pattern = Float128{0x0102030405060708, 0x090a0b0c0d0e0f10}
+
+// reversed returns a reversed copy of b.
+func reversed(b []byte) []byte {
+	b = slices.Clone(b)
+	slices.Reverse(b)
+	return b
+}
+
+func TestFloat128_MarshalBinary(t *testing.T) {
+	a := float128Pattern
+	got, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}; !bytes.Equal(got, want) {
+		t.Errorf("MarshalBinary() = %x; want %x", got, want)
+	}
+
+	var b Float128
+	if err := b.UnmarshalBinary(got); err != nil {
+		t.Fatal(err)
+	}
+	if b != a {
+		t.Errorf("UnmarshalBinary(%x) = %v; want %v", got, b, a)
+	}
+
+	if err := b.UnmarshalBinary(got[1:]); err == nil {
+		t.Error("UnmarshalBinary of short data doesn't return error")
+	}
+}
+
+func TestFloat128_Gob(t *testing.T) {
+	type T struct {
+		X Float128
+		Y []Float128
+	}
+	in := T{X: Float128Pi(), Y: []Float128{float128Pattern, NewFloat128NaN()}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out T
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.X != in.X || !slices.Equal(out.Y, in.Y) {
+		t.Errorf("gob round trip = %v; want %v", out, in)
+	}
+}
+
+func TestFloat128s(t *testing.T) {
+	pattern, _ := float128Pattern.MarshalBinary()
+	one, _ := NewFloat128(1).MarshalBinary()
+	src := []Float128{float128Pattern, NewFloat128(1)}
+	tests := []struct {
+		order binary.ByteOrder
+		want  []byte
+	}{
+		{binary.LittleEndian, slices.Concat(reversed(pattern), reversed(one))},
+		{binary.BigEndian, slices.Concat(pattern, one)},
+	}
+	for _, tt := range tests {
+		order := tt.order.(binary.AppendByteOrder)
+
+		buf := make([]byte, len(tt.want))
+		PutFloat128s(buf, tt.order, src)
+		if !bytes.Equal(buf, tt.want) {
+			t.Errorf("PutFloat128s(%v) = %x; want %x", tt.order, buf, tt.want)
+		}
+
+		if got := AppendFloat128s([]byte{0xff}, order, src); !bytes.Equal(got, append([]byte{0xff}, tt.want...)) {
+			t.Errorf("AppendFloat128s(%v) = %x; want ff%x", tt.order, got, tt.want)
+		}
+
+		got := make([]Float128, len(src))
+		GetFloat128s(got, tt.order, tt.want)
+		if !slices.Equal(got, src) {
+			t.Errorf("GetFloat128s(%v) = %v; want %v", tt.order, got, src)
+		}
+
+		var w bytes.Buffer
+		if err := WriteFloat128s(&w, tt.order, src); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(w.Bytes(), tt.want) {
+			t.Errorf("WriteFloat128s(%v) = %x; want %x", tt.order, w.Bytes(), tt.want)
+		}
+
+		got = make([]Float128, len(src))
+		if err := ReadFloat128s(bytes.NewReader(tt.want), tt.order, got); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, src) {
+			t.Errorf("ReadFloat128s(%v) = %v; want %v", tt.order, got, src)
+		}
+	}
+}
+
+func TestFloat128s_Allocs(t *testing.T) {
+	src := []Float128{float128Pattern, float128Pattern}
+	buf := make([]byte, 32)
+	dst := make([]Float128, 2)
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian, binary.NativeEndian} {
+		allocs := testing.AllocsPerRun(100, func() {
+			PutFloat128s(buf, order, src)
+			GetFloat128s(dst, order, buf)
+			AppendFloat128s(buf[:0], order.(binary.AppendByteOrder), src)
+		})
+		if allocs != 0 {
+			t.Errorf("the codecs of %v allocate %v times; want 0", order, allocs)
+		}
+	}
+}
+
+// wrappedOrder is a byte order that isn't provided by encoding/binary.
+type wrappedOrder struct {
+	binary.ByteOrder
+}
+
+func TestFloat128s_CustomOrder(t *testing.T) {
+	src := []Float128{float128Pattern}
+	want := make([]byte, 16)
+	PutFloat128s(want, binary.LittleEndian, src)
+
+	got := make([]byte, 16)
+	PutFloat128s(got, wrappedOrder{binary.LittleEndian}, src)
+	if !bytes.Equal(got, want) {
+		t.Errorf("PutFloat128s(wrappedOrder) = %x; want %x", got, want)
+	}
+}
+
+func TestReadFloat128s_EOF(t *testing.T) {
+	dst := make([]Float128, 2)
+	if err := ReadFloat128s(bytes.NewReader(nil), binary.LittleEndian, dst); err != io.EOF {
+		t.Errorf("ReadFloat128s of empty reader returns %v; want io.EOF", err)
+	}
+	if err := ReadFloat128s(bytes.NewReader(make([]byte, 16+1)), binary.LittleEndian, dst); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadFloat128s of short reader returns %v; want io.ErrUnexpectedEOF", err)
+	}
+}
@@ -0,0 +1,121 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"io"
+	"unsafe"
+)
+
+var _ encoding.BinaryMarshaler = Float16(0)
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The encoding is the 2-byte IEEE 754 binary representation of a in big-endian byte order.
+func (a Float16) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 2))
+}
+
+var _ encoding.BinaryAppender = Float16(0)
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (a Float16) AppendBinary(dst []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint16(dst, uint16(a)), nil
+}
+
+var _ encoding.BinaryUnmarshaler = (*Float16)(nil)
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It decodes the encoding of [Float16.MarshalBinary].
+func (a *Float16) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return errors.New("floats: invalid length of binary Float16")
+	}
+	*a = Float16(binary.BigEndian.Uint16(data))
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+// The encoding is the same as [Float16.MarshalBinary].
+func (a Float16) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (a *Float16) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// PutFloat16s encodes the elements of src into dst in the given byte order.
+// It panics if len(dst) < 2×len(src).
+func PutFloat16s(dst []byte, order binary.ByteOrder, src []Float16) {
+	_ = dst[:2*len(src)] // early bounds check
+	for i, v := range src {
+		order.PutUint16(dst[2*i:], uint16(v))
+	}
+}
+
+// AppendFloat16s appends the elements of src encoded in the given byte order to dst
+// and returns the extended buffer.
+func AppendFloat16s(dst []byte, order binary.AppendByteOrder, src []Float16) []byte {
+	for _, v := range src {
+		dst = order.AppendUint16(dst, uint16(v))
+	}
+	return dst
+}
+
+// GetFloat16s decodes the elements of dst from src in the given byte order.
+// It panics if len(src) < 2×len(dst).
+func GetFloat16s(dst []Float16, order binary.ByteOrder, src []byte) {
+	_ = src[:2*len(dst)] // early bounds check
+	for i := range dst {
+		dst[i] = Float16(order.Uint16(src[2*i:]))
+	}
+}
+
+// ReadFloat16s reads exactly len(dst) values in the given byte order from r.
+// It reads directly into the memory of dst without intermediate buffers.
+// The error is [io.EOF] only if no bytes were read,
+// and [io.ErrUnexpectedEOF] if some but not all the bytes were read.
+// If an error occurs, the contents of dst are unspecified.
+func ReadFloat16s(r io.Reader, order binary.ByteOrder, dst []Float16) error {
+	b := bytesOf(dst)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	if !isNativeOrder(order) {
+		// decode in place; each element is decoded from its own memory.
+		GetFloat16s(dst, order, b)
+	}
+	return nil
+}
+
+// WriteFloat16s writes the elements of src in the given byte order to w.
+func WriteFloat16s(w io.Writer, order binary.ByteOrder, src []Float16) error {
+	if isNativeOrder(order) {
+		_, err := w.Write(bytesOf(src))
+		return err
+	}
+	return writeValues(w, src, 2, func(dst []byte, src []Float16) {
+		PutFloat16s(dst, order, src)
+	})
+}
+
+// ViewFloat16s returns a slice of Float16 that shares the memory with b, without copying.
+// It is useful to decode memory-mapped files.
+// It reports ok = false if order isn't the byte order of the host,
+// len(b) is odd, or b isn't aligned to 2 bytes;
+// use [GetFloat16s] to decode b in such cases.
+func ViewFloat16s(b []byte, order binary.ByteOrder) (s []Float16, ok bool) {
+	if !isNativeOrder(order) || len(b)%2 != 0 {
+		return nil, false
+	}
+	if len(b) == 0 {
+		return []Float16{}, true
+	}
+	p := unsafe.Pointer(unsafe.SliceData(b))
+	if uintptr(p)%unsafe.Alignof(Float16(0)) != 0 {
+		return nil, false
+	}
+	return unsafe.Slice((*Float16)(p), len(b)/2), true
+}
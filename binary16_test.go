@@ -0,0 +1,181 @@
+package floats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestFloat16_MarshalBinary(t *testing.T) {
+	a := Float16(0x3c01)
+	got, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x3c, 0x01}; !bytes.Equal(got, want) {
+		t.Errorf("MarshalBinary() = %x; want %x", got, want)
+	}
+
+	var b Float16
+	if err := b.UnmarshalBinary(got); err != nil {
+		t.Fatal(err)
+	}
+	if b != a {
+		t.Errorf("UnmarshalBinary(%x) = %v; want %v", got, b, a)
+	}
+
+	if err := b.UnmarshalBinary([]byte{0x3c}); err == nil {
+		t.Error("UnmarshalBinary of 1 byte doesn't return error")
+	}
+}
+
+func TestFloat16_Gob(t *testing.T) {
+	type T struct {
+		X Float16
+		Y []Float16
+	}
+	in := T{X: 0x7e01, Y: []Float16{0x3c00, 0x8000}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out T
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.X != in.X || !slices.Equal(out.Y, in.Y) {
+		t.Errorf("gob round trip = %v; want %v", out, in)
+	}
+}
+
+func TestFloat16s(t *testing.T) {
+	src := []Float16{0x0102, 0x3c00, 0xfc00}
+	tests := []struct {
+		order binary.ByteOrder
+		want  []byte
+	}{
+		{binary.LittleEndian, []byte{0x02, 0x01, 0x00, 0x3c, 0x00, 0xfc}},
+		{binary.BigEndian, []byte{0x01, 0x02, 0x3c, 0x00, 0xfc, 0x00}},
+	}
+	for _, tt := range tests {
+		order := tt.order.(binary.AppendByteOrder)
+
+		buf := make([]byte, 6)
+		PutFloat16s(buf, tt.order, src)
+		if !bytes.Equal(buf, tt.want) {
+			t.Errorf("PutFloat16s(%v) = %x; want %x", tt.order, buf, tt.want)
+		}
+
+		if got := AppendFloat16s([]byte{0xff}, order, src); !bytes.Equal(got, append([]byte{0xff}, tt.want...)) {
+			t.Errorf("AppendFloat16s(%v) = %x; want ff%x", tt.order, got, tt.want)
+		}
+
+		got := make([]Float16, len(src))
+		GetFloat16s(got, tt.order, tt.want)
+		if !slices.Equal(got, src) {
+			t.Errorf("GetFloat16s(%v) = %v; want %v", tt.order, got, src)
+		}
+
+		var w bytes.Buffer
+		if err := WriteFloat16s(&w, tt.order, src); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(w.Bytes(), tt.want) {
+			t.Errorf("WriteFloat16s(%v) = %x; want %x", tt.order, w.Bytes(), tt.want)
+		}
+
+		got = make([]Float16, len(src))
+		if err := ReadFloat16s(bytes.NewReader(tt.want), tt.order, got); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, src) {
+			t.Errorf("ReadFloat16s(%v) = %v; want %v", tt.order, got, src)
+		}
+	}
+}
+
+func TestWriteFloat16s_Large(t *testing.T) {
+	// larger than the internal buffer.
+	src := make([]Float16, 5000)
+	for i := range src {
+		src[i] = Float16(i)
+	}
+	var w bytes.Buffer
+	if err := WriteFloat16s(&w, binary.BigEndian, src); err != nil {
+		t.Fatal(err)
+	}
+	got := make([]Float16, len(src))
+	if err := ReadFloat16s(&w, binary.BigEndian, got); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(got, src) {
+		t.Error("ReadFloat16s doesn't recover the values written by WriteFloat16s")
+	}
+}
+
+func TestFloat16s_Allocs(t *testing.T) {
+	src := []Float16{0x0102, 0x3c00, 0xfc00}
+	buf := make([]byte, 6)
+	dst := make([]Float16, 3)
+	for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian, binary.NativeEndian} {
+		allocs := testing.AllocsPerRun(100, func() {
+			PutFloat16s(buf, order, src)
+			GetFloat16s(dst, order, buf)
+			ViewFloat16s(buf, order)
+		})
+		if allocs != 0 {
+			t.Errorf("the codecs of %v allocate %v times; want 0", order, allocs)
+		}
+	}
+}
+
+func TestReadFloat16s_EOF(t *testing.T) {
+	dst := make([]Float16, 2)
+	if err := ReadFloat16s(bytes.NewReader(nil), binary.LittleEndian, dst); err != io.EOF {
+		t.Errorf("ReadFloat16s of empty reader returns %v; want io.EOF", err)
+	}
+	if err := ReadFloat16s(bytes.NewReader([]byte{1, 2, 3}), binary.LittleEndian, dst); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadFloat16s of short reader returns %v; want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestViewFloat16s(t *testing.T) {
+	buf := make([]byte, 8)
+	PutFloat16s(buf, binary.NativeEndian, []Float16{1, 2, 3, 4})
+
+	s, ok := ViewFloat16s(buf, binary.NativeEndian)
+	if !ok {
+		t.Fatal("ViewFloat16s of native-endian data is not ok")
+	}
+	if want := []Float16{1, 2, 3, 4}; !slices.Equal(s, want) {
+		t.Errorf("ViewFloat16s() = %v; want %v", s, want)
+	}
+
+	// s shares the memory with buf.
+	s[0] = 0x3c00
+	var b [2]byte
+	binary.NativeEndian.PutUint16(b[:], 0x3c00)
+	if !bytes.Equal(buf[:2], b[:]) {
+		t.Errorf("ViewFloat16s() doesn't share the memory")
+	}
+
+	if _, ok := ViewFloat16s(buf[:3], binary.NativeEndian); ok {
+		t.Error("ViewFloat16s of odd length is ok")
+	}
+	if _, ok := ViewFloat16s(buf[1:7], binary.NativeEndian); ok {
+		t.Error("ViewFloat16s of misaligned data is ok")
+	}
+
+	nonNative := binary.ByteOrder(binary.BigEndian)
+	if isNativeOrder(binary.BigEndian) {
+		nonNative = binary.LittleEndian
+	}
+	if _, ok := ViewFloat16s(buf, nonNative); ok {
+		t.Errorf("ViewFloat16s(%v) is ok on the host of the other byte order", nonNative)
+	}
+}
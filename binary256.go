@@ -0,0 +1,125 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var _ encoding.BinaryMarshaler = Float256{}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The encoding is the 32-byte IEEE 754 binary representation of a in big-endian byte order.
+func (a Float256) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 32))
+}
+
+var _ encoding.BinaryAppender = Float256{}
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (a Float256) AppendBinary(dst []byte) ([]byte, error) {
+	dst = binary.BigEndian.AppendUint64(dst, a[0])
+	dst = binary.BigEndian.AppendUint64(dst, a[1])
+	dst = binary.BigEndian.AppendUint64(dst, a[2])
+	dst = binary.BigEndian.AppendUint64(dst, a[3])
+	return dst, nil
+}
+
+var _ encoding.BinaryUnmarshaler = (*Float256)(nil)
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It decodes the encoding of [Float256.MarshalBinary].
+func (a *Float256) UnmarshalBinary(data []byte) error {
+	if len(data) != 32 {
+		return errors.New("floats: invalid length of binary Float256")
+	}
+	*a = Float256{binary.BigEndian.Uint64(data[0:]), binary.BigEndian.Uint64(data[8:]), binary.BigEndian.Uint64(data[16:]), binary.BigEndian.Uint64(data[24:])}
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+// The encoding is the same as [Float256.MarshalBinary].
+func (a Float256) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (a *Float256) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
+
+// PutFloat256s encodes the elements of src into dst in the given byte order.
+// It panics if len(dst) < 32×len(src).
+func PutFloat256s(dst []byte, order binary.ByteOrder, src []Float256) {
+	_ = dst[:32*len(src)] // early bounds check
+	le := isLittleEndian(order)
+	for i, v := range src {
+		b := dst[32*i : 32*i+32]
+		// the words of v are in the big-endian order.
+		for j, w := range v {
+			k := j
+			if le {
+				k = 3 - j
+			}
+			order.PutUint64(b[8*k:], w)
+		}
+	}
+}
+
+// AppendFloat256s appends the elements of src encoded in the given byte order to dst
+// and returns the extended buffer.
+func AppendFloat256s(dst []byte, order binary.AppendByteOrder, src []Float256) []byte {
+	le := isLittleEndianAppend(order)
+	for _, v := range src {
+		for j := range v {
+			k := j
+			if le {
+				k = 3 - j
+			}
+			dst = order.AppendUint64(dst, v[k])
+		}
+	}
+	return dst
+}
+
+// GetFloat256s decodes the elements of dst from src in the given byte order.
+// It panics if len(src) < 32×len(dst).
+func GetFloat256s(dst []Float256, order binary.ByteOrder, src []byte) {
+	_ = src[:32*len(dst)] // early bounds check
+	le := isLittleEndian(order)
+	for i := range dst {
+		b := src[32*i : 32*i+32]
+		var v Float256
+		for j := range v {
+			k := j
+			if le {
+				k = 3 - j
+			}
+			v[j] = order.Uint64(b[8*k:])
+		}
+		dst[i] = v
+	}
+}
+
+// ReadFloat256s reads exactly len(dst) values in the given byte order from r.
+// It reads directly into the memory of dst without intermediate buffers.
+// The error is [io.EOF] only if no bytes were read,
+// and [io.ErrUnexpectedEOF] if some but not all the bytes were read.
+// If an error occurs, the contents of dst are unspecified.
+func ReadFloat256s(r io.Reader, order binary.ByteOrder, dst []Float256) error {
+	b := bytesOf(dst)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return err
+	}
+	// decode in place; each element is decoded from its own memory before it is overwritten.
+	GetFloat256s(dst, order, b)
+	return nil
+}
+
+// WriteFloat256s writes the elements of src in the given byte order to w.
+func WriteFloat256s(w io.Writer, order binary.ByteOrder, src []Float256) error {
+	return writeValues(w, src, 32, func(dst []byte, src []Float256) {
+		PutFloat256s(dst, order, src)
+	})
+}
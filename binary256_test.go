@@ -0,0 +1,115 @@
+package floats
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+// float256Pattern is a Float256 with the bytes 0x01, 0x02, ..., 0x20 in big-endian byte order.
+var float256Pattern = Float256{0x0102030405060708, 0x090a0b0c0d0e0f10, 0x1112131415161718, 0x191a1b1c1d1e1f20}
+
+func TestFloat256_MarshalBinary(t *testing.T) {
+	a := float256Pattern
+	got, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20}; !bytes.Equal(got, want) {
+		t.Errorf("MarshalBinary() = %x; want %x", got, want)
+	}
+
+	var b Float256
+	if err := b.UnmarshalBinary(got); err != nil {
+		t.Fatal(err)
+	}
+	if b != a {
+		t.Errorf("UnmarshalBinary(%x) = %v; want %v", got, b, a)
+	}
+
+	if err := b.UnmarshalBinary(got[1:]); err == nil {
+		t.Error("UnmarshalBinary of short data doesn't return error")
+	}
+}
+
+func TestFloat256_Gob(t *testing.T) {
+	type T struct {
+		X Float256
+		Y []Float256
+	}
+	in := T{X: Float256Pi(), Y: []Float256{float256Pattern, NewFloat256NaN()}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out T
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.X != in.X || !slices.Equal(out.Y, in.Y) {
+		t.Errorf("gob round trip = %v; want %v", out, in)
+	}
+}
+
+func TestFloat256s(t *testing.T) {
+	pattern, _ := float256Pattern.MarshalBinary()
+	one, _ := NewFloat256(1).MarshalBinary()
+	src := []Float256{float256Pattern, NewFloat256(1)}
+	tests := []struct {
+		order binary.ByteOrder
+		want  []byte
+	}{
+		{binary.LittleEndian, slices.Concat(reversed(pattern), reversed(one))},
+		{binary.BigEndian, slices.Concat(pattern, one)},
+	}
+	for _, tt := range tests {
+		order := tt.order.(binary.AppendByteOrder)
+
+		buf := make([]byte, len(tt.want))
+		PutFloat256s(buf, tt.order, src)
+		if !bytes.Equal(buf, tt.want) {
+			t.Errorf("PutFloat256s(%v) = %x; want %x", tt.order, buf, tt.want)
+		}
+
+		if got := AppendFloat256s([]byte{0xff}, order, src); !bytes.Equal(got, append([]byte{0xff}, tt.want...)) {
+			t.Errorf("AppendFloat256s(%v) = %x; want ff%x", tt.order, got, tt.want)
+		}
+
+		got := make([]Float256, len(src))
+		GetFloat256s(got, tt.order, tt.want)
+		if !slices.Equal(got, src) {
+			t.Errorf("GetFloat256s(%v) = %v; want %v", tt.order, got, src)
+		}
+
+		var w bytes.Buffer
+		if err := WriteFloat256s(&w, tt.order, src); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(w.Bytes(), tt.want) {
+			t.Errorf("WriteFloat256s(%v) = %x; want %x", tt.order, w.Bytes(), tt.want)
+		}
+
+		got = make([]Float256, len(src))
+		if err := ReadFloat256s(bytes.NewReader(tt.want), tt.order, got); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(got, src) {
+			t.Errorf("ReadFloat256s(%v) = %v; want %v", tt.order, got, src)
+		}
+	}
+}
+
+func TestReadFloat256s_EOF(t *testing.T) {
+	dst := make([]Float256, 2)
+	if err := ReadFloat256s(bytes.NewReader(nil), binary.LittleEndian, dst); err != io.EOF {
+		t.Errorf("ReadFloat256s of empty reader returns %v; want io.EOF", err)
+	}
+	if err := ReadFloat256s(bytes.NewReader(make([]byte, 32+1)), binary.LittleEndian, dst); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadFloat256s of short reader returns %v; want io.ErrUnexpectedEOF", err)
+	}
+}
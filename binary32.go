@@ -0,0 +1,45 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+)
+
+var _ encoding.BinaryMarshaler = Float32(0)
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The encoding is the 4-byte IEEE 754 binary representation of a in big-endian byte order.
+func (a Float32) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 4))
+}
+
+var _ encoding.BinaryAppender = Float32(0)
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (a Float32) AppendBinary(dst []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint32(dst, a.Bits()), nil
+}
+
+var _ encoding.BinaryUnmarshaler = (*Float32)(nil)
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It decodes the encoding of [Float32.MarshalBinary].
+func (a *Float32) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return errors.New("floats: invalid length of binary Float32")
+	}
+	*a = NewFloat32FromBits(binary.BigEndian.Uint32(data))
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+// The encoding is the same as [Float32.MarshalBinary].
+func (a Float32) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (a *Float32) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
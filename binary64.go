@@ -0,0 +1,45 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+)
+
+var _ encoding.BinaryMarshaler = Float64(0)
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The encoding is the 8-byte IEEE 754 binary representation of a in big-endian byte order.
+func (a Float64) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 8))
+}
+
+var _ encoding.BinaryAppender = Float64(0)
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (a Float64) AppendBinary(dst []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(dst, a.Bits()), nil
+}
+
+var _ encoding.BinaryUnmarshaler = (*Float64)(nil)
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It decodes the encoding of [Float64.MarshalBinary].
+func (a *Float64) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return errors.New("floats: invalid length of binary Float64")
+	}
+	*a = NewFloat64FromBits(binary.BigEndian.Uint64(data))
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+// The encoding is the same as [Float64.MarshalBinary].
+func (a Float64) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (a *Float64) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
@@ -0,0 +1,46 @@
+package floats
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestFloat64_MarshalBinary(t *testing.T) {
+	a := Float64(1.5)
+	got, err := a.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0x3f, 0xf8, 0, 0, 0, 0, 0, 0}; !bytes.Equal(got, want) {
+		t.Errorf("MarshalBinary() = %x; want %x", got, want)
+	}
+
+	var b Float64
+	if err := b.UnmarshalBinary(got); err != nil {
+		t.Fatal(err)
+	}
+	if b != a {
+		t.Errorf("UnmarshalBinary(%x) = %v; want %v", got, b, a)
+	}
+
+	if err := b.UnmarshalBinary([]byte{0x3f, 0xf8}); err == nil {
+		t.Error("UnmarshalBinary of short data doesn't return error")
+	}
+}
+
+func TestFloat64_Gob(t *testing.T) {
+	in := NewFloat64FromBits(0x64) // a subnormal number
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	var out Float64
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Bits() != in.Bits() {
+		t.Errorf("gob round trip = %v; want %v", out, in)
+	}
+}
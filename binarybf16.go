@@ -0,0 +1,45 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+)
+
+var _ encoding.BinaryMarshaler = BFloat16(0)
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+// The encoding is the 2-byte bfloat16 representation of a in big-endian byte order.
+func (a BFloat16) MarshalBinary() ([]byte, error) {
+	return a.AppendBinary(make([]byte, 0, 2))
+}
+
+var _ encoding.BinaryAppender = BFloat16(0)
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (a BFloat16) AppendBinary(dst []byte) ([]byte, error) {
+	return binary.BigEndian.AppendUint16(dst, a.Bits()), nil
+}
+
+var _ encoding.BinaryUnmarshaler = (*BFloat16)(nil)
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+// It decodes the encoding of [BFloat16.MarshalBinary].
+func (a *BFloat16) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return errors.New("floats: invalid length of binary BFloat16")
+	}
+	*a = NewBFloat16FromBits(binary.BigEndian.Uint16(data))
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+// The encoding is the same as [BFloat16.MarshalBinary].
+func (a BFloat16) GobEncode() ([]byte, error) {
+	return a.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (a *BFloat16) GobDecode(data []byte) error {
+	return a.UnmarshalBinary(data)
+}
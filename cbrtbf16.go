@@ -0,0 +1,14 @@
+package floats
+
+import "math"
+
+// Cbrt returns the cube root of a.
+//
+// Special cases are:
+//
+//	±0.Cbrt() = ±0
+//	±Inf.Cbrt() = ±Inf
+//	NaN.Cbrt() = NaN
+func (a BFloat16) Cbrt() BFloat16 {
+	return NewBFloat16(math.Cbrt(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,47 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Cbrt(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(27), 3},
+		{exactBF16(8), 2},
+		{exactBF16(1), 1},
+		{exactBF16(0), 0},
+		{exactBF16(-1), -1},
+		{exactBF16(-8), -2},
+		{exactBF16(-27), -3},
+	}
+
+	for _, test := range tests {
+		got := test.x.Cbrt()
+		if !closeBF16(got, test.want) {
+			t.Errorf("Cbrt(%v) = %v; want %v", test.x, got, test.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// Special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cbrt()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Cbrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package floats
+
+// The elementary functions of Complex256 are computed in [Complex512],
+// and the results are rounded to Complex256.
+
+// Sqrt returns the square root of c.
+// The result r is chosen so that real(r) ≥ 0 and imag(r) has the same sign as imag(c).
+func (c Complex256) Sqrt() Complex256 {
+	return c.Complex512().Sqrt().Complex256()
+}
+
+// Exp returns e**c, the base-e exponential of c.
+func (c Complex256) Exp() Complex256 {
+	return c.Complex512().Exp().Complex256()
+}
+
+// Log returns the natural logarithm of c, also called the principal value.
+// The imaginary part is in the range [-Pi, Pi].
+func (c Complex256) Log() Complex256 {
+	return c.Complex512().Log().Complex256()
+}
+
+// Log10 returns the decimal logarithm of c.
+func (c Complex256) Log10() Complex256 {
+	return c.Complex512().Log10().Complex256()
+}
+
+// Pow returns c**d, the base-c exponential of d.
+// For generalized compatibility with [Float128.Pow]:
+//
+//	0.Pow(±0) = 1+0i
+//	0.Pow(d) = Inf+0i for real(d) < 0 and imag(d) = 0
+//	0.Pow(d) = Inf+Inf i for real(d) < 0 and imag(d) ≠ 0
+func (c Complex256) Pow(d Complex256) Complex256 {
+	return c.Complex512().Pow(d.Complex512()).Complex256()
+}
+
+// Sin returns the sine of c.
+func (c Complex256) Sin() Complex256 {
+	return c.Complex512().Sin().Complex256()
+}
+
+// Sinh returns the hyperbolic sine of c.
+func (c Complex256) Sinh() Complex256 {
+	return c.Complex512().Sinh().Complex256()
+}
+
+// Cos returns the cosine of c.
+func (c Complex256) Cos() Complex256 {
+	return c.Complex512().Cos().Complex256()
+}
+
+// Cosh returns the hyperbolic cosine of c.
+func (c Complex256) Cosh() Complex256 {
+	return c.Complex512().Cosh().Complex256()
+}
+
+// Tan returns the tangent of c.
+func (c Complex256) Tan() Complex256 {
+	return c.Complex512().Tan().Complex256()
+}
+
+// Tanh returns the hyperbolic tangent of c.
+func (c Complex256) Tanh() Complex256 {
+	return c.Complex512().Tanh().Complex256()
+}
+
+// Cot returns the cotangent of c.
+// Unlike math/cmplx, it returns the limit ∓i for x±∞i instead of NaN.
+func (c Complex256) Cot() Complex256 {
+	return c.Complex512().Cot().Complex256()
+}
+
+// Asin returns the inverse sine of c.
+func (c Complex256) Asin() Complex256 {
+	return c.Complex512().Asin().Complex256()
+}
+
+// Asinh returns the inverse hyperbolic sine of c.
+func (c Complex256) Asinh() Complex256 {
+	return c.Complex512().Asinh().Complex256()
+}
+
+// Acos returns the inverse cosine of c.
+func (c Complex256) Acos() Complex256 {
+	return c.Complex512().Acos().Complex256()
+}
+
+// Acosh returns the inverse hyperbolic cosine of c.
+func (c Complex256) Acosh() Complex256 {
+	return c.Complex512().Acosh().Complex256()
+}
+
+// Atan returns the inverse tangent of c.
+// On the branch cut, the real part of the result has the same sign as real(c), as in C99.
+func (c Complex256) Atan() Complex256 {
+	return c.Complex512().Atan().Complex256()
+}
+
+// Atanh returns the inverse hyperbolic tangent of c.
+func (c Complex256) Atanh() Complex256 {
+	return c.Complex512().Atanh().Complex256()
+}
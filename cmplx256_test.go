@@ -0,0 +1,83 @@
+package floats
+
+import (
+	"math/cmplx"
+	"testing"
+)
+
+func TestComplex256_Functions(t *testing.T) {
+	for _, fn := range cmplxFuncs512 {
+		for _, c := range vcTests {
+			x := NewComplex256FromComplex128(c)
+			got := complex256Func(fn.name)(x)
+
+			// the result is the one of Complex512 rounded to Complex256.
+			want := fn.f(x.Complex512()).Complex256()
+			if !got.Eq(want) && !(got.IsNaN() && want.IsNaN()) {
+				t.Errorf("%s(%v) = %v; want %v", fn.name, c, got, want)
+			}
+			if !closeComplex128(got.Complex128(), fn.want(c), 1e-13) {
+				t.Errorf("%s(%v) = %v; want %v", fn.name, c, got, fn.want(c))
+			}
+		}
+	}
+}
+
+func complex256Func(name string) func(c Complex256) Complex256 {
+	switch name {
+	case "Sqrt":
+		return Complex256.Sqrt
+	case "Exp":
+		return Complex256.Exp
+	case "Log":
+		return Complex256.Log
+	case "Log10":
+		return Complex256.Log10
+	case "Sin":
+		return Complex256.Sin
+	case "Sinh":
+		return Complex256.Sinh
+	case "Cos":
+		return Complex256.Cos
+	case "Cosh":
+		return Complex256.Cosh
+	case "Tan":
+		return Complex256.Tan
+	case "Tanh":
+		return Complex256.Tanh
+	case "Cot":
+		return Complex256.Cot
+	case "Asin":
+		return Complex256.Asin
+	case "Asinh":
+		return Complex256.Asinh
+	case "Acos":
+		return Complex256.Acos
+	case "Acosh":
+		return Complex256.Acosh
+	case "Atan":
+		return Complex256.Atan
+	case "Atanh":
+		return Complex256.Atanh
+	}
+	panic("unknown function: " + name)
+}
+
+func TestComplex256_Pow(t *testing.T) {
+	for _, c := range vcTests[:10] {
+		for _, d := range vcTests[10:] {
+			got := NewComplex256FromComplex128(c).Pow(NewComplex256FromComplex128(d)).Complex128()
+			want := cmplx.Pow(c, d)
+			if !closeComplex128(got, want, 1e-13) {
+				t.Errorf("Pow(%v, %v) = %v; want %v", c, d, got, want)
+			}
+		}
+	}
+
+	// i**i = e**(-π/2)
+	i := NewComplex256(Float128{}, exact128(1))
+	got := i.Pow(i)
+	if !close128(got.Real(), "0.2078795763507619085469556198349787700338778416317696080751358830554198772854821397886002778654260") || !got.Imag().IsZero() {
+		t.Errorf("Pow(i, i) = %v; want e**(-π/2)", got)
+	}
+}
@@ -0,0 +1,110 @@
+package floats
+
+import "math/cmplx"
+
+// Most elementary functions of Complex32 are computed in complex128 by the math/cmplx package,
+// and the results are rounded to Complex32.
+// The functions whose special cases differ from math/cmplx are computed in [Complex512].
+
+// Sqrt returns the square root of c.
+// The result r is chosen so that real(r) ≥ 0 and imag(r) has the same sign as imag(c).
+func (c Complex32) Sqrt() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Sqrt(c.Complex128()))
+}
+
+// Exp returns e**c, the base-e exponential of c.
+func (c Complex32) Exp() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Exp(c.Complex128()))
+}
+
+// Log returns the natural logarithm of c, also called the principal value.
+// The imaginary part is in the range [-Pi, Pi].
+func (c Complex32) Log() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Log(c.Complex128()))
+}
+
+// Log10 returns the decimal logarithm of c.
+func (c Complex32) Log10() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Log10(c.Complex128()))
+}
+
+// Pow returns c**d, the base-c exponential of d.
+// For generalized compatibility with [Float16.Pow]:
+//
+//	0.Pow(±0) = 1+0i
+//	0.Pow(d) = Inf+0i for real(d) < 0 and imag(d) = 0
+//	0.Pow(d) = Inf+Inf i for real(d) < 0 and imag(d) ≠ 0
+func (c Complex32) Pow(d Complex32) Complex32 {
+	if c.IsZero() {
+		// math/cmplx panics if real(d) is NaN and imag(d) is an infinity.
+		return c.Complex512().Pow(d.Complex512()).Complex32()
+	}
+	return NewComplex32FromComplex128(cmplx.Pow(c.Complex128(), d.Complex128()))
+}
+
+// Sin returns the sine of c.
+func (c Complex32) Sin() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Sin(c.Complex128()))
+}
+
+// Sinh returns the hyperbolic sine of c.
+func (c Complex32) Sinh() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Sinh(c.Complex128()))
+}
+
+// Cos returns the cosine of c.
+func (c Complex32) Cos() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Cos(c.Complex128()))
+}
+
+// Cosh returns the hyperbolic cosine of c.
+func (c Complex32) Cosh() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Cosh(c.Complex128()))
+}
+
+// Tan returns the tangent of c.
+func (c Complex32) Tan() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Tan(c.Complex128()))
+}
+
+// Tanh returns the hyperbolic tangent of c.
+func (c Complex32) Tanh() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Tanh(c.Complex128()))
+}
+
+// Cot returns the cotangent of c.
+// Unlike math/cmplx, it returns the limit ∓i for x±∞i instead of NaN.
+func (c Complex32) Cot() Complex32 {
+	return c.Complex512().Cot().Complex32()
+}
+
+// Asin returns the inverse sine of c.
+func (c Complex32) Asin() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Asin(c.Complex128()))
+}
+
+// Asinh returns the inverse hyperbolic sine of c.
+func (c Complex32) Asinh() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Asinh(c.Complex128()))
+}
+
+// Acos returns the inverse cosine of c.
+func (c Complex32) Acos() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Acos(c.Complex128()))
+}
+
+// Acosh returns the inverse hyperbolic cosine of c.
+func (c Complex32) Acosh() Complex32 {
+	return NewComplex32FromComplex128(cmplx.Acosh(c.Complex128()))
+}
+
+// Atan returns the inverse tangent of c.
+// On the branch cut, the real part of the result has the same sign as real(c), as in C99.
+func (c Complex32) Atan() Complex32 {
+	return c.Complex512().Atan().Complex32()
+}
+
+// Atanh returns the inverse hyperbolic tangent of c.
+func (c Complex32) Atanh() Complex32 {
+	return c.Complex512().Atanh().Complex32()
+}
@@ -0,0 +1,72 @@
+package floats
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestComplex32_Functions(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c Complex32) Complex32
+		want func(c complex128) complex128
+	}{
+		{"Sqrt", Complex32.Sqrt, cmplx.Sqrt},
+		{"Exp", Complex32.Exp, cmplx.Exp},
+		{"Log", Complex32.Log, cmplx.Log},
+		{"Log10", Complex32.Log10, cmplx.Log10},
+		{"Sin", Complex32.Sin, cmplx.Sin},
+		{"Sinh", Complex32.Sinh, cmplx.Sinh},
+		{"Cos", Complex32.Cos, cmplx.Cos},
+		{"Cosh", Complex32.Cosh, cmplx.Cosh},
+		{"Tan", Complex32.Tan, cmplx.Tan},
+		{"Tanh", Complex32.Tanh, cmplx.Tanh},
+		{"Cot", Complex32.Cot, cmplx.Cot},
+		{"Asin", Complex32.Asin, cmplx.Asin},
+		{"Asinh", Complex32.Asinh, cmplx.Asinh},
+		{"Acos", Complex32.Acos, cmplx.Acos},
+		{"Acosh", Complex32.Acosh, cmplx.Acosh},
+		{"Atan", Complex32.Atan, cmplx.Atan},
+		{"Atanh", Complex32.Atanh, cmplx.Atanh},
+	}
+	inputs := []complex128{
+		0.5 + 0.25i,
+		-0.5 - 0.25i,
+		1 + 0i,
+		3 - 4i,
+		-1.5 + 2i,
+		complex(math.Copysign(0, -1), 0),
+		complex(math.Inf(1), 1),
+		complex(math.NaN(), 0),
+	}
+	for _, tt := range tests {
+		for _, c := range inputs {
+			x := NewComplex32FromComplex128(c)
+			got := tt.f(x)
+			want := NewComplex32FromComplex128(tt.want(x.Complex128()))
+			if !closeComplex128(got.Complex128(), want.Complex128(), 0x1p-10) {
+				t.Errorf("%s(%v) = %v; want %v", tt.name, c, got, want)
+			}
+		}
+	}
+}
+
+func TestComplex32_Pow(t *testing.T) {
+	tests := []struct {
+		c, d complex128
+		want complex128
+	}{
+		{2, 10, 1024},
+		{1i, 2, -1},
+		{0, 0, 1},
+		{0, -2, complex(math.Inf(1), 0)},
+		{0, complex(math.NaN(), math.Inf(1)), complex(math.NaN(), math.NaN())},
+	}
+	for _, tt := range tests {
+		got := NewComplex32FromComplex128(tt.c).Pow(NewComplex32FromComplex128(tt.d)).Complex128()
+		if !closeComplex128(got, tt.want, 0x1p-10) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.c, tt.d, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,437 @@
+package floats
+
+// The elementary functions of Complex512 are ported from Go's math/cmplx package,
+// and they have the same special cases unless noted otherwise.
+
+// Sqrt returns the square root of c.
+// The result r is chosen so that real(r) ≥ 0 and imag(r) has the same sign as imag(c).
+func (c Complex512) Sqrt() Complex512 {
+	var Zero Float256
+	a, b := c.re, c.im
+
+	if b.IsZero() {
+		// Ensure that imag(r) has the same sign as imag(c) for imag(c) == signed zero.
+		switch {
+		case a.IsZero():
+			return Complex512{re: Zero, im: b}
+		case a.Signbit():
+			return Complex512{re: Zero, im: a.Neg().Sqrt().Copysign(b)}
+		}
+		return Complex512{re: a.Sqrt(), im: b}
+	} else if b.IsInf(0) {
+		return Complex512{re: NewFloat256Inf(1), im: b}
+	}
+	if a.IsZero() {
+		r := b.Abs().Ldexp(-1).Sqrt()
+		return Complex512{re: r, im: r.Copysign(b)}
+	}
+
+	// Hypot256 and Ldexp don't overflow or underflow in the range of Float256,
+	// so no rescaling is necessary unlike math/cmplx.
+	r := Hypot256(a, b)
+	var t Float256
+	if a.Gt(Zero) {
+		t = r.Add(a).Ldexp(-1).Sqrt()
+		r = b.Ldexp(-1).Quo(t).Abs()
+	} else {
+		r = r.Sub(a).Ldexp(-1).Sqrt()
+		t = b.Ldexp(-1).Quo(r).Abs()
+	}
+	return Complex512{re: t, im: r.Copysign(b)}
+}
+
+// Exp returns e**c, the base-e exponential of c.
+func (c Complex512) Exp() Complex512 {
+	var Zero Float256
+	re, im := c.re, c.im
+
+	switch {
+	case re.IsInf(0):
+		switch {
+		case re.Gt(Zero) && im.IsZero():
+			return c
+		case im.IsInf(0) || im.IsNaN():
+			if re.Lt(Zero) {
+				return Complex512{re: Zero, im: Zero.Copysign(im)}
+			}
+			return Complex512{re: NewFloat256Inf(1), im: NewFloat256NaN()}
+		}
+	case re.IsNaN():
+		if im.IsZero() {
+			return Complex512{re: NewFloat256NaN(), im: im}
+		}
+	}
+	r := re.Exp()
+	s, co := im.Sincos()
+	return Complex512{re: r.Mul(co), im: r.Mul(s)}
+}
+
+// Log returns the natural logarithm of c, also called the principal value.
+// The imaginary part is in the range [-Pi, Pi].
+func (c Complex512) Log() Complex512 {
+	return Complex512{re: c.Abs().Log(), im: c.Phase()}
+}
+
+// Log10 returns the decimal logarithm of c.
+func (c Complex512) Log10() Complex512 {
+	// Log10E = 1/log(10)
+	var Log10E = Float256{
+		0x3fff_dbcb_7b15_26e5, 0x0e32_a6ab_7555_f5a6,
+		0x7b86_47dc_68c0_48b9, 0x3440_4747_e5a8_9ef2,
+	}
+	return Complex512{re: c.Abs().Log10(), im: c.Phase().Mul(Log10E)}
+}
+
+// Pow returns c**d, the base-c exponential of d.
+// For generalized compatibility with [Float256.Pow]:
+//
+//	0.Pow(±0) = 1+0i
+//	0.Pow(d) = Inf+0i for real(d) < 0 and imag(d) = 0
+//	0.Pow(d) = Inf+Inf i for real(d) < 0 and imag(d) ≠ 0
+func (c Complex512) Pow(d Complex512) Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+
+	if c.IsZero() {
+		if d.IsNaN() {
+			return NewComplex512NaN()
+		}
+		r, i := d.re, d.im
+		switch {
+		case r.IsZero():
+			return Complex512{re: One}
+		case r.Lt(Zero):
+			if i.IsZero() {
+				return Complex512{re: NewFloat256Inf(1)}
+			}
+			return NewComplex512Inf()
+		case r.Gt(Zero):
+			return Complex512{}
+		}
+		// real(d) is NaN
+		return NewComplex512NaN()
+	}
+	modulus := c.Abs()
+	if modulus.IsZero() {
+		return Complex512{}
+	}
+	r := modulus.Pow(d.re)
+	arg := c.Phase()
+	theta := d.re.Mul(arg)
+	if !d.im.IsZero() {
+		r = r.Mul(d.im.Neg().Mul(arg).Exp())
+		theta = theta.Add(d.im.Mul(modulus.Log()))
+	}
+	s, co := theta.Sincos()
+	return Complex512{re: r.Mul(co), im: r.Mul(s)}
+}
+
+// Sin returns the sine of c.
+func (c Complex512) Sin() Complex512 {
+	re, im := c.re, c.im
+	switch {
+	case im.IsZero() && (re.IsInf(0) || re.IsNaN()):
+		return Complex512{re: NewFloat256NaN(), im: im}
+	case im.IsInf(0):
+		switch {
+		case re.IsZero():
+			return c
+		case re.IsInf(0) || re.IsNaN():
+			return Complex512{re: NewFloat256NaN(), im: im}
+		}
+	case re.IsZero() && im.IsNaN():
+		return c
+	}
+	s, co := re.Sincos()
+	sh, ch := im.Sinh(), im.Cosh()
+	return Complex512{re: s.Mul(ch), im: co.Mul(sh)}
+}
+
+// Sinh returns the hyperbolic sine of c.
+func (c Complex512) Sinh() Complex512 {
+	re, im := c.re, c.im
+	switch {
+	case re.IsZero() && (im.IsInf(0) || im.IsNaN()):
+		return Complex512{re: re, im: NewFloat256NaN()}
+	case re.IsInf(0):
+		switch {
+		case im.IsZero():
+			return c
+		case im.IsInf(0) || im.IsNaN():
+			return Complex512{re: re, im: NewFloat256NaN()}
+		}
+	case im.IsZero() && re.IsNaN():
+		return Complex512{re: NewFloat256NaN(), im: im}
+	}
+	s, co := im.Sincos()
+	sh, ch := re.Sinh(), re.Cosh()
+	return Complex512{re: co.Mul(sh), im: s.Mul(ch)}
+}
+
+// Cos returns the cosine of c.
+func (c Complex512) Cos() Complex512 {
+	var Zero Float256
+	re, im := c.re, c.im
+	switch {
+	case im.IsZero() && (re.IsInf(0) || re.IsNaN()):
+		return Complex512{re: NewFloat256NaN(), im: im.Neg().Mul(Zero.Copysign(re))}
+	case im.IsInf(0):
+		switch {
+		case re.IsZero():
+			return Complex512{re: NewFloat256Inf(1), im: re.Neg().Mul(Zero.Copysign(im))}
+		case re.IsInf(0) || re.IsNaN():
+			return Complex512{re: NewFloat256Inf(1), im: NewFloat256NaN()}
+		}
+	case re.IsZero() && im.IsNaN():
+		return Complex512{re: NewFloat256NaN()}
+	}
+	s, co := re.Sincos()
+	sh, ch := im.Sinh(), im.Cosh()
+	return Complex512{re: co.Mul(ch), im: s.Neg().Mul(sh)}
+}
+
+// Cosh returns the hyperbolic cosine of c.
+func (c Complex512) Cosh() Complex512 {
+	var Zero Float256
+	re, im := c.re, c.im
+	switch {
+	case re.IsZero() && (im.IsInf(0) || im.IsNaN()):
+		return Complex512{re: NewFloat256NaN(), im: re.Mul(Zero.Copysign(im))}
+	case re.IsInf(0):
+		switch {
+		case im.IsZero():
+			return Complex512{re: NewFloat256Inf(1), im: im.Mul(Zero.Copysign(re))}
+		case im.IsInf(0) || im.IsNaN():
+			return Complex512{re: NewFloat256Inf(1), im: NewFloat256NaN()}
+		}
+	case im.IsZero() && re.IsNaN():
+		return Complex512{re: NewFloat256NaN(), im: im}
+	}
+	s, co := im.Sincos()
+	sh, ch := re.Sinh(), re.Cosh()
+	return Complex512{re: co.Mul(ch), im: s.Mul(sh)}
+}
+
+// tanLarge256 is the threshold of |imag(c)| for Tan,
+// where tan(c) is ±i in the precision of Float256.
+var tanLarge256 = Float256{0x4000_6000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000} // 128
+
+// Tan returns the tangent of c.
+func (c Complex512) Tan() Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+	re, im := c.re, c.im
+	switch {
+	case im.IsInf(0):
+		if re.IsInf(0) || re.IsNaN() {
+			return Complex512{re: Zero.Copysign(re), im: One.Copysign(im)}
+		}
+		return Complex512{re: Zero.Copysign(re.Ldexp(1).Sin()), im: One.Copysign(im)}
+	case re.IsZero() && im.IsNaN():
+		return c
+	}
+
+	// tan(x+yi) = (sin(2x) + sinh(2y)i) / (cos(2x) + cosh(2y))
+	//           = (sin(x)cos(x) + sinh(y)cosh(y)i) / (cos²(x) + sinh²(y)),
+	// which doesn't suffer from the cancellation near the poles unlike math/cmplx.
+	s, co := re.Sincos()
+	if im.Abs().Gt(tanLarge256) {
+		// sinh²(y) overflows; tan(x+yi) = 4sin(x)cos(x)e**(-2|y|) ± i
+		e := im.Abs().Ldexp(1).Neg().Exp()
+		return Complex512{re: s.Mul(co).Ldexp(2).Mul(e), im: One.Copysign(im)}
+	}
+	sh, ch := im.Sinh(), im.Cosh()
+	d := co.Mul(co).Add(sh.Mul(sh))
+	if d.IsZero() {
+		return NewComplex512Inf()
+	}
+	return Complex512{re: s.Mul(co).Quo(d), im: sh.Mul(ch).Quo(d)}
+}
+
+// Tanh returns the hyperbolic tangent of c.
+func (c Complex512) Tanh() Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+	re, im := c.re, c.im
+	switch {
+	case re.IsInf(0):
+		if im.IsInf(0) || im.IsNaN() {
+			return Complex512{re: One.Copysign(re), im: Zero.Copysign(im)}
+		}
+		return Complex512{re: One.Copysign(re), im: Zero.Copysign(im.Ldexp(1).Sin())}
+	case im.IsZero() && re.IsNaN():
+		return c
+	}
+
+	// tanh(x+yi) = (sinh(2x) + sin(2y)i) / (cosh(2x) + cos(2y))
+	//            = (sinh(x)cosh(x) + sin(y)cos(y)i) / (sinh²(x) + cos²(y))
+	s, co := im.Sincos()
+	if re.Abs().Gt(tanLarge256) {
+		e := re.Abs().Ldexp(1).Neg().Exp()
+		return Complex512{re: One.Copysign(re), im: s.Mul(co).Ldexp(2).Mul(e)}
+	}
+	sh, ch := re.Sinh(), re.Cosh()
+	d := sh.Mul(sh).Add(co.Mul(co))
+	if d.IsZero() {
+		return NewComplex512Inf()
+	}
+	return Complex512{re: sh.Mul(ch).Quo(d), im: s.Mul(co).Quo(d)}
+}
+
+// Cot returns the cotangent of c.
+// Unlike math/cmplx, it returns the limit ∓i for x±∞i instead of NaN.
+func (c Complex512) Cot() Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+	re, im := c.re, c.im
+	if im.IsInf(0) {
+		if re.IsInf(0) || re.IsNaN() {
+			return Complex512{re: Zero.Copysign(re), im: One.Copysign(im).Neg()}
+		}
+		return Complex512{re: Zero.Copysign(re.Ldexp(1).Sin()), im: One.Copysign(im).Neg()}
+	}
+
+	// cot(x+yi) = (sin(2x) - sinh(2y)i) / (cosh(2y) - cos(2x))
+	//           = (sin(x)cos(x) - sinh(y)cosh(y)i) / (sin²(x) + sinh²(y))
+	s, co := re.Sincos()
+	if im.Abs().Gt(tanLarge256) {
+		e := im.Abs().Ldexp(1).Neg().Exp()
+		return Complex512{re: s.Mul(co).Ldexp(2).Mul(e), im: One.Copysign(im).Neg()}
+	}
+	sh, ch := im.Sinh(), im.Cosh()
+	d := s.Mul(s).Add(sh.Mul(sh))
+	if d.IsZero() {
+		return NewComplex512Inf()
+	}
+	return Complex512{re: s.Mul(co).Quo(d), im: sh.Mul(ch).Quo(d).Neg()}
+}
+
+// Asin returns the inverse sine of c.
+func (c Complex512) Asin() Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+	re, im := c.re, c.im
+	switch {
+	case im.IsZero() && re.Abs().Le(One):
+		return Complex512{re: re.Asin(), im: im}
+	case re.IsZero() && im.Abs().Le(One):
+		return Complex512{re: re, im: im.Asinh()}
+	case im.IsNaN():
+		switch {
+		case re.IsZero():
+			return Complex512{re: re, im: NewFloat256NaN()}
+		case re.IsInf(0):
+			return Complex512{re: NewFloat256NaN(), im: re}
+		default:
+			return NewComplex512NaN()
+		}
+	case im.IsInf(0):
+		switch {
+		case re.IsNaN():
+			return c
+		case re.IsInf(0):
+			return Complex512{re: Float256Pi().Ldexp(-2).Copysign(re), im: im}
+		default:
+			return Complex512{re: Zero.Copysign(re), im: im}
+		}
+	case re.IsInf(0):
+		return Complex512{re: Float256Pi().Ldexp(-1).Copysign(re), im: re.Copysign(im)}
+	}
+	ct := Complex512{re: im.Neg(), im: re} // i * c
+	xx := c.Mul(c)
+	x1 := Complex512{re: One.Sub(xx.re), im: xx.im.Neg()} // 1 - c*c
+	x2 := x1.Sqrt()                                       // x2 = sqrt(1 - c*c)
+	w := ct.Add(x2).Log()
+	return Complex512{re: w.im, im: w.re.Neg()} // -i * w
+}
+
+// Asinh returns the inverse hyperbolic sine of c.
+func (c Complex512) Asinh() Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+	re, im := c.re, c.im
+	switch {
+	case im.IsZero() && re.Abs().Le(One):
+		return Complex512{re: re.Asinh(), im: im}
+	case re.IsZero() && im.Abs().Le(One):
+		return Complex512{re: re, im: im.Asin()}
+	case re.IsInf(0):
+		switch {
+		case im.IsInf(0):
+			return Complex512{re: re, im: Float256Pi().Ldexp(-2).Copysign(im)}
+		case im.IsNaN():
+			return c
+		default:
+			return Complex512{re: re, im: Zero.Copysign(im)}
+		}
+	case re.IsNaN():
+		switch {
+		case im.IsZero():
+			return c
+		case im.IsInf(0):
+			return Complex512{re: im, im: re}
+		default:
+			return NewComplex512NaN()
+		}
+	case im.IsInf(0):
+		return Complex512{re: im.Copysign(re), im: Float256Pi().Ldexp(-1).Copysign(im)}
+	}
+	xx := c.Mul(c)
+	x1 := Complex512{re: One.Add(xx.re), im: xx.im} // 1 + c*c
+	return c.Add(x1.Sqrt()).Log()                   // log(c + sqrt(1 + c*c))
+}
+
+// Acos returns the inverse cosine of c.
+func (c Complex512) Acos() Complex512 {
+	w := c.Asin()
+	return Complex512{re: Float256Pi().Ldexp(-1).Sub(w.re), im: w.im.Neg()}
+}
+
+// Acosh returns the inverse hyperbolic cosine of c.
+func (c Complex512) Acosh() Complex512 {
+	if c.IsZero() {
+		return Complex512{im: Float256Pi().Ldexp(-1).Copysign(c.im)}
+	}
+	w := c.Acos()
+	if w.im.Le(Float256{}) {
+		return Complex512{re: w.im.Neg(), im: w.re} // i * w
+	}
+	return Complex512{re: w.im, im: w.re.Neg()} // -i * w
+}
+
+// Atan returns the inverse tangent of c.
+// On the branch cut, the real part of the result has the same sign as real(c), as in C99,
+// while math/cmplx returns -π/2 for both ±0.
+func (c Complex512) Atan() Complex512 {
+	var Zero, One = Float256{}, Float256(uvone256)
+	re, im := c.re, c.im
+	switch {
+	case im.IsZero():
+		return Complex512{re: re.Atan(), im: im}
+	case re.IsZero() && im.Abs().Le(One):
+		return Complex512{re: re, im: im.Atanh()}
+	case im.IsInf(0) || re.IsInf(0):
+		if re.IsNaN() {
+			return Complex512{re: NewFloat256NaN(), im: Zero.Copysign(im)}
+		}
+		return Complex512{re: Float256Pi().Ldexp(-1).Copysign(re), im: Zero.Copysign(im)}
+	case re.IsNaN() || im.IsNaN():
+		return NewComplex512NaN()
+	}
+	x2 := re.Mul(re)
+	a := One.Sub(x2).Sub(im.Mul(im))
+	if a.IsZero() {
+		return NewComplex512NaN()
+	}
+	w := re.Ldexp(1).Atan2(a).Ldexp(-1)
+
+	t := im.Sub(One)
+	b := x2.Add(t.Mul(t))
+	if b.IsZero() {
+		return NewComplex512NaN()
+	}
+	t = im.Add(One)
+	q := x2.Add(t.Mul(t)).Quo(b)
+	return Complex512{re: w, im: q.Log().Ldexp(-2)}
+}
+
+// Atanh returns the inverse hyperbolic tangent of c.
+func (c Complex512) Atanh() Complex512 {
+	z := Complex512{re: c.im.Neg(), im: c.re} // z = i * c
+	z = z.Atan()
+	return Complex512{re: z.im, im: z.re.Neg()} // z = -i * z
+}
@@ -0,0 +1,261 @@
+package floats
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+// the test inputs of the complex functions, borrowed from math/cmplx.
+var vcTests = []complex128{
+	4.97901192488367350108546816 + 7.73887247457810456552351752i,
+	7.73887247457810456552351752 - 0.27688005719200159404635997i,
+	-0.27688005719200159404635997 - 5.01060361827107492160848778i,
+	-5.01060361827107492160848778 + 9.63629370719841737980004837i,
+	9.63629370719841737980004837 + 2.92637723924396464525443662i,
+	2.92637723924396464525443662 + 5.22908343145930665230025625i,
+	5.22908343145930665230025625 + 2.72793991043601025126008608i,
+	2.72793991043601025126008608 + 1.82530809168085506044576505i,
+	1.82530809168085506044576505 - 8.68592476857560136238589621i,
+	-8.68592476857560136238589621 + 4.97901192488367350108546816i,
+	0.5 + 0.25i,
+	-0.5 - 0.25i,
+	1 + 0i,
+	-1 + 0i,
+	-1.5 + 2i,
+	0 - 0.5i,
+}
+
+// the special values of the complex functions.
+var vcSpecialTests = []complex128{
+	complex(0, 0),
+	complex(math.Copysign(0, -1), 0),
+	complex(0, math.Copysign(0, -1)),
+	complex(math.Copysign(0, -1), math.Copysign(0, -1)),
+	complex(1, 0),
+	complex(1, math.Copysign(0, -1)),
+	complex(2, 0),
+	complex(-2, 0),
+	complex(0, 2),
+	complex(0, -2),
+	complex(math.Inf(1), 0),
+	complex(math.Inf(-1), 0),
+	complex(math.Inf(1), 1),
+	complex(math.Inf(-1), 1),
+	complex(1, math.Inf(1)),
+	complex(1, math.Inf(-1)),
+	complex(0, math.Inf(1)),
+	complex(math.Inf(1), math.Inf(1)),
+	complex(math.Inf(-1), math.Inf(-1)),
+	complex(math.NaN(), 0),
+	complex(0, math.NaN()),
+	complex(math.NaN(), 1),
+	complex(1, math.NaN()),
+	complex(math.NaN(), math.Inf(1)),
+	complex(math.Inf(1), math.NaN()),
+	complex(math.NaN(), math.NaN()),
+}
+
+var cmplxFuncs512 = []struct {
+	name string
+	f    func(c Complex512) Complex512
+	want func(c complex128) complex128
+}{
+	{"Sqrt", Complex512.Sqrt, cmplx.Sqrt},
+	{"Exp", Complex512.Exp, cmplx.Exp},
+	{"Log", Complex512.Log, cmplx.Log},
+	{"Log10", Complex512.Log10, cmplx.Log10},
+	{"Sin", Complex512.Sin, cmplx.Sin},
+	{"Sinh", Complex512.Sinh, cmplx.Sinh},
+	{"Cos", Complex512.Cos, cmplx.Cos},
+	{"Cosh", Complex512.Cosh, cmplx.Cosh},
+	{"Tan", Complex512.Tan, cmplx.Tan},
+	{"Tanh", Complex512.Tanh, cmplx.Tanh},
+	{"Cot", Complex512.Cot, cmplx.Cot},
+	{"Asin", Complex512.Asin, cmplx.Asin},
+	{"Asinh", Complex512.Asinh, cmplx.Asinh},
+	{"Acos", Complex512.Acos, cmplx.Acos},
+	{"Acosh", Complex512.Acosh, cmplx.Acosh},
+	{"Atan", Complex512.Atan, cmplx.Atan},
+	{"Atanh", Complex512.Atanh, cmplx.Atanh},
+}
+
+// closeComplex128 reports whether a is close to b within the relative tolerance e.
+// The special values must be the same, including the signs of zeros and infinities.
+func closeComplex128(a, b complex128, e float64) bool {
+	abs := cmplx.Abs(b)
+	part := func(x, y float64) bool {
+		tol := e * abs
+		if math.IsNaN(tol) || math.IsInf(tol, 0) {
+			tol = e * math.Abs(y)
+		}
+		switch {
+		case math.IsNaN(y):
+			return math.IsNaN(x)
+		case math.IsInf(y, 0), y == 0 && tol == 0:
+			return x == y && math.Signbit(x) == math.Signbit(y)
+		}
+		return math.Abs(x-y) <= tol
+	}
+	return part(real(a), real(b)) && part(imag(a), imag(b))
+}
+
+func TestComplex512_Functions(t *testing.T) {
+	for _, fn := range cmplxFuncs512 {
+		for _, c := range vcTests {
+			got := fn.f(NewComplex512FromComplex128(c)).Complex128()
+			want := fn.want(c)
+			if !closeComplex128(got, want, 1e-13) {
+				t.Errorf("%s(%v) = %v; want %v", fn.name, c, got, want)
+			}
+		}
+	}
+}
+
+func TestComplex512_SpecialCases(t *testing.T) {
+	for _, fn := range cmplxFuncs512 {
+		for _, c := range vcSpecialTests {
+			if fn.name == "Atan" && real(c) == 0 && math.Abs(imag(c)) > 1 {
+				// math/cmplx loses the sign of the real part on the branch cut.
+				continue
+			}
+			if fn.name == "Cot" && math.IsInf(imag(c), 0) {
+				// math/cmplx returns NaN instead of the limit.
+				continue
+			}
+			got := fn.f(NewComplex512FromComplex128(c)).Complex128()
+			want := fn.want(c)
+			if !closeComplex128(got, want, 1e-15) {
+				t.Errorf("%s(%v) = %v; want %v", fn.name, c, got, want)
+			}
+		}
+	}
+}
+
+func TestComplex512_Pow(t *testing.T) {
+	for _, c := range vcTests {
+		for _, d := range vcTests[10:] {
+			got := NewComplex512FromComplex128(c).Pow(NewComplex512FromComplex128(d)).Complex128()
+			want := cmplx.Pow(c, d)
+			if !closeComplex128(got, want, 1e-13) {
+				t.Errorf("Pow(%v, %v) = %v; want %v", c, d, got, want)
+			}
+		}
+	}
+
+	// i**i = e**(-π/2)
+	i := NewComplex512(Float256{}, Float256(uvone256))
+	got := i.Pow(i)
+	if !close256(got.Real(), "0.2078795763507619085469556198349787700338778416317696080751358830554198772854821397886002778654260") || !got.Imag().IsZero() {
+		t.Errorf("Pow(i, i) = %v; want e**(-π/2)", got)
+	}
+
+	zero := Complex512{}
+	tests := []struct {
+		d    complex128
+		want complex128
+	}{
+		{0, 1},
+		{complex(math.Copysign(0, -1), 0), 1},
+		{2, 0},
+		{-2, complex(math.Inf(1), 0)},
+		{-2 + 1i, cmplx.Inf()},
+	}
+	for _, tt := range tests {
+		got := zero.Pow(NewComplex512FromComplex128(tt.d)).Complex128()
+		if !closeComplex128(got, tt.want, 0) {
+			t.Errorf("Pow(0, %v) = %v; want %v", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestComplex512_Precision(t *testing.T) {
+	var zero Float256
+	one := Float256(uvone256)
+
+	// sqrt(3+4i) = 2+i
+	got := NewComplex512(exact256(3), exact256(4)).Sqrt()
+	if !got.Eq(NewComplex512(exact256(2), one)) {
+		t.Errorf("Sqrt(3+4i) = %v; want (2+1i)", got)
+	}
+
+	// log(-1) = πi
+	got = NewComplex512(one.Neg(), zero).Log()
+	if !got.Real().IsZero() || !got.Imag().Eq(Float256Pi()) {
+		t.Errorf("Log(-1) = %v; want πi", got)
+	}
+
+	// exp(1) = e
+	got = NewComplex512(one, zero).Exp()
+	if !close256(got.Real(), "2.718281828459045235360287471352662497757247093699959574966967627724076630353547594571382178525166427") || !got.Imag().IsZero() {
+		t.Errorf("Exp(1) = %v; want e", got)
+	}
+
+	// the inverse functions recover the argument in the precision of Float256.
+	eps, err := ParseFloat256("1e-65")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inverses := []struct {
+		name string
+		f, g func(c Complex512) Complex512
+	}{
+		{"Exp(Log(c))", Complex512.Log, Complex512.Exp},
+		{"Sin(Asin(c))", Complex512.Asin, Complex512.Sin},
+		{"Cos(Acos(c))", Complex512.Acos, Complex512.Cos},
+		{"Tan(Atan(c))", Complex512.Atan, Complex512.Tan},
+		{"Sinh(Asinh(c))", Complex512.Asinh, Complex512.Sinh},
+		{"Cosh(Acosh(c))", Complex512.Acosh, Complex512.Cosh},
+		{"Tanh(Atanh(c))", Complex512.Atanh, Complex512.Tanh},
+	}
+	for _, inv := range inverses {
+		for _, c := range vcTests[10:] {
+			x := NewComplex512FromComplex128(c)
+			got := inv.g(inv.f(x))
+			if got.Sub(x).Abs().Gt(x.Abs().Mul(eps)) {
+				t.Errorf("%s = %v; want %v", inv.name, got, x)
+			}
+		}
+	}
+}
+
+func TestComplex512_BranchCut(t *testing.T) {
+	halfPi := Float256Pi().Ldexp(-1)
+	tests := []struct {
+		name string
+		f    func(c Complex512) Complex512
+		c    complex128
+		re   Float256
+		im   float64
+	}{
+		// the same as C99; atan(±0+yi) = ±π/2 + ... for |y| > 1.
+		{"Atan", Complex512.Atan, complex(0, 2), halfPi, 0.5493061443340548},
+		{"Atan", Complex512.Atan, complex(0, -2), halfPi, -0.5493061443340548},
+		{"Atan", Complex512.Atan, complex(math.Copysign(0, -1), 2), halfPi.Neg(), 0.5493061443340548},
+
+		// cot(x±∞i) = ∓i
+		{"Cot", Complex512.Cot, complex(1, math.Inf(1)), Float256{}, -1},
+		{"Cot", Complex512.Cot, complex(1, math.Inf(-1)), Float256{}, 1},
+		{"Cot", Complex512.Cot, complex(math.Inf(1), math.Inf(1)), Float256{}, -1},
+	}
+	for _, tt := range tests {
+		got := tt.f(NewComplex512FromComplex128(tt.c))
+		if !eq256(got.Real(), tt.re) || !close64(got.Imag().Float64(), tt.im) {
+			t.Errorf("%s(%v) = %v; want %v", tt.name, tt.c, got, NewComplex512(tt.re, NewFloat256(tt.im)))
+		}
+	}
+}
+
+func TestComplex512_Tan_Large(t *testing.T) {
+	// tan(1+1000i) = i in the precision of Float256, without overflow.
+	got := NewComplex512(exact256(1), exact256(1000)).Tan()
+	if !got.Imag().Eq(Float256(uvone256)) || got.Real().IsZero() || got.Real().Signbit() {
+		t.Errorf("Tan(1+1000i) = %v; want (+tiny+1i)", got)
+	}
+
+	// tanh(-1000+1i) = -1 in the precision of Float256, without overflow.
+	got = NewComplex512(exact256(-1000), exact256(1)).Tanh()
+	if !got.Real().Eq(Float256(uvone256).Neg()) || got.Imag().IsZero() {
+		t.Errorf("Tanh(-1000+1i) = %v; want (-1+tiny i)", got)
+	}
+}
@@ -0,0 +1,148 @@
+package floats
+
+// Complex256 is a complex number with [Float128] real and imaginary parts.
+type Complex256 struct {
+	re, im Float128
+}
+
+// NewComplex256 returns the complex number re + im×i.
+func NewComplex256(re, im Float128) Complex256 {
+	return Complex256{re: re, im: im}
+}
+
+// NewComplex256FromComplex128 converts c to Complex256.
+// The result is exact.
+func NewComplex256FromComplex128(c complex128) Complex256 {
+	return Complex256{re: NewFloat128(real(c)), im: NewFloat128(imag(c))}
+}
+
+// NewComplex256Inf returns a complex infinity, complex(+Inf, +Inf).
+func NewComplex256Inf() Complex256 {
+	inf := NewFloat128Inf(1)
+	return Complex256{re: inf, im: inf}
+}
+
+// NewComplex256NaN returns a complex “not-a-number” value, complex(NaN, NaN).
+func NewComplex256NaN() Complex256 {
+	nan := NewFloat128NaN()
+	return Complex256{re: nan, im: nan}
+}
+
+// Rect256 returns the complex number x with polar coordinates r, θ.
+func Rect256(r, θ Float128) Complex256 {
+	return Rect512(r.Float256(), θ.Float256()).Complex256()
+}
+
+// Real returns the real part of c.
+func (c Complex256) Real() Float128 {
+	return c.re
+}
+
+// Imag returns the imaginary part of c.
+func (c Complex256) Imag() Float128 {
+	return c.im
+}
+
+// Complex32 converts c to Complex32, rounding each part to nearest even.
+func (c Complex256) Complex32() Complex32 {
+	return Complex32{re: c.re.Float16(), im: c.im.Float16()}
+}
+
+// Complex256 returns c.
+func (c Complex256) Complex256() Complex256 {
+	return c
+}
+
+// Complex512 converts c to Complex512.
+// The result is exact.
+func (c Complex256) Complex512() Complex512 {
+	return Complex512{re: c.re.Float256(), im: c.im.Float256()}
+}
+
+// Complex128 converts c to complex128, rounding each part to nearest even.
+func (c Complex256) Complex128() complex128 {
+	return complex(c.re.Float64().BuiltIn(), c.im.Float64().BuiltIn())
+}
+
+// IsNaN reports whether either real(c) or imag(c) is NaN
+// and neither is an infinity.
+func (c Complex256) IsNaN() bool {
+	if c.re.IsInf(0) || c.im.IsInf(0) {
+		return false
+	}
+	return c.re.IsNaN() || c.im.IsNaN()
+}
+
+// IsInf reports whether either real(c) or imag(c) is an infinity.
+func (c Complex256) IsInf() bool {
+	return c.re.IsInf(0) || c.im.IsInf(0)
+}
+
+// IsZero reports whether c is zero, that is, both parts are ±0.
+func (c Complex256) IsZero() bool {
+	return c.re.IsZero() && c.im.IsZero()
+}
+
+// Eq returns c == d.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (c Complex256) Eq(d Complex256) bool {
+	return c.re.Eq(d.re) && c.im.Eq(d.im)
+}
+
+// Ne returns c != d.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (c Complex256) Ne(d Complex256) bool {
+	return !c.Eq(d)
+}
+
+// Neg returns -c.
+func (c Complex256) Neg() Complex256 {
+	return Complex256{re: c.re.Neg(), im: c.im.Neg()}
+}
+
+// Conj returns the complex conjugate of c.
+func (c Complex256) Conj() Complex256 {
+	return Complex256{re: c.re, im: c.im.Neg()}
+}
+
+// Add returns the sum of c and d.
+func (c Complex256) Add(d Complex256) Complex256 {
+	return Complex256{re: c.re.Add(d.re), im: c.im.Add(d.im)}
+}
+
+// Sub returns the difference of c and d.
+func (c Complex256) Sub(d Complex256) Complex256 {
+	return Complex256{re: c.re.Sub(d.re), im: c.im.Sub(d.im)}
+}
+
+// Mul returns the product of c and d.
+// It is computed in [Complex512], where the products of the parts are exact.
+func (c Complex256) Mul(d Complex256) Complex256 {
+	return c.Complex512().Mul(d.Complex512()).Complex256()
+}
+
+// Quo returns the quotient of c and d.
+// It is computed in [Complex512], so it doesn't overflow or underflow in the intermediate values.
+func (c Complex256) Quo(d Complex256) Complex256 {
+	return c.Complex512().Quo(d.Complex512()).Complex256()
+}
+
+// Abs returns the absolute value (also called the modulus) of c.
+func (c Complex256) Abs() Float128 {
+	return Hypot128(c.re, c.im)
+}
+
+// Phase returns the phase (also called the argument) of c.
+// The returned value is in the range [-Pi, Pi].
+func (c Complex256) Phase() Float128 {
+	return c.im.Atan2(c.re)
+}
+
+// Polar returns the absolute value r and phase θ of c,
+// such that c = r * e**θi.
+// The phase is in the range [-Pi, Pi].
+func (c Complex256) Polar() (r, θ Float128) {
+	return c.Abs(), c.Phase()
+}
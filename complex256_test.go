@@ -0,0 +1,96 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComplex256_Arithmetic(t *testing.T) {
+	for _, c := range vcTests {
+		for _, d := range vcTests {
+			x, y := NewComplex256FromComplex128(c), NewComplex256FromComplex128(d)
+
+			if got, want := x.Add(y).Complex128(), c+d; got != want {
+				t.Errorf("(%v).Add(%v) = %v; want %v", c, d, got, want)
+			}
+			if got, want := x.Sub(y).Complex128(), c-d; got != want {
+				t.Errorf("(%v).Sub(%v) = %v; want %v", c, d, got, want)
+			}
+			if got, want := x.Mul(y).Complex128(), c*d; !closeComplex128(got, want, 1e-15) {
+				t.Errorf("(%v).Mul(%v) = %v; want %v", c, d, got, want)
+			}
+			if got, want := x.Quo(y).Complex128(), c/d; !closeComplex128(got, want, 1e-15) {
+				t.Errorf("(%v).Quo(%v) = %v; want %v", c, d, got, want)
+			}
+		}
+	}
+}
+
+func TestComplex256_Mul(t *testing.T) {
+	// the product is correctly rounded.
+	x := NewComplex256(exact128(1), exact128(0x1p-100))
+	got := x.Mul(x.Conj())
+	if want := exact128(1); !got.Real().Eq(want) || !got.Imag().IsZero() {
+		t.Errorf("(%v).Mul(%v) = %v; want %v", x, x.Conj(), got, want)
+	}
+
+	x = NewComplex256(exact128(1), exact128(0x1p-56))
+	got = x.Mul(x.Conj())
+	if want := exact128(1).Add(exact128(0x1p-112)); !got.Real().Eq(want) || !got.Imag().IsZero() {
+		t.Errorf("(%v).Mul(%v) = %v; want %v", x, x.Conj(), got, want)
+	}
+}
+
+func TestComplex256_Quo(t *testing.T) {
+	// the intermediate values overflow in the naive formula.
+	big := NewFloat128Pow10(4900)
+	x := NewComplex256(big, big)
+	y := NewComplex256(big, big.Neg())
+	if got := x.Quo(y); !got.Real().IsZero() || !got.Imag().Eq(exact128(1)) {
+		t.Errorf("(%v).Quo(%v) = %v; want (0+1i)", x, y, got)
+	}
+
+	inf, nan := math.Inf(1), math.NaN()
+	tests := []struct {
+		c, d complex128
+		want complex128
+	}{
+		{complex(1, 1), 0, complex(inf, inf)},
+		{0, 0, complex(nan, nan)},
+		{complex(inf, 1), complex(1, 1), complex(inf, -inf)},
+		{complex(1, 1), complex(inf, 1), complex(0, 0)},
+	}
+	for _, tt := range tests {
+		got := NewComplex256FromComplex128(tt.c).Quo(NewComplex256FromComplex128(tt.d)).Complex128()
+		if !closeComplex128(got, tt.want, 0) {
+			t.Errorf("(%v).Quo(%v) = %v; want %v", tt.c, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestComplex256_Abs(t *testing.T) {
+	c := NewComplex256(exact128(3), exact128(-4))
+	if got := c.Abs(); !got.Eq(exact128(5)) {
+		t.Errorf("(%v).Abs() = %v; want 5", c, got)
+	}
+
+	// Abs doesn't overflow.
+	half := Float128Max().Ldexp(-1)
+	c = NewComplex256(half, half)
+	if got, want := c.Abs(), half.Float256().Mul(Float256Sqrt2()).Float128(); !close128(got, want.String()) {
+		t.Errorf("(%v).Abs() = %v; want %v", c, got, want)
+	}
+}
+
+func TestComplex256_Convert(t *testing.T) {
+	c := NewComplex256(Float128Pi(), Float128E().Neg())
+	if got, want := c.Complex512(), NewComplex512(Float128Pi().Float256(), Float128E().Neg().Float256()); !got.Eq(want) {
+		t.Errorf("(%v).Complex512() = %v; want %v", c, got, want)
+	}
+	if got, want := c.Complex32(), NewComplex32(Float16Pi, Float16E.Neg()); !got.Eq(want) {
+		t.Errorf("(%v).Complex32() = %v; want %v", c, got, want)
+	}
+	if got, want := c.Complex128(), complex(math.Pi, -math.E); got != want {
+		t.Errorf("(%v).Complex128() = %v; want %v", c, got, want)
+	}
+}
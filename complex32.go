@@ -0,0 +1,152 @@
+package floats
+
+import "math/cmplx"
+
+// Complex32 is a complex number with [Float16] real and imaginary parts.
+type Complex32 struct {
+	re, im Float16
+}
+
+// NewComplex32 returns the complex number re + im×i.
+func NewComplex32(re, im Float16) Complex32 {
+	return Complex32{re: re, im: im}
+}
+
+// NewComplex32FromComplex128 converts c to Complex32, rounding each part to nearest even.
+func NewComplex32FromComplex128(c complex128) Complex32 {
+	return Complex32{re: NewFloat16(real(c)), im: NewFloat16(imag(c))}
+}
+
+// NewComplex32Inf returns a complex infinity, complex(+Inf, +Inf).
+func NewComplex32Inf() Complex32 {
+	inf := NewFloat16Inf(1)
+	return Complex32{re: inf, im: inf}
+}
+
+// NewComplex32NaN returns a complex “not-a-number” value, complex(NaN, NaN).
+func NewComplex32NaN() Complex32 {
+	nan := NewFloat16NaN()
+	return Complex32{re: nan, im: nan}
+}
+
+// Rect32 returns the complex number x with polar coordinates r, θ.
+func Rect32(r, θ Float16) Complex32 {
+	return NewComplex32FromComplex128(cmplx.Rect(r.Float64().BuiltIn(), θ.Float64().BuiltIn()))
+}
+
+// Real returns the real part of c.
+func (c Complex32) Real() Float16 {
+	return c.re
+}
+
+// Imag returns the imaginary part of c.
+func (c Complex32) Imag() Float16 {
+	return c.im
+}
+
+// Complex32 returns c.
+func (c Complex32) Complex32() Complex32 {
+	return c
+}
+
+// Complex256 converts c to Complex256.
+// The result is exact.
+func (c Complex32) Complex256() Complex256 {
+	return Complex256{re: c.re.Float128(), im: c.im.Float128()}
+}
+
+// Complex512 converts c to Complex512.
+// The result is exact.
+func (c Complex32) Complex512() Complex512 {
+	return Complex512{re: c.re.Float256(), im: c.im.Float256()}
+}
+
+// Complex128 converts c to complex128.
+// The result is exact.
+func (c Complex32) Complex128() complex128 {
+	return complex(c.re.Float64().BuiltIn(), c.im.Float64().BuiltIn())
+}
+
+// IsNaN reports whether either real(c) or imag(c) is NaN
+// and neither is an infinity.
+func (c Complex32) IsNaN() bool {
+	if c.re.IsInf(0) || c.im.IsInf(0) {
+		return false
+	}
+	return c.re.IsNaN() || c.im.IsNaN()
+}
+
+// IsInf reports whether either real(c) or imag(c) is an infinity.
+func (c Complex32) IsInf() bool {
+	return c.re.IsInf(0) || c.im.IsInf(0)
+}
+
+// IsZero reports whether c is zero, that is, both parts are ±0.
+func (c Complex32) IsZero() bool {
+	return c.re.IsZero() && c.im.IsZero()
+}
+
+// Eq returns c == d.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (c Complex32) Eq(d Complex32) bool {
+	return c.re.Eq(d.re) && c.im.Eq(d.im)
+}
+
+// Ne returns c != d.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (c Complex32) Ne(d Complex32) bool {
+	return !c.Eq(d)
+}
+
+// Neg returns -c.
+func (c Complex32) Neg() Complex32 {
+	return Complex32{re: c.re.Neg(), im: c.im.Neg()}
+}
+
+// Conj returns the complex conjugate of c.
+func (c Complex32) Conj() Complex32 {
+	return Complex32{re: c.re, im: c.im.Neg()}
+}
+
+// Add returns the sum of c and d.
+func (c Complex32) Add(d Complex32) Complex32 {
+	return Complex32{re: c.re.Add(d.re), im: c.im.Add(d.im)}
+}
+
+// Sub returns the difference of c and d.
+func (c Complex32) Sub(d Complex32) Complex32 {
+	return Complex32{re: c.re.Sub(d.re), im: c.im.Sub(d.im)}
+}
+
+// Mul returns the product of c and d.
+// It is computed in [Complex512], where the parts of the product are exact,
+// so each part is correctly rounded.
+func (c Complex32) Mul(d Complex32) Complex32 {
+	return c.Complex512().Mul(d.Complex512()).Complex32()
+}
+
+// Quo returns the quotient of c and d.
+// It is computed in [Complex512], so it doesn't overflow or underflow in the intermediate values.
+func (c Complex32) Quo(d Complex32) Complex32 {
+	return c.Complex512().Quo(d.Complex512()).Complex32()
+}
+
+// Abs returns the absolute value (also called the modulus) of c.
+func (c Complex32) Abs() Float16 {
+	return Hypot16(c.re, c.im)
+}
+
+// Phase returns the phase (also called the argument) of c.
+// The returned value is in the range [-Pi, Pi].
+func (c Complex32) Phase() Float16 {
+	return c.im.Atan2(c.re)
+}
+
+// Polar returns the absolute value r and phase θ of c,
+// such that c = r * e**θi.
+// The phase is in the range [-Pi, Pi].
+func (c Complex32) Polar() (r, θ Float16) {
+	return c.Abs(), c.Phase()
+}
@@ -0,0 +1,99 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComplex32_Arithmetic(t *testing.T) {
+	tests := []struct {
+		c, d          complex128
+		add, sub, mul complex128
+		quo           complex128
+	}{
+		{1 + 2i, 3 - 4i, 4 - 2i, -2 + 6i, 11 + 2i, -0.2 + 0.4i},
+		{0.5 + 0.25i, 2, 2.5 + 0.25i, -1.5 + 0.25i, 1 + 0.5i, 0.25 + 0.125i},
+	}
+	for _, tt := range tests {
+		x, y := NewComplex32FromComplex128(tt.c), NewComplex32FromComplex128(tt.d)
+		if got, want := x.Add(y), NewComplex32FromComplex128(tt.add); !got.Eq(want) {
+			t.Errorf("(%v).Add(%v) = %v; want %v", tt.c, tt.d, got, want)
+		}
+		if got, want := x.Sub(y), NewComplex32FromComplex128(tt.sub); !got.Eq(want) {
+			t.Errorf("(%v).Sub(%v) = %v; want %v", tt.c, tt.d, got, want)
+		}
+		if got, want := x.Mul(y), NewComplex32FromComplex128(tt.mul); !got.Eq(want) {
+			t.Errorf("(%v).Mul(%v) = %v; want %v", tt.c, tt.d, got, want)
+		}
+		if got, want := x.Quo(y), NewComplex32FromComplex128(tt.quo); !got.Eq(want) {
+			t.Errorf("(%v).Quo(%v) = %v; want %v", tt.c, tt.d, got, want)
+		}
+	}
+}
+
+func TestComplex32_Mul(t *testing.T) {
+	// the products of all Float16 pairs in some range are correctly rounded.
+	for i := uint16(0x3c00); i < 0x3c00+0x80; i++ {
+		for j := uint16(0x3800); j < 0x3800+0x80; j += 7 {
+			a, b := Float16(i), Float16(j)
+			x := NewComplex32(a, b)
+			y := NewComplex32(b, a.Neg())
+			got := x.Mul(y)
+			want := x.Complex512().Mul(y.Complex512()).Complex32()
+			if !got.Eq(want) {
+				t.Errorf("(%v).Mul(%v) = %v; want %v", x, y, got, want)
+			}
+		}
+	}
+}
+
+func TestComplex32_Quo(t *testing.T) {
+	// the intermediate values overflow in Float16.
+	x := NewComplex32(Float16Max, Float16Max)
+	y := NewComplex32(Float16Max, Float16Max.Neg())
+	if got := x.Quo(y); !got.Real().IsZero() || !got.Imag().Eq(exact16(1)) {
+		t.Errorf("(%v).Quo(%v) = %v; want (0+1i)", x, y, got)
+	}
+
+	inf, nan := math.Inf(1), math.NaN()
+	tests := []struct {
+		c, d complex128
+		want complex128
+	}{
+		{complex(1, 1), 0, complex(inf, inf)},
+		{0, 0, complex(nan, nan)},
+		{complex(1, 1), complex(inf, 1), complex(0, 0)},
+	}
+	for _, tt := range tests {
+		got := NewComplex32FromComplex128(tt.c).Quo(NewComplex32FromComplex128(tt.d)).Complex128()
+		if !closeComplex128(got, tt.want, 0) {
+			t.Errorf("(%v).Quo(%v) = %v; want %v", tt.c, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestComplex32_Abs(t *testing.T) {
+	c := NewComplex32(exact16(3), exact16(-4))
+	if got := c.Abs(); !got.Eq(exact16(5)) {
+		t.Errorf("(%v).Abs() = %v; want 5", c, got)
+	}
+
+	// Abs doesn't overflow in the intermediate values.
+	c = NewComplex32(exact16(40000), exact16(30000))
+	if got := c.Abs(); !got.Eq(exact16(49984)) {
+		t.Errorf("(%v).Abs() = %v; want 49984", c, got)
+	}
+}
+
+func TestComplex32_Convert(t *testing.T) {
+	c := NewComplex32(Float16Pi, Float16E.Neg())
+	if got, want := c.Complex512(), NewComplex512(Float16Pi.Float256(), Float16E.Neg().Float256()); !got.Eq(want) {
+		t.Errorf("(%v).Complex512() = %v; want %v", c, got, want)
+	}
+	if got, want := c.Complex256(), NewComplex256(Float16Pi.Float128(), Float16E.Neg().Float128()); !got.Eq(want) {
+		t.Errorf("(%v).Complex256() = %v; want %v", c, got, want)
+	}
+	if got, want := c.Complex128(), complex(3.140625, -2.71875); got != want {
+		t.Errorf("(%v).Complex128() = %v; want %v", c, got, want)
+	}
+}
@@ -0,0 +1,256 @@
+package floats
+
+// Complex512 is a complex number with [Float256] real and imaginary parts.
+type Complex512 struct {
+	re, im Float256
+}
+
+// NewComplex512 returns the complex number re + im×i.
+func NewComplex512(re, im Float256) Complex512 {
+	return Complex512{re: re, im: im}
+}
+
+// NewComplex512FromComplex128 converts c to Complex512.
+// The result is exact.
+func NewComplex512FromComplex128(c complex128) Complex512 {
+	return Complex512{re: NewFloat256(real(c)), im: NewFloat256(imag(c))}
+}
+
+// NewComplex512Inf returns a complex infinity, complex(+Inf, +Inf).
+func NewComplex512Inf() Complex512 {
+	inf := NewFloat256Inf(1)
+	return Complex512{re: inf, im: inf}
+}
+
+// NewComplex512NaN returns a complex “not-a-number” value, complex(NaN, NaN).
+func NewComplex512NaN() Complex512 {
+	nan := NewFloat256NaN()
+	return Complex512{re: nan, im: nan}
+}
+
+// Rect512 returns the complex number x with polar coordinates r, θ.
+func Rect512(r, θ Float256) Complex512 {
+	s, c := θ.Sincos()
+	return Complex512{re: r.Mul(c), im: r.Mul(s)}
+}
+
+// Real returns the real part of c.
+func (c Complex512) Real() Float256 {
+	return c.re
+}
+
+// Imag returns the imaginary part of c.
+func (c Complex512) Imag() Float256 {
+	return c.im
+}
+
+// Complex32 converts c to Complex32, rounding each part to nearest even.
+func (c Complex512) Complex32() Complex32 {
+	return Complex32{re: c.re.Float16(), im: c.im.Float16()}
+}
+
+// Complex256 converts c to Complex256, rounding each part to nearest even.
+func (c Complex512) Complex256() Complex256 {
+	return Complex256{re: c.re.Float128(), im: c.im.Float128()}
+}
+
+// Complex512 returns c.
+func (c Complex512) Complex512() Complex512 {
+	return c
+}
+
+// Complex128 converts c to complex128, rounding each part to nearest even.
+func (c Complex512) Complex128() complex128 {
+	return complex(c.re.Float64().BuiltIn(), c.im.Float64().BuiltIn())
+}
+
+// IsNaN reports whether either real(c) or imag(c) is NaN
+// and neither is an infinity.
+func (c Complex512) IsNaN() bool {
+	if c.re.IsInf(0) || c.im.IsInf(0) {
+		return false
+	}
+	return c.re.IsNaN() || c.im.IsNaN()
+}
+
+// IsInf reports whether either real(c) or imag(c) is an infinity.
+func (c Complex512) IsInf() bool {
+	return c.re.IsInf(0) || c.im.IsInf(0)
+}
+
+// IsZero reports whether c is zero, that is, both parts are ±0.
+func (c Complex512) IsZero() bool {
+	return c.re.IsZero() && c.im.IsZero()
+}
+
+// Eq returns c == d.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (c Complex512) Eq(d Complex512) bool {
+	return c.re.Eq(d.re) && c.im.Eq(d.im)
+}
+
+// Ne returns c != d.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (c Complex512) Ne(d Complex512) bool {
+	return !c.Eq(d)
+}
+
+// Neg returns -c.
+func (c Complex512) Neg() Complex512 {
+	return Complex512{re: c.re.Neg(), im: c.im.Neg()}
+}
+
+// Conj returns the complex conjugate of c.
+func (c Complex512) Conj() Complex512 {
+	return Complex512{re: c.re, im: c.im.Neg()}
+}
+
+// Add returns the sum of c and d.
+func (c Complex512) Add(d Complex512) Complex512 {
+	return Complex512{re: c.re.Add(d.re), im: c.im.Add(d.im)}
+}
+
+// Sub returns the difference of c and d.
+func (c Complex512) Sub(d Complex512) Complex512 {
+	return Complex512{re: c.re.Sub(d.re), im: c.im.Sub(d.im)}
+}
+
+// Mul returns the product of c and d.
+// Each part is computed with [FMA256], so it is rounded at most twice.
+func (c Complex512) Mul(d Complex512) Complex512 {
+	re := FMA256(c.re, d.re, c.im.Mul(d.im).Neg())
+	im := FMA256(c.re, d.im, c.im.Mul(d.re))
+	if re.IsNaN() && im.IsNaN() {
+		// recover infinities, see C99 Annex G.5.1.
+		return mulInf512(c, d)
+	}
+	return Complex512{re: re, im: im}
+}
+
+// mulInf512 returns the product of c and d
+// if it is an infinity that the naive formula computes as NaN.
+func mulInf512(c, d Complex512) Complex512 {
+	var Zero Float256
+
+	a, b := c.re, c.im
+	x, y := d.re, d.im
+	recalc := false
+	if a.IsInf(0) || b.IsInf(0) {
+		// c is infinite; "box" the infinity and change NaNs in the other factor to 0.
+		a = boxInf256(a)
+		b = boxInf256(b)
+		if x.IsNaN() {
+			x = Zero.Copysign(x)
+		}
+		if y.IsNaN() {
+			y = Zero.Copysign(y)
+		}
+		recalc = true
+	}
+	if x.IsInf(0) || y.IsInf(0) {
+		x = boxInf256(x)
+		y = boxInf256(y)
+		if a.IsNaN() {
+			a = Zero.Copysign(a)
+		}
+		if b.IsNaN() {
+			b = Zero.Copysign(b)
+		}
+		recalc = true
+	}
+	if !recalc {
+		return Complex512{re: NewFloat256NaN(), im: NewFloat256NaN()}
+	}
+	inf := NewFloat256Inf(1)
+	re := a.Mul(x).Sub(b.Mul(y))
+	im := a.Mul(y).Add(b.Mul(x))
+	return Complex512{re: inf.Mul(re), im: inf.Mul(im)}
+}
+
+// boxInf256 returns ±1 if a is an infinity, and ±0 otherwise, with the sign of a.
+func boxInf256(a Float256) Float256 {
+	if a.IsInf(0) {
+		return Float256(uvone256).Copysign(a)
+	}
+	return Float256{}.Copysign(a)
+}
+
+// Quo returns the quotient of c and d.
+// It uses the robust algorithm of Baudin and Smith,
+// which avoids unnecessary overflow and underflow in the intermediate values.
+func (c Complex512) Quo(d Complex512) Complex512 {
+	a, b := c.re, c.im
+	x, y := d.re, d.im
+	var e, f Float256
+	if y.Abs().Le(x.Abs()) {
+		r := y.Quo(x)
+		t := Float256(uvone256).Quo(x.Add(y.Mul(r)))
+		e = quoPart256(a, b, x, y, r, t)
+		f = quoPart256(b, a.Neg(), x, y, r, t)
+	} else {
+		r := x.Quo(y)
+		t := Float256(uvone256).Quo(y.Add(x.Mul(r)))
+		e = quoPart256(b, a, y, x, r, t)
+		f = quoPart256(a, b.Neg(), y, x, r, t).Neg()
+	}
+
+	if e.IsNaN() && f.IsNaN() {
+		// recover infinities and zeros, see C99 Annex G.5.1.
+		inf := NewFloat256Inf(1)
+		switch {
+		case x.IsZero() && y.IsZero() && (!a.IsNaN() || !b.IsNaN()):
+			inf = inf.Copysign(x)
+			e = inf.Mul(a)
+			f = inf.Mul(b)
+		case (a.IsInf(0) || b.IsInf(0)) && isFinite256(x) && isFinite256(y):
+			a, b = boxInf256(a), boxInf256(b)
+			e = inf.Mul(a.Mul(x).Add(b.Mul(y)))
+			f = inf.Mul(b.Mul(x).Sub(a.Mul(y)))
+		case (x.IsInf(0) || y.IsInf(0)) && isFinite256(a) && isFinite256(b):
+			x, y = boxInf256(x), boxInf256(y)
+			var zero Float256
+			e = zero.Mul(a.Mul(x).Add(b.Mul(y)))
+			f = zero.Mul(b.Mul(x).Sub(a.Mul(y)))
+		}
+	}
+	return Complex512{re: e, im: f}
+}
+
+// quoPart256 returns (a + b×r) × t, where r = y/x and t = 1/(x + y×r).
+// It is the real part of (a + b×i) / (x + y×i) if |y| <= |x|.
+func quoPart256(a, b, x, y, r, t Float256) Float256 {
+	if !r.IsZero() {
+		br := b.Mul(r)
+		if !br.IsZero() {
+			return a.Add(br).Mul(t)
+		}
+		return a.Mul(t).Add(b.Mul(t).Mul(r))
+	}
+	// r underflows; compute y/x×b in the other order.
+	return a.Add(y.Mul(b.Quo(x))).Mul(t)
+}
+
+// isFinite256 reports whether a is neither an infinity nor NaN.
+func isFinite256(a Float256) bool {
+	return !a.IsNaN() && !a.IsInf(0)
+}
+
+// Abs returns the absolute value (also called the modulus) of c.
+func (c Complex512) Abs() Float256 {
+	return Hypot256(c.re, c.im)
+}
+
+// Phase returns the phase (also called the argument) of c.
+// The returned value is in the range [-Pi, Pi].
+func (c Complex512) Phase() Float256 {
+	return c.im.Atan2(c.re)
+}
+
+// Polar returns the absolute value r and phase θ of c,
+// such that c = r * e**θi.
+// The phase is in the range [-Pi, Pi].
+func (c Complex512) Polar() (r, θ Float256) {
+	return c.Abs(), c.Phase()
+}
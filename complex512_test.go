@@ -0,0 +1,180 @@
+package floats
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestComplex512_Arithmetic(t *testing.T) {
+	for _, c := range vcTests {
+		for _, d := range vcTests {
+			x, y := NewComplex512FromComplex128(c), NewComplex512FromComplex128(d)
+
+			if got, want := x.Add(y).Complex128(), c+d; got != want {
+				t.Errorf("(%v).Add(%v) = %v; want %v", c, d, got, want)
+			}
+			if got, want := x.Sub(y).Complex128(), c-d; got != want {
+				t.Errorf("(%v).Sub(%v) = %v; want %v", c, d, got, want)
+			}
+			if got, want := x.Mul(y).Complex128(), c*d; !closeComplex128(got, want, 1e-15) {
+				t.Errorf("(%v).Mul(%v) = %v; want %v", c, d, got, want)
+			}
+			if got, want := x.Quo(y).Complex128(), c/d; !closeComplex128(got, want, 1e-15) {
+				t.Errorf("(%v).Quo(%v) = %v; want %v", c, d, got, want)
+			}
+		}
+	}
+}
+
+func TestComplex512_Mul(t *testing.T) {
+	// (1+2⁻²⁰⁰i)(1-2⁻²⁰⁰i) = 1+2⁻⁴⁰⁰, which is lost without FMA.
+	tiny := exact256(0x1p-200)
+	x := NewComplex512(exact256(1), tiny)
+	got := x.Mul(x.Conj())
+	if want := exact256(1).Add(exact256(0x1p-400)); !got.Real().Eq(want) || !got.Imag().IsZero() {
+		t.Errorf("(%v).Mul(%v) = %v; want %v", x, x.Conj(), got, want)
+	}
+
+	inf, nan := math.Inf(1), math.NaN()
+	tests := []struct {
+		c, d complex128
+		want complex128
+	}{
+		// infinities are recovered from NaN, see C99 Annex G.5.1.
+		{complex(inf, nan), 1, complex(inf, nan)},
+		{complex(inf, 0), complex(0, 1), complex(nan, inf)},
+		{complex(inf, inf), complex(1, 0), complex(inf, inf)},
+		{complex(inf, inf), complex(0, 1), complex(-inf, inf)},
+		{complex(nan, inf), complex(nan, 1), complex(-inf, nan)},
+		{complex(nan, nan), 1, complex(nan, nan)},
+	}
+	for _, tt := range tests {
+		got := NewComplex512FromComplex128(tt.c).Mul(NewComplex512FromComplex128(tt.d)).Complex128()
+		if !closeComplex128(got, tt.want, 0) {
+			t.Errorf("(%v).Mul(%v) = %v; want %v", tt.c, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestComplex512_Quo(t *testing.T) {
+	// the intermediate values overflow and underflow in the naive formula.
+	big := NewFloat256Pow10(78000)
+	x := NewComplex512(big, big)
+	y := NewComplex512(big, big.Neg())
+	if got := x.Quo(y); !got.Real().IsZero() || !got.Imag().Eq(Float256(uvone256)) {
+		t.Errorf("(%v).Quo(%v) = %v; want (0+1i)", x, y, got)
+	}
+
+	small := NewFloat256Pow10(-78000)
+	x = NewComplex512(Float256(uvone256), Float256(uvone256))
+	y = NewComplex512(small, small)
+	if got, want := x.Quo(y), NewComplex512(NewFloat256Pow10(78000), Float256{}); got.Real().Sub(want.Real()).Abs().Gt(want.Real().Mul(exact256(0x1p-230))) || !got.Imag().IsZero() {
+		t.Errorf("(%v).Quo(%v) = %v; want %v", x, y, got, want)
+	}
+
+	inf, nan := math.Inf(1), math.NaN()
+	negZero := math.Copysign(0, -1)
+	tests := []struct {
+		c, d complex128
+		want complex128
+	}{
+		{complex(1, 1), 0, complex(inf, inf)},
+		{complex(1, -1), complex(negZero, 0), complex(-inf, inf)},
+		{0, 0, complex(nan, nan)},
+		{complex(inf, 1), complex(1, 1), complex(inf, -inf)},
+		{complex(1, 1), complex(inf, 1), complex(0, 0)},
+		{complex(-1, 1), complex(inf, inf), complex(0, 0)},
+		{complex(1, 2), complex(nan, 1), complex(nan, nan)},
+		{complex(inf, inf), complex(inf, inf), complex(nan, nan)},
+	}
+	for _, tt := range tests {
+		got := NewComplex512FromComplex128(tt.c).Quo(NewComplex512FromComplex128(tt.d)).Complex128()
+		if !closeComplex128(got, tt.want, 0) {
+			t.Errorf("(%v).Quo(%v) = %v; want %v", tt.c, tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestComplex512_Abs(t *testing.T) {
+	tests := []struct {
+		c    complex128
+		want float64
+	}{
+		{complex(3, 4), 5},
+		{complex(-5, 12), 13},
+		{complex(math.Inf(-1), math.NaN()), math.Inf(1)},
+		{complex(math.NaN(), 1), math.NaN()},
+	}
+	for _, tt := range tests {
+		got := NewComplex512FromComplex128(tt.c).Abs()
+		if !eq256(got, exact256(tt.want)) {
+			t.Errorf("(%v).Abs() = %v; want %v", tt.c, got, tt.want)
+		}
+	}
+
+	// Abs doesn't overflow.
+	big := NewFloat256Pow10(78000)
+	c := NewComplex512(big, big)
+	if got, want := c.Abs(), big.Mul(Float256Sqrt2()); !got.Eq(want) {
+		t.Errorf("(%v).Abs() = %v; want %v", c, got, want)
+	}
+}
+
+func TestComplex512_Polar(t *testing.T) {
+	for _, c := range vcTests {
+		r, θ := NewComplex512FromComplex128(c).Polar()
+		wantR, wantθ := cmplx.Polar(c)
+		if !close64(r.Float64(), wantR) || !close64(θ.Float64(), wantθ) {
+			t.Errorf("(%v).Polar() = %v, %v; want %v, %v", c, r, θ, wantR, wantθ)
+		}
+
+		got := Rect512(r, θ).Complex128()
+		if !closeComplex128(got, c, 1e-15) {
+			t.Errorf("Rect512(%v, %v) = %v; want %v", r, θ, got, c)
+		}
+	}
+}
+
+func TestComplex512_IsNaN(t *testing.T) {
+	tests := []struct {
+		c     complex128
+		isNaN bool
+		isInf bool
+	}{
+		{complex(1, 2), false, false},
+		{complex(math.NaN(), 2), true, false},
+		{complex(1, math.NaN()), true, false},
+		{complex(math.NaN(), math.Inf(-1)), false, true},
+		{complex(math.Inf(1), 2), false, true},
+	}
+	for _, tt := range tests {
+		c := NewComplex512FromComplex128(tt.c)
+		if got := c.IsNaN(); got != tt.isNaN {
+			t.Errorf("(%v).IsNaN() = %t; want %t", tt.c, got, tt.isNaN)
+		}
+		if got := c.IsInf(); got != tt.isInf {
+			t.Errorf("(%v).IsInf() = %t; want %t", tt.c, got, tt.isInf)
+		}
+	}
+
+	if c := NewComplex512NaN(); !c.IsNaN() {
+		t.Errorf("NewComplex512NaN() = %v; want NaN", c)
+	}
+	if c := NewComplex512Inf(); !c.IsInf() {
+		t.Errorf("NewComplex512Inf() = %v; want Inf", c)
+	}
+}
+
+func TestComplex512_Convert(t *testing.T) {
+	c := NewComplex512(Float256Pi(), Float256E().Neg())
+	if got, want := c.Complex256(), NewComplex256(Float128Pi(), Float128E().Neg()); !got.Eq(want) {
+		t.Errorf("(%v).Complex256() = %v; want %v", c, got, want)
+	}
+	if got, want := c.Complex32(), NewComplex32(Float16Pi, Float16E.Neg()); !got.Eq(want) {
+		t.Errorf("(%v).Complex32() = %v; want %v", c, got, want)
+	}
+	if got, want := c.Complex128(), complex(math.Pi, -math.E); got != want {
+		t.Errorf("(%v).Complex128() = %v; want %v", c, got, want)
+	}
+}
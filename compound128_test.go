@@ -0,0 +1,61 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat128_Compound(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		n    int
+		want string
+	}{
+		{exact128(0.5), 3, "3.375"},
+		{exact128(0.25), -2, "0.64"},
+		{exact128(-0.5), 5, "0.03125"},
+		{exact128(0x1p-10), 100, "1.102531166976293808182445707743351278846"},
+		{exact128(0.125), -7, "0.4384623860200641066249854431421152844603"},
+		{exact128(3), 4, "256"},
+		{exact128(-0.75), -3, "64"},
+		{exact128(0x1p-20), 1000000, "2.595226670281386068623004280475786876063"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !close128(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		n    int
+		want Float128
+	}{
+		{exact128(math.NaN()), 0, exact128(1)},
+		{exact128(math.NaN()), 1, exact128(math.NaN())},
+		{exact128(-2), 1, exact128(math.NaN())},
+		{exact128(math.Inf(-1)), 2, exact128(math.NaN())},
+		{exact128(0.5), 0, exact128(1)},
+		{exact128(-1), 0, exact128(1)},
+		{exact128(math.Inf(1)), 0, exact128(1)},
+		{exact128(-1), -1, exact128(math.Inf(1))},
+		{exact128(-1), -2, exact128(math.Inf(1))},
+		{exact128(-1), 2, exact128(0)},
+		{exact128(math.Inf(1)), 3, exact128(math.Inf(1))},
+		{exact128(math.Inf(1)), -3, exact128(0)},
+		{exact128(0), 5, exact128(1)},
+		{exact128(math.Copysign(0, -1)), -5, exact128(1)},
+		{exact128(1), 10, exact128(1024)},
+		{exact128(0.5), 3, exact128(3.375)},
+		{exact128(-0.5), -2, exact128(4)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Compound(tt.n)
+		if !eq128(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package floats
+
+// Compound returns (1+a)**n, the compound interest of the rate a over n periods.
+//
+// Special cases are (in order):
+//
+//	NaN.Compound(0) = 1
+//	NaN.Compound(n) = NaN
+//	(a < -1).Compound(n) = NaN
+//	a.Compound(0) = 1 for a >= -1
+//	-1.Compound(n) = +Inf for n < 0
+//	-1.Compound(n) = +0 for n > 0
+//	+Inf.Compound(n) = +Inf for n > 0
+//	+Inf.Compound(n) = +0 for n < 0
+func (a Float16) Compound(n int) Float16 {
+	if ret, ok := roundFloat16(a.Float64().Compound(n).BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Compound(n).Float16()
+}
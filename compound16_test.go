@@ -0,0 +1,78 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16_Compound(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		n    int
+		want float64
+	}{
+		{exact16(0.5), 3, 3.375},
+		{exact16(0.25), -2, 0.64},
+		{exact16(-0.5), 5, 0.03125},
+		{exact16(0x1p-10), 100, 1.1025311669762938},
+		{exact16(0.125), -7, 0.4384623860200641},
+		{exact16(3), 4, 256.0},
+		{exact16(-0.75), -3, 64.0},
+		{exact16(0x1p-20), 1000000, 2.595226670281386},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !close16(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		n    int
+		want Float16
+	}{
+		{exact16(math.NaN()), 0, exact16(1)},
+		{exact16(math.NaN()), 1, exact16(math.NaN())},
+		{exact16(-2), 1, exact16(math.NaN())},
+		{exact16(math.Inf(-1)), 2, exact16(math.NaN())},
+		{exact16(0.5), 0, exact16(1)},
+		{exact16(-1), 0, exact16(1)},
+		{exact16(math.Inf(1)), 0, exact16(1)},
+		{exact16(-1), -1, exact16(math.Inf(1))},
+		{exact16(-1), -2, exact16(math.Inf(1))},
+		{exact16(-1), 2, exact16(0)},
+		{exact16(math.Inf(1)), 3, exact16(math.Inf(1))},
+		{exact16(math.Inf(1)), -3, exact16(0)},
+		{exact16(0), 5, exact16(1)},
+		{exact16(math.Copysign(0, -1)), -5, exact16(1)},
+		{exact16(1), 10, exact16(1024)},
+		{exact16(0.5), 3, exact16(3.375)},
+		{exact16(-0.5), -2, exact16(4)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Compound(tt.n)
+		if !eq16(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Compound_CorrectlyRounded(t *testing.T) {
+	// 1+x has at most 40 significant bits, so (1+x)**n is exact in Float256 for n <= 5.
+	one := NewFloat256(1)
+	for _, n := range []int{-3, 2, 5} {
+		for i := range 1 << 16 {
+			x := NewFloat16FromBits(uint16(i))
+			if x.IsNaN() || x.IsInf(0) || x.Lt(NewFloat16(-1)) {
+				continue
+			}
+			want := pownExact256(one.Add(x.Float256()), n).Float16()
+			if got := x.Compound(n); !eq16(got, want) {
+				t.Errorf("Compound(%v, %d) = %v; want %v", x, n, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package floats
+
+// Compound returns (1+a)**n, the compound interest of the rate a over n periods.
+//
+// Special cases are (in order):
+//
+//	NaN.Compound(0) = 1
+//	NaN.Compound(n) = NaN
+//	(a < -1).Compound(n) = NaN
+//	a.Compound(0) = 1 for a >= -1
+//	-1.Compound(n) = +Inf for n < 0
+//	-1.Compound(n) = +0 for n > 0
+//	+Inf.Compound(n) = +Inf for n > 0
+//	+Inf.Compound(n) = +0 for n < 0
+func (a Float256) Compound(n int) Float256 {
+	var One = Float256(uvone256)
+
+	// special cases
+	switch {
+	case a.IsNaN():
+		if n == 0 {
+			return One
+		}
+		return NewFloat256NaN()
+	case a.Lt(One.Neg()):
+		return NewFloat256NaN()
+	case n == 0:
+		return One
+	}
+
+	if s := One.Add(a); s.Sub(One).Eq(a) {
+		// 1 + a is exact.
+		return s.Pown(n)
+	}
+
+	// compute; (1+a)**n = exp(n×log(1+a))
+	return NewFloat256FromInt64(int64(n)).Mul(a.Log1p()).Exp()
+}
@@ -0,0 +1,61 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat256_Compound(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		n    int
+		want string
+	}{
+		{exact256(0.5), 3, "3.375"},
+		{exact256(0.25), -2, "0.64"},
+		{exact256(-0.5), 5, "0.03125"},
+		{exact256(0x1p-10), 100, "1.102531166976293808182445707743351278845835938647833174826535166593035"},
+		{exact256(0.125), -7, "0.4384623860200641066249854431421152844603425194685560370556447261104975"},
+		{exact256(3), 4, "256"},
+		{exact256(-0.75), -3, "64"},
+		{exact256(0x1p-20), 1000000, "2.595226670281386068623004280475786876063238447638220623913688354520212"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !close256(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		n    int
+		want Float256
+	}{
+		{exact256(math.NaN()), 0, exact256(1)},
+		{exact256(math.NaN()), 1, exact256(math.NaN())},
+		{exact256(-2), 1, exact256(math.NaN())},
+		{exact256(math.Inf(-1)), 2, exact256(math.NaN())},
+		{exact256(0.5), 0, exact256(1)},
+		{exact256(-1), 0, exact256(1)},
+		{exact256(math.Inf(1)), 0, exact256(1)},
+		{exact256(-1), -1, exact256(math.Inf(1))},
+		{exact256(-1), -2, exact256(math.Inf(1))},
+		{exact256(-1), 2, exact256(0)},
+		{exact256(math.Inf(1)), 3, exact256(math.Inf(1))},
+		{exact256(math.Inf(1)), -3, exact256(0)},
+		{exact256(0), 5, exact256(1)},
+		{exact256(math.Copysign(0, -1)), -5, exact256(1)},
+		{exact256(1), 10, exact256(1024)},
+		{exact256(0.5), 3, exact256(3.375)},
+		{exact256(-0.5), -2, exact256(4)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Compound(tt.n)
+		if !eq256(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat32_Compound(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		n    int
+		want float64
+	}{
+		{exact32(0.5), 3, 3.375},
+		{exact32(0.25), -2, 0.64},
+		{exact32(-0.5), 5, 0.03125},
+		{exact32(0x1p-10), 100, 1.1025311669762938},
+		{exact32(0.125), -7, 0.4384623860200641},
+		{exact32(3), 4, 256.0},
+		{exact32(-0.75), -3, 64.0},
+		{exact32(0x1p-20), 1000000, 2.595226670281386},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !close32(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		n    int
+		want Float32
+	}{
+		{exact32(math.NaN()), 0, exact32(1)},
+		{exact32(math.NaN()), 1, exact32(math.NaN())},
+		{exact32(-2), 1, exact32(math.NaN())},
+		{exact32(math.Inf(-1)), 2, exact32(math.NaN())},
+		{exact32(0.5), 0, exact32(1)},
+		{exact32(-1), 0, exact32(1)},
+		{exact32(math.Inf(1)), 0, exact32(1)},
+		{exact32(-1), -1, exact32(math.Inf(1))},
+		{exact32(-1), -2, exact32(math.Inf(1))},
+		{exact32(-1), 2, exact32(0)},
+		{exact32(math.Inf(1)), 3, exact32(math.Inf(1))},
+		{exact32(math.Inf(1)), -3, exact32(0)},
+		{exact32(0), 5, exact32(1)},
+		{exact32(math.Copysign(0, -1)), -5, exact32(1)},
+		{exact32(1), 10, exact32(1024)},
+		{exact32(0.5), 3, exact32(3.375)},
+		{exact32(-0.5), -2, exact32(4)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Compound(tt.n)
+		if !eq32(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Compound_CorrectlyRounded(t *testing.T) {
+	// 1+x has at most 64 significant bits for |x| >= 2**-40,
+	// so (1+x)**n is exact in Float256 for n <= 3.
+	one := NewFloat256(1)
+	r := rand.New(rand.NewPCG(32, 12))
+	for _, n := range []int{-3, 2, 3} {
+		for range 20000 {
+			x := Float32(randFloat32(r, -40, 7))
+			if x < -1 {
+				continue
+			}
+			want := pownExact256(one.Add(x.Float256()), n).Float32()
+			if got := x.Compound(n); !eq32(got, want) {
+				t.Errorf("Compound(%v, %d) = %v; want %v", x, n, got, want)
+			}
+		}
+	}
+}
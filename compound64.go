@@ -0,0 +1,47 @@
+package floats
+
+import "math"
+
+// Compound returns (1+a)**n, the compound interest of the rate a over n periods.
+//
+// The result is rounded in the same way as [Float64.Pown].
+//
+// Special cases are (in order):
+//
+//	NaN.Compound(0) = 1
+//	NaN.Compound(n) = NaN
+//	(a < -1).Compound(n) = NaN
+//	a.Compound(0) = 1 for a >= -1
+//	-1.Compound(n) = +Inf for n < 0
+//	-1.Compound(n) = +0 for n > 0
+//	+Inf.Compound(n) = +Inf for n > 0
+//	+Inf.Compound(n) = +0 for n < 0
+func (a Float64) Compound(n int) Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case math.IsNaN(x):
+		if n == 0 {
+			return 1
+		}
+		return NewFloat64NaN()
+	case x < -1:
+		return NewFloat64NaN()
+	case n == 0 || x == 0:
+		return 1
+	case x == -1 || math.IsInf(x, 1):
+		return NewFloat64(math.Pow(x+1, float64(n)))
+	}
+
+	// compute; (1+x)**n = exp(n×log(1+x))
+	y := newDoubleDoubleInt64(int64(n)).Mul(log1pDD(NewDoubleDouble(x))).Exp()
+
+	// if 1+x is not exact in Float256, it has more than 237 significant bits,
+	// and (1+x)**n can't be a midpoint.
+	var b Float256
+	if s := NewFloat256(1).Add(a.Float256()); s.Sub(NewFloat256(1)).Eq(a.Float256()) {
+		b = s
+	}
+	return roundPown64(y, b, n, func() Float256 { return a.Float256().Compound(n) })
+}
@@ -0,0 +1,88 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat64_Compound(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		n    int
+		want float64
+	}{
+		{exact64(0.5), 3, 3.375},
+		{exact64(0.25), -2, 0.64},
+		{exact64(-0.5), 5, 0.03125},
+		{exact64(0x1p-10), 100, 1.1025311669762938},
+		{exact64(0.125), -7, 0.4384623860200641},
+		{exact64(3), 4, 256.0},
+		{exact64(-0.75), -3, 64.0},
+		{exact64(0x1p-20), 1000000, 2.595226670281386},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !close64(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		n    int
+		want Float64
+	}{
+		{exact64(math.NaN()), 0, exact64(1)},
+		{exact64(math.NaN()), 1, exact64(math.NaN())},
+		{exact64(-2), 1, exact64(math.NaN())},
+		{exact64(math.Inf(-1)), 2, exact64(math.NaN())},
+		{exact64(0.5), 0, exact64(1)},
+		{exact64(-1), 0, exact64(1)},
+		{exact64(math.Inf(1)), 0, exact64(1)},
+		{exact64(-1), -1, exact64(math.Inf(1))},
+		{exact64(-1), -2, exact64(math.Inf(1))},
+		{exact64(-1), 2, exact64(0)},
+		{exact64(math.Inf(1)), 3, exact64(math.Inf(1))},
+		{exact64(math.Inf(1)), -3, exact64(0)},
+		{exact64(0), 5, exact64(1)},
+		{exact64(math.Copysign(0, -1)), -5, exact64(1)},
+		{exact64(1), 10, exact64(1024)},
+		{exact64(0.5), 3, exact64(3.375)},
+		{exact64(-0.5), -2, exact64(4)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Compound(tt.n)
+		if !eq64(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Compound_Midpoint(t *testing.T) {
+	// (1+x)² of a 27-bit odd integer 1+x needs 54 bits, so it is often a midpoint.
+	for x := Float64(94906266); x < 94906266+2000; x += 2 {
+		y := x + 1
+		if got, want := x.Compound(2), y.Mul(y); !eq64(got, want) {
+			t.Errorf("Compound(%v, 2) = %v; want %v", x, got, want)
+		}
+	}
+
+	tests := []struct {
+		x    Float64
+		n    int
+		want Float64
+	}{
+		// midpoints in the subnormal range
+		{exact64(-1 + 0x1p-25), 43, exact64(0)}, // 2⁻¹⁰⁷⁵
+		{exact64(1), -1075, exact64(0)},
+		{exact64(1), -1074, exact64(0x1p-1074)},
+	}
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !eq64(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Compound(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		n    int
+		want float64
+	}{
+		{exactBF16(0.5), 3, 3.375},
+		{exactBF16(0.25), -2, 0.64},
+		{exactBF16(-0.5), 5, 0.03125},
+		{exactBF16(0x1p-10), 100, 1.1025311669762938},
+		{exactBF16(0.125), -7, 0.4384623860200641},
+		{exactBF16(3), 4, 256.0},
+		{exactBF16(-0.75), -3, 64.0},
+		{exactBF16(0x1p-20), 1000000, 2.595226670281386},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Compound(tt.n)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		n    int
+		want BFloat16
+	}{
+		{exactBF16(math.NaN()), 0, exactBF16(1)},
+		{exactBF16(math.NaN()), 1, exactBF16(math.NaN())},
+		{exactBF16(-2), 1, exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), 2, exactBF16(math.NaN())},
+		{exactBF16(0.5), 0, exactBF16(1)},
+		{exactBF16(-1), 0, exactBF16(1)},
+		{exactBF16(math.Inf(1)), 0, exactBF16(1)},
+		{exactBF16(-1), -1, exactBF16(math.Inf(1))},
+		{exactBF16(-1), -2, exactBF16(math.Inf(1))},
+		{exactBF16(-1), 2, exactBF16(0)},
+		{exactBF16(math.Inf(1)), 3, exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(1)), -3, exactBF16(0)},
+		{exactBF16(0), 5, exactBF16(1)},
+		{exactBF16(math.Copysign(0, -1)), -5, exactBF16(1)},
+		{exactBF16(1), 10, exactBF16(1024)},
+		{exactBF16(0.5), 3, exactBF16(3.375)},
+		{exactBF16(-0.5), -2, exactBF16(4)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Compound(tt.n)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Compound(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package floats
+
+// Float128Pi returns Pi, rounded to the nearest Float128 value.
+func Float128Pi() Float128 {
+	return Float128{0x4000_921f_b544_42d1, 0x8469_898c_c517_01b8}
+}
+
+// Float128E returns E, the base of natural logarithms, rounded to the nearest Float128 value.
+func Float128E() Float128 {
+	return Float128{0x4000_5bf0_a8b1_4576, 0x9535_5fb8_ac40_4e7a}
+}
+
+// Float128Ln2 returns the natural logarithm of 2, rounded to the nearest Float128 value.
+func Float128Ln2() Float128 {
+	return Float128{0x3ffe_62e4_2fef_a39e, 0xf357_93c7_6730_07e6}
+}
+
+// Float128Ln10 returns the natural logarithm of 10, rounded to the nearest Float128 value.
+func Float128Ln10() Float128 {
+	return Float128{0x4000_26bb_1bbb_5551, 0x582d_d4ad_ac57_05a6}
+}
+
+// Float128Log2E returns the base-2 logarithm of E, rounded to the nearest Float128 value.
+func Float128Log2E() Float128 {
+	return Float128{0x3fff_7154_7652_b82f, 0xe177_7d0f_fda0_d23a}
+}
+
+// Float128Sqrt2 returns the square root of 2, rounded to the nearest Float128 value.
+func Float128Sqrt2() Float128 {
+	return Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea95}
+}
+
+// Float128Phi returns the golden ratio, rounded to the nearest Float128 value.
+func Float128Phi() Float128 {
+	return Float128{0x3fff_9e37_79b9_7f4a, 0x7c15_f39c_c060_5cee}
+}
+
+// Float128Max returns the largest finite Float128 value, about 1.18973e+4932.
+func Float128Max() Float128 {
+	return Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}
+}
+
+// Float128SmallestNormal returns 2**-16382, the smallest positive normal Float128 value.
+func Float128SmallestNormal() Float128 {
+	return Float128{0x0001_0000_0000_0000, 0x0000_0000_0000_0000}
+}
+
+// Float128SmallestSubnormal returns 2**-16494, the smallest positive subnormal Float128 value.
+func Float128SmallestSubnormal() Float128 {
+	return Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0001}
+}
+
+// Float128Epsilon returns 2**-112, the difference between 1 and the next larger Float128 value.
+func Float128Epsilon() Float128 {
+	return Float128{0x3f8f_0000_0000_0000, 0x0000_0000_0000_0000}
+}
+
+const (
+	// Float128MantissaBits is the number of fraction bits of Float128, excluding the implicit leading bit.
+	Float128MantissaBits = 112
+
+	// Float128ExponentBias is the exponent bias of Float128.
+	Float128ExponentBias = 16383
+)
@@ -0,0 +1,40 @@
+package floats
+
+import "testing"
+
+func TestFloat128Constants(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float128
+		want string
+	}{
+		{"Pi", Float128Pi(), "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706"},
+		{"E", Float128E(), "2.71828182845904523536028747135266249775724709369995957496696762772407663035354759457138217852516642"},
+		{"Ln2", Float128Ln2(), "0.69314718055994530941723212145817656807550013436025525412068000949339362196969471560586332699641868"},
+		{"Ln10", Float128Ln10(), "2.30258509299404568401799145468436420760110148862877297603332790096757260967735248023599720508959829"},
+		{"Log2E", Float128Log2E(), "1.44269504088896340735992468100189213742664595415298593413544940693110921918118507988552662289350634"},
+		{"Sqrt2", Float128Sqrt2(), "1.41421356237309504880168872420969807856967187537694807317667973799073247846210703885038753432764157"},
+		{"Phi", Float128Phi(), "1.61803398874989484820458683436563811772030917980576286213544862270526046281890244970720720418939113"},
+		{"Max", Float128Max(), "0x1.ffffffffffffffffffffffffffffp16383"},
+		{"SmallestNormal", Float128SmallestNormal(), "0x1p-16382"},
+		{"SmallestSubnormal", Float128SmallestSubnormal(), "0x1p-16494"},
+		{"Epsilon", Float128Epsilon(), "0x1p-112"},
+	}
+
+	for _, tt := range tests {
+		want, err := ParseFloat128(tt.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eq128(tt.got, want) {
+			t.Errorf("Float128%s = %v; want %v", tt.name, tt.got, want)
+		}
+	}
+
+	if Float128MantissaBits != shift128 {
+		t.Errorf("Float128MantissaBits = %d; want %d", Float128MantissaBits, shift128)
+	}
+	if Float128ExponentBias != bias128 {
+		t.Errorf("Float128ExponentBias = %d; want %d", Float128ExponentBias, bias128)
+	}
+}
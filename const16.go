@@ -0,0 +1,28 @@
+package floats
+
+// Mathematical constants, rounded to the nearest Float16 values.
+const (
+	Float16Pi    Float16 = 0x4248 // 3.140625
+	Float16E     Float16 = 0x4170 // 2.71875
+	Float16Ln2   Float16 = 0x398c // 0.693359375
+	Float16Ln10  Float16 = 0x409b // 2.302734375
+	Float16Log2E Float16 = 0x3dc5 // 1.4423828125
+	Float16Sqrt2 Float16 = 0x3da8 // 1.4140625
+	Float16Phi   Float16 = 0x3e79 // 1.6181640625
+)
+
+// Limits of Float16.
+const (
+	Float16Max               Float16 = 0x7bff // 65504, the largest finite value
+	Float16SmallestNormal    Float16 = 0x0400 // 2**-14, the smallest positive normal value
+	Float16SmallestSubnormal Float16 = 0x0001 // 2**-24, the smallest positive subnormal value
+	Float16Epsilon           Float16 = 0x1400 // 2**-10, the difference between 1 and the next larger value
+)
+
+const (
+	// Float16MantissaBits is the number of fraction bits of Float16, excluding the implicit leading bit.
+	Float16MantissaBits = 10
+
+	// Float16ExponentBias is the exponent bias of Float16.
+	Float16ExponentBias = 15
+)
@@ -0,0 +1,40 @@
+package floats
+
+import "testing"
+
+func TestFloat16Constants(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float16
+		want string
+	}{
+		{"Pi", Float16Pi, "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706"},
+		{"E", Float16E, "2.71828182845904523536028747135266249775724709369995957496696762772407663035354759457138217852516642"},
+		{"Ln2", Float16Ln2, "0.69314718055994530941723212145817656807550013436025525412068000949339362196969471560586332699641868"},
+		{"Ln10", Float16Ln10, "2.30258509299404568401799145468436420760110148862877297603332790096757260967735248023599720508959829"},
+		{"Log2E", Float16Log2E, "1.44269504088896340735992468100189213742664595415298593413544940693110921918118507988552662289350634"},
+		{"Sqrt2", Float16Sqrt2, "1.41421356237309504880168872420969807856967187537694807317667973799073247846210703885038753432764157"},
+		{"Phi", Float16Phi, "1.61803398874989484820458683436563811772030917980576286213544862270526046281890244970720720418939113"},
+		{"Max", Float16Max, "65504"},
+		{"SmallestNormal", Float16SmallestNormal, "0x1p-14"},
+		{"SmallestSubnormal", Float16SmallestSubnormal, "0x1p-24"},
+		{"Epsilon", Float16Epsilon, "0x1p-10"},
+	}
+
+	for _, tt := range tests {
+		want, err := ParseFloat16(tt.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eq16(tt.got, want) {
+			t.Errorf("Float16%s = %v; want %v", tt.name, tt.got, want)
+		}
+	}
+
+	if Float16MantissaBits != shift16 {
+		t.Errorf("Float16MantissaBits = %d; want %d", Float16MantissaBits, shift16)
+	}
+	if Float16ExponentBias != bias16 {
+		t.Errorf("Float16ExponentBias = %d; want %d", Float16ExponentBias, bias16)
+	}
+}
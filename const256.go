@@ -0,0 +1,97 @@
+package floats
+
+// Float256Pi returns Pi, rounded to the nearest Float256 value.
+func Float256Pi() Float256 {
+	return Float256{
+		0x4000_0921_fb54_442d, 0x1846_9898_cc51_701b,
+		0x839a_2520_49c1_114c, 0xf98e_8041_77d4_c762,
+	}
+}
+
+// Float256E returns E, the base of natural logarithms, rounded to the nearest Float256 value.
+func Float256E() Float256 {
+	return Float256{
+		0x4000_05bf_0a8b_1457, 0x6953_55fb_8ac4_04e7,
+		0xa79e_3b17_38b0_79c5, 0xa6d2_b53c_26c8_228d,
+	}
+}
+
+// Float256Ln2 returns the natural logarithm of 2, rounded to the nearest Float256 value.
+func Float256Ln2() Float256 {
+	return Float256{
+		0x3fff_e62e_42fe_fa39, 0xef35_793c_7673_007e,
+		0x5ed5_e81e_6864_ce53, 0x16c5_b141_a2eb_7175,
+	}
+}
+
+// Float256Ln10 returns the natural logarithm of 10, rounded to the nearest Float256 value.
+func Float256Ln10() Float256 {
+	return Float256{
+		0x4000_026b_b1bb_b555, 0x1582_dd4a_dac5_705a,
+		0x6145_1c51_fd9f_3b4b, 0xbf21_d078_c3d0_403e,
+	}
+}
+
+// Float256Log2E returns the base-2 logarithm of E, rounded to the nearest Float256 value.
+func Float256Log2E() Float256 {
+	return Float256{
+		0x3fff_f715_4765_2b82, 0xfe17_77d0_ffda_0d23,
+		0xa7d1_1d6a_ef55_1bad, 0x2b4b_1164_a2cd_9a34,
+	}
+}
+
+// Float256Sqrt2 returns the square root of 2, rounded to the nearest Float256 value.
+func Float256Sqrt2() Float256 {
+	return Float256{
+		0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9,
+		0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b066,
+	}
+}
+
+// Float256Phi returns the golden ratio, rounded to the nearest Float256 value.
+func Float256Phi() Float256 {
+	return Float256{
+		0x3fff_f9e3_779b_97f4, 0xa7c1_5f39_cc06_05ce,
+		0xdc83_4108_2276_bf3a, 0x2725_1f86_c6a1_1d0c,
+	}
+}
+
+// Float256Max returns the largest finite Float256 value, about 1.61132e+78913.
+func Float256Max() Float256 {
+	return Float256{
+		0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+		0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+	}
+}
+
+// Float256SmallestNormal returns 2**-262142, the smallest positive normal Float256 value.
+func Float256SmallestNormal() Float256 {
+	return Float256{
+		0x0000_1000_0000_0000, 0x0000_0000_0000_0000,
+		0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+	}
+}
+
+// Float256SmallestSubnormal returns 2**-262378, the smallest positive subnormal Float256 value.
+func Float256SmallestSubnormal() Float256 {
+	return Float256{
+		0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+		0x0000_0000_0000_0000, 0x0000_0000_0000_0001,
+	}
+}
+
+// Float256Epsilon returns 2**-236, the difference between 1 and the next larger Float256 value.
+func Float256Epsilon() Float256 {
+	return Float256{
+		0x3ff1_3000_0000_0000, 0x0000_0000_0000_0000,
+		0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+	}
+}
+
+const (
+	// Float256MantissaBits is the number of fraction bits of Float256, excluding the implicit leading bit.
+	Float256MantissaBits = 236
+
+	// Float256ExponentBias is the exponent bias of Float256.
+	Float256ExponentBias = 262143
+)
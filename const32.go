@@ -0,0 +1,30 @@
+package floats
+
+import "math"
+
+// Mathematical constants, rounded to the nearest Float32 values.
+const (
+	Float32Pi    Float32 = math.Pi
+	Float32E     Float32 = math.E
+	Float32Ln2   Float32 = math.Ln2
+	Float32Ln10  Float32 = math.Ln10
+	Float32Log2E Float32 = math.Log2E
+	Float32Sqrt2 Float32 = math.Sqrt2
+	Float32Phi   Float32 = math.Phi
+)
+
+// Limits of Float32.
+const (
+	Float32Max               Float32 = math.MaxFloat32             // the largest finite value
+	Float32SmallestNormal    Float32 = 0x1p-126                    // the smallest positive normal value
+	Float32SmallestSubnormal Float32 = math.SmallestNonzeroFloat32 // 2**-149, the smallest positive subnormal value
+	Float32Epsilon           Float32 = 0x1p-23                     // the difference between 1 and the next larger value
+)
+
+const (
+	// Float32MantissaBits is the number of fraction bits of Float32, excluding the implicit leading bit.
+	Float32MantissaBits = 23
+
+	// Float32ExponentBias is the exponent bias of Float32.
+	Float32ExponentBias = 127
+)
@@ -0,0 +1,30 @@
+package floats
+
+import "math"
+
+// Mathematical constants, rounded to the nearest Float64 values.
+const (
+	Float64Pi    Float64 = math.Pi
+	Float64E     Float64 = math.E
+	Float64Ln2   Float64 = math.Ln2
+	Float64Ln10  Float64 = math.Ln10
+	Float64Log2E Float64 = math.Log2E
+	Float64Sqrt2 Float64 = math.Sqrt2
+	Float64Phi   Float64 = math.Phi
+)
+
+// Limits of Float64.
+const (
+	Float64Max               Float64 = math.MaxFloat64             // the largest finite value
+	Float64SmallestNormal    Float64 = 0x1p-1022                   // the smallest positive normal value
+	Float64SmallestSubnormal Float64 = math.SmallestNonzeroFloat64 // 2**-1074, the smallest positive subnormal value
+	Float64Epsilon           Float64 = 0x1p-52                     // the difference between 1 and the next larger value
+)
+
+const (
+	// Float64MantissaBits is the number of fraction bits of Float64, excluding the implicit leading bit.
+	Float64MantissaBits = 52
+
+	// Float64ExponentBias is the exponent bias of Float64.
+	Float64ExponentBias = 1023
+)
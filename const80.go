@@ -0,0 +1,64 @@
+package floats
+
+// Float80Pi returns Pi, rounded to the nearest Float80 value.
+func Float80Pi() Float80 {
+	return Float80{se: 0x4000, frac: 0xc90f_daa2_2168_c235}
+}
+
+// Float80E returns E, the base of natural logarithms, rounded to the nearest Float80 value.
+func Float80E() Float80 {
+	return Float80{se: 0x4000, frac: 0xadf8_5458_a2bb_4a9b}
+}
+
+// Float80Ln2 returns the natural logarithm of 2, rounded to the nearest Float80 value.
+func Float80Ln2() Float80 {
+	return Float80{se: 0x3ffe, frac: 0xb172_17f7_d1cf_79ac}
+}
+
+// Float80Ln10 returns the natural logarithm of 10, rounded to the nearest Float80 value.
+func Float80Ln10() Float80 {
+	return Float80{se: 0x4000, frac: 0x935d_8ddd_aaa8_ac17}
+}
+
+// Float80Log2E returns the base-2 logarithm of E, rounded to the nearest Float80 value.
+func Float80Log2E() Float80 {
+	return Float80{se: 0x3fff, frac: 0xb8aa_3b29_5c17_f0bc}
+}
+
+// Float80Sqrt2 returns the square root of 2, rounded to the nearest Float80 value.
+func Float80Sqrt2() Float80 {
+	return Float80{se: 0x3fff, frac: 0xb504_f333_f9de_6484}
+}
+
+// Float80Phi returns the golden ratio, rounded to the nearest Float80 value.
+func Float80Phi() Float80 {
+	return Float80{se: 0x3fff, frac: 0xcf1b_bcdc_bfa5_3e0b}
+}
+
+// Float80Max returns the largest finite Float80 value, about 1.18973e+4932.
+func Float80Max() Float80 {
+	return Float80{se: 0x7ffe, frac: 0xffff_ffff_ffff_ffff}
+}
+
+// Float80SmallestNormal returns 2**-16382, the smallest positive normal Float80 value.
+func Float80SmallestNormal() Float80 {
+	return Float80{se: 0x0001, frac: 0x8000_0000_0000_0000}
+}
+
+// Float80SmallestSubnormal returns 2**-16445, the smallest positive subnormal Float80 value.
+func Float80SmallestSubnormal() Float80 {
+	return Float80{se: 0x0000, frac: 0x0000_0000_0000_0001}
+}
+
+// Float80Epsilon returns 2**-63, the difference between 1 and the next larger Float80 value.
+func Float80Epsilon() Float80 {
+	return Float80{se: 0x3fc0, frac: 0x8000_0000_0000_0000}
+}
+
+const (
+	// Float80MantissaBits is the number of fraction bits of Float80, excluding the explicit integer bit.
+	Float80MantissaBits = 63
+
+	// Float80ExponentBias is the exponent bias of Float80.
+	Float80ExponentBias = 16383
+)
@@ -0,0 +1,17 @@
+package floats
+
+// Limits of Float8E4M3.
+const (
+	Float8E4M3Max               Float8E4M3 = 0x7e // 448, the largest finite value
+	Float8E4M3SmallestNormal    Float8E4M3 = 0x08 // 2**-6, the smallest positive normal value
+	Float8E4M3SmallestSubnormal Float8E4M3 = 0x01 // 2**-9, the smallest positive subnormal value
+	Float8E4M3Epsilon           Float8E4M3 = 0x20 // 2**-3, the difference between 1 and the next larger value
+)
+
+const (
+	// Float8E4M3MantissaBits is the number of fraction bits of Float8E4M3, excluding the implicit leading bit.
+	Float8E4M3MantissaBits = 3
+
+	// Float8E4M3ExponentBias is the exponent bias of Float8E4M3.
+	Float8E4M3ExponentBias = 7
+)
@@ -0,0 +1,33 @@
+package floats
+
+import "testing"
+
+func TestFloat8E4M3Constants(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float8E4M3
+		want string
+	}{
+		{"Max", Float8E4M3Max, "448"},
+		{"SmallestNormal", Float8E4M3SmallestNormal, "0x1p-6"},
+		{"SmallestSubnormal", Float8E4M3SmallestSubnormal, "0x1p-9"},
+		{"Epsilon", Float8E4M3Epsilon, "0x1p-3"},
+	}
+
+	for _, tt := range tests {
+		want, err := ParseFloat8E4M3(tt.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqE4M3(tt.got, want) {
+			t.Errorf("Float8E4M3%s = %v; want %v", tt.name, tt.got, want)
+		}
+	}
+
+	if Float8E4M3MantissaBits != shiftE4M3 {
+		t.Errorf("Float8E4M3MantissaBits = %d; want %d", Float8E4M3MantissaBits, shiftE4M3)
+	}
+	if Float8E4M3ExponentBias != biasE4M3 {
+		t.Errorf("Float8E4M3ExponentBias = %d; want %d", Float8E4M3ExponentBias, biasE4M3)
+	}
+}
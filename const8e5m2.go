@@ -0,0 +1,17 @@
+package floats
+
+// Limits of Float8E5M2.
+const (
+	Float8E5M2Max               Float8E5M2 = 0x7b // 57344, the largest finite value
+	Float8E5M2SmallestNormal    Float8E5M2 = 0x04 // 2**-14, the smallest positive normal value
+	Float8E5M2SmallestSubnormal Float8E5M2 = 0x01 // 2**-16, the smallest positive subnormal value
+	Float8E5M2Epsilon           Float8E5M2 = 0x34 // 2**-2, the difference between 1 and the next larger value
+)
+
+const (
+	// Float8E5M2MantissaBits is the number of fraction bits of Float8E5M2, excluding the implicit leading bit.
+	Float8E5M2MantissaBits = 2
+
+	// Float8E5M2ExponentBias is the exponent bias of Float8E5M2.
+	Float8E5M2ExponentBias = 15
+)
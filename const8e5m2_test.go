@@ -0,0 +1,33 @@
+package floats
+
+import "testing"
+
+func TestFloat8E5M2Constants(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float8E5M2
+		want string
+	}{
+		{"Max", Float8E5M2Max, "57344"},
+		{"SmallestNormal", Float8E5M2SmallestNormal, "0x1p-14"},
+		{"SmallestSubnormal", Float8E5M2SmallestSubnormal, "0x1p-16"},
+		{"Epsilon", Float8E5M2Epsilon, "0x1p-2"},
+	}
+
+	for _, tt := range tests {
+		want, err := ParseFloat8E5M2(tt.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqE5M2(tt.got, want) {
+			t.Errorf("Float8E5M2%s = %v; want %v", tt.name, tt.got, want)
+		}
+	}
+
+	if Float8E5M2MantissaBits != shiftE5M2 {
+		t.Errorf("Float8E5M2MantissaBits = %d; want %d", Float8E5M2MantissaBits, shiftE5M2)
+	}
+	if Float8E5M2ExponentBias != biasE5M2 {
+		t.Errorf("Float8E5M2ExponentBias = %d; want %d", Float8E5M2ExponentBias, biasE5M2)
+	}
+}
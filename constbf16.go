@@ -0,0 +1,28 @@
+package floats
+
+// Mathematical constants, rounded to the nearest BFloat16 values.
+const (
+	BFloat16Pi    BFloat16 = 0x4049 // 3.140625
+	BFloat16E     BFloat16 = 0x402e // 2.71875
+	BFloat16Ln2   BFloat16 = 0x3f31 // 0.69140625
+	BFloat16Ln10  BFloat16 = 0x4013 // 2.296875
+	BFloat16Log2E BFloat16 = 0x3fb9 // 1.4453125
+	BFloat16Sqrt2 BFloat16 = 0x3fb5 // 1.4140625
+	BFloat16Phi   BFloat16 = 0x3fcf // 1.6171875
+)
+
+// Limits of BFloat16.
+const (
+	BFloat16Max               BFloat16 = 0x7f7f // about 3.38953e+38, the largest finite value
+	BFloat16SmallestNormal    BFloat16 = 0x0080 // 2**-126, the smallest positive normal value
+	BFloat16SmallestSubnormal BFloat16 = 0x0001 // 2**-133, the smallest positive subnormal value
+	BFloat16Epsilon           BFloat16 = 0x3c00 // 2**-7, the difference between 1 and the next larger value
+)
+
+const (
+	// BFloat16MantissaBits is the number of fraction bits of BFloat16, excluding the implicit leading bit.
+	BFloat16MantissaBits = 7
+
+	// BFloat16ExponentBias is the exponent bias of BFloat16.
+	BFloat16ExponentBias = 127
+)
@@ -0,0 +1,40 @@
+package floats
+
+import "testing"
+
+func TestBFloat16Constants(t *testing.T) {
+	tests := []struct {
+		name string
+		got  BFloat16
+		want string
+	}{
+		{"Pi", BFloat16Pi, "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706"},
+		{"E", BFloat16E, "2.71828182845904523536028747135266249775724709369995957496696762772407663035354759457138217852516642"},
+		{"Ln2", BFloat16Ln2, "0.69314718055994530941723212145817656807550013436025525412068000949339362196969471560586332699641868"},
+		{"Ln10", BFloat16Ln10, "2.30258509299404568401799145468436420760110148862877297603332790096757260967735248023599720508959829"},
+		{"Log2E", BFloat16Log2E, "1.44269504088896340735992468100189213742664595415298593413544940693110921918118507988552662289350634"},
+		{"Sqrt2", BFloat16Sqrt2, "1.41421356237309504880168872420969807856967187537694807317667973799073247846210703885038753432764157"},
+		{"Phi", BFloat16Phi, "1.61803398874989484820458683436563811772030917980576286213544862270526046281890244970720720418939113"},
+		{"Max", BFloat16Max, "0x1.fep127"},
+		{"SmallestNormal", BFloat16SmallestNormal, "0x1p-126"},
+		{"SmallestSubnormal", BFloat16SmallestSubnormal, "0x1p-133"},
+		{"Epsilon", BFloat16Epsilon, "0x1p-7"},
+	}
+
+	for _, tt := range tests {
+		want, err := ParseBFloat16(tt.want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !eqBF16(tt.got, want) {
+			t.Errorf("BFloat16%s = %v; want %v", tt.name, tt.got, want)
+		}
+	}
+
+	if BFloat16MantissaBits != shiftBF16 {
+		t.Errorf("BFloat16MantissaBits = %d; want %d", BFloat16MantissaBits, shiftBF16)
+	}
+	if BFloat16ExponentBias != biasBF16 {
+		t.Errorf("BFloat16ExponentBias = %d; want %d", BFloat16ExponentBias, biasBF16)
+	}
+}
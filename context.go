@@ -0,0 +1,22 @@
+package floats
+
+// A Context specifies the rounding mode used by arithmetic operations,
+// and records the exception flags raised by them.
+// The zero value rounds to nearest, ties to even,
+// which is the rounding mode used by the methods of the floating-point types.
+//
+// The results of Context methods are correctly rounded according to Mode.
+// Operations on formats narrower than Float256 are evaluated in Float256
+// with round-to-odd, and then rounded to the destination format.
+// Float256 has more than twice the precision of Float128 plus two bits,
+// and its exponent range covers all the intermediate results,
+// so the result is the same as if it were rounded only once.
+type Context struct {
+	// Mode is the rounding mode.
+	Mode RoundingMode
+
+	// Flags is the set of exception flags raised by the operations.
+	// The flags are sticky: operations set them, but never clear them.
+	// Set Flags to zero to clear them.
+	Flags Flags
+}
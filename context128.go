@@ -0,0 +1,47 @@
+package floats
+
+// Float128 converts x to a Float128, rounding according to c.Mode.
+// Other formats can be converted through their exact Float256 method.
+func (c *Context) Float128(x Float256) Float128 {
+	return c.result128(x, 0)
+}
+
+// Add128 returns the sum of a and b, rounded according to c.Mode.
+func (c *Context) Add128(a, b Float128) Float128 {
+	return c.result128(a.Float256().add(b.Float256(), c.Mode|toOdd))
+}
+
+// Sub128 returns the difference of a and b, rounded according to c.Mode.
+func (c *Context) Sub128(a, b Float128) Float128 {
+	return c.result128(a.Float256().sub(b.Float256(), c.Mode|toOdd))
+}
+
+// Mul128 returns the product of a and b, rounded according to c.Mode.
+func (c *Context) Mul128(a, b Float128) Float128 {
+	return c.result128(a.Float256().mul(b.Float256(), c.Mode|toOdd))
+}
+
+// Quo128 returns the quotient of a and b, rounded according to c.Mode.
+func (c *Context) Quo128(a, b Float128) Float128 {
+	return c.result128(a.Float256().quo(b.Float256(), c.Mode|toOdd))
+}
+
+// Sqrt128 returns the square root of a, rounded according to c.Mode.
+//
+// Special cases are the same as [Float128.Sqrt].
+func (c *Context) Sqrt128(a Float128) Float128 {
+	return c.result128(a.Float256().sqrt(c.Mode | toOdd))
+}
+
+// FMA128 returns x * y + z, computed with only one rounding according to c.Mode.
+func (c *Context) FMA128(x, y, z Float128) Float128 {
+	return c.result128(fma256(x.Float256(), y.Float256(), z.Float256(), c.Mode|toOdd))
+}
+
+// result128 rounds x to a Float128 according to c.Mode,
+// and records flags and the exception flags raised by the rounding.
+func (c *Context) result128(x Float256, flags Flags) Float128 {
+	ret, f := x.float128(c.Mode)
+	c.Flags |= flags | f
+	return ret
+}
@@ -0,0 +1,223 @@
+package floats
+
+import (
+	"testing"
+)
+
+func TestContext_Add128(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float128
+		want Float128
+	}{
+		// 1 + 0.5 ulp is a tie
+		{ToNearestEven, exact128(1), exact128(0x1p-113), Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, exact128(1), exact128(0x1p-113), Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToZero, exact128(1), exact128(0x1p-113), Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, exact128(1), exact128(0x1p-113), Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToNegativeInf, exact128(1), exact128(0x1p-113), Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, exact128(1), exact128(0x1p-113), Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0001}},
+
+		// overflow
+		{ToNearestEven, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToZero, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{AwayFromZero, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNegativeInf, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{ToPositiveInf, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7fff_0000_0000_0000, 0x0000_0000_0000_0000}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Add128(tt.a, tt.b)
+		if !eq128(got, tt.want) {
+			t.Errorf("Context{%v}.Add128(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sub128(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float128
+		want Float128
+	}{
+		// the sign of the exact zero difference depends on the rounding mode
+		{ToNearestEven, exact128(1), exact128(1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, exact128(1), exact128(1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToZero, exact128(1), exact128(1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, exact128(1), exact128(1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNegativeInf, exact128(1), exact128(1), Float128{0x8000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, exact128(1), exact128(1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sub128(tt.a, tt.b)
+		if !eq128(got, tt.want) {
+			t.Errorf("Context{%v}.Sub128(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Mul128(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float128
+		want Float128
+	}{
+		// half of the smallest subnormal number is a tie
+		{ToNearestEven, Float128{0, 1}, exact128(0.5), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, Float128{0, 1}, exact128(0.5), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToZero, Float128{0, 1}, exact128(0.5), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, Float128{0, 1}, exact128(0.5), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToNegativeInf, Float128{0, 1}, exact128(0.5), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, Float128{0, 1}, exact128(0.5), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Mul128(tt.a, tt.b)
+		if !eq128(got, tt.want) {
+			t.Errorf("Context{%v}.Mul128(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Quo128(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float128
+		want Float128
+	}{
+		// 1/3
+		{ToNearestEven, exact128(1), exact128(3), Float128{0x3ffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToNearestAway, exact128(1), exact128(3), Float128{0x3ffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToZero, exact128(1), exact128(3), Float128{0x3ffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{AwayFromZero, exact128(1), exact128(3), Float128{0x3ffd_5555_5555_5555, 0x5555_5555_5555_5556}},
+		{ToNegativeInf, exact128(1), exact128(3), Float128{0x3ffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToPositiveInf, exact128(1), exact128(3), Float128{0x3ffd_5555_5555_5555, 0x5555_5555_5555_5556}},
+
+		// -1/3
+		{ToNearestEven, exact128(-1), exact128(3), Float128{0xbffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToNearestAway, exact128(-1), exact128(3), Float128{0xbffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToZero, exact128(-1), exact128(3), Float128{0xbffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{AwayFromZero, exact128(-1), exact128(3), Float128{0xbffd_5555_5555_5555, 0x5555_5555_5555_5556}},
+		{ToNegativeInf, exact128(-1), exact128(3), Float128{0xbffd_5555_5555_5555, 0x5555_5555_5555_5556}},
+		{ToPositiveInf, exact128(-1), exact128(3), Float128{0xbffd_5555_5555_5555, 0x5555_5555_5555_5555}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Quo128(tt.a, tt.b)
+		if !eq128(got, tt.want) {
+			t.Errorf("Context{%v}.Quo128(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sqrt128(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a    Float128
+		want Float128
+	}{
+		// sqrt(2)
+		{ToNearestEven, exact128(2), Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea95}},
+		{ToNearestAway, exact128(2), Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea95}},
+		{ToZero, exact128(2), Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea95}},
+		{AwayFromZero, exact128(2), Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea96}},
+		{ToNegativeInf, exact128(2), Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea95}},
+		{ToPositiveInf, exact128(2), Float128{0x3fff_6a09_e667_f3bc, 0xc908_b2fb_1366_ea96}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sqrt128(tt.a)
+		if !eq128(got, tt.want) {
+			t.Errorf("Context{%v}.Sqrt128(%x) = %x, want %x", tt.mode, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FMA128(t *testing.T) {
+	tests := []struct {
+		mode    RoundingMode
+		x, y, z Float128
+		want    Float128
+	}{
+		// (1+ulp)*(1+ulp)-1 = 2ulp + ulp*ulp is a tie; rounding twice gives 2ulp
+		{ToNearestEven, exact128(1 + 0x1p-112), exact128(1 + 0x1p-112), exact128(-1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, exact128(1 + 0x1p-112), exact128(1 + 0x1p-112), exact128(-1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToZero, exact128(1 + 0x1p-112), exact128(1 + 0x1p-112), exact128(-1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, exact128(1 + 0x1p-112), exact128(1 + 0x1p-112), exact128(-1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNegativeInf, exact128(1 + 0x1p-112), exact128(1 + 0x1p-112), exact128(-1), Float128{0x8000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, exact128(1 + 0x1p-112), exact128(1 + 0x1p-112), exact128(-1), Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.FMA128(tt.x, tt.y, tt.z)
+		if !eq128(got, tt.want) {
+			t.Errorf("Context{%v}.FMA128(%x, %x, %x) = %x, want %x", tt.mode, tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Flags128(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c *Context)
+		want Flags
+	}{
+		{"exact", func(c *Context) { c.Add128(exact128(1), exact128(1)) }, 0},
+		{"inexact", func(c *Context) { c.Quo128(exact128(1), exact128(3)) }, Inexact},
+		{"overflow", func(c *Context) {
+			c.Add128(Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"underflow", func(c *Context) { c.Mul128(Float128{0, 1}, exact128(0.5)) }, Underflow | Inexact},
+		{"exact subnormal result does not underflow", func(c *Context) { c.Mul128(Float128{0, 1}, exact128(2)) }, 0},
+		{"divide by zero", func(c *Context) { c.Quo128(exact128(1), exact128(0)) }, DivByZero},
+		{"infinity divided by zero is exact", func(c *Context) { c.Quo128(NewFloat128Inf(1), exact128(0)) }, 0},
+		{"0/0", func(c *Context) { c.Quo128(exact128(0), exact128(0)) }, Invalid},
+		{"inf-inf", func(c *Context) { c.Sub128(NewFloat128Inf(1), NewFloat128Inf(1)) }, Invalid},
+		{"0*inf", func(c *Context) { c.Mul128(exact128(0), NewFloat128Inf(1)) }, Invalid},
+		{"sqrt of negative number", func(c *Context) { c.Sqrt128(exact128(-1)) }, Invalid},
+		{"0*inf+NaN", func(c *Context) { c.FMA128(exact128(0), NewFloat128Inf(1), NewFloat128NaN()) }, Invalid},
+		{"quiet NaN", func(c *Context) { c.Add128(NewFloat128NaN(), exact128(1)) }, 0},
+		{"signaling NaN", func(c *Context) { c.Add128(Float128{0x7fff_0000_0000_0000, 0x0000_0000_0000_0001}, exact128(1)) }, Invalid},
+		{"conversion: inexact", func(c *Context) { c.Float128(NewFloat256(1).Quo(NewFloat256(3))) }, Inexact},
+		{"conversion: overflow", func(c *Context) {
+			c.Float128(Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"conversion: underflow", func(c *Context) { c.Float128(Float256{0, 0, 0, 1}) }, Underflow | Inexact},
+		{"conversion: signaling NaN", func(c *Context) {
+			c.Float128(Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001})
+		}, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			tt.f(&c)
+			if c.Flags != tt.want {
+				t.Errorf("got %v, want %v", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_StickyFlags128(t *testing.T) {
+	var c Context
+	c.Quo128(exact128(1), exact128(0))
+	c.Quo128(exact128(1), exact128(3))
+	c.Add128(exact128(1), exact128(1))
+	if want := DivByZero | Inexact; c.Flags != want {
+		t.Errorf("got %v, want %v", c.Flags, want)
+	}
+}
+
+func TestContext_NaNPayload128(t *testing.T) {
+	var c Context
+	want := SetPayload128(exact128(42))
+	got := c.Add128(exact128(1), SetPayloadSignaling128(exact128(42)))
+	if got != want {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if c.Flags != Invalid {
+		t.Errorf("got %v, want %v", c.Flags, Invalid)
+	}
+}
@@ -0,0 +1,47 @@
+package floats
+
+// Float16 converts x to a Float16, rounding according to c.Mode.
+// Other formats can be converted through their exact Float256 method.
+func (c *Context) Float16(x Float256) Float16 {
+	return c.result16(x, 0)
+}
+
+// Add16 returns the sum of a and b, rounded according to c.Mode.
+func (c *Context) Add16(a, b Float16) Float16 {
+	return c.result16(a.Float256().add(b.Float256(), c.Mode|toOdd))
+}
+
+// Sub16 returns the difference of a and b, rounded according to c.Mode.
+func (c *Context) Sub16(a, b Float16) Float16 {
+	return c.result16(a.Float256().sub(b.Float256(), c.Mode|toOdd))
+}
+
+// Mul16 returns the product of a and b, rounded according to c.Mode.
+func (c *Context) Mul16(a, b Float16) Float16 {
+	return c.result16(a.Float256().mul(b.Float256(), c.Mode|toOdd))
+}
+
+// Quo16 returns the quotient of a and b, rounded according to c.Mode.
+func (c *Context) Quo16(a, b Float16) Float16 {
+	return c.result16(a.Float256().quo(b.Float256(), c.Mode|toOdd))
+}
+
+// Sqrt16 returns the square root of a, rounded according to c.Mode.
+//
+// Special cases are the same as [Float16.Sqrt].
+func (c *Context) Sqrt16(a Float16) Float16 {
+	return c.result16(a.Float256().sqrt(c.Mode | toOdd))
+}
+
+// FMA16 returns x * y + z, computed with only one rounding according to c.Mode.
+func (c *Context) FMA16(x, y, z Float16) Float16 {
+	return c.result16(fma256(x.Float256(), y.Float256(), z.Float256(), c.Mode|toOdd))
+}
+
+// result16 rounds x to a Float16 according to c.Mode,
+// and records flags and the exception flags raised by the rounding.
+func (c *Context) result16(x Float256, flags Flags) Float16 {
+	ret, f := x.float16(c.Mode)
+	c.Flags |= flags | f
+	return ret
+}
@@ -0,0 +1,221 @@
+package floats
+
+import (
+	"testing"
+)
+
+func TestContext_Add16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float16
+		want Float16
+	}{
+		// 1 + 0.5 ulp is a tie
+		{ToNearestEven, exact16(1), exact16(0x1p-11), 0x3c00},
+		{ToNearestAway, exact16(1), exact16(0x1p-11), 0x3c01},
+		{ToZero, exact16(1), exact16(0x1p-11), 0x3c00},
+		{AwayFromZero, exact16(1), exact16(0x1p-11), 0x3c01},
+		{ToNegativeInf, exact16(1), exact16(0x1p-11), 0x3c00},
+		{ToPositiveInf, exact16(1), exact16(0x1p-11), 0x3c01},
+
+		// overflow
+		{ToNearestEven, 0x7bff, 0x7bff, 0x7c00},
+		{ToNearestAway, 0x7bff, 0x7bff, 0x7c00},
+		{ToZero, 0x7bff, 0x7bff, 0x7bff},
+		{AwayFromZero, 0x7bff, 0x7bff, 0x7c00},
+		{ToNegativeInf, 0x7bff, 0x7bff, 0x7bff},
+		{ToPositiveInf, 0x7bff, 0x7bff, 0x7c00},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Add16(tt.a, tt.b)
+		if !eq16(got, tt.want) {
+			t.Errorf("Context{%v}.Add16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sub16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float16
+		want Float16
+	}{
+		// the sign of the exact zero difference depends on the rounding mode
+		{ToNearestEven, exact16(1), exact16(1), 0x0000},
+		{ToNearestAway, exact16(1), exact16(1), 0x0000},
+		{ToZero, exact16(1), exact16(1), 0x0000},
+		{AwayFromZero, exact16(1), exact16(1), 0x0000},
+		{ToNegativeInf, exact16(1), exact16(1), 0x8000},
+		{ToPositiveInf, exact16(1), exact16(1), 0x0000},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sub16(tt.a, tt.b)
+		if !eq16(got, tt.want) {
+			t.Errorf("Context{%v}.Sub16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Mul16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float16
+		want Float16
+	}{
+		// half of the smallest subnormal number is a tie
+		{ToNearestEven, 0x0001, exact16(0.5), 0x0000},
+		{ToNearestAway, 0x0001, exact16(0.5), 0x0001},
+		{ToZero, 0x0001, exact16(0.5), 0x0000},
+		{AwayFromZero, 0x0001, exact16(0.5), 0x0001},
+		{ToNegativeInf, 0x0001, exact16(0.5), 0x0000},
+		{ToPositiveInf, 0x0001, exact16(0.5), 0x0001},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Mul16(tt.a, tt.b)
+		if !eq16(got, tt.want) {
+			t.Errorf("Context{%v}.Mul16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Quo16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float16
+		want Float16
+	}{
+		// 1/3
+		{ToNearestEven, exact16(1), exact16(3), 0x3555},
+		{ToNearestAway, exact16(1), exact16(3), 0x3555},
+		{ToZero, exact16(1), exact16(3), 0x3555},
+		{AwayFromZero, exact16(1), exact16(3), 0x3556},
+		{ToNegativeInf, exact16(1), exact16(3), 0x3555},
+		{ToPositiveInf, exact16(1), exact16(3), 0x3556},
+
+		// -1/3
+		{ToNearestEven, exact16(-1), exact16(3), 0xb555},
+		{ToNearestAway, exact16(-1), exact16(3), 0xb555},
+		{ToZero, exact16(-1), exact16(3), 0xb555},
+		{AwayFromZero, exact16(-1), exact16(3), 0xb556},
+		{ToNegativeInf, exact16(-1), exact16(3), 0xb556},
+		{ToPositiveInf, exact16(-1), exact16(3), 0xb555},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Quo16(tt.a, tt.b)
+		if !eq16(got, tt.want) {
+			t.Errorf("Context{%v}.Quo16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sqrt16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a    Float16
+		want Float16
+	}{
+		// sqrt(2)
+		{ToNearestEven, exact16(2), 0x3da8},
+		{ToNearestAway, exact16(2), 0x3da8},
+		{ToZero, exact16(2), 0x3da8},
+		{AwayFromZero, exact16(2), 0x3da9},
+		{ToNegativeInf, exact16(2), 0x3da8},
+		{ToPositiveInf, exact16(2), 0x3da9},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sqrt16(tt.a)
+		if !eq16(got, tt.want) {
+			t.Errorf("Context{%v}.Sqrt16(%x) = %x, want %x", tt.mode, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FMA16(t *testing.T) {
+	tests := []struct {
+		mode    RoundingMode
+		x, y, z Float16
+		want    Float16
+	}{
+		// (1+ulp)*(1+ulp)-1 = 2ulp + ulp*ulp is a tie; rounding twice gives 2ulp
+		{ToNearestEven, exact16(1 + 0x1p-10), exact16(1 + 0x1p-10), exact16(-1), 0x1800},
+		{ToNearestAway, exact16(1 + 0x1p-10), exact16(1 + 0x1p-10), exact16(-1), 0x1801},
+		{ToZero, exact16(1 + 0x1p-10), exact16(1 + 0x1p-10), exact16(-1), 0x1800},
+		{AwayFromZero, exact16(1 + 0x1p-10), exact16(1 + 0x1p-10), exact16(-1), 0x1801},
+		{ToNegativeInf, exact16(1 + 0x1p-10), exact16(1 + 0x1p-10), exact16(-1), 0x1800},
+		{ToPositiveInf, exact16(1 + 0x1p-10), exact16(1 + 0x1p-10), exact16(-1), 0x1801},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.FMA16(tt.x, tt.y, tt.z)
+		if !eq16(got, tt.want) {
+			t.Errorf("Context{%v}.FMA16(%x, %x, %x) = %x, want %x", tt.mode, tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Flags16(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c *Context)
+		want Flags
+	}{
+		{"exact", func(c *Context) { c.Add16(exact16(1), exact16(1)) }, 0},
+		{"inexact", func(c *Context) { c.Quo16(exact16(1), exact16(3)) }, Inexact},
+		{"overflow", func(c *Context) { c.Add16(0x7bff, 0x7bff) }, Overflow | Inexact},
+		{"underflow", func(c *Context) { c.Mul16(0x0001, exact16(0.5)) }, Underflow | Inexact},
+		{"exact subnormal result does not underflow", func(c *Context) { c.Mul16(0x0001, exact16(2)) }, 0},
+		{"divide by zero", func(c *Context) { c.Quo16(exact16(1), exact16(0)) }, DivByZero},
+		{"infinity divided by zero is exact", func(c *Context) { c.Quo16(NewFloat16Inf(1), exact16(0)) }, 0},
+		{"0/0", func(c *Context) { c.Quo16(exact16(0), exact16(0)) }, Invalid},
+		{"inf-inf", func(c *Context) { c.Sub16(NewFloat16Inf(1), NewFloat16Inf(1)) }, Invalid},
+		{"0*inf", func(c *Context) { c.Mul16(exact16(0), NewFloat16Inf(1)) }, Invalid},
+		{"sqrt of negative number", func(c *Context) { c.Sqrt16(exact16(-1)) }, Invalid},
+		{"0*inf+NaN", func(c *Context) { c.FMA16(exact16(0), NewFloat16Inf(1), NewFloat16NaN()) }, Invalid},
+		{"quiet NaN", func(c *Context) { c.Add16(NewFloat16NaN(), exact16(1)) }, 0},
+		{"signaling NaN", func(c *Context) { c.Add16(0x7c01, exact16(1)) }, Invalid},
+		{"conversion: inexact", func(c *Context) { c.Float16(NewFloat256(1).Quo(NewFloat256(3))) }, Inexact},
+		{"conversion: overflow", func(c *Context) {
+			c.Float16(Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"conversion: underflow", func(c *Context) { c.Float16(Float256{0, 0, 0, 1}) }, Underflow | Inexact},
+		{"conversion: signaling NaN", func(c *Context) {
+			c.Float16(Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001})
+		}, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			tt.f(&c)
+			if c.Flags != tt.want {
+				t.Errorf("got %v, want %v", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_StickyFlags16(t *testing.T) {
+	var c Context
+	c.Quo16(exact16(1), exact16(0))
+	c.Quo16(exact16(1), exact16(3))
+	c.Add16(exact16(1), exact16(1))
+	if want := DivByZero | Inexact; c.Flags != want {
+		t.Errorf("got %v, want %v", c.Flags, want)
+	}
+}
+
+func TestContext_NaNPayload16(t *testing.T) {
+	var c Context
+	want := SetPayload16(exact16(42))
+	got := c.Add16(exact16(1), SetPayloadSignaling16(exact16(42)))
+	if got != want {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if c.Flags != Invalid {
+		t.Errorf("got %v, want %v", c.Flags, Invalid)
+	}
+}
@@ -0,0 +1,39 @@
+package floats
+
+// Add256 returns the sum of a and b, rounded according to c.Mode.
+func (c *Context) Add256(a, b Float256) Float256 {
+	return c.result256(a.add(b, c.Mode))
+}
+
+// Sub256 returns the difference of a and b, rounded according to c.Mode.
+func (c *Context) Sub256(a, b Float256) Float256 {
+	return c.result256(a.sub(b, c.Mode))
+}
+
+// Mul256 returns the product of a and b, rounded according to c.Mode.
+func (c *Context) Mul256(a, b Float256) Float256 {
+	return c.result256(a.mul(b, c.Mode))
+}
+
+// Quo256 returns the quotient of a and b, rounded according to c.Mode.
+func (c *Context) Quo256(a, b Float256) Float256 {
+	return c.result256(a.quo(b, c.Mode))
+}
+
+// Sqrt256 returns the square root of a, rounded according to c.Mode.
+//
+// Special cases are the same as [Float256.Sqrt].
+func (c *Context) Sqrt256(a Float256) Float256 {
+	return c.result256(a.sqrt(c.Mode))
+}
+
+// FMA256 returns x * y + z, computed with only one rounding according to c.Mode.
+func (c *Context) FMA256(x, y, z Float256) Float256 {
+	return c.result256(fma256(x, y, z, c.Mode))
+}
+
+// result256 records flags and returns x.
+func (c *Context) result256(x Float256, flags Flags) Float256 {
+	c.Flags |= flags
+	return x
+}
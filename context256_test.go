@@ -0,0 +1,217 @@
+package floats
+
+import (
+	"testing"
+)
+
+func TestContext_Add256(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float256
+		want Float256
+	}{
+		// 1 + 0.5 ulp is a tie
+		{ToNearestEven, exact256(1), exact256(0x1p-237), Float256{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, exact256(1), exact256(0x1p-237), Float256{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToZero, exact256(1), exact256(0x1p-237), Float256{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, exact256(1), exact256(0x1p-237), Float256{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToNegativeInf, exact256(1), exact256(0x1p-237), Float256{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, exact256(1), exact256(0x1p-237), Float256{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+
+		// overflow
+		{ToNearestEven, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToZero, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{AwayFromZero, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNegativeInf, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{ToPositiveInf, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Add256(tt.a, tt.b)
+		if !eq256(got, tt.want) {
+			t.Errorf("Context{%v}.Add256(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sub256(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float256
+		want Float256
+	}{
+		// the sign of the exact zero difference depends on the rounding mode
+		{ToNearestEven, exact256(1), exact256(1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, exact256(1), exact256(1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToZero, exact256(1), exact256(1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, exact256(1), exact256(1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNegativeInf, exact256(1), exact256(1), Float256{0x8000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, exact256(1), exact256(1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sub256(tt.a, tt.b)
+		if !eq256(got, tt.want) {
+			t.Errorf("Context{%v}.Sub256(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Mul256(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float256
+		want Float256
+	}{
+		// half of the smallest subnormal number is a tie
+		{ToNearestEven, Float256{0, 0, 0, 1}, exact256(0.5), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, Float256{0, 0, 0, 1}, exact256(0.5), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToZero, Float256{0, 0, 0, 1}, exact256(0.5), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, Float256{0, 0, 0, 1}, exact256(0.5), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+		{ToNegativeInf, Float256{0, 0, 0, 1}, exact256(0.5), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, Float256{0, 0, 0, 1}, exact256(0.5), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Mul256(tt.a, tt.b)
+		if !eq256(got, tt.want) {
+			t.Errorf("Context{%v}.Mul256(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Quo256(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float256
+		want Float256
+	}{
+		// 1/3
+		{ToNearestEven, exact256(1), exact256(3), Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToNearestAway, exact256(1), exact256(3), Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToZero, exact256(1), exact256(3), Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{AwayFromZero, exact256(1), exact256(3), Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5556}},
+		{ToNegativeInf, exact256(1), exact256(3), Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToPositiveInf, exact256(1), exact256(3), Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5556}},
+
+		// -1/3
+		{ToNearestEven, exact256(-1), exact256(3), Float256{0xbfff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToNearestAway, exact256(-1), exact256(3), Float256{0xbfff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{ToZero, exact256(-1), exact256(3), Float256{0xbfff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+		{AwayFromZero, exact256(-1), exact256(3), Float256{0xbfff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5556}},
+		{ToNegativeInf, exact256(-1), exact256(3), Float256{0xbfff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5556}},
+		{ToPositiveInf, exact256(-1), exact256(3), Float256{0xbfff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Quo256(tt.a, tt.b)
+		if !eq256(got, tt.want) {
+			t.Errorf("Context{%v}.Quo256(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sqrt256(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a    Float256
+		want Float256
+	}{
+		// sqrt(2)
+		{ToNearestEven, exact256(2), Float256{0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9, 0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b066}},
+		{ToNearestAway, exact256(2), Float256{0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9, 0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b066}},
+		{ToZero, exact256(2), Float256{0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9, 0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b066}},
+		{AwayFromZero, exact256(2), Float256{0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9, 0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b067}},
+		{ToNegativeInf, exact256(2), Float256{0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9, 0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b066}},
+		{ToPositiveInf, exact256(2), Float256{0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9, 0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b067}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sqrt256(tt.a)
+		if !eq256(got, tt.want) {
+			t.Errorf("Context{%v}.Sqrt256(%x) = %x, want %x", tt.mode, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FMA256(t *testing.T) {
+	tests := []struct {
+		mode    RoundingMode
+		x, y, z Float256
+		want    Float256
+	}{
+		// (1+ulp)*(1+ulp)-1 = 2ulp + ulp*ulp is a tie; rounding twice gives 2ulp
+		{ToNearestEven, exact256(1 + 0x1p-236), exact256(1 + 0x1p-236), exact256(-1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNearestAway, exact256(1 + 0x1p-236), exact256(1 + 0x1p-236), exact256(-1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToZero, exact256(1 + 0x1p-236), exact256(1 + 0x1p-236), exact256(-1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{AwayFromZero, exact256(1 + 0x1p-236), exact256(1 + 0x1p-236), exact256(-1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToNegativeInf, exact256(1 + 0x1p-236), exact256(1 + 0x1p-236), exact256(-1), Float256{0x8000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+		{ToPositiveInf, exact256(1 + 0x1p-236), exact256(1 + 0x1p-236), exact256(-1), Float256{0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.FMA256(tt.x, tt.y, tt.z)
+		if !eq256(got, tt.want) {
+			t.Errorf("Context{%v}.FMA256(%x, %x, %x) = %x, want %x", tt.mode, tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Flags256(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c *Context)
+		want Flags
+	}{
+		{"exact", func(c *Context) { c.Add256(exact256(1), exact256(1)) }, 0},
+		{"inexact", func(c *Context) { c.Quo256(exact256(1), exact256(3)) }, Inexact},
+		{"overflow", func(c *Context) {
+			c.Add256(Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"underflow", func(c *Context) { c.Mul256(Float256{0, 0, 0, 1}, exact256(0.5)) }, Underflow | Inexact},
+		{"exact subnormal result does not underflow", func(c *Context) { c.Mul256(Float256{0, 0, 0, 1}, exact256(2)) }, 0},
+		{"divide by zero", func(c *Context) { c.Quo256(exact256(1), exact256(0)) }, DivByZero},
+		{"infinity divided by zero is exact", func(c *Context) { c.Quo256(NewFloat256Inf(1), exact256(0)) }, 0},
+		{"0/0", func(c *Context) { c.Quo256(exact256(0), exact256(0)) }, Invalid},
+		{"inf-inf", func(c *Context) { c.Sub256(NewFloat256Inf(1), NewFloat256Inf(1)) }, Invalid},
+		{"0*inf", func(c *Context) { c.Mul256(exact256(0), NewFloat256Inf(1)) }, Invalid},
+		{"sqrt of negative number", func(c *Context) { c.Sqrt256(exact256(-1)) }, Invalid},
+		{"0*inf+NaN", func(c *Context) { c.FMA256(exact256(0), NewFloat256Inf(1), NewFloat256NaN()) }, Invalid},
+		{"quiet NaN", func(c *Context) { c.Add256(NewFloat256NaN(), exact256(1)) }, 0},
+		{"signaling NaN", func(c *Context) {
+			c.Add256(Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001}, exact256(1))
+		}, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			tt.f(&c)
+			if c.Flags != tt.want {
+				t.Errorf("got %v, want %v", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_StickyFlags256(t *testing.T) {
+	var c Context
+	c.Quo256(exact256(1), exact256(0))
+	c.Quo256(exact256(1), exact256(3))
+	c.Add256(exact256(1), exact256(1))
+	if want := DivByZero | Inexact; c.Flags != want {
+		t.Errorf("got %v, want %v", c.Flags, want)
+	}
+}
+
+func TestContext_NaNPayload256(t *testing.T) {
+	var c Context
+	want := SetPayload256(exact256(42))
+	got := c.Add256(exact256(1), SetPayloadSignaling256(exact256(42)))
+	if got != want {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if c.Flags != Invalid {
+		t.Errorf("got %v, want %v", c.Flags, Invalid)
+	}
+}
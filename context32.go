@@ -0,0 +1,47 @@
+package floats
+
+// Float32 converts x to a Float32, rounding according to c.Mode.
+// Other formats can be converted through their exact Float256 method.
+func (c *Context) Float32(x Float256) Float32 {
+	return c.result32(x, 0)
+}
+
+// Add32 returns the sum of a and b, rounded according to c.Mode.
+func (c *Context) Add32(a, b Float32) Float32 {
+	return c.result32(a.Float256().add(b.Float256(), c.Mode|toOdd))
+}
+
+// Sub32 returns the difference of a and b, rounded according to c.Mode.
+func (c *Context) Sub32(a, b Float32) Float32 {
+	return c.result32(a.Float256().sub(b.Float256(), c.Mode|toOdd))
+}
+
+// Mul32 returns the product of a and b, rounded according to c.Mode.
+func (c *Context) Mul32(a, b Float32) Float32 {
+	return c.result32(a.Float256().mul(b.Float256(), c.Mode|toOdd))
+}
+
+// Quo32 returns the quotient of a and b, rounded according to c.Mode.
+func (c *Context) Quo32(a, b Float32) Float32 {
+	return c.result32(a.Float256().quo(b.Float256(), c.Mode|toOdd))
+}
+
+// Sqrt32 returns the square root of a, rounded according to c.Mode.
+//
+// Special cases are the same as [Float32.Sqrt].
+func (c *Context) Sqrt32(a Float32) Float32 {
+	return c.result32(a.Float256().sqrt(c.Mode | toOdd))
+}
+
+// FMA32 returns x * y + z, computed with only one rounding according to c.Mode.
+func (c *Context) FMA32(x, y, z Float32) Float32 {
+	return c.result32(fma256(x.Float256(), y.Float256(), z.Float256(), c.Mode|toOdd))
+}
+
+// result32 rounds x to a Float32 according to c.Mode,
+// and records flags and the exception flags raised by the rounding.
+func (c *Context) result32(x Float256, flags Flags) Float32 {
+	ret, f := x.float32(c.Mode)
+	c.Flags |= flags | f
+	return ret
+}
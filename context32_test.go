@@ -0,0 +1,222 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContext_Add32(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float32
+		want Float32
+	}{
+		// 1 + 0.5 ulp is a tie
+		{ToNearestEven, exact32(1), exact32(0x1p-24), NewFloat32FromBits(0x3f800000)},
+		{ToNearestAway, exact32(1), exact32(0x1p-24), NewFloat32FromBits(0x3f800001)},
+		{ToZero, exact32(1), exact32(0x1p-24), NewFloat32FromBits(0x3f800000)},
+		{AwayFromZero, exact32(1), exact32(0x1p-24), NewFloat32FromBits(0x3f800001)},
+		{ToNegativeInf, exact32(1), exact32(0x1p-24), NewFloat32FromBits(0x3f800000)},
+		{ToPositiveInf, exact32(1), exact32(0x1p-24), NewFloat32FromBits(0x3f800001)},
+
+		// overflow
+		{ToNearestEven, exact32(math.MaxFloat32), exact32(math.MaxFloat32), NewFloat32FromBits(0x7f800000)},
+		{ToNearestAway, exact32(math.MaxFloat32), exact32(math.MaxFloat32), NewFloat32FromBits(0x7f800000)},
+		{ToZero, exact32(math.MaxFloat32), exact32(math.MaxFloat32), NewFloat32FromBits(0x7f7fffff)},
+		{AwayFromZero, exact32(math.MaxFloat32), exact32(math.MaxFloat32), NewFloat32FromBits(0x7f800000)},
+		{ToNegativeInf, exact32(math.MaxFloat32), exact32(math.MaxFloat32), NewFloat32FromBits(0x7f7fffff)},
+		{ToPositiveInf, exact32(math.MaxFloat32), exact32(math.MaxFloat32), NewFloat32FromBits(0x7f800000)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Add32(tt.a, tt.b)
+		if !eq32(got, tt.want) {
+			t.Errorf("Context{%v}.Add32(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sub32(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float32
+		want Float32
+	}{
+		// the sign of the exact zero difference depends on the rounding mode
+		{ToNearestEven, exact32(1), exact32(1), NewFloat32FromBits(0x00000000)},
+		{ToNearestAway, exact32(1), exact32(1), NewFloat32FromBits(0x00000000)},
+		{ToZero, exact32(1), exact32(1), NewFloat32FromBits(0x00000000)},
+		{AwayFromZero, exact32(1), exact32(1), NewFloat32FromBits(0x00000000)},
+		{ToNegativeInf, exact32(1), exact32(1), NewFloat32FromBits(0x80000000)},
+		{ToPositiveInf, exact32(1), exact32(1), NewFloat32FromBits(0x00000000)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sub32(tt.a, tt.b)
+		if !eq32(got, tt.want) {
+			t.Errorf("Context{%v}.Sub32(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Mul32(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float32
+		want Float32
+	}{
+		// half of the smallest subnormal number is a tie
+		{ToNearestEven, NewFloat32FromBits(0x00000001), exact32(0.5), NewFloat32FromBits(0x00000000)},
+		{ToNearestAway, NewFloat32FromBits(0x00000001), exact32(0.5), NewFloat32FromBits(0x00000001)},
+		{ToZero, NewFloat32FromBits(0x00000001), exact32(0.5), NewFloat32FromBits(0x00000000)},
+		{AwayFromZero, NewFloat32FromBits(0x00000001), exact32(0.5), NewFloat32FromBits(0x00000001)},
+		{ToNegativeInf, NewFloat32FromBits(0x00000001), exact32(0.5), NewFloat32FromBits(0x00000000)},
+		{ToPositiveInf, NewFloat32FromBits(0x00000001), exact32(0.5), NewFloat32FromBits(0x00000001)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Mul32(tt.a, tt.b)
+		if !eq32(got, tt.want) {
+			t.Errorf("Context{%v}.Mul32(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Quo32(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float32
+		want Float32
+	}{
+		// 1/3
+		{ToNearestEven, exact32(1), exact32(3), NewFloat32FromBits(0x3eaaaaab)},
+		{ToNearestAway, exact32(1), exact32(3), NewFloat32FromBits(0x3eaaaaab)},
+		{ToZero, exact32(1), exact32(3), NewFloat32FromBits(0x3eaaaaaa)},
+		{AwayFromZero, exact32(1), exact32(3), NewFloat32FromBits(0x3eaaaaab)},
+		{ToNegativeInf, exact32(1), exact32(3), NewFloat32FromBits(0x3eaaaaaa)},
+		{ToPositiveInf, exact32(1), exact32(3), NewFloat32FromBits(0x3eaaaaab)},
+
+		// -1/3
+		{ToNearestEven, exact32(-1), exact32(3), NewFloat32FromBits(0xbeaaaaab)},
+		{ToNearestAway, exact32(-1), exact32(3), NewFloat32FromBits(0xbeaaaaab)},
+		{ToZero, exact32(-1), exact32(3), NewFloat32FromBits(0xbeaaaaaa)},
+		{AwayFromZero, exact32(-1), exact32(3), NewFloat32FromBits(0xbeaaaaab)},
+		{ToNegativeInf, exact32(-1), exact32(3), NewFloat32FromBits(0xbeaaaaab)},
+		{ToPositiveInf, exact32(-1), exact32(3), NewFloat32FromBits(0xbeaaaaaa)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Quo32(tt.a, tt.b)
+		if !eq32(got, tt.want) {
+			t.Errorf("Context{%v}.Quo32(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sqrt32(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a    Float32
+		want Float32
+	}{
+		// sqrt(2)
+		{ToNearestEven, exact32(2), NewFloat32FromBits(0x3fb504f3)},
+		{ToNearestAway, exact32(2), NewFloat32FromBits(0x3fb504f3)},
+		{ToZero, exact32(2), NewFloat32FromBits(0x3fb504f3)},
+		{AwayFromZero, exact32(2), NewFloat32FromBits(0x3fb504f4)},
+		{ToNegativeInf, exact32(2), NewFloat32FromBits(0x3fb504f3)},
+		{ToPositiveInf, exact32(2), NewFloat32FromBits(0x3fb504f4)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sqrt32(tt.a)
+		if !eq32(got, tt.want) {
+			t.Errorf("Context{%v}.Sqrt32(%x) = %x, want %x", tt.mode, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FMA32(t *testing.T) {
+	tests := []struct {
+		mode    RoundingMode
+		x, y, z Float32
+		want    Float32
+	}{
+		// (1+ulp)*(1+ulp)-1 = 2ulp + ulp*ulp is a tie; rounding twice gives 2ulp
+		{ToNearestEven, exact32(1 + 0x1p-23), exact32(1 + 0x1p-23), exact32(-1), NewFloat32FromBits(0x34800000)},
+		{ToNearestAway, exact32(1 + 0x1p-23), exact32(1 + 0x1p-23), exact32(-1), NewFloat32FromBits(0x34800001)},
+		{ToZero, exact32(1 + 0x1p-23), exact32(1 + 0x1p-23), exact32(-1), NewFloat32FromBits(0x34800000)},
+		{AwayFromZero, exact32(1 + 0x1p-23), exact32(1 + 0x1p-23), exact32(-1), NewFloat32FromBits(0x34800001)},
+		{ToNegativeInf, exact32(1 + 0x1p-23), exact32(1 + 0x1p-23), exact32(-1), NewFloat32FromBits(0x34800000)},
+		{ToPositiveInf, exact32(1 + 0x1p-23), exact32(1 + 0x1p-23), exact32(-1), NewFloat32FromBits(0x34800001)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.FMA32(tt.x, tt.y, tt.z)
+		if !eq32(got, tt.want) {
+			t.Errorf("Context{%v}.FMA32(%x, %x, %x) = %x, want %x", tt.mode, tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Flags32(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c *Context)
+		want Flags
+	}{
+		{"exact", func(c *Context) { c.Add32(exact32(1), exact32(1)) }, 0},
+		{"inexact", func(c *Context) { c.Quo32(exact32(1), exact32(3)) }, Inexact},
+		{"overflow", func(c *Context) { c.Add32(exact32(math.MaxFloat32), exact32(math.MaxFloat32)) }, Overflow | Inexact},
+		{"underflow", func(c *Context) { c.Mul32(NewFloat32FromBits(0x00000001), exact32(0.5)) }, Underflow | Inexact},
+		{"exact subnormal result does not underflow", func(c *Context) { c.Mul32(NewFloat32FromBits(0x00000001), exact32(2)) }, 0},
+		{"divide by zero", func(c *Context) { c.Quo32(exact32(1), exact32(0)) }, DivByZero},
+		{"infinity divided by zero is exact", func(c *Context) { c.Quo32(NewFloat32Inf(1), exact32(0)) }, 0},
+		{"0/0", func(c *Context) { c.Quo32(exact32(0), exact32(0)) }, Invalid},
+		{"inf-inf", func(c *Context) { c.Sub32(NewFloat32Inf(1), NewFloat32Inf(1)) }, Invalid},
+		{"0*inf", func(c *Context) { c.Mul32(exact32(0), NewFloat32Inf(1)) }, Invalid},
+		{"sqrt of negative number", func(c *Context) { c.Sqrt32(exact32(-1)) }, Invalid},
+		{"0*inf+NaN", func(c *Context) { c.FMA32(exact32(0), NewFloat32Inf(1), NewFloat32NaN()) }, Invalid},
+		{"quiet NaN", func(c *Context) { c.Add32(NewFloat32NaN(), exact32(1)) }, 0},
+		{"signaling NaN", func(c *Context) { c.Add32(NewFloat32FromBits(0x7f800001), exact32(1)) }, Invalid},
+		{"conversion: inexact", func(c *Context) { c.Float32(NewFloat256(1).Quo(NewFloat256(3))) }, Inexact},
+		{"conversion: overflow", func(c *Context) {
+			c.Float32(Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"conversion: underflow", func(c *Context) { c.Float32(Float256{0, 0, 0, 1}) }, Underflow | Inexact},
+		{"conversion: signaling NaN", func(c *Context) {
+			c.Float32(Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001})
+		}, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			tt.f(&c)
+			if c.Flags != tt.want {
+				t.Errorf("got %v, want %v", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_StickyFlags32(t *testing.T) {
+	var c Context
+	c.Quo32(exact32(1), exact32(0))
+	c.Quo32(exact32(1), exact32(3))
+	c.Add32(exact32(1), exact32(1))
+	if want := DivByZero | Inexact; c.Flags != want {
+		t.Errorf("got %v, want %v", c.Flags, want)
+	}
+}
+
+func TestContext_NaNPayload32(t *testing.T) {
+	var c Context
+	want := SetPayload32(exact32(42))
+	got := c.Add32(exact32(1), SetPayloadSignaling32(exact32(42)))
+	if got.Bits() != want.Bits() {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if c.Flags != Invalid {
+		t.Errorf("got %v, want %v", c.Flags, Invalid)
+	}
+}
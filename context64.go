@@ -0,0 +1,47 @@
+package floats
+
+// Float64 converts x to a Float64, rounding according to c.Mode.
+// Other formats can be converted through their exact Float256 method.
+func (c *Context) Float64(x Float256) Float64 {
+	return c.result64(x, 0)
+}
+
+// Add64 returns the sum of a and b, rounded according to c.Mode.
+func (c *Context) Add64(a, b Float64) Float64 {
+	return c.result64(a.Float256().add(b.Float256(), c.Mode|toOdd))
+}
+
+// Sub64 returns the difference of a and b, rounded according to c.Mode.
+func (c *Context) Sub64(a, b Float64) Float64 {
+	return c.result64(a.Float256().sub(b.Float256(), c.Mode|toOdd))
+}
+
+// Mul64 returns the product of a and b, rounded according to c.Mode.
+func (c *Context) Mul64(a, b Float64) Float64 {
+	return c.result64(a.Float256().mul(b.Float256(), c.Mode|toOdd))
+}
+
+// Quo64 returns the quotient of a and b, rounded according to c.Mode.
+func (c *Context) Quo64(a, b Float64) Float64 {
+	return c.result64(a.Float256().quo(b.Float256(), c.Mode|toOdd))
+}
+
+// Sqrt64 returns the square root of a, rounded according to c.Mode.
+//
+// Special cases are the same as [Float64.Sqrt].
+func (c *Context) Sqrt64(a Float64) Float64 {
+	return c.result64(a.Float256().sqrt(c.Mode | toOdd))
+}
+
+// FMA64 returns x * y + z, computed with only one rounding according to c.Mode.
+func (c *Context) FMA64(x, y, z Float64) Float64 {
+	return c.result64(fma256(x.Float256(), y.Float256(), z.Float256(), c.Mode|toOdd))
+}
+
+// result64 rounds x to a Float64 according to c.Mode,
+// and records flags and the exception flags raised by the rounding.
+func (c *Context) result64(x Float256, flags Flags) Float64 {
+	ret, f := x.float64(c.Mode)
+	c.Flags |= flags | f
+	return ret
+}
@@ -0,0 +1,222 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestContext_Add64(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float64
+		want Float64
+	}{
+		// 1 + 0.5 ulp is a tie
+		{ToNearestEven, exact64(1), exact64(0x1p-53), NewFloat64FromBits(0x3ff0000000000000)},
+		{ToNearestAway, exact64(1), exact64(0x1p-53), NewFloat64FromBits(0x3ff0000000000001)},
+		{ToZero, exact64(1), exact64(0x1p-53), NewFloat64FromBits(0x3ff0000000000000)},
+		{AwayFromZero, exact64(1), exact64(0x1p-53), NewFloat64FromBits(0x3ff0000000000001)},
+		{ToNegativeInf, exact64(1), exact64(0x1p-53), NewFloat64FromBits(0x3ff0000000000000)},
+		{ToPositiveInf, exact64(1), exact64(0x1p-53), NewFloat64FromBits(0x3ff0000000000001)},
+
+		// overflow
+		{ToNearestEven, exact64(math.MaxFloat64), exact64(math.MaxFloat64), NewFloat64FromBits(0x7ff0000000000000)},
+		{ToNearestAway, exact64(math.MaxFloat64), exact64(math.MaxFloat64), NewFloat64FromBits(0x7ff0000000000000)},
+		{ToZero, exact64(math.MaxFloat64), exact64(math.MaxFloat64), NewFloat64FromBits(0x7fefffffffffffff)},
+		{AwayFromZero, exact64(math.MaxFloat64), exact64(math.MaxFloat64), NewFloat64FromBits(0x7ff0000000000000)},
+		{ToNegativeInf, exact64(math.MaxFloat64), exact64(math.MaxFloat64), NewFloat64FromBits(0x7fefffffffffffff)},
+		{ToPositiveInf, exact64(math.MaxFloat64), exact64(math.MaxFloat64), NewFloat64FromBits(0x7ff0000000000000)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Add64(tt.a, tt.b)
+		if !eq64(got, tt.want) {
+			t.Errorf("Context{%v}.Add64(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sub64(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float64
+		want Float64
+	}{
+		// the sign of the exact zero difference depends on the rounding mode
+		{ToNearestEven, exact64(1), exact64(1), NewFloat64FromBits(0x0000000000000000)},
+		{ToNearestAway, exact64(1), exact64(1), NewFloat64FromBits(0x0000000000000000)},
+		{ToZero, exact64(1), exact64(1), NewFloat64FromBits(0x0000000000000000)},
+		{AwayFromZero, exact64(1), exact64(1), NewFloat64FromBits(0x0000000000000000)},
+		{ToNegativeInf, exact64(1), exact64(1), NewFloat64FromBits(0x8000000000000000)},
+		{ToPositiveInf, exact64(1), exact64(1), NewFloat64FromBits(0x0000000000000000)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sub64(tt.a, tt.b)
+		if !eq64(got, tt.want) {
+			t.Errorf("Context{%v}.Sub64(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Mul64(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float64
+		want Float64
+	}{
+		// half of the smallest subnormal number is a tie
+		{ToNearestEven, NewFloat64FromBits(0x0000000000000001), exact64(0.5), NewFloat64FromBits(0x0000000000000000)},
+		{ToNearestAway, NewFloat64FromBits(0x0000000000000001), exact64(0.5), NewFloat64FromBits(0x0000000000000001)},
+		{ToZero, NewFloat64FromBits(0x0000000000000001), exact64(0.5), NewFloat64FromBits(0x0000000000000000)},
+		{AwayFromZero, NewFloat64FromBits(0x0000000000000001), exact64(0.5), NewFloat64FromBits(0x0000000000000001)},
+		{ToNegativeInf, NewFloat64FromBits(0x0000000000000001), exact64(0.5), NewFloat64FromBits(0x0000000000000000)},
+		{ToPositiveInf, NewFloat64FromBits(0x0000000000000001), exact64(0.5), NewFloat64FromBits(0x0000000000000001)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Mul64(tt.a, tt.b)
+		if !eq64(got, tt.want) {
+			t.Errorf("Context{%v}.Mul64(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Quo64(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b Float64
+		want Float64
+	}{
+		// 1/3
+		{ToNearestEven, exact64(1), exact64(3), NewFloat64FromBits(0x3fd5555555555555)},
+		{ToNearestAway, exact64(1), exact64(3), NewFloat64FromBits(0x3fd5555555555555)},
+		{ToZero, exact64(1), exact64(3), NewFloat64FromBits(0x3fd5555555555555)},
+		{AwayFromZero, exact64(1), exact64(3), NewFloat64FromBits(0x3fd5555555555556)},
+		{ToNegativeInf, exact64(1), exact64(3), NewFloat64FromBits(0x3fd5555555555555)},
+		{ToPositiveInf, exact64(1), exact64(3), NewFloat64FromBits(0x3fd5555555555556)},
+
+		// -1/3
+		{ToNearestEven, exact64(-1), exact64(3), NewFloat64FromBits(0xbfd5555555555555)},
+		{ToNearestAway, exact64(-1), exact64(3), NewFloat64FromBits(0xbfd5555555555555)},
+		{ToZero, exact64(-1), exact64(3), NewFloat64FromBits(0xbfd5555555555555)},
+		{AwayFromZero, exact64(-1), exact64(3), NewFloat64FromBits(0xbfd5555555555556)},
+		{ToNegativeInf, exact64(-1), exact64(3), NewFloat64FromBits(0xbfd5555555555556)},
+		{ToPositiveInf, exact64(-1), exact64(3), NewFloat64FromBits(0xbfd5555555555555)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Quo64(tt.a, tt.b)
+		if !eq64(got, tt.want) {
+			t.Errorf("Context{%v}.Quo64(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sqrt64(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a    Float64
+		want Float64
+	}{
+		// sqrt(2)
+		{ToNearestEven, exact64(2), NewFloat64FromBits(0x3ff6a09e667f3bcd)},
+		{ToNearestAway, exact64(2), NewFloat64FromBits(0x3ff6a09e667f3bcd)},
+		{ToZero, exact64(2), NewFloat64FromBits(0x3ff6a09e667f3bcc)},
+		{AwayFromZero, exact64(2), NewFloat64FromBits(0x3ff6a09e667f3bcd)},
+		{ToNegativeInf, exact64(2), NewFloat64FromBits(0x3ff6a09e667f3bcc)},
+		{ToPositiveInf, exact64(2), NewFloat64FromBits(0x3ff6a09e667f3bcd)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.Sqrt64(tt.a)
+		if !eq64(got, tt.want) {
+			t.Errorf("Context{%v}.Sqrt64(%x) = %x, want %x", tt.mode, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FMA64(t *testing.T) {
+	tests := []struct {
+		mode    RoundingMode
+		x, y, z Float64
+		want    Float64
+	}{
+		// (1+ulp)*(1+ulp)-1 = 2ulp + ulp*ulp is a tie; rounding twice gives 2ulp
+		{ToNearestEven, exact64(1 + 0x1p-52), exact64(1 + 0x1p-52), exact64(-1), NewFloat64FromBits(0x3cc0000000000000)},
+		{ToNearestAway, exact64(1 + 0x1p-52), exact64(1 + 0x1p-52), exact64(-1), NewFloat64FromBits(0x3cc0000000000001)},
+		{ToZero, exact64(1 + 0x1p-52), exact64(1 + 0x1p-52), exact64(-1), NewFloat64FromBits(0x3cc0000000000000)},
+		{AwayFromZero, exact64(1 + 0x1p-52), exact64(1 + 0x1p-52), exact64(-1), NewFloat64FromBits(0x3cc0000000000001)},
+		{ToNegativeInf, exact64(1 + 0x1p-52), exact64(1 + 0x1p-52), exact64(-1), NewFloat64FromBits(0x3cc0000000000000)},
+		{ToPositiveInf, exact64(1 + 0x1p-52), exact64(1 + 0x1p-52), exact64(-1), NewFloat64FromBits(0x3cc0000000000001)},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.FMA64(tt.x, tt.y, tt.z)
+		if !eq64(got, tt.want) {
+			t.Errorf("Context{%v}.FMA64(%x, %x, %x) = %x, want %x", tt.mode, tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Flags64(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c *Context)
+		want Flags
+	}{
+		{"exact", func(c *Context) { c.Add64(exact64(1), exact64(1)) }, 0},
+		{"inexact", func(c *Context) { c.Quo64(exact64(1), exact64(3)) }, Inexact},
+		{"overflow", func(c *Context) { c.Add64(exact64(math.MaxFloat64), exact64(math.MaxFloat64)) }, Overflow | Inexact},
+		{"underflow", func(c *Context) { c.Mul64(NewFloat64FromBits(0x0000000000000001), exact64(0.5)) }, Underflow | Inexact},
+		{"exact subnormal result does not underflow", func(c *Context) { c.Mul64(NewFloat64FromBits(0x0000000000000001), exact64(2)) }, 0},
+		{"divide by zero", func(c *Context) { c.Quo64(exact64(1), exact64(0)) }, DivByZero},
+		{"infinity divided by zero is exact", func(c *Context) { c.Quo64(NewFloat64Inf(1), exact64(0)) }, 0},
+		{"0/0", func(c *Context) { c.Quo64(exact64(0), exact64(0)) }, Invalid},
+		{"inf-inf", func(c *Context) { c.Sub64(NewFloat64Inf(1), NewFloat64Inf(1)) }, Invalid},
+		{"0*inf", func(c *Context) { c.Mul64(exact64(0), NewFloat64Inf(1)) }, Invalid},
+		{"sqrt of negative number", func(c *Context) { c.Sqrt64(exact64(-1)) }, Invalid},
+		{"0*inf+NaN", func(c *Context) { c.FMA64(exact64(0), NewFloat64Inf(1), NewFloat64NaN()) }, Invalid},
+		{"quiet NaN", func(c *Context) { c.Add64(NewFloat64NaN(), exact64(1)) }, 0},
+		{"signaling NaN", func(c *Context) { c.Add64(NewFloat64FromBits(0x7ff0_0000_0000_0001), exact64(1)) }, Invalid},
+		{"conversion: inexact", func(c *Context) { c.Float64(NewFloat256(1).Quo(NewFloat256(3))) }, Inexact},
+		{"conversion: overflow", func(c *Context) {
+			c.Float64(Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"conversion: underflow", func(c *Context) { c.Float64(Float256{0, 0, 0, 1}) }, Underflow | Inexact},
+		{"conversion: signaling NaN", func(c *Context) {
+			c.Float64(Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001})
+		}, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			tt.f(&c)
+			if c.Flags != tt.want {
+				t.Errorf("got %v, want %v", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_StickyFlags64(t *testing.T) {
+	var c Context
+	c.Quo64(exact64(1), exact64(0))
+	c.Quo64(exact64(1), exact64(3))
+	c.Add64(exact64(1), exact64(1))
+	if want := DivByZero | Inexact; c.Flags != want {
+		t.Errorf("got %v, want %v", c.Flags, want)
+	}
+}
+
+func TestContext_NaNPayload64(t *testing.T) {
+	var c Context
+	want := SetPayload64(exact64(42))
+	got := c.Add64(exact64(1), SetPayloadSignaling64(exact64(42)))
+	if got.Bits() != want.Bits() {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if c.Flags != Invalid {
+		t.Errorf("got %v, want %v", c.Flags, Invalid)
+	}
+}
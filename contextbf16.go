@@ -0,0 +1,47 @@
+package floats
+
+// BFloat16 converts x to a BFloat16, rounding according to c.Mode.
+// Other formats can be converted through their exact Float256 method.
+func (c *Context) BFloat16(x Float256) BFloat16 {
+	return c.resultBF16(x, 0)
+}
+
+// AddBF16 returns the sum of a and b, rounded according to c.Mode.
+func (c *Context) AddBF16(a, b BFloat16) BFloat16 {
+	return c.resultBF16(a.Float256().add(b.Float256(), c.Mode|toOdd))
+}
+
+// SubBF16 returns the difference of a and b, rounded according to c.Mode.
+func (c *Context) SubBF16(a, b BFloat16) BFloat16 {
+	return c.resultBF16(a.Float256().sub(b.Float256(), c.Mode|toOdd))
+}
+
+// MulBF16 returns the product of a and b, rounded according to c.Mode.
+func (c *Context) MulBF16(a, b BFloat16) BFloat16 {
+	return c.resultBF16(a.Float256().mul(b.Float256(), c.Mode|toOdd))
+}
+
+// QuoBF16 returns the quotient of a and b, rounded according to c.Mode.
+func (c *Context) QuoBF16(a, b BFloat16) BFloat16 {
+	return c.resultBF16(a.Float256().quo(b.Float256(), c.Mode|toOdd))
+}
+
+// SqrtBF16 returns the square root of a, rounded according to c.Mode.
+//
+// Special cases are the same as [BFloat16.Sqrt].
+func (c *Context) SqrtBF16(a BFloat16) BFloat16 {
+	return c.resultBF16(a.Float256().sqrt(c.Mode | toOdd))
+}
+
+// FMABF16 returns x * y + z, computed with only one rounding according to c.Mode.
+func (c *Context) FMABF16(x, y, z BFloat16) BFloat16 {
+	return c.resultBF16(fma256(x.Float256(), y.Float256(), z.Float256(), c.Mode|toOdd))
+}
+
+// resultBF16 rounds x to a BFloat16 according to c.Mode,
+// and records flags and the exception flags raised by the rounding.
+func (c *Context) resultBF16(x Float256, flags Flags) BFloat16 {
+	ret, f := x.bfloat16(c.Mode)
+	c.Flags |= flags | f
+	return ret
+}
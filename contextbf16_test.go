@@ -0,0 +1,221 @@
+package floats
+
+import (
+	"testing"
+)
+
+func TestContext_AddBF16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b BFloat16
+		want BFloat16
+	}{
+		// 1 + 0.5 ulp is a tie
+		{ToNearestEven, exactBF16(1), exactBF16(0x1p-8), 0x3f80},
+		{ToNearestAway, exactBF16(1), exactBF16(0x1p-8), 0x3f81},
+		{ToZero, exactBF16(1), exactBF16(0x1p-8), 0x3f80},
+		{AwayFromZero, exactBF16(1), exactBF16(0x1p-8), 0x3f81},
+		{ToNegativeInf, exactBF16(1), exactBF16(0x1p-8), 0x3f80},
+		{ToPositiveInf, exactBF16(1), exactBF16(0x1p-8), 0x3f81},
+
+		// overflow
+		{ToNearestEven, 0x7f7f, 0x7f7f, 0x7f80},
+		{ToNearestAway, 0x7f7f, 0x7f7f, 0x7f80},
+		{ToZero, 0x7f7f, 0x7f7f, 0x7f7f},
+		{AwayFromZero, 0x7f7f, 0x7f7f, 0x7f80},
+		{ToNegativeInf, 0x7f7f, 0x7f7f, 0x7f7f},
+		{ToPositiveInf, 0x7f7f, 0x7f7f, 0x7f80},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.AddBF16(tt.a, tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Context{%v}.AddBF16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_SubBF16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b BFloat16
+		want BFloat16
+	}{
+		// the sign of the exact zero difference depends on the rounding mode
+		{ToNearestEven, exactBF16(1), exactBF16(1), 0x0000},
+		{ToNearestAway, exactBF16(1), exactBF16(1), 0x0000},
+		{ToZero, exactBF16(1), exactBF16(1), 0x0000},
+		{AwayFromZero, exactBF16(1), exactBF16(1), 0x0000},
+		{ToNegativeInf, exactBF16(1), exactBF16(1), 0x8000},
+		{ToPositiveInf, exactBF16(1), exactBF16(1), 0x0000},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.SubBF16(tt.a, tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Context{%v}.SubBF16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_MulBF16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b BFloat16
+		want BFloat16
+	}{
+		// half of the smallest subnormal number is a tie
+		{ToNearestEven, 0x0001, exactBF16(0.5), 0x0000},
+		{ToNearestAway, 0x0001, exactBF16(0.5), 0x0001},
+		{ToZero, 0x0001, exactBF16(0.5), 0x0000},
+		{AwayFromZero, 0x0001, exactBF16(0.5), 0x0001},
+		{ToNegativeInf, 0x0001, exactBF16(0.5), 0x0000},
+		{ToPositiveInf, 0x0001, exactBF16(0.5), 0x0001},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.MulBF16(tt.a, tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Context{%v}.MulBF16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_QuoBF16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a, b BFloat16
+		want BFloat16
+	}{
+		// 1/3
+		{ToNearestEven, exactBF16(1), exactBF16(3), 0x3eab},
+		{ToNearestAway, exactBF16(1), exactBF16(3), 0x3eab},
+		{ToZero, exactBF16(1), exactBF16(3), 0x3eaa},
+		{AwayFromZero, exactBF16(1), exactBF16(3), 0x3eab},
+		{ToNegativeInf, exactBF16(1), exactBF16(3), 0x3eaa},
+		{ToPositiveInf, exactBF16(1), exactBF16(3), 0x3eab},
+
+		// -1/3
+		{ToNearestEven, exactBF16(-1), exactBF16(3), 0xbeab},
+		{ToNearestAway, exactBF16(-1), exactBF16(3), 0xbeab},
+		{ToZero, exactBF16(-1), exactBF16(3), 0xbeaa},
+		{AwayFromZero, exactBF16(-1), exactBF16(3), 0xbeab},
+		{ToNegativeInf, exactBF16(-1), exactBF16(3), 0xbeab},
+		{ToPositiveInf, exactBF16(-1), exactBF16(3), 0xbeaa},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.QuoBF16(tt.a, tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Context{%v}.QuoBF16(%x, %x) = %x, want %x", tt.mode, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestContext_SqrtBF16(t *testing.T) {
+	tests := []struct {
+		mode RoundingMode
+		a    BFloat16
+		want BFloat16
+	}{
+		// sqrt(2)
+		{ToNearestEven, exactBF16(2), 0x3fb5},
+		{ToNearestAway, exactBF16(2), 0x3fb5},
+		{ToZero, exactBF16(2), 0x3fb5},
+		{AwayFromZero, exactBF16(2), 0x3fb6},
+		{ToNegativeInf, exactBF16(2), 0x3fb5},
+		{ToPositiveInf, exactBF16(2), 0x3fb6},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.SqrtBF16(tt.a)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Context{%v}.SqrtBF16(%x) = %x, want %x", tt.mode, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FMABF16(t *testing.T) {
+	tests := []struct {
+		mode    RoundingMode
+		x, y, z BFloat16
+		want    BFloat16
+	}{
+		// (1+ulp)*(1+ulp)-1 = 2ulp + ulp*ulp is a tie; rounding twice gives 2ulp
+		{ToNearestEven, exactBF16(1 + 0x1p-7), exactBF16(1 + 0x1p-7), exactBF16(-1), 0x3c80},
+		{ToNearestAway, exactBF16(1 + 0x1p-7), exactBF16(1 + 0x1p-7), exactBF16(-1), 0x3c81},
+		{ToZero, exactBF16(1 + 0x1p-7), exactBF16(1 + 0x1p-7), exactBF16(-1), 0x3c80},
+		{AwayFromZero, exactBF16(1 + 0x1p-7), exactBF16(1 + 0x1p-7), exactBF16(-1), 0x3c81},
+		{ToNegativeInf, exactBF16(1 + 0x1p-7), exactBF16(1 + 0x1p-7), exactBF16(-1), 0x3c80},
+		{ToPositiveInf, exactBF16(1 + 0x1p-7), exactBF16(1 + 0x1p-7), exactBF16(-1), 0x3c81},
+	}
+	for _, tt := range tests {
+		c := &Context{Mode: tt.mode}
+		got := c.FMABF16(tt.x, tt.y, tt.z)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Context{%v}.FMABF16(%x, %x, %x) = %x, want %x", tt.mode, tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestContext_FlagsBF16(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(c *Context)
+		want Flags
+	}{
+		{"exact", func(c *Context) { c.AddBF16(exactBF16(1), exactBF16(1)) }, 0},
+		{"inexact", func(c *Context) { c.QuoBF16(exactBF16(1), exactBF16(3)) }, Inexact},
+		{"overflow", func(c *Context) { c.AddBF16(0x7f7f, 0x7f7f) }, Overflow | Inexact},
+		{"underflow", func(c *Context) { c.MulBF16(0x0001, exactBF16(0.5)) }, Underflow | Inexact},
+		{"exact subnormal result does not underflow", func(c *Context) { c.MulBF16(0x0001, exactBF16(2)) }, 0},
+		{"divide by zero", func(c *Context) { c.QuoBF16(exactBF16(1), exactBF16(0)) }, DivByZero},
+		{"infinity divided by zero is exact", func(c *Context) { c.QuoBF16(NewBFloat16Inf(1), exactBF16(0)) }, 0},
+		{"0/0", func(c *Context) { c.QuoBF16(exactBF16(0), exactBF16(0)) }, Invalid},
+		{"inf-inf", func(c *Context) { c.SubBF16(NewBFloat16Inf(1), NewBFloat16Inf(1)) }, Invalid},
+		{"0*inf", func(c *Context) { c.MulBF16(exactBF16(0), NewBFloat16Inf(1)) }, Invalid},
+		{"sqrt of negative number", func(c *Context) { c.SqrtBF16(exactBF16(-1)) }, Invalid},
+		{"0*inf+NaN", func(c *Context) { c.FMABF16(exactBF16(0), NewBFloat16Inf(1), NewBFloat16NaN()) }, Invalid},
+		{"quiet NaN", func(c *Context) { c.AddBF16(NewBFloat16NaN(), exactBF16(1)) }, 0},
+		{"signaling NaN", func(c *Context) { c.AddBF16(0x7f81, exactBF16(1)) }, Invalid},
+		{"conversion: inexact", func(c *Context) { c.BFloat16(NewFloat256(1).Quo(NewFloat256(3))) }, Inexact},
+		{"conversion: overflow", func(c *Context) {
+			c.BFloat16(Float256{0x7fff_efff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff})
+		}, Overflow | Inexact},
+		{"conversion: underflow", func(c *Context) { c.BFloat16(Float256{0, 0, 0, 1}) }, Underflow | Inexact},
+		{"conversion: signaling NaN", func(c *Context) {
+			c.BFloat16(Float256{0x7fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0001})
+		}, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			tt.f(&c)
+			if c.Flags != tt.want {
+				t.Errorf("got %v, want %v", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_StickyFlagsBF16(t *testing.T) {
+	var c Context
+	c.QuoBF16(exactBF16(1), exactBF16(0))
+	c.QuoBF16(exactBF16(1), exactBF16(3))
+	c.AddBF16(exactBF16(1), exactBF16(1))
+	if want := DivByZero | Inexact; c.Flags != want {
+		t.Errorf("got %v, want %v", c.Flags, want)
+	}
+}
+
+func TestContext_NaNPayloadBF16(t *testing.T) {
+	var c Context
+	want := SetPayloadBF16(exactBF16(42))
+	got := c.AddBF16(exactBF16(1), SetPayloadSignalingBF16(exactBF16(42)))
+	if got != want {
+		t.Errorf("got %x, want %x", got, want)
+	}
+	if c.Flags != Invalid {
+		t.Errorf("got %v, want %v", c.Flags, Invalid)
+	}
+}
@@ -0,0 +1,65 @@
+package floats
+
+import (
+	"github.com/shogo82148/ints"
+)
+
+// Int64 converts x to an int64, rounding according to c.Mode.
+// It returns the exception flags raised by this conversion,
+// and also records them in c.Flags.
+// The conversion is the same as [Float256.ToInt64].
+func (c *Context) Int64(x Float256) (int64, Flags) {
+	ret, flags := x.ToInt64(c.Mode)
+	c.Flags |= flags
+	return ret, flags
+}
+
+// Uint64 converts x to a uint64, rounding according to c.Mode.
+// It returns the exception flags raised by this conversion,
+// and also records them in c.Flags.
+// The conversion is the same as [Float256.ToUint64].
+func (c *Context) Uint64(x Float256) (uint64, Flags) {
+	ret, flags := x.ToUint64(c.Mode)
+	c.Flags |= flags
+	return ret, flags
+}
+
+// Int128 converts x to an ints.Int128, rounding according to c.Mode.
+// It returns the exception flags raised by this conversion,
+// and also records them in c.Flags.
+// The conversion is the same as [Float256.ToInt128].
+func (c *Context) Int128(x Float256) (ints.Int128, Flags) {
+	ret, flags := x.ToInt128(c.Mode)
+	c.Flags |= flags
+	return ret, flags
+}
+
+// Uint128 converts x to an ints.Uint128, rounding according to c.Mode.
+// It returns the exception flags raised by this conversion,
+// and also records them in c.Flags.
+// The conversion is the same as [Float256.ToUint128].
+func (c *Context) Uint128(x Float256) (ints.Uint128, Flags) {
+	ret, flags := x.ToUint128(c.Mode)
+	c.Flags |= flags
+	return ret, flags
+}
+
+// Int256 converts x to an ints.Int256, rounding according to c.Mode.
+// It returns the exception flags raised by this conversion,
+// and also records them in c.Flags.
+// The conversion is the same as [Float256.ToInt256].
+func (c *Context) Int256(x Float256) (ints.Int256, Flags) {
+	ret, flags := x.ToInt256(c.Mode)
+	c.Flags |= flags
+	return ret, flags
+}
+
+// Uint256 converts x to an ints.Uint256, rounding according to c.Mode.
+// It returns the exception flags raised by this conversion,
+// and also records them in c.Flags.
+// The conversion is the same as [Float256.ToUint256].
+func (c *Context) Uint256(x Float256) (ints.Uint256, Flags) {
+	ret, flags := x.ToUint256(c.Mode)
+	c.Flags |= flags
+	return ret, flags
+}
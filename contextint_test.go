@@ -0,0 +1,40 @@
+package floats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+func TestContext_Int64(t *testing.T) {
+	c := &Context{Mode: ToPositiveInf}
+
+	// the flags of each call are returned, and accumulated in c.Flags.
+	if got, flags := c.Int64(NewFloat256(2.5)); got != 3 || flags != Inexact {
+		t.Errorf("Int64(2.5) = %d, %v, want 3, Inexact", got, flags)
+	}
+	if got, flags := c.Int64(NewFloat256(-42)); got != -42 || flags != 0 {
+		t.Errorf("Int64(-42) = %d, %v, want -42, 0", got, flags)
+	}
+	if got, flags := c.Int64(NewFloat256(0x1p63)); got != math.MaxInt64 || flags != Invalid {
+		t.Errorf("Int64(2**63) = %d, %v, want MaxInt64, Invalid", got, flags)
+	}
+	if c.Flags != Inexact|Invalid {
+		t.Errorf("c.Flags = %v, want Inexact|Invalid", c.Flags)
+	}
+}
+
+func TestContext_Uint256(t *testing.T) {
+	c := &Context{Mode: ToNegativeInf}
+	if got, flags := c.Uint256(NewFloat256(-0.25)); got != (ints.Uint256{}) || flags != Invalid {
+		t.Errorf("Uint256(-0.25) = %x, %v, want 0, Invalid", got, flags)
+	}
+	c.Mode = ToZero
+	if got, flags := c.Uint256(NewFloat256(-0.25)); got != (ints.Uint256{}) || flags != Inexact {
+		t.Errorf("Uint256(-0.25) = %x, %v, want 0, Inexact", got, flags)
+	}
+	if c.Flags != Inexact|Invalid {
+		t.Errorf("c.Flags = %v, want Inexact|Invalid", c.Flags)
+	}
+}
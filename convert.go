@@ -14,6 +14,9 @@ func (a Float16) Float16() Float16 {
 
 // Float32 converts a to a Float32.
 func (a Float16) Float32() Float32 {
+	if a.IsNaN() {
+		return nan32(a.Signbit(), a.nanPayload())
+	}
 	sign := uint32(a&signMask16) << (32 - 16)
 	exp := uint32(a>>shift16) & mask16
 	frac := uint32(a & fracMask16)
@@ -29,7 +32,7 @@ func (a Float16) Float32() Float32 {
 			exp = bias32 - (bias16 + shift16) + uint32(l)
 		}
 	} else if exp == mask16 {
-		// a is infinity or NaN
+		// a is infinity
 		exp = mask32
 	} else {
 		// a is normal number
@@ -42,6 +45,9 @@ func (a Float16) Float32() Float32 {
 
 // Float64 converts a to a Float64.
 func (a Float16) Float64() Float64 {
+	if a.IsNaN() {
+		return nan64(a.Signbit(), a.nanPayload())
+	}
 	sign := uint64(a&signMask16) << (64 - 16)
 	exp := uint64(a>>shift16) & mask16
 	frac := uint64(a & fracMask16)
@@ -57,7 +63,7 @@ func (a Float16) Float64() Float64 {
 			exp = bias64 - (bias16 + shift16) + uint64(l)
 		}
 	} else if exp == mask16 {
-		// a is infinity or NaN
+		// a is infinity
 		exp = mask64
 	} else {
 		// a is normal number
@@ -70,6 +76,9 @@ func (a Float16) Float64() Float64 {
 
 // Float128 converts a to a Float128.
 func (a Float16) Float128() Float128 {
+	if a.IsNaN() {
+		return nan128(a.Signbit(), a.nanPayload())
+	}
 	sign := uint64(a&signMask16) << (64 - 16)
 	exp := uint64(a>>shift16) & mask16
 	frac := uint64(a & fracMask16)
@@ -85,7 +94,7 @@ func (a Float16) Float128() Float128 {
 			exp = bias128 - (bias16 + shift16) + uint64(l)
 		}
 	} else if exp == mask16 {
-		// a is infinity or NaN
+		// a is infinity
 		exp = mask128
 	} else {
 		// a is normal number
@@ -98,6 +107,14 @@ func (a Float16) Float128() Float128 {
 
 // Float256 converts a to a Float256.
 func (a Float16) Float256() Float256 {
+	if a.IsNaN() {
+		// Float256 represents all NaNs exactly; signaling NaNs stay signaling.
+		ret := nan256(a.Signbit(), a.nanPayload())
+		if a.IsSignalingNaN() {
+			ret[0] &^= quietMask256[0]
+		}
+		return ret
+	}
 	sign := uint64(a&signMask16) << (64 - 16)
 	exp := uint64(a>>shift16) & mask16
 	frac := uint64(a & fracMask16)
@@ -113,7 +130,7 @@ func (a Float16) Float256() Float256 {
 			exp = bias256 - (bias16 + shift16) + uint64(l)
 		}
 	} else if exp == mask16 {
-		// a is infinity or NaN
+		// a is infinity
 		exp = mask256
 	} else {
 		// a is normal number
@@ -125,6 +142,44 @@ func (a Float16) Float256() Float256 {
 	return Float256{sign | exp | frac, 0, 0, 0}
 }
 
+// Float80 converts a to a Float80.
+func (a Float16) Float80() Float80 {
+	// Float16 is exactly representable in Float80.
+	ret, _ := a.Float256().float80(ToNearestEven)
+	return ret
+}
+
+// BFloat16 converts a to a BFloat16.
+func (a Float16) BFloat16() BFloat16 {
+	// Float16 is exactly representable in Float32,
+	// so it is rounded only once.
+	return a.Float32().BFloat16()
+}
+
+// Float8E4M3 converts a to a Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func (a Float16) Float8E4M3() Float8E4M3 {
+	return a.Float64().float8E4M3(false)
+}
+
+// Float8E4M3Sat converts a to a Float8E4M3 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±448.
+func (a Float16) Float8E4M3Sat() Float8E4M3 {
+	return a.Float64().float8E4M3(true)
+}
+
+// Float8E5M2 converts a to a Float8E5M2.
+// Values that are too large to be represented are converted to ±Inf.
+func (a Float16) Float8E5M2() Float8E5M2 {
+	return a.Float64().float8E5M2(false)
+}
+
+// Float8E5M2Sat converts a to a Float8E5M2 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±57344.
+func (a Float16) Float8E5M2Sat() Float8E5M2 {
+	return a.Float64().float8E5M2(true)
+}
+
 // Float16 converts a to a Float16.
 func (a Float32) Float16() Float16 {
 	b := math.Float32bits(float32(a))
@@ -139,7 +194,7 @@ func (a Float32) Float16() Float16 {
 			return Float16(sign | mask16<<shift16)
 		} else {
 			// a is NaN
-			return Float16(sign | uvnan16)
+			return nan16(a.Signbit(), a.nanPayload())
 		}
 	}
 
@@ -173,18 +228,24 @@ func (a Float32) Float32() Float32 {
 
 // Float64 converts a to a Float64.
 func (a Float32) Float64() Float64 {
+	if a.IsNaN() {
+		return nan64(a.Signbit(), a.nanPayload())
+	}
 	return Float64(a)
 }
 
 // Float128 converts a to a Float128.
 func (a Float32) Float128() Float128 {
+	if a.IsNaN() {
+		return nan128(a.Signbit(), a.nanPayload())
+	}
 	b := math.Float32bits(float32(a))
 	sign := uint64(b&signMask32) << (64 - 32)
 	exp := int((b >> shift32) & mask32)
 	frac := uint64(b & fracMask32)
 
 	if exp == mask32 {
-		// a is ±infinity or NaN
+		// a is ±infinity
 		return Float128{sign | mask128<<(shift128-64) | frac<<(shift128-shift32-64), 0}
 	} else if exp == 0 {
 		// a is subnormal
@@ -209,13 +270,21 @@ func (a Float32) Float128() Float128 {
 
 // Float256 converts a to a Float256.
 func (a Float32) Float256() Float256 {
+	if a.IsNaN() {
+		// Float256 represents all NaNs exactly; signaling NaNs stay signaling.
+		ret := nan256(a.Signbit(), a.nanPayload())
+		if a.IsSignalingNaN() {
+			ret[0] &^= quietMask256[0]
+		}
+		return ret
+	}
 	b := math.Float32bits(float32(a))
 	sign := uint64(b&signMask32) << (64 - 32)
 	exp := int((b >> shift32) & mask32)
 	frac := uint64(b & fracMask32)
 
 	if exp == mask32 {
-		// a is ±infinity or NaN
+		// a is ±infinity
 		return Float256{
 			sign | mask256<<(shift256-192) | frac<<(shift256-shift32-192),
 			0,
@@ -245,6 +314,52 @@ func (a Float32) Float256() Float256 {
 	}
 }
 
+// Float80 converts a to a Float80.
+func (a Float32) Float80() Float80 {
+	// Float32 is exactly representable in Float80.
+	ret, _ := a.Float256().float80(ToNearestEven)
+	return ret
+}
+
+// BFloat16 converts a to a BFloat16.
+func (a Float32) BFloat16() BFloat16 {
+	if a.IsNaN() {
+		return nanBF16(a.Signbit(), a.nanPayload())
+	}
+
+	// BFloat16 has the same exponent range as Float32,
+	// so rounding the lower half of the bits is enough.
+	// Overflow carries into the exponent and results in ±infinity.
+	b := math.Float32bits(float32(a))
+	const halfMinusULP = 1<<(shift32-shiftBF16-1) - 1
+	b += halfMinusULP + ((b >> (shift32 - shiftBF16)) & 1) // round to nearest even
+	return BFloat16(b >> (32 - 16))
+}
+
+// Float8E4M3 converts a to a Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func (a Float32) Float8E4M3() Float8E4M3 {
+	return a.Float64().float8E4M3(false)
+}
+
+// Float8E4M3Sat converts a to a Float8E4M3 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±448.
+func (a Float32) Float8E4M3Sat() Float8E4M3 {
+	return a.Float64().float8E4M3(true)
+}
+
+// Float8E5M2 converts a to a Float8E5M2.
+// Values that are too large to be represented are converted to ±Inf.
+func (a Float32) Float8E5M2() Float8E5M2 {
+	return a.Float64().float8E5M2(false)
+}
+
+// Float8E5M2Sat converts a to a Float8E5M2 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±57344.
+func (a Float32) Float8E5M2Sat() Float8E5M2 {
+	return a.Float64().float8E5M2(true)
+}
+
 // Float16 converts a to a Float16.
 func (a Float64) Float16() Float16 {
 	b := math.Float64bits(float64(a))
@@ -259,7 +374,7 @@ func (a Float64) Float16() Float16 {
 			return Float16(sign | mask16<<shift16)
 		} else {
 			// a is NaN
-			return Float16(sign | uvnan16)
+			return nan16(a.Signbit(), a.nanPayload())
 		}
 	}
 
@@ -288,6 +403,9 @@ func (a Float64) Float16() Float16 {
 
 // Float32 converts a to a Float32.
 func (a Float64) Float32() Float32 {
+	if a.IsNaN() {
+		return nan32(a.Signbit(), a.nanPayload())
+	}
 	return Float32(a)
 }
 
@@ -298,13 +416,16 @@ func (a Float64) Float64() Float64 {
 
 // Float128 converts a to a Float128.
 func (a Float64) Float128() Float128 {
+	if a.IsNaN() {
+		return nan128(a.Signbit(), a.nanPayload())
+	}
 	b := math.Float64bits(float64(a))
 	sign := uint64(b & signMask64)
 	exp := int((b >> shift64) & mask64)
 	frac := uint64(b & fracMask64)
 
 	if exp == mask64 {
-		// a is ±infinity or NaN
+		// a is ±infinity
 		return Float128{
 			sign | mask128<<(shift128-64) | frac>>(64-shift128+shift64),
 			frac << (shift128 - shift64),
@@ -331,13 +452,21 @@ func (a Float64) Float128() Float128 {
 
 // Float256 converts a to a Float256.
 func (a Float64) Float256() Float256 {
+	if a.IsNaN() {
+		// Float256 represents all NaNs exactly; signaling NaNs stay signaling.
+		ret := nan256(a.Signbit(), a.nanPayload())
+		if a.IsSignalingNaN() {
+			ret[0] &^= quietMask256[0]
+		}
+		return ret
+	}
 	b := math.Float64bits(float64(a))
 	sign := uint64(b & signMask64)
 	exp := int((b >> shift64) & mask64)
 	frac := uint64(b & fracMask64)
 
 	if exp == mask64 {
-		// a is ±infinity or NaN
+		// a is ±infinity
 		return Float256{
 			sign | mask256<<(shift256-192) | frac>>(192-shift256+shift64),
 			frac << (shift256 - shift64 - 128),
@@ -366,6 +495,169 @@ func (a Float64) Float256() Float256 {
 	}
 }
 
+// Float80 converts a to a Float80.
+func (a Float64) Float80() Float80 {
+	// Float64 is exactly representable in Float80.
+	ret, _ := a.Float256().float80(ToNearestEven)
+	return ret
+}
+
+// BFloat16 converts a to a BFloat16.
+func (a Float64) BFloat16() BFloat16 {
+	b := math.Float64bits(float64(a))
+	sign := uint16((b & signMask64) >> (64 - 16))
+	exp := int((b >> shift64) & mask64)
+
+	if exp == mask64 {
+		// a is ±infinity or NaN
+		frac := b & fracMask64
+		if frac == 0 {
+			// a is ±infinity
+			return BFloat16(sign | maskBF16<<shiftBF16)
+		} else {
+			// a is NaN
+			return nanBF16(a.Signbit(), a.nanPayload())
+		}
+	}
+
+	exp -= bias64
+	if exp <= -biasBF16 {
+		// the result is subnormal number
+		roundBit := -exp + shift64 - (biasBF16 + shiftBF16 - 1)
+		frac := (b & fracMask64) | (1 << shift64)
+		halfMinusULP := uint64(1<<(roundBit-1) - 1)
+		frac += halfMinusULP + ((frac >> uint(roundBit)) & 1) // round to nearest even
+		return BFloat16(sign | uint16(frac>>roundBit))
+	}
+
+	// the result is normal number
+	const halfMinusULP = 1<<(shift64-shiftBF16-1) - 1
+	b += halfMinusULP + ((b >> uint(shift64-shiftBF16)) & 1) // round to nearest even
+
+	exp16 := uint16((b>>shift64)&mask64) - bias64 + biasBF16
+	if exp16 >= maskBF16 {
+		// overflow
+		return BFloat16(sign | maskBF16<<shiftBF16)
+	}
+	frac16 := uint16(b>>(shift64-shiftBF16)) & fracMaskBF16
+	return BFloat16(sign | (exp16 << shiftBF16) | frac16)
+}
+
+// Float8E4M3 converts a to a Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func (a Float64) Float8E4M3() Float8E4M3 {
+	return a.float8E4M3(false)
+}
+
+// Float8E4M3Sat converts a to a Float8E4M3 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±448.
+func (a Float64) Float8E4M3Sat() Float8E4M3 {
+	return a.float8E4M3(true)
+}
+
+// Float8E5M2 converts a to a Float8E5M2.
+// Values that are too large to be represented are converted to ±Inf.
+func (a Float64) Float8E5M2() Float8E5M2 {
+	return a.float8E5M2(false)
+}
+
+// Float8E5M2Sat converts a to a Float8E5M2 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±57344.
+func (a Float64) Float8E5M2Sat() Float8E5M2 {
+	return a.float8E5M2(true)
+}
+
+// float8E4M3 converts a to a Float8E4M3, rounding to nearest even.
+// If saturate is true, overflows result in the largest finite value instead of NaN.
+func (a Float64) float8E4M3(saturate bool) Float8E4M3 {
+	b := math.Float64bits(float64(a))
+	sign := uint8((b & signMask64) >> (64 - 8))
+	exp := int((b >> shift64) & mask64)
+
+	if exp == mask64 {
+		// a is ±infinity or NaN
+		frac := b & fracMask64
+		if frac == 0 && saturate {
+			// a is ±infinity
+			return Float8E4M3(sign | uvmaxE4M3)
+		}
+		// Float8E4M3 has no infinities, and its NaN has no payload.
+		return nanE4M3(a.Signbit())
+	}
+
+	exp -= bias64
+	if exp <= -biasE4M3 {
+		// the result is subnormal number
+		roundBit := -exp + shift64 - (biasE4M3 + shiftE4M3 - 1)
+		frac := (b & fracMask64) | (1 << shift64)
+		halfMinusULP := uint64(1<<(roundBit-1) - 1)
+		frac += halfMinusULP + ((frac >> uint(roundBit)) & 1) // round to nearest even
+		return Float8E4M3(sign | uint8(frac>>roundBit))
+	}
+
+	// the result is normal number
+	const halfMinusULP = 1<<(shift64-shiftE4M3-1) - 1
+	b += halfMinusULP + ((b >> uint(shift64-shiftE4M3)) & 1) // round to nearest even
+
+	exp8 := int((b>>shift64)&mask64) - bias64 + biasE4M3
+	frac8 := uint8(b>>(shift64-shiftE4M3)) & fracMaskE4M3
+	if exp8 > maskE4M3 || exp8 == maskE4M3 && frac8 == fracMaskE4M3 {
+		// overflow
+		if saturate {
+			return Float8E4M3(sign | uvmaxE4M3)
+		}
+		return Float8E4M3(sign | uvnanE4M3)
+	}
+	return Float8E4M3(sign | uint8(exp8)<<shiftE4M3 | frac8)
+}
+
+// float8E5M2 converts a to a Float8E5M2, rounding to nearest even.
+// If saturate is true, overflows and infinities result in the largest finite value.
+func (a Float64) float8E5M2(saturate bool) Float8E5M2 {
+	b := math.Float64bits(float64(a))
+	sign := uint8((b & signMask64) >> (64 - 8))
+	exp := int((b >> shift64) & mask64)
+
+	if exp == mask64 {
+		// a is ±infinity or NaN
+		frac := b & fracMask64
+		if frac != 0 {
+			// a is NaN
+			return nanE5M2(a.Signbit(), a.nanPayload())
+		}
+		// a is ±infinity
+		if saturate {
+			return Float8E5M2(sign | uvmaxE5M2)
+		}
+		return Float8E5M2(sign | uvinfE5M2)
+	}
+
+	exp -= bias64
+	if exp <= -biasE5M2 {
+		// the result is subnormal number
+		roundBit := -exp + shift64 - (biasE5M2 + shiftE5M2 - 1)
+		frac := (b & fracMask64) | (1 << shift64)
+		halfMinusULP := uint64(1<<(roundBit-1) - 1)
+		frac += halfMinusULP + ((frac >> uint(roundBit)) & 1) // round to nearest even
+		return Float8E5M2(sign | uint8(frac>>roundBit))
+	}
+
+	// the result is normal number
+	const halfMinusULP = 1<<(shift64-shiftE5M2-1) - 1
+	b += halfMinusULP + ((b >> uint(shift64-shiftE5M2)) & 1) // round to nearest even
+
+	exp8 := int((b>>shift64)&mask64) - bias64 + biasE5M2
+	if exp8 >= maskE5M2 {
+		// overflow
+		if saturate {
+			return Float8E5M2(sign | uvmaxE5M2)
+		}
+		return Float8E5M2(sign | uvinfE5M2)
+	}
+	frac8 := uint8(b>>(shift64-shiftE5M2)) & fracMaskE5M2
+	return Float8E5M2(sign | uint8(exp8)<<shiftE5M2 | frac8)
+}
+
 // Float16 converts a to a Float16.
 func (a Float128) Float16() Float16 {
 	sign := uint16((a[0] & signMask128[0]) >> (64 - 16))
@@ -379,7 +671,7 @@ func (a Float128) Float16() Float16 {
 			return Float16(sign | mask16<<shift16)
 		} else {
 			// a is NaN
-			return Float16(sign | uvnan16)
+			return nan16(a.Signbit(), a.nanPayload())
 		}
 	}
 
@@ -424,7 +716,7 @@ func (a Float128) Float32() Float32 {
 			return Float32(math.Float32frombits(sign | mask32<<shift32))
 		} else {
 			// a is NaN
-			return Float32(math.Float32frombits(sign | uvnan32))
+			return nan32(a.Signbit(), a.nanPayload())
 		}
 	}
 
@@ -470,7 +762,7 @@ func (a Float128) Float64() Float64 {
 			return Float64(math.Float64frombits(sign | mask64<<shift64))
 		} else {
 			// a is NaN
-			return Float64(math.Float64frombits(sign | uvnan64))
+			return nan64(a.Signbit(), a.nanPayload())
 		}
 	}
 
@@ -508,13 +800,21 @@ func (a Float128) Float128() Float128 {
 
 // Float256 converts a to a Float256.
 func (a Float128) Float256() Float256 {
+	if a.IsNaN() {
+		// Float256 represents all NaNs exactly; signaling NaNs stay signaling.
+		ret := nan256(a.Signbit(), a.nanPayload())
+		if a.IsSignalingNaN() {
+			ret[0] &^= quietMask256[0]
+		}
+		return ret
+	}
 	b := ints.Uint128(a)
 	sign := b[0] & signMask128[0]
 	exp := int((b[0] >> (shift128 - 64)) & mask128)
 	frac := b.And(fracMask128)
 
 	if exp == mask128 {
-		// a is ±infinity or NaN
+		// a is ±infinity
 		frac256 := frac.Uint256().Lsh(shift256 - shift128)
 		return Float256{
 			sign | mask256<<(shift256-192) | frac256[0],
@@ -545,6 +845,42 @@ func (a Float128) Float256() Float256 {
 	}
 }
 
+// Float80 converts a to a Float80.
+func (a Float128) Float80() Float80 {
+	ret, _ := a.Float256().float80(ToNearestEven)
+	return ret
+}
+
+// BFloat16 converts a to a BFloat16.
+func (a Float128) BFloat16() BFloat16 {
+	ret, _ := a.Float256().bfloat16(ToNearestEven)
+	return ret
+}
+
+// Float8E4M3 converts a to a Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func (a Float128) Float8E4M3() Float8E4M3 {
+	return a.Float256().Float8E4M3()
+}
+
+// Float8E4M3Sat converts a to a Float8E4M3 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±448.
+func (a Float128) Float8E4M3Sat() Float8E4M3 {
+	return a.Float256().Float8E4M3Sat()
+}
+
+// Float8E5M2 converts a to a Float8E5M2.
+// Values that are too large to be represented are converted to ±Inf.
+func (a Float128) Float8E5M2() Float8E5M2 {
+	return a.Float256().Float8E5M2()
+}
+
+// Float8E5M2Sat converts a to a Float8E5M2 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±57344.
+func (a Float128) Float8E5M2Sat() Float8E5M2 {
+	return a.Float256().Float8E5M2Sat()
+}
+
 // Float16 converts a to a Float16.
 func (a Float256) Float16() Float16 {
 	sign := uint16((a[0] & signMask256[0]) >> (64 - 16))
@@ -558,21 +894,15 @@ func (a Float256) Float16() Float16 {
 			return Float16(sign | mask16<<shift16)
 		} else {
 			// a is NaN
-			return Float16(sign | uvnan16)
+			return nan16(a.Signbit(), a.nanPayload())
 		}
 	}
 
 	exp -= bias256
 	if exp <= -bias16 {
 		// the result is subnormal number
-		frac := ints.Uint256(a).And(fracMask256)
-		frac[0] |= (1 << (shift256 - 192))
-		// round to nearest even
-		roundBit := -exp + shift256 - (bias16 + shift16 - 1) - 192
-		halfMinusULP := uint64(1<<(roundBit-1) - 1)
-		frac[0] |= nonzero64(frac[1]) | nonzero64(frac[2]) | nonzero64(frac[3])
-		frac[0] += halfMinusULP + ((a[0] >> uint(roundBit)) & 1)
-		return Float16(sign | uint16(frac[0]>>roundBit))
+		ret, _ := a.float16(ToNearestEven)
+		return ret
 	}
 
 	// the result is normal number
@@ -603,21 +933,15 @@ func (a Float256) Float32() Float32 {
 			return Float32(math.Float32frombits(sign | mask32<<shift32))
 		} else {
 			// a is NaN
-			return Float32(math.Float32frombits(sign | uvnan32))
+			return nan32(a.Signbit(), a.nanPayload())
 		}
 	}
 
 	exp -= bias256
 	if exp <= -bias32 {
 		// the result is subnormal number
-		frac := ints.Uint256(a).And(fracMask256)
-		frac[0] |= (1 << (shift256 - 192))
-		// round to nearest even
-		roundBit := -exp + shift256 - (bias32 + shift32 - 1) - 192
-		halfMinusULP := uint64(1<<(roundBit-1) - 1)
-		frac[0] |= nonzero64(frac[1]) | nonzero64(frac[2]) | nonzero64(frac[3])
-		frac[0] += halfMinusULP + ((a[0] >> uint(roundBit)) & 1)
-		return Float32(math.Float32frombits(sign | uint32(frac[0]>>uint(roundBit))))
+		ret, _ := a.float32(ToNearestEven)
+		return ret
 	}
 
 	// the result is normal number
@@ -649,21 +973,15 @@ func (a Float256) Float64() Float64 {
 			return Float64(math.Float64frombits(sign | mask64<<shift64))
 		} else {
 			// a is NaN
-			return Float64(math.Float64frombits(sign | uvnan64))
+			return nan64(a.Signbit(), a.nanPayload())
 		}
 	}
 
 	exp -= bias256
 	if exp <= -bias64 {
 		// the result is subnormal number
-		frac := b.And(fracMask256)
-		frac[0] |= (1 << (shift256 - 192))
-		// round to nearest even
-		roundBit := uint(-exp + shift256 - (bias64 + shift64 - 1))
-		halfMinusULP := ints.Uint256{0, 0, 0, 1}.Lsh(roundBit - 1).Sub(ints.Uint256{0, 0, 0, 1})
-		frac = frac.Add(halfMinusULP).Add(frac.Rsh(roundBit).And(ints.Uint256{0, 0, 0, 1}))
-		frac = frac.Rsh(roundBit)
-		return Float64(math.Float64frombits(sign | frac[1]))
+		ret, _ := a.float64(ToNearestEven)
+		return ret
 	}
 
 	// the result is normal number
@@ -694,24 +1012,15 @@ func (a Float256) Float128() Float128 {
 			return Float128{sign | mask128<<(shift128-64), 0}
 		} else {
 			// a is NaN
-			return Float128{sign | mask128<<(shift128-64) | 1<<(shift128-64-1), 0}
+			return nan128(a.Signbit(), a.nanPayload())
 		}
 	}
 
 	exp -= bias256
 	if exp <= -bias128 {
 		// the result is subnormal number
-		frac := b.And(fracMask256)
-		frac[0] |= (1 << (shift256 - 192))
-		// round to nearest even
-		roundBit := uint(-exp + shift256 - (bias128 + shift128 - 1))
-		halfMinusULP := ints.Uint256{0, 0, 0, 1}.Lsh(roundBit - 1).Sub(ints.Uint256{0, 0, 0, 1})
-		frac = frac.Add(halfMinusULP).Add(frac.Rsh(roundBit).And(ints.Uint256{0, 0, 0, 1}))
-		frac = frac.Rsh(roundBit)
-		return Float128{
-			sign | frac[2],
-			frac[3],
-		}
+		ret, _ := a.float128(ToNearestEven)
+		return ret
 	}
 
 	// the result is normal number
@@ -735,3 +1044,715 @@ func (a Float256) Float128() Float128 {
 func (a Float256) Float256() Float256 {
 	return a
 }
+
+// Float80 converts a to a Float80.
+func (a Float256) Float80() Float80 {
+	ret, _ := a.float80(ToNearestEven)
+	return ret
+}
+
+// BFloat16 converts a to a BFloat16.
+func (a Float256) BFloat16() BFloat16 {
+	ret, _ := a.bfloat16(ToNearestEven)
+	return ret
+}
+
+// Float8E4M3 converts a to a Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func (a Float256) Float8E4M3() Float8E4M3 {
+	return a.float64ToOdd().float8E4M3(false)
+}
+
+// Float8E4M3Sat converts a to a Float8E4M3 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±448.
+func (a Float256) Float8E4M3Sat() Float8E4M3 {
+	return a.float64ToOdd().float8E4M3(true)
+}
+
+// Float8E5M2 converts a to a Float8E5M2.
+// Values that are too large to be represented are converted to ±Inf.
+func (a Float256) Float8E5M2() Float8E5M2 {
+	return a.float64ToOdd().float8E5M2(false)
+}
+
+// Float8E5M2Sat converts a to a Float8E5M2 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±57344.
+func (a Float256) Float8E5M2Sat() Float8E5M2 {
+	return a.float64ToOdd().float8E5M2(true)
+}
+
+// float64ToOdd converts a to a Float64, rounding to odd.
+// Float64 has enough extra bits that rounding the result again
+// to an 8-bit format gives the correctly rounded value.
+func (a Float256) float64ToOdd() Float64 {
+	ret, _ := a.float64(ToNearestEven | toOdd)
+	return ret
+}
+
+// BFloat16 returns a itself.
+func (a BFloat16) BFloat16() BFloat16 {
+	return a
+}
+
+// Float16 converts a to a Float16.
+func (a BFloat16) Float16() Float16 {
+	// BFloat16 is exactly representable in Float32,
+	// so it is rounded only once.
+	return a.Float32().Float16()
+}
+
+// Float32 converts a to a Float32.
+func (a BFloat16) Float32() Float32 {
+	if a.IsNaN() {
+		return nan32(a.Signbit(), a.nanPayload())
+	}
+	return NewFloat32FromBits(uint32(a) << (32 - 16))
+}
+
+// Float64 converts a to a Float64.
+func (a BFloat16) Float64() Float64 {
+	return a.Float32().Float64()
+}
+
+// Float128 converts a to a Float128.
+func (a BFloat16) Float128() Float128 {
+	return a.Float32().Float128()
+}
+
+// Float256 converts a to a Float256.
+func (a BFloat16) Float256() Float256 {
+	if a.IsNaN() {
+		// Float256 represents all NaNs exactly; signaling NaNs stay signaling.
+		ret := nan256(a.Signbit(), a.nanPayload())
+		if a.IsSignalingNaN() {
+			ret[0] &^= quietMask256[0]
+		}
+		return ret
+	}
+	return a.Float32().Float256()
+}
+
+// Float80 converts a to a Float80.
+func (a BFloat16) Float80() Float80 {
+	// BFloat16 is exactly representable in Float80.
+	ret, _ := a.Float256().float80(ToNearestEven)
+	return ret
+}
+
+// Float8E4M3 converts a to a Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func (a BFloat16) Float8E4M3() Float8E4M3 {
+	return a.Float64().float8E4M3(false)
+}
+
+// Float8E4M3Sat converts a to a Float8E4M3 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±448.
+func (a BFloat16) Float8E4M3Sat() Float8E4M3 {
+	return a.Float64().float8E4M3(true)
+}
+
+// Float8E5M2 converts a to a Float8E5M2.
+// Values that are too large to be represented are converted to ±Inf.
+func (a BFloat16) Float8E5M2() Float8E5M2 {
+	return a.Float64().float8E5M2(false)
+}
+
+// Float8E5M2Sat converts a to a Float8E5M2 in the saturation mode.
+// Values that are too large to be represented, including infinities, are converted to ±57344.
+func (a BFloat16) Float8E5M2Sat() Float8E5M2 {
+	return a.Float64().float8E5M2(true)
+}
+
+// Float16 converts a to a Float16.
+func (a Float8E4M3) Float16() Float16 {
+	// Float8E4M3 is exactly representable in Float16.
+	return a.Float32().Float16()
+}
+
+// Float32 converts a to a Float32.
+func (a Float8E4M3) Float32() Float32 {
+	if a.IsNaN() {
+		return nan32(a.Signbit(), ints.Uint256{})
+	}
+	if a.IsZero() {
+		return NewFloat32FromBits(uint32(a) << (32 - 8))
+	}
+
+	sign, exp, frac := a.normalize()
+	b := uint32(sign) << (32 - 8)
+	b |= uint32(exp+bias32) << shift32
+	b |= uint32(frac&fracMaskE4M3) << (shift32 - shiftE4M3)
+	return NewFloat32FromBits(b)
+}
+
+// Float64 converts a to a Float64.
+func (a Float8E4M3) Float64() Float64 {
+	return a.Float32().Float64()
+}
+
+// Float16 converts a to a Float16.
+func (a Float8E5M2) Float16() Float16 {
+	if a.IsNaN() {
+		return nan16(a.Signbit(), a.nanPayload())
+	}
+	// Float8E5M2 has the same layout as the upper half of Float16.
+	return Float16(uint16(a) << (16 - 8))
+}
+
+// Float32 converts a to a Float32.
+func (a Float8E5M2) Float32() Float32 {
+	return a.Float16().Float32()
+}
+
+// Float64 converts a to a Float64.
+func (a Float8E5M2) Float64() Float64 {
+	return a.Float16().Float64()
+}
+
+// Float80 returns a itself.
+func (a Float80) Float80() Float80 {
+	return a
+}
+
+// Float16 converts a to a Float16.
+func (a Float80) Float16() Float16 {
+	ret, _ := a.Float256().float16(ToNearestEven)
+	return ret
+}
+
+// Float32 converts a to a Float32.
+func (a Float80) Float32() Float32 {
+	ret, _ := a.Float256().float32(ToNearestEven)
+	return ret
+}
+
+// Float64 converts a to a Float64.
+func (a Float80) Float64() Float64 {
+	ret, _ := a.Float256().float64(ToNearestEven)
+	return ret
+}
+
+// Float128 converts a to a Float128.
+func (a Float80) Float128() Float128 {
+	// Float80 is exactly representable in Float128.
+	ret, _ := a.Float256().float128(ToNearestEven)
+	return ret
+}
+
+// Float256 converts a to a Float256.
+// Pseudo-denormals are converted to their values,
+// and invalid encodings are converted to the default NaN.
+func (a Float80) Float256() Float256 {
+	if a.IsInvalid() {
+		return nan256(false, ints.Uint256{})
+	}
+	if a.IsNaN() {
+		// Float256 represents all NaNs exactly; signaling NaNs stay signaling.
+		ret := nan256(a.Signbit(), a.nanPayload())
+		if a.IsSignalingNaN() {
+			ret[0] &^= quietMask256[0]
+		}
+		return ret
+	}
+	if a.IsInf(1) {
+		return Float256(uvinf256)
+	} else if a.IsInf(-1) {
+		return Float256(uvneginf256)
+	}
+
+	sign, exp, frac := a.normalize()
+	sign256 := uint64(sign) << (64 - 16)
+	if frac == 0 {
+		// a is zero
+		return Float256{sign256, 0, 0, 0}
+	}
+
+	exp += bias256
+	frac256 := ints.Uint256{0, 0, 0, frac & fracMask80}.Lsh(shift256 - shift80)
+	return Float256{
+		sign256 | uint64(exp)<<(shift256-192) | frac256[0],
+		frac256[1],
+		frac256[2],
+		frac256[3],
+	}
+}
+
+// BFloat16 converts a to a BFloat16.
+func (a Float80) BFloat16() BFloat16 {
+	ret, _ := a.Float256().bfloat16(ToNearestEven)
+	return ret
+}
+
+// DoubleDouble converts a to a DoubleDouble.
+func (a Float64) DoubleDouble() DoubleDouble {
+	return DoubleDouble{float64(a), 0}
+}
+
+// QuadDouble converts a to a QuadDouble.
+func (a Float64) QuadDouble() QuadDouble {
+	return QuadDouble{float64(a), 0, 0, 0}
+}
+
+// DoubleDouble converts a to a DoubleDouble.
+func (a Float128) DoubleDouble() DoubleDouble {
+	// Float128 is exactly representable in Float256.
+	return a.Float256().DoubleDouble()
+}
+
+// QuadDouble converts a to a QuadDouble.
+func (a Float128) QuadDouble() QuadDouble {
+	// Float128 is exactly representable in Float256.
+	return a.Float256().QuadDouble()
+}
+
+// DoubleDouble converts a to a DoubleDouble.
+func (a Float256) DoubleDouble() DoubleDouble {
+	var ret DoubleDouble
+	a.splitFloat64(ret[:])
+	return ret
+}
+
+// QuadDouble converts a to a QuadDouble.
+func (a Float256) QuadDouble() QuadDouble {
+	var ret QuadDouble
+	a.splitFloat64(ret[:])
+	return ret
+}
+
+// splitFloat64 splits a into the non-overlapping float64 components.
+// Each component is the rest of a rounded to float64.
+func (a Float256) splitFloat64(dst []float64) {
+	for i := range dst {
+		f, _ := a.float64(ToNearestEven)
+		dst[i] = float64(f)
+		if i != 0 && f == 0 {
+			// a is fully consumed; keep the remaining components +0.
+			dst[i] = 0
+			return
+		}
+		if !isFinite(float64(f)) {
+			return
+		}
+		// the difference is exact.
+		a = a.Sub(f.Float256())
+	}
+}
+
+// float256 converts a to a Float256, rounding according to mode.
+func (a DoubleDouble) float256(mode RoundingMode) Float256 {
+	if a[0] == 0 || !isFinite(a[0]) {
+		return Float64(a[0]).Float256()
+	}
+	ret, _ := Float64(a[0]).Float256().add(Float64(a[1]).Float256(), mode)
+	return ret
+}
+
+// float256 converts a to a Float256, rounding according to mode.
+func (a QuadDouble) float256(mode RoundingMode) Float256 {
+	if a[0] == 0 || !isFinite(a[0]) {
+		return Float64(a[0]).Float256()
+	}
+
+	// The components are non-overlapping,
+	// so rounding to odd followed by the final rounding gives the correctly rounded sum.
+	ret := Float64(a[3]).Float256()
+	ret, _ = ret.add(Float64(a[2]).Float256(), toOdd)
+	ret, _ = ret.add(Float64(a[1]).Float256(), toOdd)
+	ret, _ = ret.add(Float64(a[0]).Float256(), mode)
+	return ret
+}
+
+// DoubleDouble returns a itself.
+func (a DoubleDouble) DoubleDouble() DoubleDouble {
+	return a
+}
+
+// QuadDouble converts a to a QuadDouble.
+func (a DoubleDouble) QuadDouble() QuadDouble {
+	return QuadDouble{a[0], a[1], 0, 0}
+}
+
+// Float64 converts a to a Float64.
+func (a DoubleDouble) Float64() Float64 {
+	ret, _ := a.float256(toOdd).float64(ToNearestEven)
+	return ret
+}
+
+// Float128 converts a to a Float128.
+func (a DoubleDouble) Float128() Float128 {
+	ret, _ := a.float256(toOdd).float128(ToNearestEven)
+	return ret
+}
+
+// Float256 converts a to a Float256.
+func (a DoubleDouble) Float256() Float256 {
+	return a.float256(ToNearestEven)
+}
+
+// QuadDouble returns a itself.
+func (a QuadDouble) QuadDouble() QuadDouble {
+	return a
+}
+
+// DoubleDouble converts a to a DoubleDouble.
+func (a QuadDouble) DoubleDouble() DoubleDouble {
+	return a.float256(toOdd).DoubleDouble()
+}
+
+// Float64 converts a to a Float64.
+func (a QuadDouble) Float64() Float64 {
+	ret, _ := a.float256(toOdd).float64(ToNearestEven)
+	return ret
+}
+
+// Float128 converts a to a Float128.
+func (a QuadDouble) Float128() Float128 {
+	ret, _ := a.float256(toOdd).float128(ToNearestEven)
+	return ret
+}
+
+// Float256 converts a to a Float256.
+func (a QuadDouble) Float256() Float256 {
+	return a.float256(ToNearestEven)
+}
+
+// roundBits rounds a to a binary interchange format that has shift fraction bits,
+// the exponent bias, and the all-ones exponent field mask, according to mode.
+// It returns the sign of a, the encoding of |a| in the format, and the raised exception flags.
+// a must not be NaN.
+func (a Float256) roundBits(shift uint, bias, mask int, mode RoundingMode) (neg bool, bits ints.Uint256, flags Flags) {
+	one := ints.Uint256{0, 0, 0, 1}
+	neg = a.Signbit()
+	if a.IsInf(0) {
+		return neg, ints.Uint256{0, 0, 0, uint64(mask)}.Lsh(shift), 0
+	}
+	if a.IsZero() {
+		return neg, ints.Uint256{}, 0
+	}
+
+	_, exp, frac := a.normalize()
+
+	// the exponent of the least significant bit of the result
+	lsb := exp - int(shift)
+	tiny := false
+	if minLSB := 1 - bias - int(shift); lsb < minLSB {
+		// the result is subnormal before rounding.
+		// it is tiny if it is still subnormal after rounding with unbounded exponent range.
+		tiny = true
+		if lsb == minLSB-1 {
+			q, _ := round256(frac, uint(lsb-(exp-shift256)), neg, mode)
+			tiny = q.BitLen() <= int(shift)+1
+		}
+		lsb = minLSB
+	}
+	frac, inexact := round256(frac, uint(lsb-(exp-shift256)), neg, mode)
+	if inexact {
+		flags |= Inexact
+		if tiny {
+			flags |= Underflow
+		}
+	}
+	if frac.BitLen() > int(shift)+1 {
+		// carry-out caused by rounding
+		frac = frac.Rsh(1)
+		lsb++
+	}
+
+	e := lsb + int(shift) + bias
+	if e >= mask {
+		// overflow
+		flags |= Overflow | Inexact
+		if mode.overflowToInf(neg) {
+			return neg, ints.Uint256{0, 0, 0, uint64(mask)}.Lsh(shift), flags
+		}
+		// the largest finite value
+		return neg, ints.Uint256{0, 0, 0, uint64(mask)}.Lsh(shift).Sub(one), flags
+	}
+	if frac.BitLen() <= int(shift) {
+		// the result is subnormal
+		e = 0
+	}
+	frac = frac.And(one.Lsh(shift).Sub(one))
+	return neg, frac.Or(ints.Uint256{0, 0, 0, uint64(e)}.Lsh(shift)), flags
+}
+
+// float16 converts a to a Float16, rounding according to mode.
+func (a Float256) float16(mode RoundingMode) (Float16, Flags) {
+	if a.IsNaN() {
+		return nan16(a.Signbit(), a.nanPayload()), nanFlags256(a, a)
+	}
+	neg, bits, flags := a.roundBits(shift16, bias16, mask16, mode)
+	ret := Float16(bits[3])
+	if neg {
+		ret |= signMask16
+	}
+	return ret, flags
+}
+
+// float32 converts a to a Float32, rounding according to mode.
+func (a Float256) float32(mode RoundingMode) (Float32, Flags) {
+	if a.IsNaN() {
+		return nan32(a.Signbit(), a.nanPayload()), nanFlags256(a, a)
+	}
+	neg, bits, flags := a.roundBits(shift32, bias32, mask32, mode)
+	ret := uint32(bits[3])
+	if neg {
+		ret |= signMask32
+	}
+	return NewFloat32FromBits(ret), flags
+}
+
+// float64 converts a to a Float64, rounding according to mode.
+func (a Float256) float64(mode RoundingMode) (Float64, Flags) {
+	if a.IsNaN() {
+		return nan64(a.Signbit(), a.nanPayload()), nanFlags256(a, a)
+	}
+	neg, bits, flags := a.roundBits(shift64, bias64, mask64, mode)
+	ret := bits[3]
+	if neg {
+		ret |= signMask64
+	}
+	return NewFloat64FromBits(ret), flags
+}
+
+// float128 converts a to a Float128, rounding according to mode.
+func (a Float256) float128(mode RoundingMode) (Float128, Flags) {
+	if a.IsNaN() {
+		return nan128(a.Signbit(), a.nanPayload()), nanFlags256(a, a)
+	}
+	neg, bits, flags := a.roundBits(shift128, bias128, mask128, mode)
+	ret := Float128{bits[2], bits[3]}
+	if neg {
+		ret[0] |= signMask128[0]
+	}
+	return ret, flags
+}
+
+// bfloat16 converts a to a BFloat16, rounding according to mode.
+func (a Float256) bfloat16(mode RoundingMode) (BFloat16, Flags) {
+	if a.IsNaN() {
+		return nanBF16(a.Signbit(), a.nanPayload()), nanFlags256(a, a)
+	}
+	neg, bits, flags := a.roundBits(shiftBF16, biasBF16, maskBF16, mode)
+	ret := BFloat16(bits[3])
+	if neg {
+		ret |= signMaskBF16
+	}
+	return ret, flags
+}
+
+// float80 converts a to a Float80, rounding according to mode.
+func (a Float256) float80(mode RoundingMode) (Float80, Flags) {
+	if a.IsNaN() {
+		return nan80(a.Signbit(), a.nanPayload()), nanFlags256(a, a)
+	}
+	neg, bits, flags := a.roundBits(shift80, bias80, mask80, mode)
+
+	// the explicit integer bit is set unless the result is subnormal or zero.
+	ret := Float80{
+		se:   uint16(bits[2]<<(64-shift80) | bits[3]>>shift80),
+		frac: bits[3] & fracMask80,
+	}
+	if ret.se != 0 {
+		ret.frac |= intBit80
+	}
+	if neg {
+		ret.se |= signMask80
+	}
+	return ret, flags
+}
+
+// Decimal32 converts a to a Decimal32, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float32) Decimal32() Decimal32 {
+	return pack32(decForm32.fromFloat256(a.Float256()))
+}
+
+// Decimal64 converts a to a Decimal64, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float32) Decimal64() Decimal64 {
+	return pack64(decForm64.fromFloat256(a.Float256()))
+}
+
+// Decimal128 converts a to a Decimal128, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float32) Decimal128() Decimal128 {
+	return pack128(decForm128.fromFloat256(a.Float256()))
+}
+
+// Decimal32 converts a to a Decimal32, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float64) Decimal32() Decimal32 {
+	return pack32(decForm32.fromFloat256(a.Float256()))
+}
+
+// Decimal64 converts a to a Decimal64, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float64) Decimal64() Decimal64 {
+	return pack64(decForm64.fromFloat256(a.Float256()))
+}
+
+// Decimal128 converts a to a Decimal128, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float64) Decimal128() Decimal128 {
+	return pack128(decForm128.fromFloat256(a.Float256()))
+}
+
+// Decimal32 converts a to a Decimal32, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float128) Decimal32() Decimal32 {
+	return pack32(decForm32.fromFloat256(a.Float256()))
+}
+
+// Decimal64 converts a to a Decimal64, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float128) Decimal64() Decimal64 {
+	return pack64(decForm64.fromFloat256(a.Float256()))
+}
+
+// Decimal128 converts a to a Decimal128, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float128) Decimal128() Decimal128 {
+	return pack128(decForm128.fromFloat256(a.Float256()))
+}
+
+// Decimal32 converts a to a Decimal32, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float256) Decimal32() Decimal32 {
+	return pack32(decForm32.fromFloat256(a))
+}
+
+// Decimal64 converts a to a Decimal64, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float256) Decimal64() Decimal64 {
+	return pack64(decForm64.fromFloat256(a))
+}
+
+// Decimal128 converts a to a Decimal128, rounding ties to even.
+// If the conversion is exact, the exponent closest to zero is chosen, e.g. 0.5 is 5×10^-1 and 100 is 100×10^0.
+func (a Float256) Decimal128() Decimal128 {
+	return pack128(decForm128.fromFloat256(a))
+}
+
+// Float16 converts a to a Float16, rounding ties to even.
+func (a Decimal32) Float16() Float16 {
+	return a.unpack().float16()
+}
+
+// Float32 converts a to a Float32, rounding ties to even.
+func (a Decimal32) Float32() Float32 {
+	return Float32(a.unpack().float64(32))
+}
+
+// Float64 converts a to a Float64, rounding ties to even.
+func (a Decimal32) Float64() Float64 {
+	return Float64(a.unpack().float64(64))
+}
+
+// Float128 converts a to a Float128, rounding ties to even.
+func (a Decimal32) Float128() Float128 {
+	return a.unpack().float128()
+}
+
+// Float256 converts a to a Float256, rounding ties to even.
+func (a Decimal32) Float256() Float256 {
+	return a.unpack().float256()
+}
+
+// Decimal32 returns a.
+func (a Decimal32) Decimal32() Decimal32 {
+	return a
+}
+
+// Decimal64 converts a to a Decimal64.
+// The conversion is exact and keeps the exponent.
+func (a Decimal32) Decimal64() Decimal64 {
+	return pack64(decForm64.fromDec(a.unpack()))
+}
+
+// Decimal128 converts a to a Decimal128.
+// The conversion is exact and keeps the exponent.
+func (a Decimal32) Decimal128() Decimal128 {
+	return pack128(decForm128.fromDec(a.unpack()))
+}
+
+// Float16 converts a to a Float16, rounding ties to even.
+func (a Decimal64) Float16() Float16 {
+	return a.unpack().float16()
+}
+
+// Float32 converts a to a Float32, rounding ties to even.
+func (a Decimal64) Float32() Float32 {
+	return Float32(a.unpack().float64(32))
+}
+
+// Float64 converts a to a Float64, rounding ties to even.
+func (a Decimal64) Float64() Float64 {
+	return Float64(a.unpack().float64(64))
+}
+
+// Float128 converts a to a Float128, rounding ties to even.
+func (a Decimal64) Float128() Float128 {
+	return a.unpack().float128()
+}
+
+// Float256 converts a to a Float256, rounding ties to even.
+func (a Decimal64) Float256() Float256 {
+	return a.unpack().float256()
+}
+
+// Decimal32 converts a to a Decimal32, rounding ties to even.
+func (a Decimal64) Decimal32() Decimal32 {
+	return pack32(decForm32.fromDec(a.unpack()))
+}
+
+// Decimal64 returns a.
+func (a Decimal64) Decimal64() Decimal64 {
+	return a
+}
+
+// Decimal128 converts a to a Decimal128.
+// The conversion is exact and keeps the exponent.
+func (a Decimal64) Decimal128() Decimal128 {
+	return pack128(decForm128.fromDec(a.unpack()))
+}
+
+// Float16 converts a to a Float16, rounding ties to even.
+func (a Decimal128) Float16() Float16 {
+	return a.unpack().float16()
+}
+
+// Float32 converts a to a Float32, rounding ties to even.
+func (a Decimal128) Float32() Float32 {
+	return Float32(a.unpack().float64(32))
+}
+
+// Float64 converts a to a Float64, rounding ties to even.
+func (a Decimal128) Float64() Float64 {
+	return Float64(a.unpack().float64(64))
+}
+
+// Float128 converts a to a Float128, rounding ties to even.
+func (a Decimal128) Float128() Float128 {
+	return a.unpack().float128()
+}
+
+// Float256 converts a to a Float256, rounding ties to even.
+func (a Decimal128) Float256() Float256 {
+	return a.unpack().float256()
+}
+
+// Decimal32 converts a to a Decimal32, rounding ties to even.
+func (a Decimal128) Decimal32() Decimal32 {
+	return pack32(decForm32.fromDec(a.unpack()))
+}
+
+// Decimal64 converts a to a Decimal64, rounding ties to even.
+func (a Decimal128) Decimal64() Decimal64 {
+	return pack64(decForm64.fromDec(a.unpack()))
+}
+
+// Decimal128 returns a.
+func (a Decimal128) Decimal128() Decimal128 {
+	return a
+}
@@ -6,6 +6,26 @@ import (
 	"testing"
 )
 
+// eqE4M3 reports whether a and b are equal.
+// It returns true if both a and b are NaN.
+// It distinguishes between +0 and -0.
+func eqE4M3(a, b Float8E4M3) bool {
+	if a.IsNaN() && b.IsNaN() {
+		return true
+	}
+	return a == b
+}
+
+// eqE5M2 reports whether a and b are equal.
+// It returns true if both a and b are NaN.
+// It distinguishes between +0 and -0.
+func eqE5M2(a, b Float8E5M2) bool {
+	if a.IsNaN() && b.IsNaN() {
+		return true
+	}
+	return a == b
+}
+
 // eq16 reports whether a and b are equal.
 // It returns true if both a and b are NaN.
 // It distinguishes between +0 and -0.
@@ -16,6 +36,16 @@ func eq16(a, b Float16) bool {
 	return a == b
 }
 
+// eqBF16 reports whether a and b are equal.
+// It returns true if both a and b are NaN.
+// It distinguishes between +0 and -0.
+func eqBF16(a, b BFloat16) bool {
+	if a.IsNaN() && b.IsNaN() {
+		return true
+	}
+	return a == b
+}
+
 // eq32 reports whether a and b are equal.
 // It returns true if both a and b are NaN.
 // It distinguishes between +0 and -0.
@@ -36,6 +66,46 @@ func eq64(a, b Float64) bool {
 	return math.Float64bits(float64(a)) == math.Float64bits(float64(b))
 }
 
+// eq80 reports whether a and b are equal.
+// It returns true if both a and b are NaN.
+// It distinguishes between +0 and -0.
+func eq80(a, b Float80) bool {
+	if a.IsNaN() && b.IsNaN() {
+		return true
+	}
+	return a == b
+}
+
+// eqDD reports whether a and b are equal.
+// It returns true if both a and b are NaN.
+// It distinguishes between +0 and -0.
+func eqDD(a, b DoubleDouble) bool {
+	if a.IsNaN() && b.IsNaN() {
+		return true
+	}
+	return eqComponents(a[:], b[:])
+}
+
+// eqQD reports whether a and b are equal.
+// It returns true if both a and b are NaN.
+// It distinguishes between +0 and -0.
+func eqQD(a, b QuadDouble) bool {
+	if a.IsNaN() && b.IsNaN() {
+		return true
+	}
+	return eqComponents(a[:], b[:])
+}
+
+// eqComponents reports whether the components of a and b have the same bits.
+func eqComponents(a, b []float64) bool {
+	for i := range a {
+		if math.Float64bits(a[i]) != math.Float64bits(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 // eq128 reports whether a and b are equal.
 // It returns true if both a and b are NaN.
 // It distinguishes between +0 and -0.
@@ -1433,6 +1503,26 @@ func TestFloat256_Float16(t *testing.T) {
 			},
 			want: 0x0202, // 0x1.01p-15
 		},
+		{
+			// 0x1p-25, halfway between zero and the smallest subnormal
+			in: Float256{
+				0x3ffe_6000_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+			},
+			want: 0x0000,
+		},
+		{
+			// 0x1.8p-24
+			in: Float256{
+				0x3ffe_7800_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+			},
+			want: 0x0002, // 0x1p-23
+		},
 
 		// overflow
 		{
@@ -1611,6 +1701,26 @@ func TestFloat256_Float32(t *testing.T) {
 			},
 			want: 0x1.000008p-127,
 		},
+		{
+			// 0x1p-150, halfway between zero and the smallest subnormal
+			in: Float256{
+				0x3ff6_9000_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+			},
+			want: 0,
+		},
+		{
+			// 0x1.8p-149
+			in: Float256{
+				0x3ff6_a800_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+				0x0000_0000_0000_0000,
+			},
+			want: 0x1p-148,
+		},
 
 		// overflow
 		{
@@ -1779,6 +1889,14 @@ func TestFloat256_Float64(t *testing.T) {
 			},
 			want: Float64(math.Inf(1)),
 		},
+
+		// subnormal numbers
+		{in: exact256(0x1p-1074), want: 0x1p-1074}, // smallest positive subnormal number
+		{in: exact256(-0x1.8p-1060), want: -0x1.8p-1060},
+		{in: exact256(0x1p-1074).Ldexp(-1), want: 0},         // ties to even
+		{in: exact256(0x3p-1074).Ldexp(-1), want: 0x1p-1073}, // ties to even
+		{in: exact256(0x1p-1074).Ldexp(-1000), want: 0},
+		{in: exact256(0x1p-1022).Sub(exact256(0x1p-1200)), want: 0x1p-1022},
 	}
 
 	for _, tt := range tests {
@@ -2023,6 +2141,13 @@ func TestFloat256_Float128(t *testing.T) {
 				0x0000_0000_0000_0000,
 			},
 		},
+
+		// subnormal numbers
+		{in: exact256(1).Ldexp(-16494), want: Float128{0, 1}}, // smallest positive subnormal number
+		{in: exact256(-1.5).Ldexp(-16480), want: Float128{0x8000_0000_0000_0000, 0x0000_0000_0000_6000}},
+		{in: exact256(1).Ldexp(-16495), want: Float128{0, 0}}, // ties to even
+		{in: exact256(3).Ldexp(-16495), want: Float128{0, 2}}, // ties to even
+		{in: exact256(1).Ldexp(-20000), want: Float128{0, 0}},
 	}
 
 	for _, tt := range tests {
@@ -2043,3 +2168,748 @@ func BenchmarkFloat256_Float128(b *testing.B) {
 		runtime.KeepAlive(f.Float128())
 	}
 }
+
+func TestConvert_NaNPayload(t *testing.T) {
+	// payload 42 fits in all formats
+	q16 := SetPayload16(exact16(42))
+	if got := q16.Float32().Payload(); !eq32(got, 42) {
+		t.Errorf("Float16.Float32().Payload() = %x, want 42", got)
+	}
+	if got := q16.Float64().Payload(); !eq64(got, 42) {
+		t.Errorf("Float16.Float64().Payload() = %x, want 42", got)
+	}
+	if got := q16.Float128().Payload(); !eq128(got, exact128(42)) {
+		t.Errorf("Float16.Float128().Payload() = %x, want 42", got)
+	}
+	if got := q16.Float256().Payload(); !eq256(got, exact256(42)) {
+		t.Errorf("Float16.Float256().Payload() = %x, want 42", got)
+	}
+	if got := q16.Float256().Float64().Float32().Float16(); got != q16 {
+		t.Errorf("round trip = %x, want %x", got, q16)
+	}
+
+	// the sign is preserved
+	neg := SetPayload128(exact128(42)).Neg()
+	if got := neg.Float16(); got != q16.Neg() {
+		t.Errorf("Float128.Float16() = %x, want %x", got, q16.Neg())
+	}
+
+	// payloads that don't fit are replaced by zero
+	q64 := SetPayload64(exact64(1 << 40))
+	if got := q64.Float16(); got != uvnan16 {
+		t.Errorf("Float64.Float16() = %x, want %x", got, uvnan16)
+	}
+	if got := q64.Float32(); got.Bits() != uvnan32 {
+		t.Errorf("Float64.Float32() = %x, want %x", got.Bits(), uvnan32)
+	}
+
+	// signaling NaNs are quieted, except for the exact conversion to Float256
+	s32 := SetPayloadSignaling32(exact32(7))
+	if got := s32.Float64(); got.IsSignalingNaN() || !eq64(got.Payload(), 7) {
+		t.Errorf("Float32.Float64() = %x, want quiet NaN with payload 7", got.Bits())
+	}
+	if got := s32.Float256(); !got.IsSignalingNaN() || !eq256(got.Payload(), exact256(7)) {
+		t.Errorf("Float32.Float256() = %x, want signaling NaN with payload 7", got)
+	}
+	if got := s32.Float256().Float32(); got.IsSignalingNaN() || !eq32(got.Payload(), 7) {
+		t.Errorf("Float256.Float32() = %x, want quiet NaN with payload 7", got.Bits())
+	}
+}
+
+func TestFloat32_BFloat16(t *testing.T) {
+	tests := []struct {
+		in   Float32
+		want BFloat16
+	}{
+		{0, 0x0000},
+		{Float32(math.Copysign(0, -1)), 0x8000},
+		{1, 0x3f80},
+		{-2, 0xc000},
+		{0x1p-133, 0x0001},             // smallest positive subnormal number
+		{0x1.fcp-127, 0x007f},          // largest positive subnormal number
+		{0x1p-126, 0x0080},             // smallest positive normal number
+		{0x1.fep+127, 0x7f7f},          // largest normal number
+		{0x1.01p+00, 0x3f80},           // round to nearest even (down)
+		{0x1.03p+00, 0x3f82},           // round to nearest even (up)
+		{0x1.010002p+00, 0x3f81},       // above the halfway point
+		{0x1.ffp+127, 0x7f80},          // overflow
+		{0x1p-134, 0x0000},             // underflow
+		{0x1.02p-134, 0x0001},          // above the halfway point of the smallest subnormal
+		{Float32(math.Inf(1)), 0x7f80}, // infinity
+		{Float32(math.Inf(-1)), 0xff80},
+		{Float32(math.NaN()), 0x7fc0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.BFloat16(); !eqBF16(got, tt.want) {
+			t.Errorf("Float32(%x).BFloat16() = %x, want %x", tt.in, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkFloat32_BFloat16(b *testing.B) {
+	f := Float32(1.0)
+	for b.Loop() {
+		runtime.KeepAlive(f.BFloat16())
+	}
+}
+
+func TestBFloat16_Conversions(t *testing.T) {
+	// every bfloat16 value survives a round trip through the wider formats
+	for i := range 1 << 16 {
+		a := BFloat16(i)
+		if a.IsNaN() {
+			continue
+		}
+		if got := a.Float32().BFloat16(); got != a {
+			t.Errorf("Float32 round trip of %x = %x", a, got)
+		}
+		if got := a.Float64().BFloat16(); got != a {
+			t.Errorf("Float64 round trip of %x = %x", a, got)
+		}
+		if got := a.Float128().BFloat16(); got != a {
+			t.Errorf("Float128 round trip of %x = %x", a, got)
+		}
+		if got := a.Float256().BFloat16(); got != a {
+			t.Errorf("Float256 round trip of %x = %x", a, got)
+		}
+		if got := a.Float80().BFloat16(); got != a {
+			t.Errorf("Float80 round trip of %x = %x", a, got)
+		}
+	}
+
+	// BFloat16 is exactly representable in Float32, so the 8-bit conversions agree with Float32.
+	for i := range 1 << 16 {
+		a := BFloat16(i)
+		f := a.Float32()
+		if got, want := a.Float8E4M3(), f.Float8E4M3(); !eqE4M3(got, want) {
+			t.Errorf("BFloat16(%x).Float8E4M3() = %x, want %x", a, got, want)
+		}
+		if got, want := a.Float8E4M3Sat(), f.Float8E4M3Sat(); !eqE4M3(got, want) {
+			t.Errorf("BFloat16(%x).Float8E4M3Sat() = %x, want %x", a, got, want)
+		}
+		if got, want := a.Float8E5M2(), f.Float8E5M2(); !eqE5M2(got, want) {
+			t.Errorf("BFloat16(%x).Float8E5M2() = %x, want %x", a, got, want)
+		}
+		if got, want := a.Float8E5M2Sat(), f.Float8E5M2Sat(); !eqE5M2(got, want) {
+			t.Errorf("BFloat16(%x).Float8E5M2Sat() = %x, want %x", a, got, want)
+		}
+	}
+
+	// the wider formats agree with Float32.BFloat16
+	for i := range 1 << 16 {
+		for _, lo := range []uint32{0x0001, 0x7fff, 0x8000, 0x8001, 0xffff} {
+			f := NewFloat32FromBits(uint32(i)<<16 | lo)
+			if f.IsNaN() {
+				continue
+			}
+			want := f.BFloat16()
+			if got := f.Float64().BFloat16(); got != want {
+				t.Errorf("Float64(%x).BFloat16() = %x, want %x", f, got, want)
+			}
+			if got := f.Float128().BFloat16(); got != want {
+				t.Errorf("Float128(%x).BFloat16() = %x, want %x", f, got, want)
+			}
+			if got := f.Float256().BFloat16(); got != want {
+				t.Errorf("Float256(%x).BFloat16() = %x, want %x", f, got, want)
+			}
+		}
+	}
+
+	// Float16 to BFloat16 may lose precision
+	if got := NewFloat16(1.0 + 1.0/1024).BFloat16(); got != 0x3f80 {
+		t.Errorf("Float16.BFloat16() = %x, want %x", got, 0x3f80)
+	}
+	if got := BFloat16(0x3f80).Float16(); got != 0x3c00 {
+		t.Errorf("BFloat16.Float16() = %x, want %x", got, 0x3c00)
+	}
+	if got := BFloat16(0x7f7f).Float16(); got != 0x7c00 {
+		t.Errorf("BFloat16.Float16() = %x, want %x", got, 0x7c00)
+	}
+}
+
+func TestConvert_NaNPayloadBF16(t *testing.T) {
+	q := SetPayloadBF16(exactBF16(42))
+	if got := q.Float32().Payload(); !eq32(got, 42) {
+		t.Errorf("BFloat16.Float32().Payload() = %x, want 42", got)
+	}
+	if got := q.Float256().Float16().BFloat16(); got != q {
+		t.Errorf("round trip = %x, want %x", got, q)
+	}
+	if got := SetPayload32(exact32(1 << 10)).BFloat16(); got != uvnanBF16 {
+		t.Errorf("Float32.BFloat16() = %x, want %x", got, uvnanBF16)
+	}
+	s := SetPayloadSignalingBF16(exactBF16(7))
+	if got := s.Float256(); !got.IsSignalingNaN() || !eq256(got.Payload(), exact256(7)) {
+		t.Errorf("BFloat16.Float256() = %x, want signaling NaN with payload 7", got)
+	}
+	if got := s.Float64(); got.IsSignalingNaN() || !eq64(got.Payload(), 7) {
+		t.Errorf("BFloat16.Float64() = %x, want quiet NaN with payload 7", got.Bits())
+	}
+}
+
+func TestFloat64_Float8E4M3(t *testing.T) {
+	tests := []struct {
+		in        Float64
+		want, sat Float8E4M3
+	}{
+		{0, 0x00, 0x00},
+		{Float64(math.Copysign(0, -1)), 0x80, 0x80},
+		{1, 0x38, 0x38},
+		{-2, 0xc0, 0xc0},
+		{0x1p-9, 0x01, 0x01},    // smallest positive subnormal number
+		{0x1.cp-7, 0x07, 0x07},  // largest positive subnormal number
+		{0x1p-6, 0x08, 0x08},    // smallest positive normal number
+		{256, 0x78, 0x78},       // the exponent 1111 is used for normal numbers
+		{448, 0x7e, 0x7e},       // largest normal number
+		{0x1.1p0, 0x38, 0x38},   // round to nearest even (down)
+		{0x1.3p0, 0x3a, 0x3a},   // round to nearest even (up)
+		{0x1p-10, 0x00, 0x00},   // underflow
+		{0x1.8p-10, 0x01, 0x01}, // above the halfway point of the smallest subnormal
+		{464, 0x7e, 0x7e},       // halfway between 448 and 480 rounds to even
+		{464.5, 0x7f, 0x7e},     // overflow
+		{-1e10, 0xff, 0xfe},
+		{Float64(math.Inf(1)), 0x7f, 0x7e},
+		{Float64(math.Inf(-1)), 0xff, 0xfe},
+		{Float64(math.NaN()), 0x7f, 0x7f},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.Float8E4M3(); !eqE4M3(got, tt.want) || got.Signbit() != tt.want.Signbit() {
+			t.Errorf("Float64(%x).Float8E4M3() = %x, want %x", tt.in, got, tt.want)
+		}
+		if got := tt.in.Float8E4M3Sat(); !eqE4M3(got, tt.sat) || got.Signbit() != tt.sat.Signbit() {
+			t.Errorf("Float64(%x).Float8E4M3Sat() = %x, want %x", tt.in, got, tt.sat)
+		}
+		if f32 := tt.in.Float32(); f32.Float64() == tt.in {
+			if got := f32.Float8E4M3(); !eqE4M3(got, tt.want) {
+				t.Errorf("Float32(%x).Float8E4M3() = %x, want %x", f32, got, tt.want)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat64_Float8E4M3(b *testing.B) {
+	f := Float64(1.0)
+	for b.Loop() {
+		runtime.KeepAlive(f.Float8E4M3())
+	}
+}
+
+func TestFloat64_Float8E5M2(t *testing.T) {
+	tests := []struct {
+		in        Float64
+		want, sat Float8E5M2
+	}{
+		{0, 0x00, 0x00},
+		{Float64(math.Copysign(0, -1)), 0x80, 0x80},
+		{1, 0x3c, 0x3c},
+		{-2, 0xc0, 0xc0},
+		{0x1p-16, 0x01, 0x01},   // smallest positive subnormal number
+		{0x1.8p-15, 0x03, 0x03}, // largest positive subnormal number
+		{0x1p-14, 0x04, 0x04},   // smallest positive normal number
+		{57344, 0x7b, 0x7b},     // largest normal number
+		{0x1.2p0, 0x3c, 0x3c},   // round to nearest even (down)
+		{0x1.6p0, 0x3e, 0x3e},   // round to nearest even (up)
+		{0x1p-17, 0x00, 0x00},   // underflow
+		{0x1.8p-17, 0x01, 0x01}, // above the halfway point of the smallest subnormal
+		{61439, 0x7b, 0x7b},     // below the halfway point of the largest normal number and 2**16
+		{61440, 0x7c, 0x7b},     // overflow
+		{-1e10, 0xfc, 0xfb},
+		{Float64(math.Inf(1)), 0x7c, 0x7b},
+		{Float64(math.Inf(-1)), 0xfc, 0xfb},
+		{Float64(math.NaN()), 0x7e, 0x7e},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.Float8E5M2(); !eqE5M2(got, tt.want) {
+			t.Errorf("Float64(%x).Float8E5M2() = %x, want %x", tt.in, got, tt.want)
+		}
+		if got := tt.in.Float8E5M2Sat(); !eqE5M2(got, tt.sat) {
+			t.Errorf("Float64(%x).Float8E5M2Sat() = %x, want %x", tt.in, got, tt.sat)
+		}
+		if f16 := tt.in.Float16(); f16.Float64() == tt.in {
+			if got := f16.Float8E5M2(); !eqE5M2(got, tt.want) {
+				t.Errorf("Float16(%x).Float8E5M2() = %x, want %x", f16, got, tt.want)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat64_Float8E5M2(b *testing.B) {
+	f := Float64(1.0)
+	for b.Loop() {
+		runtime.KeepAlive(f.Float8E5M2())
+	}
+}
+
+func TestFloat256_Float8(t *testing.T) {
+	one := NewFloat256(1)
+	tests := []struct {
+		in   Float256
+		e4m3 Float8E4M3
+		e5m2 Float8E5M2
+	}{
+		{NewFloat256(1), 0x38, 0x3c},
+		{NewFloat256(-448), 0xfe, 0xdf},
+
+		// just above the halfway points, where rounding through Float64 would round down.
+		{one.Add(one.Ldexp(-4)).Add(one.Ldexp(-60)), 0x39, 0x3c},
+		{one.Add(one.Ldexp(-3)).Add(one.Ldexp(-60)), 0x39, 0x3d},
+
+		// just below the halfway points, where rounding through Float64 would round up.
+		{one.Add(one.Ldexp(-4).Mul(NewFloat256(3))).Sub(one.Ldexp(-60)), 0x39, 0x3d},
+
+		{one.Ldexp(1000), 0x7f, 0x7c},
+		{NewFloat256NaN(), 0x7f, 0x7e},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.Float8E4M3(); got != tt.e4m3 {
+			t.Errorf("Float256(%v).Float8E4M3() = %x, want %x", tt.in, got, tt.e4m3)
+		}
+		if got := tt.in.Float128().Float8E4M3(); tt.in.Float128().Float256() == tt.in && got != tt.e4m3 {
+			t.Errorf("Float128(%v).Float8E4M3() = %x, want %x", tt.in, got, tt.e4m3)
+		}
+		if got := tt.in.Float8E5M2(); got != tt.e5m2 {
+			t.Errorf("Float256(%v).Float8E5M2() = %x, want %x", tt.in, got, tt.e5m2)
+		}
+	}
+
+	if got := one.Ldexp(1000).Float8E4M3Sat(); got != 0x7e {
+		t.Errorf("Float256(2**1000).Float8E4M3Sat() = %x, want %x", got, 0x7e)
+	}
+	if got := one.Ldexp(1000).Neg().Float8E5M2Sat(); got != 0xfb {
+		t.Errorf("Float256(-2**1000).Float8E5M2Sat() = %x, want %x", got, 0xfb)
+	}
+}
+
+func TestFloat8_Conversions(t *testing.T) {
+	// every float8 value survives a round trip through the wider formats
+	for i := range 1 << 8 {
+		a := Float8E4M3(i)
+		if a.IsNaN() {
+			continue
+		}
+		if got := a.Float16().Float8E4M3(); got != a {
+			t.Errorf("Float16 round trip of %x = %x", a, got)
+		}
+		if got := a.Float32().Float8E4M3(); got != a {
+			t.Errorf("Float32 round trip of %x = %x", a, got)
+		}
+		if got := a.Float64().Float8E4M3(); got != a {
+			t.Errorf("Float64 round trip of %x = %x", a, got)
+		}
+	}
+	for i := range 1 << 8 {
+		a := Float8E5M2(i)
+		if a.IsNaN() {
+			continue
+		}
+		if got := a.Float16().Float8E5M2(); got != a {
+			t.Errorf("Float16 round trip of %x = %x", a, got)
+		}
+		if got := a.Float32().Float8E5M2(); got != a {
+			t.Errorf("Float32 round trip of %x = %x", a, got)
+		}
+		if got := a.Float64().Float8E5M2(); got != a {
+			t.Errorf("Float64 round trip of %x = %x", a, got)
+		}
+	}
+
+	// Float8E5M2 is the upper half of Float16.
+	for i := range 1 << 8 {
+		a := Float16(i << 8)
+		if a.IsNaN() {
+			continue
+		}
+		if got := a.Float8E5M2(); got != Float8E5M2(i) {
+			t.Errorf("Float16(%x).Float8E5M2() = %x, want %x", a, got, i)
+		}
+	}
+}
+
+func TestConvert_NaNPayloadFloat8(t *testing.T) {
+	// Float8E5M2 has a 1-bit payload
+	q16 := SetPayload16(exact16(1))
+	if got := q16.Float8E5M2(); got != 0x7f {
+		t.Errorf("Float16.Float8E5M2() = %x, want %x", got, 0x7f)
+	}
+	if got := q16.Float8E5M2().Float32().Payload(); !eq32(got, 1) {
+		t.Errorf("Float8E5M2.Float32().Payload() = %x, want 1", got)
+	}
+	if got := SetPayload16(exact16(2)).Float8E5M2(); got != uvnanE5M2 {
+		t.Errorf("Float16.Float8E5M2() = %x, want %x", got, uvnanE5M2)
+	}
+
+	// Float8E4M3 has no payload, and keeps the sign
+	if got := q16.Neg().Float8E4M3(); got != 0xff {
+		t.Errorf("Float16.Float8E4M3() = %x, want %x", got, 0xff)
+	}
+	if got := Float8E4M3(0xff).Float32(); !got.IsNaN() || !got.Signbit() || !eq32(got.Payload(), 0) {
+		t.Errorf("Float8E4M3.Float32() = %x, want -NaN", got.Bits())
+	}
+}
+
+func TestFloat64_Float80(t *testing.T) {
+	tests := []struct {
+		in   Float64
+		se   uint16
+		frac uint64
+	}{
+		{0, 0x0000, 0},
+		{Float64(math.Copysign(0, -1)), 0x8000, 0},
+		{1, 0x3fff, 0x8000_0000_0000_0000},
+		{-2.5, 0xc000, 0xa000_0000_0000_0000},
+		{0.1, 0x3ffb, 0xcccc_cccc_cccc_d000},
+		{math.MaxFloat64, 0x43fe, 0xffff_ffff_ffff_f800},
+		{0x1p-1022, 0x3c01, 0x8000_0000_0000_0000},                   // min normal float64
+		{math.SmallestNonzeroFloat64, 0x3bcd, 0x8000_0000_0000_0000}, // float64 subnormals are normal in Float80
+		{Float64(math.Inf(1)), 0x7fff, 0x8000_0000_0000_0000},
+		{Float64(math.Inf(-1)), 0xffff, 0x8000_0000_0000_0000},
+		{Float64(math.NaN()), 0x7fff, 0xc000_0000_0000_0001}, // math.NaN has the payload 1
+	}
+
+	for _, tt := range tests {
+		got := tt.in.Float80()
+		if se, frac := got.Bits(); se != tt.se || frac != tt.frac {
+			t.Errorf("Float64(%x).Float80() = %04x_%016x, want %04x_%016x", tt.in, se, frac, tt.se, tt.frac)
+		}
+		if back := got.Float64(); !eq64(back, tt.in) {
+			t.Errorf("Float80(%x).Float64() = %x, want %x", got, back, tt.in)
+		}
+	}
+}
+
+func TestFloat80_Float64(t *testing.T) {
+	tests := []struct {
+		se   uint16
+		frac uint64
+		want Float64
+	}{
+		// rounding to nearest even
+		{0x3fff, 0x8000_0000_0000_0400, 1},                    // 1 + 2**-53
+		{0x3fff, 0x8000_0000_0000_0401, 1 + 0x1p-52},          // 1 + 2**-53 + 2**-63
+		{0x3fff, 0x8000_0000_0000_0c00, 1 + 0x1p-51},          // 1 + 3 * 2**-53
+		{0x3fff, 0xffff_ffff_ffff_ffff, 2},                    // 2 - 2**-63
+		{0x43fe, 0xffff_ffff_ffff_fc00, Float64(math.Inf(1))}, // overflow
+		{0xc3ff, 0x8000_0000_0000_0000, Float64(math.Inf(-1))},
+
+		// subnormal results
+		{0x3bcd, 0x8000_0000_0000_0000, math.SmallestNonzeroFloat64},
+		{0x3bcc, 0x8000_0000_0000_0000, 0},                           // 2**-1075 rounds to even
+		{0x3bcc, 0x8000_0000_0000_0001, math.SmallestNonzeroFloat64}, // just above the half
+		{0x0000, 0x0000_0000_0000_0001, 0},
+
+		// pseudo-denormal
+		{0x0000, 0x8000_0000_0000_0000, 0},
+	}
+
+	for _, tt := range tests {
+		a := NewFloat80FromBits(tt.se, tt.frac)
+		if got := a.Float64(); !eq64(got, tt.want) {
+			t.Errorf("Float80(%04x_%016x).Float64() = %x, want %x", tt.se, tt.frac, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_Float128(t *testing.T) {
+	tests := []struct {
+		se   uint16
+		frac uint64
+		want Float128
+	}{
+		{0x3fff, 0x8000_0000_0000_0000, Float128{0x3fff_0000_0000_0000, 0}},
+		{0xbfff, 0xffff_ffff_ffff_ffff, Float128{0xbfff_ffff_ffff_ffff, 0xfffe_0000_0000_0000}},
+		{0x7ffe, 0xffff_ffff_ffff_ffff, Float128{0x7ffe_ffff_ffff_ffff, 0xfffe_0000_0000_0000}}, // max finite
+		{0x0001, 0x8000_0000_0000_0000, Float128{0x0001_0000_0000_0000, 0}},                     // min normal
+		{0x0000, 0x4000_0000_0000_0000, Float128{0x0000_8000_0000_0000, 0}},                     // max subnormal / 2
+		{0x0000, 0x0000_0000_0000_0001, Float128{0x0000_0000_0000_0000, 0x0002_0000_0000_0000}}, // min subnormal
+		{0x7fff, 0x8000_0000_0000_0000, Float128{0x7fff_0000_0000_0000, 0}},                     // +Inf
+
+		// pseudo-denormal has the same value as the normal number with the exponent one
+		{0x0000, 0x8000_0000_0000_0000, Float128{0x0001_0000_0000_0000, 0}},
+		{0x8000, 0xc000_0000_0000_0000, Float128{0x8001_8000_0000_0000, 0}},
+
+		// pseudo-infinity, pseudo-NaN, and unnormal are converted to the default NaN
+		{0x7fff, 0x0000_0000_0000_0000, Float128{0x7fff_8000_0000_0000, 0}},
+		{0xffff, 0x4000_0000_0000_0001, Float128{0x7fff_8000_0000_0000, 0}},
+		{0x3fff, 0x4000_0000_0000_0000, Float128{0x7fff_8000_0000_0000, 0}},
+		{0x0001, 0x0000_0000_0000_0000, Float128{0x7fff_8000_0000_0000, 0}},
+	}
+
+	for _, tt := range tests {
+		a := NewFloat80FromBits(tt.se, tt.frac)
+		got := a.Float128()
+		if got != tt.want {
+			t.Errorf("Float80(%04x_%016x).Float128() = %x, want %x", tt.se, tt.frac, got, tt.want)
+		}
+	}
+
+	// Float128 to Float80 rounds to nearest even
+	roundTests := []struct {
+		in   Float128
+		se   uint16
+		frac uint64
+	}{
+		{Float128{0x3fff_0000_0000_0000, 0x0001_0000_0000_0000}, 0x3fff, 0x8000_0000_0000_0000}, // 1 + 2**-64
+		{Float128{0x3fff_0000_0000_0000, 0x0001_0000_0000_0001}, 0x3fff, 0x8000_0000_0000_0001},
+		{Float128{0x3fff_0000_0000_0000, 0x0003_0000_0000_0000}, 0x3fff, 0x8000_0000_0000_0002},
+		{Float128{0x3fff_ffff_ffff_ffff, 0xffff_0000_0000_0000}, 0x4000, 0x8000_0000_0000_0000}, // carry into the exponent
+		{Float128{0x0000_0000_0000_0000, 0x0000_0000_0000_0001}, 0x0000, 0},                     // underflow
+		{Float128{0x0000_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, 0x0001, 0x8000_0000_0000_0000}, // subnormal rounds up to min normal
+		{Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, 0x7fff, 0x8000_0000_0000_0000}, // overflow
+	}
+	for _, tt := range roundTests {
+		got := tt.in.Float80()
+		if se, frac := got.Bits(); se != tt.se || frac != tt.frac {
+			t.Errorf("Float128(%x).Float80() = %04x_%016x, want %04x_%016x", tt.in, se, frac, tt.se, tt.frac)
+		}
+	}
+
+	// every exactly representable value survives a round trip through Float128 and Float256
+	for _, v := range []float64{0, 1, -1.5, 0x1p-1074, math.MaxFloat64, math.Pi} {
+		a := exact80(v).Add(NewFloat80FromBits(0x3fbf, 0x8000_0000_0000_0000)) // add 2**-64
+		if got := a.Float128().Float80(); got != a {
+			t.Errorf("Float128 round trip of %x = %x", a, got)
+		}
+		if got := a.Float256().Float80(); got != a {
+			t.Errorf("Float256 round trip of %x = %x", a, got)
+		}
+	}
+}
+
+func TestConvert_NaNPayload80(t *testing.T) {
+	q := SetPayload64(exact64(42))
+	got := q.Float80()
+	if se, frac := got.Bits(); se != 0x7fff || frac != 0xc000_0000_0000_002a {
+		t.Errorf("Float64.Float80() = %04x_%016x, want 7fff_c00000000000002a", se, frac)
+	}
+	if back := got.Float64(); !eq64(back.Payload(), 42) {
+		t.Errorf("Float80.Float64().Payload() = %x, want 42", back.Payload())
+	}
+
+	// signaling NaNs stay signaling in Float256
+	s := NewFloat80FromBits(0xffff, 0x8000_0000_0000_0007)
+	if got := s.Float256(); !got.IsSignalingNaN() || !got.Signbit() || !eq256(got.Payload(), exact256(7)) {
+		t.Errorf("Float80.Float256() = %x, want signaling NaN with payload 7", got)
+	}
+	if got := s.Float64(); got.IsSignalingNaN() || !eq64(got.Payload(), 7) {
+		t.Errorf("Float80.Float64() = %x, want quiet NaN with payload 7", got.Bits())
+	}
+}
+
+func TestFloat128_DoubleDouble(t *testing.T) {
+	tests := []struct {
+		in   Float128
+		want DoubleDouble
+	}{
+		{exact128(0), DoubleDouble{0, 0}},
+		{exact128(math.Copysign(0, -1)), DoubleDouble{math.Copysign(0, -1), 0}},
+		{exact128(1.5), DoubleDouble{1.5, 0}},
+		{Float128{0x3fff_0000_0000_0000, 0x0000_0001_0000_0000}, DoubleDouble{1, 0x1p-80}},            // 1 + 2**-80
+		{Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0001}, DoubleDouble{1, 0x1p-112}},           // 1 + 2**-112
+		{Float128{0x3fff_0000_0000_0000, 0x0800_0000_0000_0001}, DoubleDouble{1 + 0x1p-52, -0x1p-53}}, // 1 + 2**-53 + 2**-112
+		{Float128{0x43ff_0000_0000_0000, 0}, DoubleDouble{math.Inf(1), 0}},                            // overflow
+		{Float128{0x7fff_0000_0000_0000, 0}, DoubleDouble{math.Inf(1), 0}},
+		{Float128{0xffff_0000_0000_0000, 0}, DoubleDouble{math.Inf(-1), 0}},
+	}
+
+	for _, tt := range tests {
+		got := tt.in.DoubleDouble()
+		if !eqDD(got, tt.want) {
+			t.Errorf("Float128(%x).DoubleDouble() = %v, want %v", tt.in, [2]float64(got), [2]float64(tt.want))
+		}
+	}
+
+	if got := NewFloat128NaN().DoubleDouble(); !got.IsNaN() {
+		t.Errorf("NaN.DoubleDouble() = %v, want NaN", [2]float64(got))
+	}
+}
+
+func TestDoubleDouble_Float(t *testing.T) {
+	tests := []struct {
+		in    DoubleDouble
+		want  float64
+		want2 Float128
+	}{
+		{DoubleDouble{1, 0x1p-80}, 1, Float128{0x3fff_0000_0000_0000, 0x0000_0001_0000_0000}},
+		{DoubleDouble{1, -0x1p-54}, 1, Float128{0x3ffe_ffff_ffff_ffff, 0xf800_0000_0000_0000}},
+		{DoubleDouble{1 + 0x1p-51, -0x1p-53}, 1 + 0x1p-51, Float128{0x3fff_0000_0000_0000, 0x1800_0000_0000_0000}}, // ties to even
+		{DoubleDouble{1, 0x1p-113}, 1, Float128{0x3fff_0000_0000_0000, 0}},                                         // ties to even
+		{DoubleDouble{1, 0x1p-113 + 0x1p-165}, 1, Float128{0x3fff_0000_0000_0000, 1}},                              // no double rounding
+		{DoubleDouble{math.Copysign(0, -1), 0}, math.Copysign(0, -1), Float128{0x8000_0000_0000_0000, 0}},
+		{DoubleDouble{math.Inf(-1), 0}, math.Inf(-1), Float128{0xffff_0000_0000_0000, 0}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.Float64(); !eq64(got, Float64(tt.want)) {
+			t.Errorf("DoubleDouble(%v).Float64() = %x, want %x", [2]float64(tt.in), got, tt.want)
+		}
+		if got := tt.in.Float128(); !eq128(got, tt.want2) {
+			t.Errorf("DoubleDouble(%v).Float128() = %x, want %x", [2]float64(tt.in), got, tt.want2)
+		}
+		if got := tt.in.Float256().DoubleDouble(); !eqDD(got, tt.in) {
+			t.Errorf("Float256 round trip of %v = %v", [2]float64(tt.in), [2]float64(got))
+		}
+		if got := tt.in.QuadDouble().DoubleDouble(); !eqDD(got, tt.in) {
+			t.Errorf("QuadDouble round trip of %v = %v", [2]float64(tt.in), [2]float64(got))
+		}
+	}
+
+	if got := NewDoubleDoubleNaN().Float128(); !got.IsNaN() {
+		t.Errorf("NaN.Float128() = %x, want NaN", got)
+	}
+}
+
+func TestQuadDouble_Float(t *testing.T) {
+	tests := []struct {
+		in   QuadDouble
+		want DoubleDouble
+	}{
+		{QuadDouble{1, 0x1p-60, 0x1p-114, 0x1p-170}, DoubleDouble{1, 0x1p-60}},
+		{QuadDouble{1, 0x1p-60, 0x1p-113, 0x1p-170}, DoubleDouble{1, 0x1p-60 + 0x1p-112}},
+		{QuadDouble{1, 0x1p-60, 0x1p-113, -0x1p-170}, DoubleDouble{1, 0x1p-60}},
+		{QuadDouble{math.Copysign(0, -1), 0, 0, 0}, DoubleDouble{math.Copysign(0, -1), 0}},
+		{QuadDouble{math.Inf(1), 0, 0, 0}, DoubleDouble{math.Inf(1), 0}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.DoubleDouble(); !eqDD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).DoubleDouble() = %v, want %v", [4]float64(tt.in), [2]float64(got), [2]float64(tt.want))
+		}
+	}
+
+	// Float256 has enough precision to hold any QuadDouble with the normalized components.
+	for _, x := range []QuadDouble{
+		{1, 0x1p-60, 0x1p-114, 0x1p-170},
+		NewQuadDouble(1).Quo(NewQuadDouble(3)),
+		NewQuadDouble(2).Sqrt().Neg(),
+	} {
+		if got := x.Float256().QuadDouble(); !eqQD(got, x) {
+			t.Errorf("Float256 round trip of %v = %v", [4]float64(x), [4]float64(got))
+		}
+	}
+	if got := NewQuadDoubleNaN().Float256(); !got.IsNaN() {
+		t.Errorf("NaN.Float256() = %x, want NaN", got)
+	}
+}
+
+func TestFloat64_Decimal(t *testing.T) {
+	tests := []struct {
+		in   float64
+		d32  string
+		d64  string
+		d128 string
+	}{
+		{0, "0", "0", "0"},
+		{math.Copysign(0, -1), "-0", "-0", "-0"},
+		{1, "1", "1", "1"},
+		{100, "100", "100", "100"},
+		{1e20, "1.000000e+20", "1.000000000000000e+20", "100000000000000000000"},
+		{0.5, "0.5", "0.5", "0.5"},
+		{0.1, "0.1000000", "0.1000000000000000", "0.1000000000000000055511151231257827"},
+		{-2.5e-7, "-2.500000e-07", "-2.500000000000000e-07", "-2.499999999999999886870279564715647e-07"},
+		{1e300, "+Inf", "1.000000000000000e+300", "1.000000000000000052504760255204420e+300"},
+		{5e-324, "0e-101", "4.940656458412465e-324", "4.940656458412465441765687928682214e-324"},
+		{math.Inf(-1), "-Inf", "-Inf", "-Inf"},
+	}
+
+	for _, tt := range tests {
+		x := Float64(tt.in)
+		if got := x.Decimal32().String(); got != tt.d32 {
+			t.Errorf("Float64(%g).Decimal32() = %s, want %s", tt.in, got, tt.d32)
+		}
+		if got := x.Decimal64().String(); got != tt.d64 {
+			t.Errorf("Float64(%g).Decimal64() = %s, want %s", tt.in, got, tt.d64)
+		}
+		if got := x.Decimal128().String(); got != tt.d128 {
+			t.Errorf("Float64(%g).Decimal128() = %s, want %s", tt.in, got, tt.d128)
+		}
+	}
+	if got := Float64(math.NaN()).Decimal64(); !got.IsNaN() {
+		t.Errorf("NaN.Decimal64() = %s, want NaN", got)
+	}
+}
+
+func TestDecimal_Float(t *testing.T) {
+	tests := []struct {
+		in  string
+		f16 float64
+		f32 float32
+		f64 float64
+	}{
+		{"0", 0, 0, 0},
+		{"1.20", 1.2001953125, 1.2, 1.2},
+		{"0.1", 0.0999755859375, 0.1, 0.1},
+		{"65519.99", 65504, 65519.99, 65519.99},
+		{"65520", math.Inf(1), 65520, 65520},
+		{"1e-8", 0, 1e-8, 1e-8},
+		{"3.4028236e+38", math.Inf(1), float32(math.Inf(1)), 3.4028236e+38},
+		{"-9.999999999999999999999999999999999e+6144", math.Inf(-1), float32(math.Inf(-1)), math.Inf(-1)},
+		{"1e-6176", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		x, err := ParseDecimal128(tt.in)
+		if err != nil {
+			t.Fatalf("ParseDecimal128(%q) returned error: %v", tt.in, err)
+		}
+		if got, want := x.Float16(), NewFloat16(tt.f16); got != want {
+			t.Errorf("Decimal128(%s).Float16() = %x, want %x", tt.in, got, want)
+		}
+		if got, want := x.Float32(), NewFloat32(float64(tt.f32)); got != want {
+			t.Errorf("Decimal128(%s).Float32() = %x, want %x", tt.in, got, want)
+		}
+		if got, want := x.Float64(), NewFloat64(tt.f64); got != want {
+			t.Errorf("Decimal128(%s).Float64() = %x, want %x", tt.in, got, want)
+		}
+	}
+
+	// Float128 and Float256 round trips through Decimal128 are exact for 34-digit values.
+	for _, s := range []string{"1", "0.1", "1.20", "3.141592653589793238462643383279503", "-1e-300"} {
+		x, _ := ParseDecimal128(s)
+		if got := x.Float256().Decimal128(); got.Ne(x) {
+			t.Errorf("Decimal128(%s).Float256().Decimal128() = %s", s, got)
+		}
+		if got := x.Float128().Decimal128(); got.Ne(x) {
+			t.Errorf("Decimal128(%s).Float128().Decimal128() = %s", s, got)
+		}
+	}
+}
+
+func TestDecimal_Decimal(t *testing.T) {
+	tests := []struct {
+		in  string
+		d32 string
+		d64 string
+	}{
+		{"1.20", "1.20", "1.20"},
+		{"1234567.5", "1234568", "1234567.5"},
+		{"1234568.5", "1234568", "1234568.5"},
+		{"1.2345678901234567890", "1.234568", "1.234567890123457"},
+		{"1e97", "+Inf", "1e+97"},
+		{"1e-102", "0e-101", "1e-102"},
+		{"NaN", "NaN", "NaN"},
+	}
+
+	for _, tt := range tests {
+		x, err := ParseDecimal128(tt.in)
+		if err != nil {
+			t.Fatalf("ParseDecimal128(%q) returned error: %v", tt.in, err)
+		}
+		if got := x.Decimal32().String(); got != tt.d32 {
+			t.Errorf("Decimal128(%s).Decimal32() = %s, want %s", tt.in, got, tt.d32)
+		}
+		if got := x.Decimal64().String(); got != tt.d64 {
+			t.Errorf("Decimal128(%s).Decimal64() = %s, want %s", tt.in, got, tt.d64)
+		}
+		// widening is exact and keeps the cohort.
+		if got := x.Decimal64().Decimal128().String(); got != tt.d64 {
+			t.Errorf("Decimal128(%s).Decimal64().Decimal128() = %s, want %s", tt.in, got, tt.d64)
+		}
+	}
+	if got := NewDecimal32(120, -2).Decimal128().String(); got != "1.20" {
+		t.Errorf("Decimal32(1.20).Decimal128() = %s, want 1.20", got)
+	}
+}
@@ -0,0 +1,121 @@
+package floats
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// appendComplex appends the string representation of re + im×i to dst,
+// in the same form as [strconv.FormatComplex].
+func appendComplex(dst []byte, re, im floatN, fmt byte, prec int) []byte {
+	dst = append(dst, '(')
+	dst = re.Append(dst, fmt, prec)
+
+	// the imaginary part always has a sign.
+	i := len(dst)
+	dst = im.Append(dst, fmt, prec)
+	if dst[i] != '+' && dst[i] != '-' {
+		dst = slices.Insert(dst, i, '+')
+	}
+	return append(dst, 'i', ')')
+}
+
+// formatComplex formats re + im×i in the same form as fmt does for complex128.
+// The flags, width and precision are applied to each part.
+func formatComplex(re, im floatN, s fmt.State, verb rune) {
+	_, _ = io.WriteString(s, "(")
+	format(re, s, verb)
+	format(im, plusState{s}, verb)
+	_, _ = io.WriteString(s, "i)")
+}
+
+// plusState is a [fmt.State] that always has the '+' flag.
+type plusState struct {
+	fmt.State
+}
+
+func (s plusState) Flag(c int) bool {
+	if c == '+' {
+		return true
+	}
+	return s.State.Flag(c)
+}
+
+var _ fmt.Formatter = Complex32{}
+
+// Format implements [fmt.Formatter].
+// It formats c in the same form as fmt does for complex128, such as (1+2i).
+func (c Complex32) Format(s fmt.State, verb rune) {
+	formatComplex(c.re, c.im, s, verb)
+}
+
+var _ fmt.Stringer = Complex32{}
+
+// String returns the string representation of c, such as (1+2i).
+func (c Complex32) String() string {
+	return c.Text('g', -1)
+}
+
+// Text returns the string representation of c in the given format and precision.
+// The format and precision are the same as [Float16.Text], and apply to each part.
+func (c Complex32) Text(fmt byte, prec int) string {
+	return string(c.Append(make([]byte, 0, 24), fmt, prec))
+}
+
+// Append appends the string representation of c in the given format and precision to dst and returns the extended buffer.
+func (c Complex32) Append(dst []byte, fmt byte, prec int) []byte {
+	return appendComplex(dst, c.re, c.im, fmt, prec)
+}
+
+var _ fmt.Formatter = Complex256{}
+
+// Format implements [fmt.Formatter].
+// It formats c in the same form as fmt does for complex128, such as (1+2i).
+func (c Complex256) Format(s fmt.State, verb rune) {
+	formatComplex(c.re, c.im, s, verb)
+}
+
+var _ fmt.Stringer = Complex256{}
+
+// String returns the string representation of c, such as (1+2i).
+func (c Complex256) String() string {
+	return c.Text('g', -1)
+}
+
+// Text returns the string representation of c in the given format and precision.
+// The format and precision are the same as [Float128.Text], and apply to each part.
+func (c Complex256) Text(fmt byte, prec int) string {
+	return string(c.Append(make([]byte, 0, 96), fmt, prec))
+}
+
+// Append appends the string representation of c in the given format and precision to dst and returns the extended buffer.
+func (c Complex256) Append(dst []byte, fmt byte, prec int) []byte {
+	return appendComplex(dst, c.re, c.im, fmt, prec)
+}
+
+var _ fmt.Formatter = Complex512{}
+
+// Format implements [fmt.Formatter].
+// It formats c in the same form as fmt does for complex128, such as (1+2i).
+func (c Complex512) Format(s fmt.State, verb rune) {
+	formatComplex(c.re, c.im, s, verb)
+}
+
+var _ fmt.Stringer = Complex512{}
+
+// String returns the string representation of c, such as (1+2i).
+func (c Complex512) String() string {
+	return c.Text('g', -1)
+}
+
+// Text returns the string representation of c in the given format and precision.
+// The format and precision are the same as [Float256.Text], and apply to each part.
+func (c Complex512) Text(fmt byte, prec int) string {
+	return string(c.Append(make([]byte, 0, 160), fmt, prec))
+}
+
+// Append appends the string representation of c in the given format and precision to dst and returns the extended buffer.
+func (c Complex512) Append(dst []byte, fmt byte, prec int) []byte {
+	return appendComplex(dst, c.re, c.im, fmt, prec)
+}
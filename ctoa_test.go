@@ -0,0 +1,116 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestComplex512_Text(t *testing.T) {
+	tests := []struct {
+		c    complex128
+		fmt  byte
+		prec int
+	}{
+		{1 + 2i, 'g', -1},
+		{1 - 2i, 'g', -1},
+		{-0.5 + 0.25i, 'f', 3},
+		{1e100 - 1e-100i, 'e', 5},
+		{complex(0, math.Copysign(0, -1)), 'g', -1},
+		{complex(math.Inf(1), math.Inf(-1)), 'g', -1},
+		{complex(math.NaN(), math.NaN()), 'g', -1},
+		{complex(1, math.Inf(1)), 'f', 2},
+		{1.5 + 3i, 'x', -1},
+	}
+	for _, tt := range tests {
+		want := strconv.FormatComplex(tt.c, tt.fmt, tt.prec, 128)
+		got := NewComplex512FromComplex128(tt.c).Text(tt.fmt, tt.prec)
+		if got != want {
+			t.Errorf("(%v).Text(%q, %d) = %q; want %q", tt.c, tt.fmt, tt.prec, got, want)
+		}
+	}
+
+	// the shortest representation in the precision of each type.
+	c := NewComplex512FromComplex128(0.5 - 3i)
+	if got, want := c.String(), "(0.5-3i)"; got != want {
+		t.Errorf("String() = %q; want %q", got, want)
+	}
+	if got, want := c.Complex256().String(), "(0.5-3i)"; got != want {
+		t.Errorf("String() = %q; want %q", got, want)
+	}
+	if got, want := c.Complex32().String(), "(0.5-3i)"; got != want {
+		t.Errorf("String() = %q; want %q", got, want)
+	}
+	if got, want := NewComplex32FromComplex128(0.1+1e4i).String(), "(0.1+10000i)"; got != want {
+		t.Errorf("String() = %q; want %q", got, want)
+	}
+	if got, want := NewComplex32NaN().String(), "(NaN+NaNi)"; got != want {
+		t.Errorf("String() = %q; want %q", got, want)
+	}
+
+	buf := []byte("c = ")
+	if got, want := string(c.Append(buf, 'f', 1)), "c = (0.5-3.0i)"; got != want {
+		t.Errorf("Append() = %q; want %q", got, want)
+	}
+}
+
+func TestComplex_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		c      complex128
+	}{
+		{"%v", 1 + 2i},
+		{"%v", -1 - 2i},
+		{"%g", 0.5 - 0.25i},
+		{"%.2f", 1 + 2i},
+		{"%.3e", 1234.5 + 0.001i},
+		{"%+g", 1 + 2i},
+		{"%8.2f", 1 - 2i},
+		{"%-8.2f", 1 - 2i},
+		{"% .1f", 1 + 2i},
+		{"%v", complex(math.Inf(1), math.Inf(1))},
+		{"%v", complex(math.Inf(-1), math.Inf(-1))},
+		{"%v", complex(math.NaN(), math.NaN())},
+		{"%.2f", complex(1, math.NaN())},
+	}
+	for _, tt := range tests {
+		want := fmt.Sprintf(tt.format, tt.c)
+		c := NewComplex512FromComplex128(tt.c)
+		if got := fmt.Sprintf(tt.format, c); got != want {
+			t.Errorf("Sprintf(%q, Complex512(%v)) = %q; want %q", tt.format, tt.c, got, want)
+		}
+		if got := fmt.Sprintf(tt.format, c.Complex256()); got != want {
+			t.Errorf("Sprintf(%q, Complex256(%v)) = %q; want %q", tt.format, tt.c, got, want)
+		}
+		if got := fmt.Sprintf(tt.format, c.Complex32()); got != want {
+			t.Errorf("Sprintf(%q, Complex32(%v)) = %q; want %q", tt.format, tt.c, got, want)
+		}
+	}
+}
+
+func TestComplex_ParseFormat(t *testing.T) {
+	for _, c := range vcTests {
+		x := NewComplex512FromComplex128(c)
+		y, err := ParseComplex512(x.String())
+		if err != nil {
+			t.Errorf("ParseComplex512(%q) returns error: %v", x.String(), err)
+			continue
+		}
+		if !y.Eq(x) {
+			t.Errorf("ParseComplex512(%q) = %v; want %v", x.String(), y, x)
+		}
+
+		x256 := x.Complex256()
+		y256, err := ParseComplex256(x256.String())
+		if err != nil || !y256.Eq(x256) {
+			t.Errorf("ParseComplex256(%q) = %v, %v; want %v", x256.String(), y256, err, x256)
+		}
+
+		x32 := x.Complex32()
+		y32, err := ParseComplex32(x32.String())
+		if err != nil || !y32.Eq(x32) {
+			t.Errorf("ParseComplex32(%q) = %v, %v; want %v", x32.String(), y32, err, x32)
+		}
+	}
+}
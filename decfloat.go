@@ -0,0 +1,706 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/shogo82148/ints"
+)
+
+// decForm describes an IEEE 754 decimal interchange format.
+type decForm struct {
+	bits int // storage width in bits
+	p    int // precision in decimal digits
+	emax int // maximum exponent
+	w    int // width of the exponent continuation field
+}
+
+var (
+	decForm32  = &decForm{bits: 32, p: 7, emax: 96, w: 6}
+	decForm64  = &decForm{bits: 64, p: 16, emax: 384, w: 8}
+	decForm128 = &decForm{bits: 128, p: 34, emax: 6144, w: 12}
+)
+
+// bias returns the exponent bias.
+func (f *decForm) bias() int {
+	return f.emax + f.p - 2
+}
+
+// qmin returns the exponent of the smallest subnormal number.
+func (f *decForm) qmin() int {
+	return -f.bias()
+}
+
+// qmax returns the exponent of the largest finite number.
+func (f *decForm) qmax() int {
+	return f.emax - f.p + 1
+}
+
+type decKind byte
+
+const (
+	decFinite decKind = iota
+	decInf
+	decNaN
+	decSNaN
+)
+
+// decFloat is an unpacked decimal floating-point number.
+// The value of a finite number is (-1)^neg × coeff × 10^exp.
+// Different exponents may represent the same value,
+// e.g. 1.0 (10×10^-1) and 1.00 (100×10^-2); they are the members of a cohort.
+type decFloat struct {
+	kind  decKind
+	neg   bool
+	coeff ints.Uint256 // coefficient, or payload of NaN
+	exp   int
+}
+
+func (x decFloat) isNaN() bool {
+	return x.kind == decNaN || x.kind == decSNaN
+}
+
+func (x decFloat) isZero() bool {
+	return x.kind == decFinite && x.coeff.IsZero()
+}
+
+// decPow10 is the table of powers of ten that fit in ints.Uint256.
+var decPow10 = func() [78]ints.Uint256 {
+	var t [78]ints.Uint256
+	t[0] = ints.Uint256{0, 0, 0, 1}
+	ten := ints.Uint256{0, 0, 0, 10}
+	for i := 1; i < len(t); i++ {
+		t[i] = t[i-1].Mul(ten)
+	}
+	return t
+}()
+
+// decMaxDigits is the number of digits that intermediate coefficients may have.
+// 10^76 fits in ints.Uint256 with room for a carry.
+const decMaxDigits = 76
+
+// decDigits returns the number of decimal digits of x.
+// Zero has no digits.
+func decDigits(x ints.Uint256) int {
+	// 1233/4096 is an approximation of log10(2).
+	n := x.BitLen() * 1233 >> 12
+	if n < len(decPow10) && x.Cmp(decPow10[n]) >= 0 {
+		n++
+	}
+	return n
+}
+
+// roundDigits removes the last n digits of c, rounding ties to even.
+// If sticky is true, the exact value is slightly larger than c.
+// It reports whether the result is inexact.
+func roundDigits(c ints.Uint256, n int, sticky bool) (ints.Uint256, bool) {
+	if n <= 0 {
+		return c, sticky
+	}
+	if n >= len(decPow10) {
+		// c is less than half of 10^n.
+		return ints.Uint256{}, sticky || !c.IsZero()
+	}
+	q, r := c.DivMod(decPow10[n])
+	cmp := r.Cmp(decPow10[n-1].Mul(ints.Uint256{0, 0, 0, 5}))
+	if cmp > 0 || cmp == 0 && (sticky || q[3]&1 != 0) {
+		q = q.Add(ints.Uint256{0, 0, 0, 1})
+	}
+	return q, sticky || !r.IsZero()
+}
+
+// round rounds x to the precision and the exponent range of f, ties to even.
+//
+// If sticky is true, x is inexact: the magnitude of the exact value
+// is larger than x by less than one unit in the last digit of x.coeff.
+// In this case, x.coeff must have more than f.p digits,
+// so that the rounding position is above the discarded digits.
+func (f *decForm) round(x decFloat, sticky bool) (decFloat, Flags) {
+	var flags Flags
+	if x.kind != decFinite {
+		return x, 0
+	}
+
+	n := max(decDigits(x.coeff)-f.p, f.qmin()-x.exp, 0)
+	if n > 0 {
+		var inexact bool
+		x.coeff, inexact = roundDigits(x.coeff, n, sticky)
+		x.exp += n
+		if x.coeff == decPow10[f.p] {
+			// rounded up, e.g., 9999999 + 1 = 10000000
+			x.coeff = decPow10[f.p-1]
+			x.exp++
+		}
+		if inexact {
+			flags |= Inexact
+			if x.coeff.Cmp(decPow10[f.p-1]) < 0 {
+				flags |= Underflow
+			}
+		}
+	}
+
+	if x.exp > f.qmax() {
+		if x.coeff.IsZero() {
+			x.exp = f.qmax()
+		} else if shift := x.exp - f.qmax(); decDigits(x.coeff)+shift <= f.p {
+			// clamp the exponent by padding the coefficient with zeros.
+			x.coeff = x.coeff.Mul(decPow10[shift])
+			x.exp = f.qmax()
+		} else {
+			return decFloat{kind: decInf, neg: x.neg}, flags | Overflow | Inexact
+		}
+	}
+	return x, flags
+}
+
+// propagateDecNaN returns the quiet NaN propagated from a or b.
+// The payload of a takes precedence.
+func propagateDecNaN(a, b decFloat) (decFloat, Flags) {
+	var flags Flags
+	if a.kind == decSNaN || b.kind == decSNaN {
+		flags |= Invalid
+	}
+	x := b
+	if a.isNaN() {
+		x = a
+	}
+	x.kind = decNaN
+	return x, flags
+}
+
+// add returns a + b rounded to f.
+func (f *decForm) add(a, b decFloat) (decFloat, Flags) {
+	// special cases
+	switch {
+	case a.isNaN() || b.isNaN():
+		return propagateDecNaN(a, b)
+	case a.kind == decInf && b.kind == decInf && a.neg != b.neg:
+		// ∞ - ∞
+		return decFloat{kind: decNaN}, Invalid
+	case a.kind == decInf:
+		return a, 0
+	case b.kind == decInf:
+		return b, 0
+	}
+
+	// align the exponents.
+	// the exact result has the smaller exponent of a and b.
+	if a.exp < b.exp {
+		a, b = b, a
+	}
+	ca, cb := a.coeff, b.coeff
+	exp := b.exp
+	var sticky bool
+	if !ca.IsZero() {
+		d := a.exp - b.exp
+		if n := decDigits(ca); n+d > decMaxDigits {
+			// b is too small to be aligned exactly.
+			// it only affects the rounding, so drop its digits.
+			k := n + d - decMaxDigits
+			d -= k
+			exp += k
+			if k < len(decPow10) {
+				var r ints.Uint256
+				cb, r = cb.DivMod(decPow10[k])
+				sticky = !r.IsZero()
+			} else {
+				sticky = !cb.IsZero()
+				cb = ints.Uint256{}
+			}
+		}
+		ca = ca.Mul(decPow10[d])
+	}
+
+	x := decFloat{neg: a.neg, exp: exp}
+	switch {
+	case a.neg == b.neg:
+		x.coeff = ca.Add(cb)
+	case ca.Cmp(cb) > 0:
+		x.coeff = ca.Sub(cb)
+		if sticky {
+			// ca - (cb + δ) = (ca - cb - 1) + (1 - δ)
+			x.coeff = x.coeff.Sub(ints.Uint256{0, 0, 0, 1})
+		}
+	case ca.Cmp(cb) < 0:
+		x.coeff = cb.Sub(ca)
+		x.neg = b.neg
+	default:
+		// the exact result is zero, and its sign is positive.
+		x.neg = false
+	}
+	return f.round(x, sticky)
+}
+
+// mul returns a × b rounded to f.
+func (f *decForm) mul(a, b decFloat) (decFloat, Flags) {
+	neg := a.neg != b.neg
+
+	// special cases
+	switch {
+	case a.isNaN() || b.isNaN():
+		return propagateDecNaN(a, b)
+	case a.kind == decInf && b.isZero() || a.isZero() && b.kind == decInf:
+		// ∞ × 0
+		return decFloat{kind: decNaN}, Invalid
+	case a.kind == decInf || b.kind == decInf:
+		return decFloat{kind: decInf, neg: neg}, 0
+	}
+
+	// the product of two coefficients has at most 68 digits, so it is exact.
+	x := decFloat{
+		neg:   neg,
+		coeff: a.coeff.Mul(b.coeff),
+		exp:   a.exp + b.exp,
+	}
+	return f.round(x, false)
+}
+
+// quo returns a / b rounded to f.
+func (f *decForm) quo(a, b decFloat) (decFloat, Flags) {
+	neg := a.neg != b.neg
+
+	// special cases
+	switch {
+	case a.isNaN() || b.isNaN():
+		return propagateDecNaN(a, b)
+	case a.kind == decInf && b.kind == decInf || a.isZero() && b.isZero():
+		// ∞ / ∞ or 0 / 0
+		return decFloat{kind: decNaN}, Invalid
+	case a.kind == decInf:
+		return decFloat{kind: decInf, neg: neg}, 0
+	case b.kind == decInf:
+		return decFloat{neg: neg, exp: f.qmin()}, 0
+	case b.isZero():
+		return decFloat{kind: decInf, neg: neg}, DivByZero
+	case a.isZero():
+		return f.round(decFloat{neg: neg, exp: a.exp - b.exp}, false)
+	}
+
+	// the preferred exponent of the exact result.
+	ideal := a.exp - b.exp
+
+	// scale the dividend so that the quotient has at least f.p+1 digits.
+	s := max(f.p+decDigits(b.coeff)-decDigits(a.coeff)+1, 0)
+	q, r := a.coeff.Mul(decPow10[s]).DivMod(b.coeff)
+	exp := ideal - s
+	sticky := !r.IsZero()
+	if !sticky {
+		// the result is exact; remove the trailing zeros toward the preferred exponent.
+		ten := ints.Uint256{0, 0, 0, 10}
+		for exp < ideal {
+			qq, rr := q.DivMod(ten)
+			if !rr.IsZero() {
+				break
+			}
+			q = qq
+			exp++
+		}
+	}
+	return f.round(decFloat{neg: neg, coeff: q, exp: exp}, sticky)
+}
+
+// quantize returns a rounded to the exponent of b.
+func (f *decForm) quantize(a, b decFloat) (decFloat, Flags) {
+	// special cases
+	switch {
+	case a.isNaN() || b.isNaN():
+		return propagateDecNaN(a, b)
+	case a.kind == decInf && b.kind == decInf:
+		return a, 0
+	case a.kind == decInf || b.kind == decInf:
+		return decFloat{kind: decNaN}, Invalid
+	}
+
+	x := decFloat{neg: a.neg, exp: b.exp}
+	if a.exp >= b.exp {
+		d := a.exp - b.exp
+		if a.coeff.IsZero() {
+			return x, 0
+		}
+		if decDigits(a.coeff)+d > f.p {
+			// the coefficient does not fit in the precision.
+			return decFloat{kind: decNaN}, Invalid
+		}
+		x.coeff = a.coeff.Mul(decPow10[d])
+		return x, 0
+	}
+
+	var flags Flags
+	var inexact bool
+	x.coeff, inexact = roundDigits(a.coeff, b.exp-a.exp, false)
+	if inexact {
+		flags |= Inexact
+	}
+	return x, flags
+}
+
+// sameDecQuantum reports whether a and b have the same exponent.
+func sameDecQuantum(a, b decFloat) bool {
+	switch {
+	case a.isNaN() || b.isNaN():
+		return a.isNaN() && b.isNaN()
+	case a.kind == decInf || b.kind == decInf:
+		return a.kind == b.kind
+	}
+	return a.exp == b.exp
+}
+
+// cmpDec compares a and b, which must not be NaN.
+// It returns -1 if a < b, 0 if a == b, and +1 if a > b.
+func cmpDec(a, b decFloat) int {
+	if a.isZero() && b.isZero() {
+		return 0
+	}
+	if a.neg != b.neg {
+		if a.neg {
+			return -1
+		}
+		return 1
+	}
+	c := cmpDecAbs(a, b)
+	if a.neg {
+		return -c
+	}
+	return c
+}
+
+// cmpDecAbs compares |a| and |b|, which must not be NaN.
+func cmpDecAbs(a, b decFloat) int {
+	switch {
+	case a.kind == decInf && b.kind == decInf:
+		return 0
+	case a.kind == decInf:
+		return 1
+	case b.kind == decInf:
+		return -1
+	case a.coeff.IsZero() && b.coeff.IsZero():
+		return 0
+	case a.coeff.IsZero():
+		return -1
+	case b.coeff.IsZero():
+		return 1
+	}
+
+	// compare the adjusted exponents, i.e. the exponents of the leading digits.
+	ea := a.exp + decDigits(a.coeff)
+	eb := b.exp + decDigits(b.coeff)
+	if ea != eb {
+		if ea < eb {
+			return -1
+		}
+		return 1
+	}
+
+	// the leading digits are in the same position,
+	// so the difference of the exponents is less than the number of digits.
+	ca, cb := a.coeff, b.coeff
+	if a.exp > b.exp {
+		ca = ca.Mul(decPow10[a.exp-b.exp])
+	} else {
+		cb = cb.Mul(decPow10[b.exp-a.exp])
+	}
+	return ca.Cmp(cb)
+}
+
+// assignDecimal assigns the finite x to d.
+func (x decFloat) assignDecimal(d *decimal) {
+	d.AssignUint256(x.coeff)
+	if d.nd != 0 {
+		d.dp += x.exp
+	}
+	d.neg = x.neg
+}
+
+// fromDecimal converts the exact value of d to f, rounding ties to even.
+// If the conversion is exact, the member of the cohort
+// whose exponent is the closest to zero is chosen.
+func (f *decForm) fromDecimal(d *decimal) decFloat {
+	x := decFloat{neg: d.neg}
+	if d.nd == 0 {
+		return x
+	}
+
+	// take the first n digits.
+	n := min(d.nd, f.p)
+	x.exp = d.dp - n
+	if x.exp < f.qmin() {
+		n -= f.qmin() - x.exp
+		x.exp = f.qmin()
+	}
+	ten := ints.Uint256{0, 0, 0, 10}
+	for i := 0; i < n; i++ {
+		x.coeff = x.coeff.Mul(ten).Add(ints.Uint256{0, 0, 0, uint64(d.d[i] - '0')})
+	}
+
+	if shouldRoundUp(d, n) {
+		x.coeff = x.coeff.Add(ints.Uint256{0, 0, 0, 1})
+		if x.coeff == decPow10[f.p] {
+			x.coeff = decPow10[f.p-1]
+			x.exp++
+		}
+	} else if n >= d.nd && !d.trunc {
+		// the conversion is exact; move the exponent toward zero.
+		for x.exp > 0 && decDigits(x.coeff) < f.p {
+			x.coeff = x.coeff.Mul(ten)
+			x.exp--
+		}
+	}
+
+	x, _ = f.round(x, false)
+	return x
+}
+
+// fromFloat256 converts a to f, rounding ties to even.
+func (f *decForm) fromFloat256(a Float256) decFloat {
+	switch {
+	case a.IsNaN():
+		return decFloat{kind: decNaN}
+	case a.IsInf(0):
+		return decFloat{kind: decInf, neg: a.Signbit()}
+	}
+
+	bufp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(bufp)
+	d := decimal{d: bufp[:]}
+	a.assignDecimal(&d)
+	d.neg = a.Signbit()
+	return f.fromDecimal(&d)
+}
+
+// fromDec converts x to f, rounding ties to even.
+func (f *decForm) fromDec(x decFloat) decFloat {
+	if x.isNaN() {
+		// keep the payload if it fits.
+		if decDigits(x.coeff) >= f.p {
+			x.coeff = ints.Uint256{}
+		}
+		return x
+	}
+	x, _ = f.round(x, false)
+	return x
+}
+
+// float16 converts x to Float16, rounding ties to even.
+func (x decFloat) float16() Float16 {
+	switch x.kind {
+	case decNaN, decSNaN:
+		return NewFloat16NaN()
+	case decInf:
+		return NewFloat16Inf(x.sign())
+	}
+
+	bufp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(bufp)
+	d := decimal{d: bufp[:]}
+	x.assignDecimal(&d)
+	f, _ := d.float16()
+	return f
+}
+
+// float64 converts x to float32 or float64, rounding ties to even.
+func (x decFloat) float64(bitSize int) float64 {
+	switch x.kind {
+	case decNaN, decSNaN:
+		return math.NaN()
+	case decInf:
+		return math.Inf(x.sign())
+	}
+
+	var buf [96]byte
+	b := x.coeff.Append(buf[:0], 10)
+	b = append(b, 'e')
+	b = strconv.AppendInt(b, int64(x.exp), 10)
+
+	// strconv.ParseFloat reports ErrRange with the correctly rounded infinity,
+	// so the error can be ignored.
+	f, _ := strconv.ParseFloat(string(b), bitSize)
+	if x.neg {
+		f = -f
+	}
+	return f
+}
+
+// float128 converts x to Float128, rounding ties to even.
+func (x decFloat) float128() Float128 {
+	switch x.kind {
+	case decNaN, decSNaN:
+		return NewFloat128NaN()
+	case decInf:
+		return NewFloat128Inf(x.sign())
+	}
+
+	bufp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(bufp)
+	d := decimal{d: bufp[:]}
+	x.assignDecimal(&d)
+	f, _ := d.float128()
+	return f
+}
+
+// float256 converts x to Float256, rounding ties to even.
+func (x decFloat) float256() Float256 {
+	switch x.kind {
+	case decNaN, decSNaN:
+		return NewFloat256NaN()
+	case decInf:
+		return NewFloat256Inf(x.sign())
+	}
+
+	bufp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(bufp)
+	d := decimal{d: bufp[:]}
+	x.assignDecimal(&d)
+	f, _ := d.float256()
+	return f
+}
+
+func (x decFloat) sign() int {
+	if x.neg {
+		return -1
+	}
+	return 1
+}
+
+// append appends the string representation of x to dst.
+// The precision -1 uses all the digits of the coefficient,
+// so the exponent of x can be read back from the result.
+func (x decFloat) append(dst []byte, fmt byte, prec int) []byte {
+	// special numbers
+	switch {
+	case x.isNaN():
+		return append(dst, "NaN"...)
+	case x.kind == decInf && !x.neg:
+		return append(dst, "+Inf"...)
+	case x.kind == decInf && x.neg:
+		return append(dst, "-Inf"...)
+	}
+
+	switch fmt {
+	case 'e', 'E', 'f', 'g', 'G':
+	default:
+		// unknown format
+		return append(dst, '%', fmt)
+	}
+
+	var buf [decMaxDigits + 2]byte
+	d := &decimal{d: buf[:]}
+	if prec < 0 {
+		// keep the trailing zeros; zero is the single digit '0'.
+		d.nd = len(x.coeff.Append(buf[:0], 10))
+		d.dp = d.nd + x.exp
+		switch fmt {
+		case 'e', 'E':
+			return fmtE(dst, x.neg, d, d.nd-1, fmt)
+		case 'f':
+			return fmtF(dst, x.neg, d, max(-x.exp, 0))
+		}
+
+		// the same rule as to-scientific-string of the General Decimal Arithmetic Specification:
+		// use the exponential notation only if the exponent is positive
+		// or the number is less than 1e-6.
+		if x.exp <= 0 && d.dp-1 >= -6 {
+			return fmtF(dst, x.neg, d, -x.exp)
+		}
+		return fmtE(dst, x.neg, d, d.nd-1, fmt+'e'-'g')
+	}
+
+	x.assignDecimal(d)
+	switch fmt {
+	case 'e', 'E':
+		d.Round(prec + 1)
+	case 'f':
+		d.Round(d.dp + prec)
+	case 'g', 'G':
+		if prec == 0 {
+			prec = 1
+		}
+		d.Round(prec)
+	}
+	return formatDigits(dst, x.neg, d, false, prec, fmt)
+}
+
+// parse parses s as a decimal floating-point number in f.
+// All the digits of s are kept, so the exponent of the result follows s,
+// e.g. "1.20" is parsed as 120×10^-2.
+func (f *decForm) parse(s string) (x decFloat, flags Flags, ok bool) {
+	if val, n, ok := special(s); ok {
+		if n != len(s) {
+			return decFloat{}, 0, false
+		}
+		if val != val {
+			return decFloat{kind: decNaN}, 0, true
+		}
+		return decFloat{kind: decInf, neg: val < 0}, 0, true
+	}
+
+	i := 0
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		x.neg = s[i] == '-'
+		i++
+	}
+
+	// digits
+	var sawdot, sawdigits, sticky bool
+	var ndigits, nfrac, dropped int
+	ten := ints.Uint256{0, 0, 0, 10}
+loop:
+	for ; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '.':
+			if sawdot {
+				break loop
+			}
+			sawdot = true
+		case '0' <= c && c <= '9':
+			sawdigits = true
+			if sawdot {
+				nfrac++
+			}
+			if c == '0' && ndigits == 0 {
+				// ignore leading zeros
+				continue
+			}
+			if ndigits < decMaxDigits {
+				x.coeff = x.coeff.Mul(ten).Add(ints.Uint256{0, 0, 0, uint64(c - '0')})
+				ndigits++
+				continue
+			}
+			dropped++
+			sticky = sticky || c != '0'
+		default:
+			break loop
+		}
+	}
+	if !sawdigits {
+		return decFloat{}, 0, false
+	}
+
+	// optional exponent
+	var e int
+	if i < len(s) && lower(s[i]) == 'e' {
+		i++
+		esign := 1
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			if s[i] == '-' {
+				esign = -1
+			}
+			i++
+		}
+		if i >= len(s) || s[i] < '0' || s[i] > '9' {
+			return decFloat{}, 0, false
+		}
+		for ; i < len(s) && '0' <= s[i] && s[i] <= '9'; i++ {
+			if e < 100_000_000 {
+				e = e*10 + int(s[i]-'0')
+			}
+		}
+		e *= esign
+	}
+	if i != len(s) {
+		return decFloat{}, 0, false
+	}
+
+	x.exp = e - nfrac + dropped
+	x, flags = f.round(x, sticky)
+	return x, flags, true
+}
@@ -24,11 +24,15 @@ type decimal struct {
 // Maximum number of decimal digits that may be produced by (or consumed for a
 // correctly-rounded conversion of) each float type. The worst case is the
 // exact expansion of the largest subnormal value. A small margin is added on
-// top of the computed maxima (16->22, 128->11564, 256->183467).
+// top of the computed maxima (e4m3->10, e5m2->14, 16->22, bf16->98, 80->11515, 128->11564, 256->183467).
 const (
-	decimalDigits16  = 32
-	decimalDigits128 = 12288
-	decimalDigits256 = 190000
+	decimalDigitsE4M3 = 16
+	decimalDigitsE5M2 = 24
+	decimalDigits16   = 32
+	decimalDigitsBF16 = 128
+	decimalDigits80   = 12288
+	decimalDigits128  = 12288
+	decimalDigits256  = 190000
 )
 
 func (a *decimal) String() string {
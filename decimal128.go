@@ -0,0 +1,239 @@
+package floats
+
+import "github.com/shogo82148/ints"
+
+// Decimal128 is an IEEE 754 decimal128 floating-point number.
+// It has 34 decimal digits of precision, and the exponent of the largest finite number is 6111.
+// Its value is (-1)^sign × coefficient × 10^exponent.
+//
+// A number may have several representations with different exponents,
+// e.g. 1.0 (10×10^-1) and 1.00 (100×10^-2); they are called a cohort.
+// The arithmetic operations are exact if the result fits in the precision,
+// and they choose the exponent of the result as IEEE 754 recommends:
+// for example, 1.20 + 1.3 is 2.50 and 2.50 × 2 is 5.00.
+// The formatting functions keep the exponent.
+//
+// Decimal128 is stored in the binary integer decimal (BID) encoding.
+// Use [NewDecimal128FromDPD] and [Decimal128.DPD] for the densely packed decimal (DPD) encoding.
+type Decimal128 ints.Uint128
+
+// NewDecimal128 returns coeff × 10^exp as a Decimal128, rounding ties to even.
+func NewDecimal128(coeff int64, exp int) Decimal128 {
+	x := decFloat{neg: coeff < 0, exp: exp}
+	if coeff < 0 {
+		x.coeff = ints.Uint256{0, 0, 0, uint64(-coeff)}
+	} else {
+		x.coeff = ints.Uint256{0, 0, 0, uint64(coeff)}
+	}
+	x, _ = decForm128.round(x, false)
+	return pack128(x)
+}
+
+// NewDecimal128FromBits converts the BID encoding to Decimal128.
+func NewDecimal128FromBits(b ints.Uint128) Decimal128 {
+	return Decimal128(b)
+}
+
+// NewDecimal128FromDPD converts the DPD encoding to Decimal128.
+func NewDecimal128FromDPD(b ints.Uint128) Decimal128 {
+	return pack128(decForm128.undpd(b))
+}
+
+// NewDecimal128NaN returns a NaN Decimal128 value.
+func NewDecimal128NaN() Decimal128 {
+	return pack128(decFloat{kind: decNaN})
+}
+
+// NewDecimal128Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewDecimal128Inf(sign int) Decimal128 {
+	return pack128(decFloat{kind: decInf, neg: sign < 0})
+}
+
+// Bits returns the BID encoding of a.
+func (a Decimal128) Bits() ints.Uint128 {
+	return ints.Uint128(a)
+}
+
+// DPD returns the DPD encoding of a.
+func (a Decimal128) DPD() ints.Uint128 {
+	b := decForm128.dpd(a.unpack())
+	return b
+}
+
+// Parts returns the sign, the coefficient, and the exponent of a,
+// i.e. a = (-1)^neg × coeff × 10^exp.
+// If a is NaN, coeff is its payload.
+// Non-canonical encodings are returned as the canonical ones.
+func (a Decimal128) Parts() (neg bool, coeff ints.Uint128, exp int) {
+	x := a.unpack()
+	return x.neg, x.coeff.Uint128(), x.exp
+}
+
+func (a Decimal128) unpack() decFloat {
+	return decForm128.unbid(ints.Uint128(a))
+}
+
+func pack128(x decFloat) Decimal128 {
+	b := decForm128.bid(x)
+	return Decimal128(b)
+}
+
+// IsNaN reports whether a is an IEEE 754 “not-a-number” value.
+func (a Decimal128) IsNaN() bool {
+	return a.unpack().isNaN()
+}
+
+// IsSignalingNaN reports whether a is a signaling NaN.
+func (a Decimal128) IsSignalingNaN() bool {
+	return a.unpack().kind == decSNaN
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a Decimal128) IsInf(sign int) bool {
+	x := a.unpack()
+	return x.kind == decInf && (sign >= 0 && !x.neg || sign <= 0 && x.neg)
+}
+
+// IsZero reports whether a is zero, regardless of its sign and exponent.
+func (a Decimal128) IsZero() bool {
+	return a.unpack().isZero()
+}
+
+// Signbit reports whether a is negative or negative zero.
+func (a Decimal128) Signbit() bool {
+	return a.unpack().neg
+}
+
+// Neg returns -a.
+func (a Decimal128) Neg() Decimal128 {
+	x := a.unpack()
+	x.neg = !x.neg
+	return pack128(x)
+}
+
+// Abs returns the absolute value of a.
+func (a Decimal128) Abs() Decimal128 {
+	x := a.unpack()
+	x.neg = false
+	return pack128(x)
+}
+
+// Add returns the sum of a and b, rounding ties to even.
+// The exponent of the exact result is the smaller exponent of a and b.
+func (a Decimal128) Add(b Decimal128) Decimal128 {
+	x, _ := decForm128.add(a.unpack(), b.unpack())
+	return pack128(x)
+}
+
+// Sub returns the difference of a and b, rounding ties to even.
+// The exponent of the exact result is the smaller exponent of a and b.
+func (a Decimal128) Sub(b Decimal128) Decimal128 {
+	return a.Add(b.Neg())
+}
+
+// Mul returns the product of a and b, rounding ties to even.
+// The exponent of the exact result is the sum of the exponents of a and b.
+func (a Decimal128) Mul(b Decimal128) Decimal128 {
+	x, _ := decForm128.mul(a.unpack(), b.unpack())
+	return pack128(x)
+}
+
+// Quo returns the quotient of a and b, rounding ties to even.
+// If the result is exact, its exponent is the closest to
+// the difference of the exponents of a and b.
+func (a Decimal128) Quo(b Decimal128) Decimal128 {
+	x, _ := decForm128.quo(a.unpack(), b.unpack())
+	return pack128(x)
+}
+
+// Quantize returns a rounded to the exponent of b, rounding ties to even.
+// If the coefficient of the result does not fit in the precision, it returns NaN.
+//
+// Special cases are:
+//
+//	NaN.Quantize(x) = NaN
+//	x.Quantize(NaN) = NaN
+//	±Inf.Quantize(±Inf) = ±Inf
+//	±Inf.Quantize(x) = NaN
+//	x.Quantize(±Inf) = NaN
+func (a Decimal128) Quantize(b Decimal128) Decimal128 {
+	x, _ := decForm128.quantize(a.unpack(), b.unpack())
+	return pack128(x)
+}
+
+// SameQuantum reports whether a and b have the same exponent.
+// NaNs have the same quantum as each other, and so do infinities.
+func (a Decimal128) SameQuantum(b Decimal128) bool {
+	return sameDecQuantum(a.unpack(), b.unpack())
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// The members of a cohort are equal, e.g. 1.0 == 1.00,
+// and -0 and 0 are equal.
+func (a Decimal128) Eq(b Decimal128) bool {
+	x, y := a.unpack(), b.unpack()
+	if x.isNaN() || y.isNaN() {
+		return false
+	}
+	return cmpDec(x, y) == 0
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// The members of a cohort are equal, e.g. 1.0 == 1.00,
+// and -0 and 0 are equal.
+func (a Decimal128) Ne(b Decimal128) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a Decimal128) Lt(b Decimal128) bool {
+	x, y := a.unpack(), b.unpack()
+	if x.isNaN() || y.isNaN() {
+		return false
+	}
+	return cmpDec(x, y) < 0
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a Decimal128) Gt(b Decimal128) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a Decimal128) Le(b Decimal128) bool {
+	x, y := a.unpack(), b.unpack()
+	if x.isNaN() || y.isNaN() {
+		return false
+	}
+	return cmpDec(x, y) <= 0
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a Decimal128) Ge(b Decimal128) bool {
+	return b.Le(a)
+}
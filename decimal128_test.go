@@ -0,0 +1,58 @@
+package floats
+
+import (
+	"testing"
+)
+
+func parseDecimal128(t *testing.T, s string) Decimal128 {
+	t.Helper()
+	d, err := ParseDecimal128(s)
+	if err != nil {
+		t.Fatalf("ParseDecimal128(%q) returned error: %v", s, err)
+	}
+	return d
+}
+
+func TestDecimal128_Arith(t *testing.T) {
+	tests := []struct {
+		op   string
+		a, b string
+		want string
+	}{
+		{"+", "1.20", "1.3", "2.50"},
+		{"+", "9999999999999999999999999999999999", "1", "1.000000000000000000000000000000000e+34"},
+		{"+", "9999999999999999999999999999999999", "0.5", "1.000000000000000000000000000000000e+34"},
+		{"+", "9999999999999999999999999999999998", "0.5", "9999999999999999999999999999999998"},
+		{"+", "9.999999999999999999999999999999999e6144", "5e6110", "+Inf"},
+		{"+", "1e-6176", "-1e-6176", "0e-6176"},
+		{"*", "2.50", "2", "5.00"},
+		{"*", "12345678901234567890", "98765432109876543210", "1.219326311370217952237463801111264e+39"},
+		{"*", "1e-3100", "1e-3100", "0e-6176"},
+		{"*", "1e3100", "1e3100", "+Inf"},
+		{"/", "1", "3", "0.3333333333333333333333333333333333"},
+		{"/", "2.40", "2", "1.20"},
+		{"/", "1", "1e-6145", "+Inf"},
+		{"quantize", "1.2345", "0.01", "1.23"},
+		{"quantize", "1", "1e-33", "1.000000000000000000000000000000000"},
+		{"quantize", "1", "1e-34", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal128(t, tt.a)
+		b := parseDecimal128(t, tt.b)
+		var got Decimal128
+		switch tt.op {
+		case "+":
+			got = a.Add(b)
+		case "*":
+			got = a.Mul(b)
+		case "/":
+			got = a.Quo(b)
+		case "quantize":
+			got = a.Quantize(b)
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s %s %s = %s, want %s", tt.a, tt.op, tt.b, got.String(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package floats
+
+import (
+	"testing"
+)
+
+func parseDecimal32(t *testing.T, s string) Decimal32 {
+	t.Helper()
+	d, err := ParseDecimal32(s)
+	if err != nil {
+		t.Fatalf("ParseDecimal32(%q) returned error: %v", s, err)
+	}
+	return d
+}
+
+func TestDecimal32_Arith(t *testing.T) {
+	tests := []struct {
+		op   string
+		a, b string
+		want string
+	}{
+		{"+", "1.20", "1.3", "2.50"},
+		{"+", "9999999", "1", "1.000000e+07"},
+		{"+", "9999999", "0.5", "1.000000e+07"},
+		{"+", "9999998", "0.5", "9999998"},
+		{"+", "9.999999e96", "5e89", "+Inf"},
+		{"+", "1e-101", "-1e-101", "0e-101"},
+		{"*", "2.50", "2", "5.00"},
+		{"*", "1234567", "7654321", "9.449772e+12"},
+		{"*", "1e-60", "1e-60", "0e-101"},
+		{"*", "1e50", "1e50", "+Inf"},
+		{"/", "1", "3", "0.3333333"},
+		{"/", "2.40", "2", "1.20"},
+		{"/", "1", "1e-97", "+Inf"},
+		{"quantize", "1.2345", "0.01", "1.23"},
+		{"quantize", "1", "1e-6", "1.000000"},
+		{"quantize", "1", "1e-7", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal32(t, tt.a)
+		b := parseDecimal32(t, tt.b)
+		var got Decimal32
+		switch tt.op {
+		case "+":
+			got = a.Add(b)
+		case "*":
+			got = a.Mul(b)
+		case "/":
+			got = a.Quo(b)
+		case "quantize":
+			got = a.Quantize(b)
+		}
+		if got.String() != tt.want {
+			t.Errorf("%s %s %s = %s, want %s", tt.a, tt.op, tt.b, got.String(), tt.want)
+		}
+	}
+}
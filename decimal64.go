@@ -0,0 +1,239 @@
+package floats
+
+import "github.com/shogo82148/ints"
+
+// Decimal64 is an IEEE 754 decimal64 floating-point number.
+// It has 16 decimal digits of precision, and the exponent of the largest finite number is 369.
+// Its value is (-1)^sign × coefficient × 10^exponent.
+//
+// A number may have several representations with different exponents,
+// e.g. 1.0 (10×10^-1) and 1.00 (100×10^-2); they are called a cohort.
+// The arithmetic operations are exact if the result fits in the precision,
+// and they choose the exponent of the result as IEEE 754 recommends:
+// for example, 1.20 + 1.3 is 2.50 and 2.50 × 2 is 5.00.
+// The formatting functions keep the exponent.
+//
+// Decimal64 is stored in the binary integer decimal (BID) encoding.
+// Use [NewDecimal64FromDPD] and [Decimal64.DPD] for the densely packed decimal (DPD) encoding.
+type Decimal64 uint64
+
+// NewDecimal64 returns coeff × 10^exp as a Decimal64, rounding ties to even.
+func NewDecimal64(coeff int64, exp int) Decimal64 {
+	x := decFloat{neg: coeff < 0, exp: exp}
+	if coeff < 0 {
+		x.coeff = ints.Uint256{0, 0, 0, uint64(-coeff)}
+	} else {
+		x.coeff = ints.Uint256{0, 0, 0, uint64(coeff)}
+	}
+	x, _ = decForm64.round(x, false)
+	return pack64(x)
+}
+
+// NewDecimal64FromBits converts the BID encoding to Decimal64.
+func NewDecimal64FromBits(b uint64) Decimal64 {
+	return Decimal64(b)
+}
+
+// NewDecimal64FromDPD converts the DPD encoding to Decimal64.
+func NewDecimal64FromDPD(b uint64) Decimal64 {
+	return pack64(decForm64.undpd(ints.Uint128{0, b}))
+}
+
+// NewDecimal64NaN returns a NaN Decimal64 value.
+func NewDecimal64NaN() Decimal64 {
+	return pack64(decFloat{kind: decNaN})
+}
+
+// NewDecimal64Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewDecimal64Inf(sign int) Decimal64 {
+	return pack64(decFloat{kind: decInf, neg: sign < 0})
+}
+
+// Bits returns the BID encoding of a.
+func (a Decimal64) Bits() uint64 {
+	return uint64(a)
+}
+
+// DPD returns the DPD encoding of a.
+func (a Decimal64) DPD() uint64 {
+	b := decForm64.dpd(a.unpack())
+	return b[1]
+}
+
+// Parts returns the sign, the coefficient, and the exponent of a,
+// i.e. a = (-1)^neg × coeff × 10^exp.
+// If a is NaN, coeff is its payload.
+// Non-canonical encodings are returned as the canonical ones.
+func (a Decimal64) Parts() (neg bool, coeff uint64, exp int) {
+	x := a.unpack()
+	return x.neg, x.coeff[3], x.exp
+}
+
+func (a Decimal64) unpack() decFloat {
+	return decForm64.unbid(ints.Uint128{0, uint64(a)})
+}
+
+func pack64(x decFloat) Decimal64 {
+	b := decForm64.bid(x)
+	return Decimal64(b[1])
+}
+
+// IsNaN reports whether a is an IEEE 754 “not-a-number” value.
+func (a Decimal64) IsNaN() bool {
+	return a.unpack().isNaN()
+}
+
+// IsSignalingNaN reports whether a is a signaling NaN.
+func (a Decimal64) IsSignalingNaN() bool {
+	return a.unpack().kind == decSNaN
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a Decimal64) IsInf(sign int) bool {
+	x := a.unpack()
+	return x.kind == decInf && (sign >= 0 && !x.neg || sign <= 0 && x.neg)
+}
+
+// IsZero reports whether a is zero, regardless of its sign and exponent.
+func (a Decimal64) IsZero() bool {
+	return a.unpack().isZero()
+}
+
+// Signbit reports whether a is negative or negative zero.
+func (a Decimal64) Signbit() bool {
+	return a.unpack().neg
+}
+
+// Neg returns -a.
+func (a Decimal64) Neg() Decimal64 {
+	x := a.unpack()
+	x.neg = !x.neg
+	return pack64(x)
+}
+
+// Abs returns the absolute value of a.
+func (a Decimal64) Abs() Decimal64 {
+	x := a.unpack()
+	x.neg = false
+	return pack64(x)
+}
+
+// Add returns the sum of a and b, rounding ties to even.
+// The exponent of the exact result is the smaller exponent of a and b.
+func (a Decimal64) Add(b Decimal64) Decimal64 {
+	x, _ := decForm64.add(a.unpack(), b.unpack())
+	return pack64(x)
+}
+
+// Sub returns the difference of a and b, rounding ties to even.
+// The exponent of the exact result is the smaller exponent of a and b.
+func (a Decimal64) Sub(b Decimal64) Decimal64 {
+	return a.Add(b.Neg())
+}
+
+// Mul returns the product of a and b, rounding ties to even.
+// The exponent of the exact result is the sum of the exponents of a and b.
+func (a Decimal64) Mul(b Decimal64) Decimal64 {
+	x, _ := decForm64.mul(a.unpack(), b.unpack())
+	return pack64(x)
+}
+
+// Quo returns the quotient of a and b, rounding ties to even.
+// If the result is exact, its exponent is the closest to
+// the difference of the exponents of a and b.
+func (a Decimal64) Quo(b Decimal64) Decimal64 {
+	x, _ := decForm64.quo(a.unpack(), b.unpack())
+	return pack64(x)
+}
+
+// Quantize returns a rounded to the exponent of b, rounding ties to even.
+// If the coefficient of the result does not fit in the precision, it returns NaN.
+//
+// Special cases are:
+//
+//	NaN.Quantize(x) = NaN
+//	x.Quantize(NaN) = NaN
+//	±Inf.Quantize(±Inf) = ±Inf
+//	±Inf.Quantize(x) = NaN
+//	x.Quantize(±Inf) = NaN
+func (a Decimal64) Quantize(b Decimal64) Decimal64 {
+	x, _ := decForm64.quantize(a.unpack(), b.unpack())
+	return pack64(x)
+}
+
+// SameQuantum reports whether a and b have the same exponent.
+// NaNs have the same quantum as each other, and so do infinities.
+func (a Decimal64) SameQuantum(b Decimal64) bool {
+	return sameDecQuantum(a.unpack(), b.unpack())
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// The members of a cohort are equal, e.g. 1.0 == 1.00,
+// and -0 and 0 are equal.
+func (a Decimal64) Eq(b Decimal64) bool {
+	x, y := a.unpack(), b.unpack()
+	if x.isNaN() || y.isNaN() {
+		return false
+	}
+	return cmpDec(x, y) == 0
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// The members of a cohort are equal, e.g. 1.0 == 1.00,
+// and -0 and 0 are equal.
+func (a Decimal64) Ne(b Decimal64) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a Decimal64) Lt(b Decimal64) bool {
+	x, y := a.unpack(), b.unpack()
+	if x.isNaN() || y.isNaN() {
+		return false
+	}
+	return cmpDec(x, y) < 0
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a Decimal64) Gt(b Decimal64) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a Decimal64) Le(b Decimal64) bool {
+	x, y := a.unpack(), b.unpack()
+	if x.isNaN() || y.isNaN() {
+		return false
+	}
+	return cmpDec(x, y) <= 0
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a Decimal64) Ge(b Decimal64) bool {
+	return b.Le(a)
+}
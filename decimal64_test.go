@@ -0,0 +1,278 @@
+package floats
+
+import (
+	"testing"
+)
+
+func parseDecimal64(t *testing.T, s string) Decimal64 {
+	t.Helper()
+	d, err := ParseDecimal64(s)
+	if err != nil {
+		t.Fatalf("ParseDecimal64(%q) returned error: %v", s, err)
+	}
+	return d
+}
+
+func TestDecimal64_Add(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"1.20", "1.3", "2.50"},
+		{"1.20", "-1.2", "0.00"},
+		{"-1.20", "1.2", "0.00"},
+		{"-0", "-0", "-0"},
+		{"-0", "0", "0"},
+		{"0e5", "1e-3", "0.001"},
+		{"1e5", "0e-3", "100000.000"},
+		{"1e16", "1", "1.000000000000000e+16"},    // ties to even
+		{"1e16", "3", "1.000000000000000e+16"},    // round down
+		{"1e16", "6", "1.000000000000001e+16"},    // round up
+		{"1e16", "-1", "9999999999999999"},        // exact
+		{"1e16", "-0.5", "1.000000000000000e+16"}, // ties to even
+		{"1e50", "1e-50", "1.000000000000000e+50"},
+		{"1e50", "-1e-50", "1.000000000000000e+50"},
+		{"9.999999999999999e384", "1e369", "+Inf"},
+		{"9.999999999999999e384", "4e368", "9.999999999999999e+384"},
+		{"1e-398", "1e-398", "2e-398"},
+		{"Inf", "1", "+Inf"},
+		{"-Inf", "1", "-Inf"},
+		{"Inf", "-Inf", "NaN"},
+		{"NaN", "1", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		got := a.Add(b)
+		if got.String() != tt.want {
+			t.Errorf("%s + %s = %s, want %s", tt.a, tt.b, got.String(), tt.want)
+		}
+	}
+}
+
+func TestDecimal64_Sub(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"2.50", "1.3", "1.20"},
+		{"1.3", "1.30", "0.00"},
+		{"-1.3", "-1.30", "0.00"},
+		{"-0", "0", "-0"},
+		{"1", "1e-20", "1.000000000000000"},
+		{"1", "6e-17", "0.9999999999999999"},
+		{"Inf", "Inf", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		got := a.Sub(b)
+		if got.String() != tt.want {
+			t.Errorf("%s - %s = %s, want %s", tt.a, tt.b, got.String(), tt.want)
+		}
+	}
+}
+
+func TestDecimal64_Mul(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"2.50", "2", "5.00"},
+		{"1.20", "3", "3.60"},
+		{"-0.1", "0.1", "-0.01"},
+		{"0e10", "1e-5", "0e+05"},
+		{"1.000000000000001", "1.000000000000001", "1.000000000000002"},
+		{"9.999999999999999", "9.999999999999999", "99.99999999999998"},
+		{"1e-200", "1e-200", "0e-398"},
+		{"5e-200", "1e-199", "0e-398"}, // ties to even
+		{"6e-200", "1e-199", "1e-398"},
+		{"1e200", "1e200", "+Inf"},
+		{"Inf", "-2", "-Inf"},
+		{"Inf", "0", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		got := a.Mul(b)
+		if got.String() != tt.want {
+			t.Errorf("%s * %s = %s, want %s", tt.a, tt.b, got.String(), tt.want)
+		}
+	}
+}
+
+func TestDecimal64_Quo(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"1", "3", "0.3333333333333333"},
+		{"2", "3", "0.6666666666666667"},
+		{"1", "4", "0.25"},
+		{"2.40", "2", "1.20"},
+		{"1000", "100", "10"},
+		{"1", "1e-2", "1e+02"},
+		{"1e2", "2", "5e+01"},
+		{"0.00", "7", "0.00"},
+		{"-0", "7", "-0"},
+		{"1", "0", "+Inf"},
+		{"-1", "0", "-Inf"},
+		{"0", "0", "NaN"},
+		{"1", "Inf", "0e-398"},
+		{"Inf", "Inf", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		got := a.Quo(b)
+		if got.String() != tt.want {
+			t.Errorf("%s / %s = %s, want %s", tt.a, tt.b, got.String(), tt.want)
+		}
+	}
+}
+
+func TestDecimal64_Quantize(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{"1.2345", "0.01", "1.23"},
+		{"1.2355", "0.01", "1.24"},
+		{"1.2250", "0.01", "1.22"}, // ties to even
+		{"1", "0.01", "1.00"},
+		{"-0", "1e5", "-0e+05"},
+		{"1", "1e-15", "1.000000000000000"},
+		{"1", "1e-16", "NaN"}, // too many digits
+		{"Inf", "-Inf", "+Inf"},
+		{"Inf", "1", "NaN"},
+		{"1", "Inf", "NaN"},
+		{"NaN", "1", "NaN"},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		got := a.Quantize(b)
+		if got.String() != tt.want {
+			t.Errorf("%s.Quantize(%s) = %s, want %s", tt.a, tt.b, got.String(), tt.want)
+		}
+	}
+}
+
+func TestDecimal64_SameQuantum(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"1.20", "3.45", true},
+		{"1.20", "1.2", false},
+		{"0.00", "-1.23", true},
+		{"Inf", "-Inf", true},
+		{"Inf", "1", false},
+		{"NaN", "NaN", true},
+		{"NaN", "1", false},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		if got := a.SameQuantum(b); got != tt.want {
+			t.Errorf("%s.SameQuantum(%s) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal64_Compare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		cmp  int // -1, 0, +1, or 2 for unordered
+	}{
+		{"1.20", "1.2", 0},
+		{"1.20", "1.21", -1},
+		{"12", "1.2e1", 0},
+		{"1e10", "9.99e9", 1},
+		{"-0", "0e5", 0},
+		{"-1", "0", -1},
+		{"-1", "-2", 1},
+		{"-Inf", "-9.999999999999999e384", -1},
+		{"Inf", "Inf", 0},
+		{"NaN", "1", 2},
+		{"1", "NaN", 2},
+	}
+
+	for _, tt := range tests {
+		a := parseDecimal64(t, tt.a)
+		b := parseDecimal64(t, tt.b)
+		if got, want := a.Eq(b), tt.cmp == 0; got != want {
+			t.Errorf("%s.Eq(%s) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := a.Ne(b), tt.cmp != 0; got != want {
+			t.Errorf("%s.Ne(%s) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := a.Lt(b), tt.cmp == -1; got != want {
+			t.Errorf("%s.Lt(%s) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := a.Le(b), tt.cmp == -1 || tt.cmp == 0; got != want {
+			t.Errorf("%s.Le(%s) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := a.Gt(b), tt.cmp == 1; got != want {
+			t.Errorf("%s.Gt(%s) = %t, want %t", tt.a, tt.b, got, want)
+		}
+		if got, want := a.Ge(b), tt.cmp == 1 || tt.cmp == 0; got != want {
+			t.Errorf("%s.Ge(%s) = %t, want %t", tt.a, tt.b, got, want)
+		}
+	}
+}
+
+func TestDecimal64_Parts(t *testing.T) {
+	tests := []struct {
+		in    string
+		neg   bool
+		coeff uint64
+		exp   int
+	}{
+		{"1.20", false, 120, -2},
+		{"-0", true, 0, 0},
+		{"1e3", false, 1, 3},
+		{"9.999999999999999e384", false, 9999999999999999, 369},
+		{"1e-398", false, 1, -398},
+	}
+
+	for _, tt := range tests {
+		neg, coeff, exp := parseDecimal64(t, tt.in).Parts()
+		if neg != tt.neg || coeff != tt.coeff || exp != tt.exp {
+			t.Errorf("%s.Parts() = (%t, %d, %d), want (%t, %d, %d)", tt.in, neg, coeff, exp, tt.neg, tt.coeff, tt.exp)
+		}
+	}
+
+	if got := NewDecimal64(-120, -2); got.String() != "-1.20" {
+		t.Errorf("NewDecimal64(-120, -2) = %s, want -1.20", got)
+	}
+	if got := NewDecimal64(12345678901234567, 0); got.String() != "1.234567890123457e+16" {
+		t.Errorf("NewDecimal64(12345678901234567, 0) = %s, want 1.234567890123457e+16", got)
+	}
+}
+
+func TestDecimal64_Special(t *testing.T) {
+	nan := NewDecimal64NaN()
+	if !nan.IsNaN() || nan.IsSignalingNaN() || nan.IsInf(0) {
+		t.Errorf("NewDecimal64NaN() = %x, want quiet NaN", nan.Bits())
+	}
+	snan := NewDecimal64FromBits(0x7e00_0000_0000_002a)
+	if !snan.IsNaN() || !snan.IsSignalingNaN() {
+		t.Errorf("%x is not a signaling NaN", snan.Bits())
+	}
+	if got := snan.Add(NewDecimal64(1, 0)); got.IsSignalingNaN() || got.Bits() != 0x7c00_0000_0000_002a {
+		t.Errorf("sNaN + 1 = %x, want 7c0000000000002a", got.Bits())
+	}
+	if inf := NewDecimal64Inf(-1); !inf.IsInf(-1) || inf.IsInf(1) || !inf.Signbit() {
+		t.Errorf("NewDecimal64Inf(-1) = %x, want -Inf", inf.Bits())
+	}
+	if z := parseDecimal64(t, "-0.00"); !z.IsZero() || !z.Signbit() || z.Neg().Signbit() || z.Abs().Signbit() {
+		t.Errorf("unexpected properties of -0.00")
+	}
+}
@@ -0,0 +1,85 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Dim(t *testing.T) {
+	tests := []struct {
+		a    BFloat16
+		b    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(5.0), exactBF16(3.0), exactBF16(2.0)},
+		{exactBF16(3.0), exactBF16(5.0), exactBF16(0.0)},
+		{exactBF16(3.0), exactBF16(3.0), exactBF16(0.0)},
+
+		// Special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(1.0), exactBF16(math.NaN())},
+		{exactBF16(1.0), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Dim(tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Dim(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Max(t *testing.T) {
+	tests := []struct {
+		a    BFloat16
+		b    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(5.0), exactBF16(3.0), exactBF16(5.0)},
+		{exactBF16(3.0), exactBF16(5.0), exactBF16(5.0)},
+		{exactBF16(3.0), exactBF16(3.0), exactBF16(3.0)},
+
+		// Special cases
+		{exactBF16(1), exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(1)), exactBF16(1), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(0), exactBF16(math.Copysign(0, -1)), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+	}
+	for _, tt := range tests {
+		got := tt.a.Max(tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Max(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Min(t *testing.T) {
+	tests := []struct {
+		a    BFloat16
+		b    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(5.0), exactBF16(3.0), exactBF16(3.0)},
+		{exactBF16(3.0), exactBF16(5.0), exactBF16(3.0)},
+		{exactBF16(3.0), exactBF16(3.0), exactBF16(3.0)},
+
+		// Special cases
+		{exactBF16(1), exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(-1)), exactBF16(1), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(0), exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(0), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+	}
+	for _, tt := range tests {
+		got := tt.a.Min(tt.b)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Min(%v, %v) = %v; want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
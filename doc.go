@@ -1,2 +1,24 @@
 // The floats package provides types for handling multi-precision floating-point numbers.
+//
+// # NaN payloads
+//
+// Arithmetic operations and conversions propagate NaN payloads.
+// If an operation has NaN operands, the result is the first NaN operand
+// in the argument order (a, b for methods; x, y, z for FMA),
+// quieted and with its sign and payload preserved.
+// NaNs produced by invalid operations, such as 0/0, have zero payloads.
+//
+// Conversions preserve the payload as an integer value, see [Float16.Payload].
+// If the payload does not fit in the destination format, it is replaced by zero.
+// Conversions to Float256 are exact, so signaling NaNs stay signaling;
+// other conversions return quiet NaNs.
+//
+// # Scanning
+//
+// The types implement [fmt.Scanner], so they can be read by [fmt.Sscan] and its friends directly.
+// The types can't implement [database/sql.Scanner] too, because its method is also named Scan.
+// Wrap the destinations with [SQL] to read them from database columns:
+//
+//	var a floats.Float128
+//	err := row.Scan(floats.SQL(&a))
 package floats
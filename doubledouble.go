@@ -0,0 +1,257 @@
+package floats
+
+import "math"
+
+// DoubleDouble is a double-double number, the unevaluated sum of two float64 values.
+// It has about 106 bits of precision and the same exponent range as float64.
+//
+// The components are normalized so that a[0] is the sum rounded to float64,
+// and |a[1]| <= ulp(a[0])/2.
+// The arithmetic is implemented with error-free transformations of float64 operations,
+// and it is much faster than [Float128] in exchange for not being correctly rounded.
+// The precision gets lower near the underflow threshold,
+// because a[1] may be subnormal.
+type DoubleDouble [2]float64
+
+// NewDoubleDouble converts f to DoubleDouble.
+func NewDoubleDouble(f float64) DoubleDouble {
+	return DoubleDouble{f, 0}
+}
+
+// newDoubleDoubleInt64 converts n to DoubleDouble exactly.
+func newDoubleDoubleInt64(n int64) DoubleDouble {
+	// both halves have at most 32 significant bits, so they are exact in float64.
+	lo := n & (1<<32 - 1)
+	s, e := twoSum(float64(n-lo), float64(lo))
+	return DoubleDouble{s, e}
+}
+
+// NewDoubleDoubleNaN returns a NaN DoubleDouble value.
+func NewDoubleDoubleNaN() DoubleDouble {
+	return DoubleDouble{math.NaN(), 0}
+}
+
+// NewDoubleDoubleInf positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewDoubleDoubleInf(sign int) DoubleDouble {
+	return DoubleDouble{math.Inf(sign), 0}
+}
+
+// twoSum returns s = fl(a + b) and the rounding error e, so that s + e = a + b exactly.
+func twoSum(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+	return
+}
+
+// quickTwoSum is the same as twoSum, but it requires |a| >= |b|.
+func quickTwoSum(a, b float64) (s, e float64) {
+	s = a + b
+	e = b - (s - a)
+	return
+}
+
+// twoProd returns p = fl(a * b) and the rounding error e, so that p + e = a * b exactly.
+func twoProd(a, b float64) (p, e float64) {
+	p = a * b
+	e = math.FMA(a, b, -p)
+	return
+}
+
+// isFinite reports whether f is neither infinity nor NaN.
+func isFinite(f float64) bool {
+	return f-f == 0
+}
+
+// IsNaN reports whether a is a “not-a-number” value.
+func (a DoubleDouble) IsNaN() bool {
+	return math.IsNaN(a[0])
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a DoubleDouble) IsInf(sign int) bool {
+	return math.IsInf(a[0], sign)
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (a DoubleDouble) Signbit() bool {
+	return math.Signbit(a[0])
+}
+
+// IsZero reports whether a is zero (+0 or -0).
+func (a DoubleDouble) IsZero() bool {
+	return a[0] == 0
+}
+
+// Neg returns the negation of a.
+func (a DoubleDouble) Neg() DoubleDouble {
+	return DoubleDouble{-a[0], -a[1]}
+}
+
+// Abs returns the absolute value of a.
+//
+// Special cases:
+//
+//	Abs(±Inf) = +Inf
+//	Abs(NaN) = NaN
+func (a DoubleDouble) Abs() DoubleDouble {
+	if math.Signbit(a[0]) {
+		return a.Neg()
+	}
+	return a
+}
+
+// Add returns the sum of a and b.
+func (a DoubleDouble) Add(b DoubleDouble) DoubleDouble {
+	s1, s2 := twoSum(a[0], b[0])
+	if !isFinite(s1) {
+		return DoubleDouble{s1, 0}
+	}
+	t1, t2 := twoSum(a[1], b[1])
+	s2 += t1
+	s1, s2 = quickTwoSum(s1, s2)
+	s2 += t2
+	s1, s2 = quickTwoSum(s1, s2)
+	if s1 == 0 {
+		// the sum is zero; its sign follows the rules of float64 addition.
+		s1 = a[0] + b[0]
+		if s1 != 0 {
+			s1 = 0
+		}
+		return DoubleDouble{s1, 0}
+	}
+	return DoubleDouble{s1, s2}
+}
+
+// Sub returns the difference of a and b.
+func (a DoubleDouble) Sub(b DoubleDouble) DoubleDouble {
+	return a.Add(b.Neg())
+}
+
+// Mul returns the product of a and b.
+func (a DoubleDouble) Mul(b DoubleDouble) DoubleDouble {
+	p, e := twoProd(a[0], b[0])
+	if p == 0 || !isFinite(p) {
+		return DoubleDouble{p, 0}
+	}
+	e += a[0]*b[1] + a[1]*b[0]
+	p, e = quickTwoSum(p, e)
+	return DoubleDouble{p, e}
+}
+
+// mulFloat64 returns the product of a and b.
+func (a DoubleDouble) mulFloat64(b float64) DoubleDouble {
+	p, e := twoProd(a[0], b)
+	if p == 0 || !isFinite(p) {
+		return DoubleDouble{p, 0}
+	}
+	e += a[1] * b
+	p, e = quickTwoSum(p, e)
+	return DoubleDouble{p, e}
+}
+
+// Quo returns the quotient of a and b.
+func (a DoubleDouble) Quo(b DoubleDouble) DoubleDouble {
+	q1 := a[0] / b[0]
+	if q1 == 0 || !isFinite(q1) {
+		return DoubleDouble{q1, 0}
+	}
+
+	// compute the quotient digit by digit, and sum them up.
+	r := a.Sub(b.mulFloat64(q1))
+	if !isFinite(r[0]) {
+		// b * q1 overflows
+		return DoubleDouble{q1, 0}
+	}
+	q2 := r[0] / b[0]
+	r = r.Sub(b.mulFloat64(q2))
+	q3 := r[0] / b[0]
+
+	q1, q2 = quickTwoSum(q1, q2)
+	return DoubleDouble{q1, q2}.Add(DoubleDouble{q3, 0})
+}
+
+// Sqrt returns the square root of a.
+//
+// Special cases are:
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func (a DoubleDouble) Sqrt() DoubleDouble {
+	if a[0] <= 0 || !isFinite(a[0]) {
+		return DoubleDouble{math.Sqrt(a[0]), 0}
+	}
+
+	// Karp's trick: sqrt(a) ~ a*x + (a - (a*x)²) * x / 2, where x ~ 1/sqrt(a).
+	x := 1 / math.Sqrt(a[0])
+	ax := a[0] * x
+	p, e := twoProd(ax, ax)
+	r := a.Sub(DoubleDouble{p, e})
+	s, t := twoSum(ax, r[0]*(x*0.5))
+	s, t = quickTwoSum(s, t)
+	return DoubleDouble{s, t}
+}
+
+// ldexp returns a × 2**exp.
+func (a DoubleDouble) ldexp(exp int) DoubleDouble {
+	return DoubleDouble{math.Ldexp(a[0], exp), math.Ldexp(a[1], exp)}
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a DoubleDouble) Eq(b DoubleDouble) bool {
+	return a[0] == b[0] && a[1] == b[1]
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a DoubleDouble) Ne(b DoubleDouble) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a DoubleDouble) Lt(b DoubleDouble) bool {
+	return a[0] < b[0] || a[0] == b[0] && a[1] < b[1]
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a DoubleDouble) Gt(b DoubleDouble) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a DoubleDouble) Le(b DoubleDouble) bool {
+	return a[0] < b[0] || a[0] == b[0] && a[1] <= b[1]
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a DoubleDouble) Ge(b DoubleDouble) bool {
+	return b.Le(a)
+}
@@ -0,0 +1,233 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"testing"
+)
+
+func TestDoubleDouble_Add(t *testing.T) {
+	tests := []struct {
+		a, b, want DoubleDouble
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(2), NewDoubleDouble(3)},
+		{NewDoubleDouble(1), NewDoubleDouble(-1), NewDoubleDouble(0)},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(math.Copysign(0, -1))},
+
+		// the rounding error of float64 is kept in the second component
+		{NewDoubleDouble(1), NewDoubleDouble(0x1p-80), DoubleDouble{1, 0x1p-80}},
+		{DoubleDouble{1, 0x1p-80}, NewDoubleDouble(-1), NewDoubleDouble(0x1p-80)},
+		{DoubleDouble{1, 0x1p-80}, DoubleDouble{1, 0x1p-80}, DoubleDouble{2, 0x1p-79}},
+
+		// handling infinity
+		{NewDoubleDoubleInf(1), NewDoubleDoubleInf(-1), NewDoubleDoubleNaN()},
+		{NewDoubleDoubleInf(1), NewDoubleDouble(1), NewDoubleDoubleInf(1)},
+		{NewDoubleDouble(math.MaxFloat64), NewDoubleDouble(math.MaxFloat64), NewDoubleDoubleInf(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Add(tt.b)
+		if !eqDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Add(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDoubleDouble_Sub(t *testing.T) {
+	tests := []struct {
+		a, b, want DoubleDouble
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(2), NewDoubleDouble(-1)},
+		{NewDoubleDouble(1), NewDoubleDouble(1), NewDoubleDouble(0)},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(0), NewDoubleDouble(math.Copysign(0, -1))},
+		{NewDoubleDouble(1), NewDoubleDouble(0x1p-80), DoubleDouble{1, -0x1p-80}},
+
+		// handling infinity
+		{NewDoubleDoubleInf(1), NewDoubleDoubleInf(1), NewDoubleDoubleNaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sub(tt.b)
+		if !eqDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Sub(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDoubleDouble_Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want DoubleDouble
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(0), NewDoubleDouble(0)},
+		{NewDoubleDouble(1.5), NewDoubleDouble(-3), NewDoubleDouble(-4.5)},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(1), NewDoubleDouble(math.Copysign(0, -1))},
+
+		// (1 + 2**-52)² = 1 + 2**-51 + 2**-104
+		{NewDoubleDouble(1 + 0x1p-52), NewDoubleDouble(1 + 0x1p-52), DoubleDouble{1 + 0x1p-51, 0x1p-104}},
+
+		// handling infinity and NaN
+		{NewDoubleDoubleInf(1), NewDoubleDouble(-1), NewDoubleDoubleInf(-1)},
+		{NewDoubleDoubleInf(1), NewDoubleDouble(0), NewDoubleDoubleNaN()},
+		{NewDoubleDoubleNaN(), NewDoubleDouble(1), NewDoubleDoubleNaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Mul(tt.b)
+		if !eqDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Mul(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkDoubleDouble_Mul(b *testing.B) {
+	x := NewDoubleDouble(1).Quo(NewDoubleDouble(3))
+	y := NewDoubleDouble(2).Sqrt()
+	for b.Loop() {
+		runtime.KeepAlive(x.Mul(y))
+	}
+}
+
+func TestDoubleDouble_Quo(t *testing.T) {
+	tests := []struct {
+		a, b DoubleDouble
+		want string
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(3), "0.3333333333333333333333333333333333333333"},
+		{NewDoubleDouble(2), NewDoubleDouble(7), "0.2857142857142857142857142857142857142857"},
+		{NewDoubleDouble(-1e300), NewDoubleDouble(3e-5), "-3.33333333333333342390189830486599761906421381e+304"},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Quo(tt.b)
+		if !closeDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Quo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		a, b, want DoubleDouble
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(0), NewDoubleDoubleInf(1)},
+		{NewDoubleDouble(0), NewDoubleDouble(0), NewDoubleDoubleNaN()},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(1), NewDoubleDouble(math.Copysign(0, -1))},
+		{NewDoubleDouble(1), NewDoubleDoubleInf(1), NewDoubleDouble(0)},
+		{NewDoubleDouble(math.MaxFloat64), NewDoubleDouble(0.5), NewDoubleDoubleInf(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.a.Quo(tt.b)
+		if !eqDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Quo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDoubleDouble_Sqrt(t *testing.T) {
+	tests := []struct {
+		a    DoubleDouble
+		want string
+	}{
+		{NewDoubleDouble(2), "1.414213562373095048801688724209698078569671875376948073176679737990732478"},
+		{NewDoubleDouble(0.5), "0.707106781186547524400844362104849039284835937688474036588339868995366239"},
+		{NewDoubleDouble(1e300), "1.00000000000000002625238012760220977975850311e+150"},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sqrt()
+		if !closeDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Sqrt() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		a, want DoubleDouble
+	}{
+		{NewDoubleDouble(4), NewDoubleDouble(2)},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(math.Copysign(0, -1))},
+		{NewDoubleDouble(-1), NewDoubleDoubleNaN()},
+		{NewDoubleDoubleInf(1), NewDoubleDoubleInf(1)},
+		{NewDoubleDoubleNaN(), NewDoubleDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.a.Sqrt()
+		if !eqDD(got, tt.want) {
+			t.Errorf("DoubleDouble(%v).Sqrt() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+// TestDoubleDouble_Accuracy compares the arithmetic with the correctly rounded Float128.
+func TestDoubleDouble_Accuracy(t *testing.T) {
+	// the relative error of double-double arithmetic is a few units of 2**-106.
+	const tolerance = 0x1p-102
+
+	r := rand.New(rand.NewPCG(1, 2))
+	random := func() DoubleDouble {
+		x := math.Ldexp(r.Float64()*2-1, r.IntN(64)-32)
+		return DoubleDouble{x, 0}.Add(NewDoubleDouble(x * (r.Float64() - 0.5) * 0x1p-53))
+	}
+	relErr := func(got DoubleDouble, want Float128) float64 {
+		d := got.Float256().Sub(want.Float256())
+		if !want.IsZero() {
+			d = d.Quo(want.Float256())
+		}
+		return math.Abs(float64(d.Float64()))
+	}
+
+	for range 1000 {
+		a, b := random(), random()
+		fa, fb := a.Float128(), b.Float128()
+		if got, want := a.Add(b), fa.Add(fb); relErr(got, want) > tolerance {
+			t.Errorf("DoubleDouble(%v).Add(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Sub(b), fa.Sub(fb); relErr(got, want) > tolerance {
+			t.Errorf("DoubleDouble(%v).Sub(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Mul(b), fa.Mul(fb); relErr(got, want) > tolerance {
+			t.Errorf("DoubleDouble(%v).Mul(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Quo(b), fa.Quo(fb); relErr(got, want) > tolerance {
+			t.Errorf("DoubleDouble(%v).Quo(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Abs().Sqrt(), fa.Abs().Sqrt(); relErr(got, want) > tolerance {
+			t.Errorf("DoubleDouble(%v).Sqrt() = %v, want %v", a.Abs(), got, want)
+		}
+	}
+}
+
+func TestDoubleDouble_Compare(t *testing.T) {
+	tests := []struct {
+		a, b               DoubleDouble
+		eq, lt, gt, le, ge bool
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(1), true, false, false, true, true},
+		{NewDoubleDouble(1), DoubleDouble{1, 0x1p-80}, false, true, false, true, false},
+		{DoubleDouble{1, -0x1p-80}, NewDoubleDouble(1), false, true, false, true, false},
+		{NewDoubleDouble(0), NewDoubleDouble(math.Copysign(0, -1)), true, false, false, true, true},
+		{NewDoubleDoubleNaN(), NewDoubleDouble(1), false, false, false, false, false},
+		{NewDoubleDouble(1), NewDoubleDoubleNaN(), false, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Eq(tt.b); got != tt.eq {
+			t.Errorf("DoubleDouble(%v).Eq(%v) = %v, want %v", tt.a, tt.b, got, tt.eq)
+		}
+		if got := tt.a.Ne(tt.b); got != !tt.eq {
+			t.Errorf("DoubleDouble(%v).Ne(%v) = %v, want %v", tt.a, tt.b, got, !tt.eq)
+		}
+		if got := tt.a.Lt(tt.b); got != tt.lt {
+			t.Errorf("DoubleDouble(%v).Lt(%v) = %v, want %v", tt.a, tt.b, got, tt.lt)
+		}
+		if got := tt.a.Gt(tt.b); got != tt.gt {
+			t.Errorf("DoubleDouble(%v).Gt(%v) = %v, want %v", tt.a, tt.b, got, tt.gt)
+		}
+		if got := tt.a.Le(tt.b); got != tt.le {
+			t.Errorf("DoubleDouble(%v).Le(%v) = %v, want %v", tt.a, tt.b, got, tt.le)
+		}
+		if got := tt.a.Ge(tt.b); got != tt.ge {
+			t.Errorf("DoubleDouble(%v).Ge(%v) = %v, want %v", tt.a, tt.b, got, tt.ge)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+package floats
+
+import "github.com/shogo82148/ints"
+
+// The encodings of the IEEE 754 decimal interchange formats.
+//
+// A decimal number consists of the sign bit, the combination field of w+5 bits,
+// and the trailing significand field of 10×J bits, where J = (p-1)/3.
+// The binary integer decimal (BID) encoding stores the coefficient as a binary integer,
+// and the densely packed decimal (DPD) encoding stores it as declets,
+// which pack three decimal digits into ten bits.
+
+// trailing returns the width of the trailing significand field.
+func (f *decForm) trailing() int {
+	return f.bits - f.w - 6
+}
+
+// lowMask128 returns the mask of the lower n bits.
+func lowMask128(n int) ints.Uint128 {
+	one := ints.Uint128{0, 1}
+	return one.Lsh(uint(n)).Sub(one)
+}
+
+// special returns the encoding of Inf and NaN, which is common to BID and DPD.
+func (f *decForm) special(x decFloat, payload ints.Uint128) ints.Uint128 {
+	var bits ints.Uint128
+	switch x.kind {
+	case decInf:
+		bits = ints.Uint128{0, 0b11110}.Lsh(uint(f.bits - 6))
+	case decNaN:
+		bits = ints.Uint128{0, 0b111110}.Lsh(uint(f.bits - 7)).Or(payload)
+	case decSNaN:
+		bits = ints.Uint128{0, 0b111111}.Lsh(uint(f.bits - 7)).Or(payload)
+	}
+	if x.neg {
+		bits = bits.Or(ints.Uint128{0, 1}.Lsh(uint(f.bits - 1)))
+	}
+	return bits
+}
+
+// unspecial decodes Inf and NaN.
+// It reports false if bits is a finite number.
+func (f *decForm) unspecial(bits ints.Uint128) (x decFloat, ok bool) {
+	x.neg = !bits.Rsh(uint(f.bits - 1)).IsZero()
+	switch bits.Rsh(uint(f.bits-6)).Uint64() & 0b11111 {
+	case 0b11110:
+		x.kind = decInf
+	case 0b11111:
+		x.kind = decNaN
+		if bits.Rsh(uint(f.bits-7)).Uint64()&1 != 0 {
+			x.kind = decSNaN
+		}
+	default:
+		return decFloat{}, false
+	}
+	return x, true
+}
+
+// bid encodes x in the binary integer decimal encoding.
+// x must be rounded to f.
+func (f *decForm) bid(x decFloat) ints.Uint128 {
+	if x.kind != decFinite {
+		return f.special(x, x.coeff.Uint128())
+	}
+
+	t := f.trailing() + 3 // width of the coefficient if it is less than 2^t
+	e := ints.Uint128{0, uint64(x.exp + f.bias())}
+	c := x.coeff.Uint128()
+	var bits ints.Uint128
+	if c.Rsh(uint(t)).IsZero() {
+		// s eeeeeeee ccc...c
+		bits = e.Lsh(uint(t)).Or(c)
+	} else {
+		// s 11 eeeeeeee c...c, the coefficient is 100c...c in binary.
+		bits = ints.Uint128{0, 0b11}.Lsh(uint(f.bits - 3))
+		bits = bits.Or(e.Lsh(uint(t - 2))).Or(c.And(lowMask128(t - 2)))
+	}
+	if x.neg {
+		bits = bits.Or(ints.Uint128{0, 1}.Lsh(uint(f.bits - 1)))
+	}
+	return bits
+}
+
+// unbid decodes bits in the binary integer decimal encoding.
+// Non-canonical coefficients are decoded as zero.
+func (f *decForm) unbid(bits ints.Uint128) decFloat {
+	if x, ok := f.unspecial(bits); ok {
+		if x.isNaN() {
+			payload := bits.And(lowMask128(f.trailing())).Uint256()
+			if payload.Cmp(decPow10[f.p-1]) < 0 {
+				x.coeff = payload
+			}
+		}
+		return x
+	}
+
+	t := f.trailing() + 3
+	x := decFloat{neg: !bits.Rsh(uint(f.bits - 1)).IsZero()}
+	var e, c ints.Uint128
+	if bits.Rsh(uint(f.bits-3)).Uint64()&0b11 == 0b11 {
+		e = bits.Rsh(uint(t - 2)).And(lowMask128(f.w + 2))
+		c = bits.And(lowMask128(t - 2)).Or(ints.Uint128{0, 1}.Lsh(uint(t)))
+	} else {
+		e = bits.Rsh(uint(t)).And(lowMask128(f.w + 2))
+		c = bits.And(lowMask128(t))
+	}
+	x.exp = int(e.Uint64()) - f.bias()
+	if c.Uint256().Cmp(decPow10[f.p]) < 0 {
+		x.coeff = c.Uint256()
+	}
+	return x
+}
+
+// dpd encodes x in the densely packed decimal encoding.
+// x must be rounded to f.
+func (f *decForm) dpd(x decFloat) ints.Uint128 {
+	c := x.coeff
+	var declets ints.Uint128
+	thousand := ints.Uint256{0, 0, 0, 1000}
+	for i := 0; i < f.trailing()/10; i++ {
+		var r ints.Uint256
+		c, r = c.DivMod(thousand)
+		declets = declets.Or(ints.Uint128{0, uint64(binToDPD[r[3]])}.Lsh(uint(10 * i)))
+	}
+	if x.kind != decFinite {
+		return f.special(x, declets)
+	}
+
+	// the combination field holds the leading digit and the two most significant bits of the exponent.
+	e := uint64(x.exp + f.bias())
+	em, ec := e>>f.w, e&(1<<f.w-1)
+	d := c[3]
+	var g uint64
+	if d < 8 {
+		g = em<<3 | d
+	} else {
+		g = 0b11000 | em<<1 | d&1
+	}
+
+	bits := ints.Uint128{0, g}.Lsh(uint(f.bits - 6))
+	bits = bits.Or(ints.Uint128{0, ec}.Lsh(uint(f.trailing())))
+	bits = bits.Or(declets)
+	if x.neg {
+		bits = bits.Or(ints.Uint128{0, 1}.Lsh(uint(f.bits - 1)))
+	}
+	return bits
+}
+
+// undpd decodes bits in the densely packed decimal encoding.
+// Non-canonical declets are decoded in the same way as the canonical ones.
+func (f *decForm) undpd(bits ints.Uint128) decFloat {
+	var c ints.Uint256
+	thousand := ints.Uint256{0, 0, 0, 1000}
+	for i := f.trailing()/10 - 1; i >= 0; i-- {
+		declet := bits.Rsh(uint(10*i)).Uint64() & 0x3ff
+		c = c.Mul(thousand).Add(ints.Uint256{0, 0, 0, uint64(dpdToBin[declet])})
+	}
+	if x, ok := f.unspecial(bits); ok {
+		if x.isNaN() {
+			x.coeff = c
+		}
+		return x
+	}
+
+	g := uint64(bits.Rsh(uint(f.bits-6)).Uint64()) & 0b11111
+	var em, d uint64
+	if g>>3 == 0b11 {
+		em, d = g>>1&0b11, 8|g&1
+	} else {
+		em, d = g>>3, g&0b111
+	}
+	ec := uint64(bits.Rsh(uint(f.trailing())).Uint64()) & (1<<f.w - 1)
+
+	return decFloat{
+		neg:   !bits.Rsh(uint(f.bits - 1)).IsZero(),
+		coeff: ints.Uint256{0, 0, 0, d}.Mul(decPow10[f.p-1]).Add(c),
+		exp:   int(em<<f.w|ec) - f.bias(),
+	}
+}
+
+// binToDPD and dpdToBin convert between three decimal digits and a declet.
+var binToDPD, dpdToBin = func() (enc [1000]uint16, dec [1024]uint16) {
+	for i := range enc {
+		enc[i] = encodeDeclet(uint16(i))
+	}
+	for i := range dec {
+		dec[i] = decodeDeclet(uint16(i))
+	}
+	return
+}()
+
+// encodeDeclet encodes three decimal digits n (0 <= n < 1000) into a declet.
+// The digits abcd efgh ijkm in BCD are encoded as follows:
+//
+//	aei  b9 b8 b7 b6 b5 b4 b3 b2 b1 b0
+//	000   b  c  d  f  g  h  0  j  k  m
+//	001   b  c  d  f  g  h  1  0  0  m
+//	010   b  c  d  j  k  h  1  0  1  m
+//	011   b  c  d  1  0  h  1  1  1  m
+//	100   j  k  d  f  g  h  1  1  0  m
+//	101   f  g  d  0  1  h  1  1  1  m
+//	110   j  k  d  0  0  h  1  1  1  m
+//	111   0  0  d  1  1  h  1  1  1  m
+func encodeDeclet(n uint16) uint16 {
+	d2, d1, d0 := n/100, n/10%10, n%10
+	d := d2 & 1
+	h := d1 & 1
+	m := d0 & 1
+	switch d2>>3<<2 | d1>>3<<1 | d0>>3 {
+	case 0b000:
+		return d2<<7 | d1<<4 | d0
+	case 0b001:
+		return d2<<7 | d1<<4 | 0b1000 | m
+	case 0b010:
+		return d2<<7 | d0>>1<<5 | h<<4 | 0b1010 | m
+	case 0b011:
+		return d2<<7 | 0b10<<5 | h<<4 | 0b1110 | m
+	case 0b100:
+		return d0>>1<<8 | d<<7 | d1<<4 | 0b1100 | m
+	case 0b101:
+		return d1>>1<<8 | d<<7 | 0b01<<5 | h<<4 | 0b1110 | m
+	case 0b110:
+		return d0>>1<<8 | d<<7 | 0b00<<5 | h<<4 | 0b1110 | m
+	default:
+		return d<<7 | 0b11<<5 | h<<4 | 0b1110 | m
+	}
+}
+
+// decodeDeclet decodes a declet b into three decimal digits.
+func decodeDeclet(b uint16) uint16 {
+	pqr := b >> 7 & 0b111
+	pq := b >> 8 & 0b11
+	r := b >> 7 & 1
+	st := b >> 5 & 0b11
+	stu := b >> 4 & 0b111
+	u := b >> 4 & 1
+	wxy := b & 0b111
+	y := b & 1
+
+	var d2, d1, d0 uint16
+	switch b >> 1 & 0b111 {
+	case 0b000, 0b001, 0b010, 0b011:
+		d2, d1, d0 = pqr, stu, wxy
+	case 0b100:
+		d2, d1, d0 = pqr, stu, 8|y
+	case 0b101:
+		d2, d1, d0 = pqr, 8|u, st<<1|y
+	case 0b110:
+		d2, d1, d0 = 8|r, stu, pq<<1|y
+	default:
+		switch st {
+		case 0b00:
+			d2, d1, d0 = 8|r, 8|u, pq<<1|y
+		case 0b01:
+			d2, d1, d0 = 8|r, pq<<1|u, 8|y
+		case 0b10:
+			d2, d1, d0 = pqr, 8|u, 8|y
+		default:
+			d2, d1, d0 = 8|r, 8|u, 8|y
+		}
+	}
+	return d2*100 + d1*10 + d0
+}
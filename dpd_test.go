@@ -0,0 +1,179 @@
+package floats
+
+import (
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+func TestDeclet(t *testing.T) {
+	tests := []struct {
+		n      uint16
+		declet uint16
+	}{
+		{0, 0x000},
+		{9, 0x009},
+		{10, 0x010},
+		{99, 0x05f},
+		{100, 0x080},
+		{123, 0x0a3},
+		{789, 0x3cf},
+		{888, 0x06e},
+		{999, 0x0ff},
+	}
+	for _, tt := range tests {
+		if got := encodeDeclet(tt.n); got != tt.declet {
+			t.Errorf("encodeDeclet(%d) = %#03x, want %#03x", tt.n, got, tt.declet)
+		}
+	}
+
+	// round trip
+	for n := range uint16(1000) {
+		if got := decodeDeclet(encodeDeclet(n)); got != n {
+			t.Errorf("decodeDeclet(encodeDeclet(%d)) = %d", n, got)
+		}
+	}
+
+	// the non-canonical declets are decoded into 888, 889, 898, 899, 988, 989, 998, and 999.
+	for _, b := range []uint16{0x16e, 0x26e, 0x36e} {
+		if got := decodeDeclet(b); got != 888 {
+			t.Errorf("decodeDeclet(%#03x) = %d, want 888", b, got)
+		}
+	}
+}
+
+func TestDecimal32_Encoding(t *testing.T) {
+	tests := []struct {
+		s   string
+		bid uint32
+		dpd uint32
+	}{
+		{"0", 0x3280_0000, 0x2250_0000},
+		{"-0", 0xb280_0000, 0xa250_0000},
+		{"1", 0x3280_0001, 0x2250_0001},
+		{"-7.50", 0xb180_02ee, 0xa230_03d0},
+		{"9999999", 0x6cb8_967f, 0x6e53_fcff},
+		{"9.999999e+96", 0x77f8_967f, 0x77f3_fcff}, // max
+		{"1e-101", 0x0000_0001, 0x0000_0001},       // min subnormal
+		{"+Inf", 0x7800_0000, 0x7800_0000},
+		{"-Inf", 0xf800_0000, 0xf800_0000},
+		{"NaN", 0x7c00_0000, 0x7c00_0000},
+	}
+
+	for _, tt := range tests {
+		x, err := ParseDecimal32(tt.s)
+		if err != nil {
+			t.Fatalf("ParseDecimal32(%q) returned error: %v", tt.s, err)
+		}
+		if got := x.Bits(); got != tt.bid {
+			t.Errorf("%s.Bits() = %#08x, want %#08x", tt.s, got, tt.bid)
+		}
+		if got := x.DPD(); got != tt.dpd {
+			t.Errorf("%s.DPD() = %#08x, want %#08x", tt.s, got, tt.dpd)
+		}
+		if got := NewDecimal32FromBits(tt.bid).String(); got != tt.s {
+			t.Errorf("NewDecimal32FromBits(%#08x) = %s, want %s", tt.bid, got, tt.s)
+		}
+		if got := NewDecimal32FromDPD(tt.dpd).String(); got != tt.s {
+			t.Errorf("NewDecimal32FromDPD(%#08x) = %s, want %s", tt.dpd, got, tt.s)
+		}
+	}
+}
+
+func TestDecimal64_Encoding(t *testing.T) {
+	tests := []struct {
+		s   string
+		bid uint64
+		dpd uint64
+	}{
+		{"0", 0x31c0_0000_0000_0000, 0x2238_0000_0000_0000},
+		{"-0", 0xb1c0_0000_0000_0000, 0xa238_0000_0000_0000},
+		{"1", 0x31c0_0000_0000_0000 | 1, 0x2238_0000_0000_0000 | 1},
+		{"9999999999999999", 0x6c73_86f2_6fc0_ffff, 0x6e38_ff3f_cff3_fcff},
+		{"9.999999999999999e+384", 0x77fb_86f2_6fc0_ffff, 0x77fc_ff3f_cff3_fcff}, // max
+		{"1e-398", 0x0000_0000_0000_0001, 0x0000_0000_0000_0001},                 // min subnormal
+		{"+Inf", 0x7800_0000_0000_0000, 0x7800_0000_0000_0000},
+		{"NaN", 0x7c00_0000_0000_0000, 0x7c00_0000_0000_0000},
+	}
+
+	for _, tt := range tests {
+		x, err := ParseDecimal64(tt.s)
+		if err != nil {
+			t.Fatalf("ParseDecimal64(%q) returned error: %v", tt.s, err)
+		}
+		if got := x.Bits(); got != tt.bid {
+			t.Errorf("%s.Bits() = %#016x, want %#016x", tt.s, got, tt.bid)
+		}
+		if got := x.DPD(); got != tt.dpd {
+			t.Errorf("%s.DPD() = %#016x, want %#016x", tt.s, got, tt.dpd)
+		}
+		if got := NewDecimal64FromBits(tt.bid).String(); got != tt.s {
+			t.Errorf("NewDecimal64FromBits(%#016x) = %s, want %s", tt.bid, got, tt.s)
+		}
+		if got := NewDecimal64FromDPD(tt.dpd).String(); got != tt.s {
+			t.Errorf("NewDecimal64FromDPD(%#016x) = %s, want %s", tt.dpd, got, tt.s)
+		}
+	}
+
+	// non-canonical coefficients are decoded as zero.
+	if x := NewDecimal64FromBits(0x6c7386f26fc10000); !x.IsZero() {
+		t.Errorf("the non-canonical coefficient 10^16 is decoded as %s, want zero", x)
+	}
+}
+
+func TestDecimal128_Encoding(t *testing.T) {
+	tests := []struct {
+		s   string
+		bid ints.Uint128
+		dpd ints.Uint128
+	}{
+		{"0", ints.Uint128{0x3040_0000_0000_0000, 0}, ints.Uint128{0x2208_0000_0000_0000, 0}},
+		{"1", ints.Uint128{0x3040_0000_0000_0000, 1}, ints.Uint128{0x2208_0000_0000_0000, 1}},
+		{"-1.00", ints.Uint128{0xb03c_0000_0000_0000, 100}, ints.Uint128{0xa207_8000_0000_0000, 0x080}},
+		{
+			"9.999999999999999999999999999999999e+6144", // max
+			ints.Uint128{0x5fff_ed09_bead_87c0, 0x378d_8e63_ffff_ffff},
+			ints.Uint128{0x77ff_cff3_fcff_3fcf, 0xf3fc_ff3f_cff3_fcff},
+		},
+		{"1e-6176", ints.Uint128{0, 1}, ints.Uint128{0, 1}}, // min subnormal
+		{"-Inf", ints.Uint128{0xf800_0000_0000_0000, 0}, ints.Uint128{0xf800_0000_0000_0000, 0}},
+		{"NaN", ints.Uint128{0x7c00_0000_0000_0000, 0}, ints.Uint128{0x7c00_0000_0000_0000, 0}},
+	}
+
+	for _, tt := range tests {
+		x, err := ParseDecimal128(tt.s)
+		if err != nil {
+			t.Fatalf("ParseDecimal128(%q) returned error: %v", tt.s, err)
+		}
+		if got := x.Bits(); got != tt.bid {
+			t.Errorf("%s.Bits() = %016x%016x, want %016x%016x", tt.s, got[0], got[1], tt.bid[0], tt.bid[1])
+		}
+		if got := x.DPD(); got != tt.dpd {
+			t.Errorf("%s.DPD() = %016x%016x, want %016x%016x", tt.s, got[0], got[1], tt.dpd[0], tt.dpd[1])
+		}
+		if got := NewDecimal128FromBits(tt.bid).String(); got != tt.s {
+			t.Errorf("NewDecimal128FromBits(%x) = %s, want %s", tt.bid, got, tt.s)
+		}
+		if got := NewDecimal128FromDPD(tt.dpd).String(); got != tt.s {
+			t.Errorf("NewDecimal128FromDPD(%x) = %s, want %s", tt.dpd, got, tt.s)
+		}
+	}
+}
+
+func TestDecimal_EncodingRoundTrip(t *testing.T) {
+	// every combination of the leading digit and the exponent must survive BID -> DPD -> BID.
+	for d := range int64(10) {
+		for exp := -101; exp <= 90; exp++ {
+			x := NewDecimal32(d*1000000+123456, exp)
+			if got := NewDecimal32FromDPD(x.DPD()); got != x {
+				t.Errorf("Decimal32 %s: DPD round trip = %s", x, got)
+			}
+		}
+		for exp := -398; exp <= 369; exp++ {
+			x := NewDecimal64(d*1000000000000000+123456789012345, exp)
+			if got := NewDecimal64FromDPD(x.DPD()); got != x {
+				t.Errorf("Decimal64 %s: DPD round trip = %s", x, got)
+			}
+		}
+	}
+}
@@ -87,6 +87,95 @@ func (a Float256) Erf() Float256 {
 	return y
 }
 
+// Erfc returns the complementary error function of a.
+//
+// Special cases are:
+//
+//	+Inf.Erfc() = 0
+//	-Inf.Erfc() = 2
+//	NaN.Erfc() = NaN
+func (a Float256) Erfc() Float256 {
+	var (
+		// Zero is 0
+		Zero = Float256{}
+		// One is 1
+		One = Float256(uvone256)
+		// Two is 2
+		Two = Float256{
+			0x4000_0000_0000_0000, 0x0000_0000_0000_0000,
+			0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+		}
+		// TwoOverSqrtPi is 2/sqrt(π)
+		TwoOverSqrtPi = Float256{
+			0x3fff_f20d_d750_429b, 0x6d11_ae3a_914f_ed7f,
+			0xd868_8281_341d_7587, 0xcea2_e734_2b06_199d,
+		}
+		// TwoPointFour is 2.4
+		TwoPointFour = Float256{
+			0x4000_0333_3333_3333, 0x3333_3333_3333_3333,
+			0x3333_3333_3333_3333, 0x3333_3333_3333_3333,
+		}
+		// Sqrt2 is sqrt(2)
+		Sqrt2 = Float256{
+			0x3fff_f6a0_9e66_7f3b, 0xcc90_8b2f_b136_6ea9,
+			0x57d3_e3ad_ec17_5127, 0x7509_9da2_f590_b066,
+		}
+		// SqrtTwoOverPi is sqrt(2/π)
+		SqrtTwoOverPi = Float256{
+			0x3fff_e988_4533_d436, 0x508d_0fcb_3c50_0bab,
+			0x8e2f_f4e0_a7cc_1449, 0xc1b6_3011_c6d2_0099,
+		}
+	)
+
+	// special cases
+	switch {
+	case a.IsInf(1):
+		return Zero
+	case a.IsInf(-1):
+		return Two
+	case a.IsNaN():
+		return NewFloat256NaN()
+	}
+
+	sign := false
+	if a.Signbit() {
+		sign = true
+		a = a.Neg()
+	}
+
+	var y Float256
+	switch {
+	case a.Lt(TwoPointFour):
+		// use Taylor series expansion
+		// erf(x) = 2/sqrt(π) * Σ[n=0..∞] (-1)^n * x^(2n+1) / (n! * (2n+1))
+		for n := 100; n >= 0; n-- {
+			term := power256(a, 2*n+1).Quo(factorial256(n).Mul(NewFloat256(float64(2*n + 1))))
+			if n%2 != 0 {
+				term = term.Neg()
+			}
+			y = y.Add(term)
+		}
+		y = One.Sub(y.Mul(TwoOverSqrtPi))
+
+	default:
+		// use continued fraction expansion, which doesn't suffer from
+		// the cancellation in 1 - erf(x) for large x.
+		// The relative error of n terms is about exp(-2a*sqrt(2n)),
+		// so 4000/a² terms are enough for 256-bit precision;
+		// at least 100 terms are needed for large a, where the estimate does not hold yet.
+		x := Sqrt2.Mul(a)
+		f := math.Min(float64(a.Float64()), 1e4)
+		for n := int(4000/(f*f)) + 100; n >= 1; n-- {
+			y = NewFloat256(float64(n)).Quo(x.Add(y))
+		}
+		y = a.Mul(a).Neg().Exp().Quo(x.Add(y)).Mul(SqrtTwoOverPi)
+	}
+	if sign {
+		y = Two.Sub(y)
+	}
+	return y
+}
+
 // Erfinv returns the inverse error function of a.
 //
 // Special cases are:
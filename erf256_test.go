@@ -54,6 +54,61 @@ func BenchmarkFloat256_Erf(b *testing.B) {
 	}
 }
 
+func TestFloat256_Erfc(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(-1), "1.84270079294971486934122063508260925929606699796630290845993789783471725409601084"},
+		{exact256(0), "1"},
+		{exact256(0.5), "0.479500122186953462317253346108035471263548424242036299941194274352806478283146429"},
+		{exact256(1), "0.157299207050285130658779364917390740703933002033697091540062102165282745903989159"},
+		{exact256(2), "4.67773498104726583793074363274707138910820295993992326164767379956271928000482263e-3"},
+		{exact256(2.375), "7.82938217891119197551102358478388606120121678603469699257976293697409508433607512e-4"},
+		{exact256(2.5), "4.06952017444958939564215739974912720348677403713420139120778769097006029849665642e-4"},
+		{exact256(3), "2.20904969985854413727761295823203798477070873992496572389548429424566836201322678e-5"},
+		{exact256(3.5), "7.43098372341412745523683756095635720660092172797462591109569943500039590529399274e-7"},
+		{exact256(5), "1.53745979442803485018834348538337889011805031472337993068791405592039136455869146e-12"},
+		{exact256(10), "2.08848758376254475700078629495778861156081811932116372701221371393817469583344029e-45"},
+
+		// 1 - Erf(x) would be zero for these arguments.
+		{exact256(27), "5.23704892378925568501606768284954709093391254796867079959921594524677287926529770e-319"},
+		{exact256(100), "6.40596142492173203902133914858639414821441439946033805776710765024890255482950583e-4346"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Erfc()
+		if !close256(got, tt.want) {
+			t.Errorf("Erfc(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		// special cases
+		{exact256(math.Inf(1)), exact256(0)},
+		{exact256(math.Inf(-1)), exact256(2)},
+		{exact256(math.NaN()), exact256(math.NaN())},
+		{exact256(1e10), exact256(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Erfc()
+		if !eq256(got, tt.want) {
+			t.Errorf("Erfc(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkFloat256_Erfc(b *testing.B) {
+	x := exact256(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Erfc())
+	}
+}
+
 func TestFloat256_Erfinv(t *testing.T) {
 	tests := []struct {
 		x    Float256
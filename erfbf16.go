@@ -0,0 +1,49 @@
+package floats
+
+import "math"
+
+// Erf returns the error function of a.
+//
+// Special cases are:
+//
+//	+Inf.Erf() = 1
+//	-Inf.Erf() = -1
+//	NaN.Erf() = NaN
+func (a BFloat16) Erf() BFloat16 {
+	return NewBFloat16(math.Erf(a.Float64().BuiltIn()))
+}
+
+// Erfc returns the complementary error function of x.
+//
+// Special cases are:
+//
+//	+Inf.Erfc() = 0
+//	-Inf.Erfc() = 2
+//	NaN.Erfc() = NaN
+func (a BFloat16) Erfc() BFloat16 {
+	return NewBFloat16(math.Erfc(a.Float64().BuiltIn()))
+}
+
+// Erfinv returns the inverse error function of a.
+//
+// Special cases are:
+//
+//	1.Erfinv() = +Inf
+//	-1.Erfinv() = -Inf
+//	x.Erfinv() = NaN if x < -1 or x > 1
+//	NaN.Erfinv() = NaN
+func (a BFloat16) Erfinv() BFloat16 {
+	return NewBFloat16(math.Erfinv(a.Float64().BuiltIn()))
+}
+
+// Erfcinv returns the inverse of [Erfc](a).
+//
+// Special cases are:
+//
+//	0.Erfcinv() = +Inf
+//	2.Erfcinv() = -Inf
+//	x.Erfcinv() = NaN if x < 0 or x > 2
+//	NaN.Erfcinv() = NaN
+func (a BFloat16) Erfcinv() BFloat16 {
+	return NewBFloat16(math.Erfcinv(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,192 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBFloat16_Erf(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Erf(0)},
+		{exactBF16(0x1p-14), math.Erf(0x1p-14)},
+		{exactBF16(1), math.Erf(1)},
+		{exactBF16(2), math.Erf(2)},
+		{exactBF16(3), math.Erf(3)},
+		{exactBF16(4), math.Erf(4)},
+		{exactBF16(11), math.Erf(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Erf()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Erf(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(1)},
+		{exactBF16(math.Inf(-1)), exactBF16(-1)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Erf()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Erf(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Erf(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Erf())
+	}
+}
+
+func TestBFloat16_Erfc(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Erfc(0)},
+		{exactBF16(0x1p-14), math.Erfc(0x1p-14)},
+		{exactBF16(1), math.Erfc(1)},
+		{exactBF16(2), math.Erfc(2)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Erfc()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Erfc(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(math.Inf(-1)), exactBF16(2)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Erfc()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Erfc(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Erfc(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Erfc())
+	}
+}
+
+func TestBFloat16_Erfinv(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-0.75), math.Erfinv(-0.75)},
+		{exactBF16(-0.5), math.Erfinv(-0.5)},
+		{exactBF16(-0.25), math.Erfinv(-0.25)},
+		{exactBF16(0), math.Erfinv(0)},
+		{exactBF16(0.25), math.Erfinv(0.25)},
+		{exactBF16(0.5), math.Erfinv(0.5)},
+		{exactBF16(0.75), math.Erfinv(0.75)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Erfinv()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Erfinv(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(1), exactBF16(math.Inf(1))},
+		{exactBF16(-1), exactBF16(math.Inf(-1))},
+		{exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Erfinv()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Erfinv(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Erfinv(b *testing.B) {
+	x := exactBF16(0.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Erfinv())
+	}
+}
+
+func TestBFloat16_Erfcinv(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.25), math.Erfcinv(0.25)},
+		{exactBF16(0.5), math.Erfcinv(0.5)},
+		{exactBF16(0.75), math.Erfcinv(0.75)},
+		{exactBF16(1), math.Erfcinv(1)},
+		{exactBF16(1.25), math.Erfcinv(1.25)},
+		{exactBF16(1.5), math.Erfcinv(1.5)},
+		{exactBF16(1.75), math.Erfcinv(1.75)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Erfcinv()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Erfcinv(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(math.Inf(1))},
+		{exactBF16(2), exactBF16(math.Inf(-1))},
+		{exactBF16(3), exactBF16(math.NaN())},
+		{exactBF16(-1), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Erfcinv()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Erfcinv(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Erfcinv(b *testing.B) {
+	x := exactBF16(0.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Erfcinv())
+	}
+}
@@ -120,3 +120,16 @@ func ExampleFloat256_Acos() {
 	// Output:
 	// 0.0
 }
+
+func ExampleFloat16_Scan() {
+	var a floats.Float16
+	var b floats.Float128
+	var c floats.Float256
+	n, err := fmt.Sscan("0.1 0x1.8p+1 -Inf", &a, &b, &c)
+	fmt.Println(n, err)
+	fmt.Println(a, b, c)
+
+	// Output:
+	// 3 <nil>
+	// 0.1 3 -Inf
+}
@@ -1,7 +1,15 @@
 package floats
 
+import "math"
+
 // Exp returns e**x, the base-e exponential of a.
 //
+// The result is computed in Float256 with a relative error less than 2⁻²²⁰,
+// and then rounded to the nearest Float128.
+// So the error is less than 0.5 + 2⁻¹⁰⁰ ulp,
+// and the result is correctly rounded unless e**x is within the relative distance 2⁻²²⁰
+// of a midpoint between two Float128 values.
+//
 // Special cases are:
 //
 //	+Inf.Exp() = +Inf
@@ -11,14 +19,6 @@ package floats
 // Very small values underflow to 1.
 func (a Float128) Exp() Float128 {
 	var (
-		// Ln2Hi = ln(2) ~ 0.6931471805599453094172321214581765
-		// Ln2Lo = ln(2) - Ln2Hi ~ 8.928835774481220748938623512047474e-35
-		Ln2Hi = Float128{0x3ffe_62e4_2fef_a39e, 0xf357_93c7_6730_07e5}
-		Ln2Lo = Float128{0x3f8d_dabd_03cd_0c99, 0xca62_d8b6_2834_5d6e}
-
-		// log2(e) ~ 1.442695040888963407359924681001892
-		Log2e = Float128{0x3fff_7154_7652_b82f, 0xe177_7d0f_fda0_d23a}
-
 		// ln(max float128 + 0.5ulp) = ln(2¹⁶³⁸³×(2-2⁻¹¹³))
 		// ~ 11356.523406294143949491931077970765
 		Overflow = Float128{0x400c_62e4_2fef_a39e, 0xf357_93c7_6730_07e6}
@@ -27,13 +27,10 @@ func (a Float128) Exp() Float128 {
 		// ~ -11433.462743336297878837243843452623
 		Underflow = Float128{0xc00c_654b_b3b2_c73e, 0xbb05_9fab_b506_ff34}
 
-		// The upper limit for underflow
-		// when exp(a) ~ 1 + a + a²/2! + ...
-		// NearZero = 2**-57
-		NearZero = Float128{0x3fc6_0000_0000_0000, 0x0000_0000_0000_0000}
-
-		// Half = 0.5
-		Half = Float128{0x3ffe_0000_0000_0000, 0x0000_0000_0000_0000}
+		// exp(a) rounds to 1 if |a| < NearZero,
+		// because the nearest midpoints are 1-2⁻¹¹⁴ and 1+2⁻¹¹³.
+		// NearZero = 2**-116
+		NearZero = Float128{0x3f8b_0000_0000_0000, 0x0000_0000_0000_0000}
 	)
 
 	// special cases
@@ -49,22 +46,10 @@ func (a Float128) Exp() Float128 {
 	case a.Lt(Underflow):
 		return Float128{} // 0
 	case a.Abs().Lt(NearZero):
-		return Float128(uvone128).Add(a)
+		return Float128(uvone128)
 	}
 
-	// reduce; computed as r = hi - lo for extra precision.
-	var k int64
-	if a.Signbit() {
-		k = Log2e.Mul(a).Sub(Half).Int64()
-	} else {
-		k = Log2e.Mul(a).Add(Half).Int64()
-	}
-	fk := NewFloat128(float64(k))
-	hi := a.Sub(fk.Mul(Ln2Hi))
-	lo := fk.Mul(Ln2Lo)
-
-	// compute
-	return expmulti128(hi, lo, k)
+	return expKernel256(a.Float256()).Float128()
 }
 
 // Exp2 returns 2**x, the base-2 exponential of x.
@@ -116,6 +101,13 @@ func (a Float128) Exp2() Float128 {
 	return expmulti128(hi, lo, k)
 }
 
+// Exp10 returns 10**a, the base-10 exponential of a.
+//
+// Special cases are the same as [Exp].
+func (a Float128) Exp10() Float128 {
+	return a.Float256().Exp10().Float128()
+}
+
 func expmulti128(hi, lo Float128, k int64) Float128 {
 	var (
 		One = Float128{0x3fff_0000_0000_0000, 0x0000_0000_0000_0000} // 1.0
@@ -148,3 +140,73 @@ func expmulti128(hi, lo Float128, k int64) Float128 {
 	y := One.Sub(lo.Sub(r.Mul(c).Quo(Two.Sub(c))).Sub(hi))
 	return y.Ldexp(int(k))
 }
+
+// expKernel256 returns e**x for finite x with |x| < 2¹⁴.
+// The relative error is less than 2⁻²²⁸.
+func expKernel256(x Float256) Float256 {
+	var (
+		// Ln2Hi = ln(2) ~ 0.69314718055994530941723212145817656807550013436025525412068000949339362
+		// Ln2Lo = ln(2) - Ln2Hi ~ 1.68505384472783385591763704017160191700974868940429492048047E-72
+		Ln2Hi = Float256{
+			0x3fff_e62e_42fe_fa39, 0xef35_793c_7673_007e,
+			0x5ed5_e81e_6864_ce53, 0x16c5_b141_a2eb_7175,
+		}
+		Ln2Lo = Float256{
+			0x3ff1_07d1_5f3d_c3b1, 0x036f_5d64_c2ac_aa97,
+			0xda57_d0d8_8769_7571, 0xae09_c0c7_cb80_70d0,
+		}
+	)
+
+	// reduce; x = k×ln(2) + r, |r| <= ln(2)/2.
+	// k×Ln2Hi and k×Ln2Lo are exact in the fused multiply-add,
+	// so r has only the rounding error of the final subtraction.
+	k := math.Round(x.Float64().BuiltIn() * math.Log2E)
+	fk := NewFloat256(-k)
+	r := FMA256(fk, Ln2Hi, x)
+	r = FMA256(fk, Ln2Lo, r)
+
+	// compute
+	return Float256(uvone256).Add(expm1Kernel256(r)).Ldexp(int(k))
+}
+
+// expm1Kernel256 returns e**r - 1 for |r| < 0.35.
+// The relative error is less than 2⁻²³⁰.
+func expm1Kernel256(r Float256) Float256 {
+	// coefficients of the Taylor series; P[n] = 1/(n+1)!
+	var P = [...]Float256{
+		{0x3fff_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000},
+		{0x3fff_e000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000},
+		{0x3fff_c555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555},
+		{0x3fff_a555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555},
+		{0x3fff_8111_1111_1111, 0x1111_1111_1111_1111, 0x1111_1111_1111_1111, 0x1111_1111_1111_1111},
+		{0x3fff_56c1_6c16_c16c, 0x16c1_6c16_c16c_16c1, 0x6c16_c16c_16c1_6c16, 0xc16c_16c1_6c16_c16c},
+		{0x3fff_2a01_a01a_01a0, 0x1a01_a01a_01a0_1a01, 0xa01a_01a0_1a01_a01a, 0x01a0_1a01_a01a_01a0},
+		{0x3ffe_fa01_a01a_01a0, 0x1a01_a01a_01a0_1a01, 0xa01a_01a0_1a01_a01a, 0x01a0_1a01_a01a_01a0},
+		{0x3ffe_c71d_e3a5_56c7, 0x338f_aac1_c88e_5001, 0x71de_3a55_6c73_38fa, 0xac1c_88e5_0017_1de4},
+		{0x3ffe_927e_4fb7_789f, 0x5c72_ef01_6d3e_a667, 0x8e4b_61dd_f05c_2d95, 0x567d_3a50_ccdf_4b1d},
+		{0x3ffe_5ae6_4567_f544, 0xe38f_e747_e4b8_37dc, 0x71e2_02b7_2f11_b6aa, 0xac59_0f01_29fe_f8e4},
+		{0x3ffe_21ee_d8ef_f8d8, 0x97b5_44da_987a_cfe8, 0x4bec_01cf_74b6_79c7, 0x1d90_b4ab_7154_a5ed},
+		{0x3ffd_e612_4613_a86d, 0x097c_a383_31d2_3af6, 0x84d3_b375_7bf4_471c, 0x7328_40d3_01a3_425f},
+		{0x3ffd_a939_74a8_c07c, 0x9d20_badf_145d_fa3e, 0x4ea8_cd18_8da9_75d7, 0x5f09_6ea8_01df_2748},
+		{0x3ffd_6ae7_f3e7_33b8, 0x1f11_d865_6b0e_e8ca, 0xfe91_ebd5_ec70_7db2, 0x8781_8719_9b98_b26f},
+		{0x3ffd_2ae7_f3e7_33b8, 0x1f11_d865_6b0e_e8ca, 0xfe91_ebd5_ec70_7db2, 0x8781_8719_9b98_b26f},
+		{0x3ffc_e952_c770_30ad, 0x4a6b_2605_1977_71af, 0xfea7_748d_1ac4_3a11, 0x7079_e890_9271_98e1},
+		{0x3ffc_a682_7863_b97d, 0x977b_b004_886a_2c2a, 0xa978_6799_dee7_500f, 0x806c_5cf2_4948_87e4},
+		{0x3ffc_62f4_9b46_8141, 0x5724_ca1e_c3b7_b967, 0x4b57_eb74_1a06_2878, 0xd7ef_76b1_154a_8d62},
+	}
+
+	// reduce; e**r - 1 = s₈ where s₀ = e**(r/2⁸) - 1 and sᵢ₊₁ = 2sᵢ + sᵢ².
+	const n = 8
+	r = r.Ldexp(-n)
+
+	// compute
+	p := P[len(P)-1]
+	for i := len(P) - 2; i >= 0; i-- {
+		p = FMA256(p, r, P[i])
+	}
+	s := p.Mul(r)
+	for range n {
+		s = FMA256(s, s, s.Ldexp(1))
+	}
+	return s
+}
@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 )
@@ -44,6 +45,29 @@ func TestFloat128_Exp(t *testing.T) {
 	}
 }
 
+func TestFloat128_Exp_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 15))
+	inputs := []Float128{
+		exact128(0x1p-116),
+		exact128(-0x1p-116),
+		exact128(0x1p-112),
+		exact128(-0x1p-113),
+		exact128(11356),
+		exact128(-11433),
+	}
+	for range 500 {
+		inputs = append(inputs, randFloat128(r, -120, 14), randFloat128(r, -120, 14).Neg())
+	}
+
+	for _, x := range inputs {
+		got := x.Exp()
+		want := round128(expBig(x.BigFloat(nil)))
+		if !eq128(got, want) {
+			t.Errorf("Exp(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
+
 func TestFloat128_Exp2(t *testing.T) {
 	tests := []struct {
 		x    Float128
@@ -96,3 +120,48 @@ func BenchmarkFloat128_Exp2(b *testing.B) {
 		runtime.KeepAlive(x.Exp2())
 	}
 }
+
+func TestFloat128_Exp10(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(-2), "0.01000000000000000000000000000000000000000"},
+		{exact128(-0.5), "0.3162277660168379331998893544432718533720"},
+		{exact128(0x1p-10), "1.002251148292912915465673638866571192454"},
+		{exact128(0.25), "1.778279410038922801225421195192684844736"},
+		{exact128(0.5), "3.162277660168379331998893544432718533720"},
+		{exact128(1.5), "31.62277660168379331998893544432718533720"},
+		{exact128(2.5), "316.2277660168379331998893544432718533720"},
+		{exact128(4), "10000.00000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10()
+		if !close128(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		{exact128(0), exact128(1)},
+		{exact128(math.Copysign(0, -1)), exact128(1)},
+		{exact128(1), exact128(10)},
+		{exact128(2), exact128(100)},
+		{exact128(3), exact128(1000)},
+		{exact128(4933), exact128(math.Inf(1))},
+		{exact128(math.Inf(1)), exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), exact128(0)},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10()
+		if !eq128(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -12,12 +12,28 @@ import "math"
 // Very large values overflow to 0 or +Inf.
 // Very small values underflow to 1.
 func (a Float16) Exp() Float16 {
-	return NewFloat16(math.Exp(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Exp(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Exp().Float16()
 }
 
 // Exp2 returns 2**x, the base-2 exponential of x.
 //
 // Special cases are the same as [Exp].
 func (a Float16) Exp2() Float16 {
-	return NewFloat16(math.Exp2(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Exp2(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Exp2().Float16()
+}
+
+// Exp10 returns 10**a, the base-10 exponential of a.
+//
+// Special cases are the same as [Exp].
+func (a Float16) Exp10() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Exp10().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Exp10().Float16()
 }
@@ -75,3 +75,62 @@ func TestFloat16_Exp2(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat16_Exp_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Exp", Float16.Exp, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Exp()
+	})
+}
+
+func TestFloat16_Exp2_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Exp2", Float16.Exp2, exp2Ref)
+}
+
+func TestFloat16_Exp10(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(-2), 0.01},
+		{exact16(-0.5), 0.31622776601683794},
+		{exact16(0x1p-10), 1.0022511482929128},
+		{exact16(0.25), 1.7782794100389228},
+		{exact16(0.5), 3.1622776601683795},
+		{exact16(1.5), 31.622776601683793},
+		{exact16(2.5), 316.22776601683796},
+		{exact16(4), 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10()
+		if !close16(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		{exact16(0), exact16(1)},
+		{exact16(math.Copysign(0, -1)), exact16(1)},
+		{exact16(1), exact16(10)},
+		{exact16(2), exact16(100)},
+		{exact16(3), exact16(1000)},
+		{exact16(5), exact16(math.Inf(1))},
+		{exact16(math.Inf(1)), exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), exact16(0)},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10()
+		if !eq16(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Exp10_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Exp10", Float16.Exp10, exp10Ref)
+}
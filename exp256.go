@@ -152,6 +152,50 @@ func (a Float256) Exp2() Float256 {
 	return expmulti256(hi, lo, k)
 }
 
+// Exp10 returns 10**a, the base-10 exponential of a.
+//
+// Special cases are the same as [Exp].
+func (a Float256) Exp10() Float256 {
+	var (
+		// Ln10Hi = ln(10) ~ 2.30258509299404568401799145468436420760110148862877297603332790096757261
+		// Ln10Lo = ln(10) - Ln10Hi ~ 4.01913005679944129629700219119721936148574309001380925092228E-73
+		Ln10Hi = Float256{
+			0x4000_026b_b1bb_b555, 0x1582_dd4a_dac5_705a,
+			0x6145_1c51_fd9f_3b4b, 0xbf21_d078_c3d0_403e,
+		}
+		Ln10Lo = Float256{
+			0x3ff0_e6b9_4b17_816b, 0xd8d4_082e_6638_65e0,
+			0x162f_86b4_1631_120c, 0x2085_f16d_7dc7_bc42,
+		}
+
+		// Pow10Limit = 2**20, 10**Pow10Limit overflows.
+		Pow10Limit = Float256{
+			0x4001_3000_0000_0000, 0x0000_0000_0000_0000,
+			0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+		}
+	)
+
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(0):
+		return a.Exp()
+	case a.Abs().Lt(Pow10Limit):
+		if i, f := a.Modf(); f.IsZero() {
+			// make sure that 10**n is exact if it is representable.
+			return NewFloat256Pow10(int(i.Int64()))
+		}
+	}
+
+	// compute; a×ln(10) = hi + lo, and e**(hi+lo) ~ e**hi × (1 + lo)
+	hi := a.Mul(Ln10Hi)
+	y := hi.Exp()
+	if y.IsInf(0) || y.IsZero() {
+		return y
+	}
+	lo := FMA256(a, Ln10Hi, hi.Neg()).Add(a.Mul(Ln10Lo))
+	return FMA256(y, lo, y)
+}
+
 func expmulti256(hi, lo Float256, k int64) Float256 {
 	var y Float256
 	r := hi.Sub(lo)
@@ -89,3 +89,48 @@ func TestFloat256_Exp2(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat256_Exp10(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(-2), "0.01000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(-0.5), "0.3162277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(0x1p-10), "1.002251148292912915465673638866571192454241130208227099208420545124890"},
+		{exact256(0.25), "1.778279410038922801225421195192684844735790526402255358011830722776302"},
+		{exact256(0.5), "3.162277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(1.5), "31.62277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(2.5), "316.2277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(4), "10000.00000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10()
+		if !close256(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		{exact256(0), exact256(1)},
+		{exact256(math.Copysign(0, -1)), exact256(1)},
+		{exact256(1), exact256(10)},
+		{exact256(2), exact256(100)},
+		{exact256(3), exact256(1000)},
+		{exact256(78914), exact256(math.Inf(1))},
+		{exact256(math.Inf(1)), exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), exact256(0)},
+		{exact256(math.NaN()), exact256(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10()
+		if !eq256(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
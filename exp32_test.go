@@ -97,3 +97,69 @@ func TestFloat32_Exp2(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkFloat32_Exp2(b *testing.B) {
+	x := exact32(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Exp2())
+	}
+}
+
+func TestFloat32_Exp_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Exp", Float32.Exp, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Exp()
+	}, -30, 7, 88.72283, 88.72284, -103.27893, -103.97208, -103.97209)
+}
+
+func TestFloat32_Exp2_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Exp2", Float32.Exp2, exp2Ref, -30, 8, -149, -150, -149.5, 127.99999, 128)
+}
+
+func TestFloat32_Exp10(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(-2), 0.01},
+		{exact32(-0.5), 0.31622776601683794},
+		{exact32(0x1p-10), 1.0022511482929128},
+		{exact32(0.25), 1.7782794100389228},
+		{exact32(0.5), 3.1622776601683795},
+		{exact32(1.5), 31.622776601683793},
+		{exact32(2.5), 316.22776601683796},
+		{exact32(4), 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10()
+		if !close32(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		{exact32(0), exact32(1)},
+		{exact32(math.Copysign(0, -1)), exact32(1)},
+		{exact32(1), exact32(10)},
+		{exact32(2), exact32(100)},
+		{exact32(3), exact32(1000)},
+		{exact32(39), exact32(math.Inf(1))},
+		{exact32(math.Inf(1)), exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), exact32(0)},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10()
+		if !eq32(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Exp10_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Exp10", Float32.Exp10, exp10Ref, -30, 6, -45, -46, 38, 39)
+}
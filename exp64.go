@@ -21,3 +21,21 @@ func (a Float64) Exp() Float64 {
 func (a Float64) Exp2() Float64 {
 	return NewFloat64(math.Exp2(a.BuiltIn()))
 }
+
+// Exp10 returns 10**a, the base-10 exponential of a.
+//
+// Special cases are the same as [Exp].
+func (a Float64) Exp10() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return NewFloat64(math.Exp(x))
+	case x == math.Trunc(x) && math.Abs(x) <= 350:
+		// 10**23 is an exact midpoint of float64 values,
+		// so DoubleDouble is not enough to round it correctly.
+		return NewFloat256Pow10(int(x)).Float64()
+	}
+	return ln10DD.mulFloat64(x).Exp().Float64()
+}
@@ -83,3 +83,51 @@ func TestFloat64_Exp2(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat64_Exp10(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(-2), 0.01},
+		{exact64(-0.5), 0.31622776601683794},
+		{exact64(0x1p-10), 1.0022511482929128},
+		{exact64(0.25), 1.7782794100389228},
+		{exact64(0.5), 3.1622776601683795},
+		{exact64(1.5), 31.622776601683793},
+		{exact64(2.5), 316.22776601683796},
+		{exact64(4), 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10()
+		if !close64(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		{exact64(0), exact64(1)},
+		{exact64(math.Copysign(0, -1)), exact64(1)},
+		{exact64(1), exact64(10)},
+		{exact64(2), exact64(100)},
+		{exact64(3), exact64(1000)},
+		{exact64(-5), exact64(1e-5)},
+		// 10**23 is an exact midpoint of float64 values.
+		{exact64(23), exact64(1e23)},
+		{exact64(309), exact64(math.Inf(1))},
+		{exact64(math.Inf(1)), exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), exact64(0)},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10()
+		if !eq64(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
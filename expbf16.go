@@ -0,0 +1,30 @@
+package floats
+
+import "math"
+
+// Exp returns e**x, the base-e exponential of a.
+//
+// Special cases are:
+//
+//	+Inf.Exp() = +Inf
+//	NaN.Exp() = NaN
+//
+// Very large values overflow to 0 or +Inf.
+// Very small values underflow to 1.
+func (a BFloat16) Exp() BFloat16 {
+	return NewBFloat16(math.Exp(a.Float64().BuiltIn()))
+}
+
+// Exp2 returns 2**x, the base-2 exponential of x.
+//
+// Special cases are the same as [Exp].
+func (a BFloat16) Exp2() BFloat16 {
+	return NewBFloat16(math.Exp2(a.Float64().BuiltIn()))
+}
+
+// Exp10 returns 10**a, the base-10 exponential of a.
+//
+// Special cases are the same as [Exp].
+func (a BFloat16) Exp10() BFloat16 {
+	return NewBFloat16(a.Float64().Exp10().BuiltIn())
+}
@@ -0,0 +1,122 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Exp(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Exp(0)},
+		{exactBF16(1), math.Exp(1)},
+		{exactBF16(2), math.Exp(2)},
+		{exactBF16(3), math.Exp(3)},
+		{exactBF16(4), math.Exp(4)},
+		{exactBF16(11), math.Exp(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Exp(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Exp(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Exp2(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Exp2(0)},
+		{exactBF16(1), math.Exp2(1)},
+		{exactBF16(1.5), math.Exp2(1.5)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Exp2(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Exp2(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Exp10(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-2), 0.01},
+		{exactBF16(-0.5), 0.31622776601683794},
+		{exactBF16(0x1p-10), 1.0022511482929128},
+		{exactBF16(0.25), 1.7782794100389228},
+		{exactBF16(0.5), 3.1622776601683795},
+		{exactBF16(1.5), 31.622776601683793},
+		{exactBF16(2.5), 316.22776601683796},
+		{exactBF16(4), 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(1)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(1), exactBF16(10)},
+		{exactBF16(2), exactBF16(100)},
+		{exactBF16(3), exactBF16(1000)},
+		{exactBF16(39), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(0)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Exp10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package floats
+
+import "math"
+
+var (
+	// ln2DD = ln(2) ~ 0.693147180559945309417232121458176568075500134360255254120680009493393621969694715605863326996418687542
+	ln2DD = DoubleDouble{0x1.62e42fefa39efp-1, 0x1.abc9e3b39803fp-56}
+
+	// ln10DD = ln(10) ~ 2.302585092994045684017991454684364207601101488628772976033327900967572609677352480235997205089598298
+	ln10DD = DoubleDouble{0x1.26bb1bbb55516p+1, -0x1.f48ad494ea3e9p-53}
+)
+
+// Exp returns e**x, the base-e exponential of a.
+//
+// Special cases are:
+//
+//	+Inf.Exp() = +Inf
+//	NaN.Exp() = NaN
+//
+// Very large values overflow to 0 or +Inf.
+func (a DoubleDouble) Exp() DoubleDouble {
+	const (
+		// ln(max float64 + 0.5ulp)
+		Overflow = 709.782712893384
+		// ln(min float64 - 0.5ulp)
+		Underflow = -745.1332191019412
+	)
+
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(1):
+		return a
+	case a[0] > Overflow:
+		return NewDoubleDoubleInf(1)
+	case a[0] < Underflow:
+		return DoubleDouble{} // 0
+	case a.IsZero():
+		return NewDoubleDouble(1)
+	}
+
+	// reduce; a = k*ln2 + r, |r| <= ln2/2
+	// ln2 in QuadDouble precision keeps r accurate for large k.
+	k := math.Round(a[0] / ln2DD[0])
+	rq := a.QuadDouble().Sub(ln2QD.mulFloat64(k))
+	r := DoubleDouble{rq[0], rq[1]}
+
+	s := expm1ReducedDD(r)
+	s = s.Add(NewDoubleDouble(1))
+	return s.ldexp(int(k))
+}
+
+// expm1ReducedDD returns e**r - 1 for |r| <= ln2/2.
+func expm1ReducedDD(r DoubleDouble) DoubleDouble {
+	// scale r down more to make the series converge faster,
+	// and scale the result up with expm1(2x) = 2*expm1(x) + expm1(x)²
+	const scale = 9
+	r = r.ldexp(-scale)
+
+	// compute expm1(r) = r + r²/2! + r³/3! + ...
+	s, t := r, r
+	for n := 2.0; n < 30; n++ {
+		t = t.Mul(r).Quo(NewDoubleDouble(n))
+		s = s.Add(t)
+		if math.Abs(t[0]) <= math.Abs(s[0])*0x1p-110 {
+			break
+		}
+	}
+	for range scale {
+		s = s.ldexp(1).Add(s.Mul(s))
+	}
+	return s
+}
+
+// expm1DD returns e**a - 1 for finite a.
+// Unlike a.Exp().Sub(1), it is accurate even when a is near zero.
+func expm1DD(a DoubleDouble) DoubleDouble {
+	one := NewDoubleDouble(1)
+	switch {
+	case a[0] < -80:
+		// e**a is below the precision of DoubleDouble.
+		return one.Neg()
+	case math.Abs(a[0]) < 0x1p-40:
+		// expm1(a) = a + a²/2 + a³/6 + ...
+		// scaling a in expm1ReducedDD might lose the precision of tiny a.
+		a2 := a.Mul(a)
+		return a.Add(a2.ldexp(-1).Add(a2.Mul(a).Quo(NewDoubleDouble(6))))
+	case math.Abs(a[0]) <= ln2DD[0]/2:
+		return expm1ReducedDD(a)
+	}
+	return a.Exp().Sub(one)
+}
@@ -36,3 +36,19 @@ func (a Float128) Expm1() Float128 {
 
 	return a.Exp().Sub(Float128(uvone128))
 }
+
+// Exp2m1 returns 2**a - 1, the base-2 exponential of a minus 1.
+// It is more accurate than Exp2(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float128) Exp2m1() Float128 {
+	return a.Float256().Exp2m1().Float128()
+}
+
+// Exp10m1 returns 10**a - 1, the base-10 exponential of a minus 1.
+// It is more accurate than Exp10(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float128) Exp10m1() Float128 {
+	return a.Float256().Exp10m1().Float128()
+}
@@ -51,3 +51,90 @@ func TestFloat128_Expm1(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat128_Exp2m1(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(-1), "-0.5000000000000000000000000000000000000000"},
+		{exact128(-0.25), "-0.1591035847462854569688745237667851049600"},
+		{exact128(-0x1p-10), "-0.0006766724973492476397163401562619588381147"},
+		{exact128(0x1p-10), "0.0006771306930663566781727848746471948378220"},
+		{exact128(0.5), "0.4142135623730950488016887242096980785697"},
+		{exact128(1.5), "1.828427124746190097603377448419396157139"},
+		{exact128(3.5), "10.31370849898476039041350979367758462856"},
+		{exact128(10), "1023.000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2m1()
+		if !close128(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(1)},
+		{exact128(3), exact128(7)},
+		{exact128(4), exact128(15)},
+		{exact128(-1), exact128(-0.5)},
+		{exact128(math.Inf(1)), exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), exact128(-1)},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2m1()
+		if !eq128(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Exp10m1(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(-1), "-0.9000000000000000000000000000000000000000"},
+		{exact128(-0.125), "-0.2501057906675441726978157243848635615581"},
+		{exact128(-0x1p-10), "-0.002246092006726248327110230482930941137676"},
+		{exact128(0x1p-10), "0.002251148292912915465673638866571192454241"},
+		{exact128(0.5), "2.162277660168379331998893544432718533720"},
+		{exact128(1.5), "30.62277660168379331998893544432718533720"},
+		{exact128(4), "9999.000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10m1()
+		if !close128(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(9)},
+		{exact128(2), exact128(99)},
+		{exact128(math.Inf(1)), exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), exact128(-1)},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10m1()
+		if !eq128(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
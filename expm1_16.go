@@ -13,5 +13,30 @@ import "math"
 //
 // Very large values overflow to -1 or +Inf.
 func (a Float16) Expm1() Float16 {
-	return NewFloat16(math.Expm1(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Expm1(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Expm1().Float16()
+}
+
+// Exp2m1 returns 2**a - 1, the base-2 exponential of a minus 1.
+// It is more accurate than Exp2(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float16) Exp2m1() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Exp2m1().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Exp2m1().Float16()
+}
+
+// Exp10m1 returns 10**a - 1, the base-10 exponential of a minus 1.
+// It is more accurate than Exp10(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float16) Exp10m1() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Exp10m1().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Exp10m1().Float16()
 }
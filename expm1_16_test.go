@@ -42,3 +42,102 @@ func TestFloat16_Expm1(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat16_Expm1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Expm1", Float16.Expm1, expm1Ref)
+}
+
+func TestFloat16_Exp2m1(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(-1), -0.5},
+		{exact16(-0.25), -0.15910358474628547},
+		{exact16(-0x1p-10), -0.0006766724973492476},
+		{exact16(0x1p-10), 0.0006771306930663567},
+		{exact16(0.5), 0.41421356237309503},
+		{exact16(1.5), 1.82842712474619},
+		{exact16(3.5), 10.313708498984761},
+		{exact16(10), 1023.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2m1()
+		if !close16(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(1)},
+		{exact16(3), exact16(7)},
+		{exact16(4), exact16(15)},
+		{exact16(-1), exact16(-0.5)},
+		{exact16(math.Inf(1)), exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), exact16(-1)},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2m1()
+		if !eq16(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Exp10m1(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(-1), -0.9},
+		{exact16(-0.125), -0.2501057906675442},
+		{exact16(-0x1p-10), -0.0022460920067262483},
+		{exact16(0x1p-10), 0.0022511482929129154},
+		{exact16(0.5), 2.1622776601683795},
+		{exact16(1.5), 30.622776601683793},
+		{exact16(4), 9999.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10m1()
+		if !close16(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(9)},
+		{exact16(2), exact16(99)},
+		{exact16(math.Inf(1)), exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), exact16(-1)},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10m1()
+		if !eq16(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Exp2m1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Exp2m1", Float16.Exp2m1, exp2m1Ref)
+}
+
+func TestFloat16_Exp10m1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Exp10m1", Float16.Exp10m1, exp10m1Ref)
+}
@@ -41,3 +41,63 @@ func (a Float256) Expm1() Float256 {
 
 	return a.Exp().Sub(Float256(uvone256))
 }
+
+// Exp2m1 returns 2**a - 1, the base-2 exponential of a minus 1.
+// It is more accurate than Exp2(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float256) Exp2m1() Float256 {
+	var (
+		// Ln2Hi = ln(2) ~ 0.69314718055994530941723212145817656807550013436025525412068000949339362
+		// Ln2Lo = ln(2) - Ln2Hi ~ 1.68505384472783385591763704017160191700974868940429492048047E-72
+		Ln2Hi = Float256{
+			0x3fff_e62e_42fe_fa39, 0xef35_793c_7673_007e,
+			0x5ed5_e81e_6864_ce53, 0x16c5_b141_a2eb_7175,
+		}
+		Ln2Lo = Float256{
+			0x3ff1_07d1_5f3d_c3b1, 0x036f_5d64_c2ac_aa97,
+			0xda57_d0d8_8769_7571, 0xae09_c0c7_cb80_70d0,
+		}
+	)
+	return expm1Scaled256(a, Ln2Hi, Ln2Lo, a.Exp2)
+}
+
+// Exp10m1 returns 10**a - 1, the base-10 exponential of a minus 1.
+// It is more accurate than Exp10(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float256) Exp10m1() Float256 {
+	var (
+		// Ln10Hi = ln(10) ~ 2.30258509299404568401799145468436420760110148862877297603332790096757261
+		// Ln10Lo = ln(10) - Ln10Hi ~ 4.01913005679944129629700219119721936148574309001380925092228E-73
+		Ln10Hi = Float256{
+			0x4000_026b_b1bb_b555, 0x1582_dd4a_dac5_705a,
+			0x6145_1c51_fd9f_3b4b, 0xbf21_d078_c3d0_403e,
+		}
+		Ln10Lo = Float256{
+			0x3ff0_e6b9_4b17_816b, 0xd8d4_082e_6638_65e0,
+			0x162f_86b4_1631_120c, 0x2085_f16d_7dc7_bc42,
+		}
+	)
+	return expm1Scaled256(a, Ln10Hi, Ln10Lo, a.Exp10)
+}
+
+// expm1Scaled256 returns e**(a×(hi+lo)) - 1, where hi+lo is ln(base) and exp returns base**a.
+func expm1Scaled256(a, hi, lo Float256, exp func() Float256) Float256 {
+	var One = Float256(uvone256)
+
+	// special cases
+	switch {
+	case a.IsZero() || a.IsNaN() || a.IsInf(0):
+		return a.Expm1()
+	case a.Abs().Ge(One):
+		// base**a - 1 doesn't cancel, and it is exact for small integers a.
+		return exp().Sub(One)
+	}
+
+	// a×(hi+lo) = t + u, and e**(t+u) - 1 ~ expm1(t) + (1 + expm1(t))×u
+	t := a.Mul(hi)
+	u := FMA256(a, hi, t.Neg()).Add(a.Mul(lo))
+	y := t.Expm1()
+	return FMA256(y.Add(One), u, y)
+}
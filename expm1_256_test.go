@@ -53,3 +53,90 @@ func TestFloat256_Expm1(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat256_Exp2m1(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(-1), "-0.5000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(-0.25), "-0.1591035847462854569688745237667851049599657376432154891867739140250752"},
+		{exact256(-0x1p-10), "-0.0006766724973492476397163401562619588381147028920463685815614010925530710"},
+		{exact256(0x1p-10), "0.0006771306930663566781727848746471948378219842487376938696040679547579958"},
+		{exact256(0.5), "0.4142135623730950488016887242096980785696718753769480731766797379907325"},
+		{exact256(1.5), "1.828427124746190097603377448419396157139343750753896146353359475981465"},
+		{exact256(3.5), "10.31370849898476039041350979367758462855737500301558458541343790392586"},
+		{exact256(10), "1023.000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2m1()
+		if !close256(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		{exact256(0), exact256(0)},
+		{exact256(math.Copysign(0, -1)), exact256(math.Copysign(0, -1))},
+		{exact256(1), exact256(1)},
+		{exact256(3), exact256(7)},
+		{exact256(4), exact256(15)},
+		{exact256(-1), exact256(-0.5)},
+		{exact256(math.Inf(1)), exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), exact256(-1)},
+		{exact256(math.NaN()), exact256(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2m1()
+		if !eq256(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat256_Exp10m1(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(-1), "-0.9000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(-0.125), "-0.2501057906675441726978157243848635615581320818350289853795809945701725"},
+		{exact256(-0x1p-10), "-0.002246092006726248327110230482930941137676233460091856006721943880943341"},
+		{exact256(0x1p-10), "0.002251148292912915465673638866571192454241130208227099208420545124889760"},
+		{exact256(0.5), "2.162277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(1.5), "30.62277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(4), "9999.000000000000000000000000000000000000000000000000000000000000000000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10m1()
+		if !close256(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		{exact256(0), exact256(0)},
+		{exact256(math.Copysign(0, -1)), exact256(math.Copysign(0, -1))},
+		{exact256(1), exact256(9)},
+		{exact256(2), exact256(99)},
+		{exact256(math.Inf(1)), exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), exact256(-1)},
+		{exact256(math.NaN()), exact256(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10m1()
+		if !eq256(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"runtime"
 	"testing"
 )
 
@@ -53,3 +54,109 @@ func TestFloat32_Expm1(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkFloat32_Expm1(b *testing.B) {
+	x := exact32(0.25)
+	for b.Loop() {
+		runtime.KeepAlive(x.Expm1())
+	}
+}
+
+func TestFloat32_Expm1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Expm1", Float32.Expm1, expm1Ref, -60, 7, 0x1p-149, -0x1p-149)
+}
+
+func TestFloat32_Exp2m1(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(-1), -0.5},
+		{exact32(-0.25), -0.15910358474628547},
+		{exact32(-0x1p-10), -0.0006766724973492476},
+		{exact32(0x1p-10), 0.0006771306930663567},
+		{exact32(0.5), 0.41421356237309503},
+		{exact32(1.5), 1.82842712474619},
+		{exact32(3.5), 10.313708498984761},
+		{exact32(10), 1023.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2m1()
+		if !close32(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(1)},
+		{exact32(3), exact32(7)},
+		{exact32(4), exact32(15)},
+		{exact32(-1), exact32(-0.5)},
+		{exact32(math.Inf(1)), exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), exact32(-1)},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2m1()
+		if !eq32(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Exp10m1(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(-1), -0.9},
+		{exact32(-0.125), -0.2501057906675442},
+		{exact32(-0x1p-10), -0.0022460920067262483},
+		{exact32(0x1p-10), 0.0022511482929129154},
+		{exact32(0.5), 2.1622776601683795},
+		{exact32(1.5), 30.622776601683793},
+		{exact32(4), 9999.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10m1()
+		if !close32(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(9)},
+		{exact32(2), exact32(99)},
+		{exact32(math.Inf(1)), exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), exact32(-1)},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10m1()
+		if !eq32(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Exp2m1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Exp2m1", Float32.Exp2m1, exp2m1Ref, -60, 8, 0x1p-149, -0x1p-149)
+}
+
+func TestFloat32_Exp10m1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Exp10m1", Float32.Exp10m1, exp10m1Ref, -60, 6, 0x1p-149, -0x1p-149)
+}
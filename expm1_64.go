@@ -15,3 +15,39 @@ import "math"
 func (a Float64) Expm1() Float64 {
 	return NewFloat64(math.Expm1(a.BuiltIn()))
 }
+
+// Exp2m1 returns 2**a - 1, the base-2 exponential of a minus 1.
+// It is more accurate than Exp2(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float64) Exp2m1() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0 || math.IsNaN(x) || math.IsInf(x, 0):
+		return NewFloat64(math.Expm1(x))
+	case x == math.Trunc(x) && math.Abs(x) <= 1100:
+		// 2**x is exact, and the subtraction is correctly rounded.
+		return NewFloat64(math.Ldexp(1, int(x)) - 1)
+	}
+	return expm1DD(ln2DD.mulFloat64(x)).Float64()
+}
+
+// Exp10m1 returns 10**a - 1, the base-10 exponential of a minus 1.
+// It is more accurate than Exp10(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a Float64) Exp10m1() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0 || math.IsNaN(x) || math.IsInf(x, 0):
+		return NewFloat64(math.Expm1(x))
+	case x == math.Trunc(x) && 0 < x && x <= 22:
+		// 10**x is exact, and the subtraction is correctly rounded.
+		return NewFloat64(math.Pow10(int(x)) - 1)
+	}
+	return expm1DD(ln10DD.mulFloat64(x)).Float64()
+}
@@ -42,3 +42,94 @@ func TestFloat64_Expm1(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat64_Exp2m1(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(-1), -0.5},
+		{exact64(-0.25), -0.15910358474628547},
+		{exact64(-0x1p-10), -0.0006766724973492476},
+		{exact64(0x1p-10), 0.0006771306930663567},
+		{exact64(0.5), 0.41421356237309503},
+		{exact64(1.5), 1.82842712474619},
+		{exact64(3.5), 10.313708498984761},
+		{exact64(10), 1023.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2m1()
+		if !close64(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(1)},
+		{exact64(3), exact64(7)},
+		{exact64(4), exact64(15)},
+		{exact64(-1), exact64(-0.5)},
+		// 2**54-1 is an exact midpoint of float64 values.
+		{exact64(54), exact64(0x1p54)},
+		{exact64(math.Inf(1)), exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), exact64(-1)},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2m1()
+		if !eq64(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Exp10m1(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(-1), -0.9},
+		{exact64(-0.125), -0.2501057906675442},
+		{exact64(-0x1p-10), -0.0022460920067262483},
+		{exact64(0x1p-10), 0.0022511482929129154},
+		{exact64(0.5), 2.1622776601683795},
+		{exact64(1.5), 30.622776601683793},
+		{exact64(4), 9999.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10m1()
+		if !close64(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(9)},
+		{exact64(2), exact64(99)},
+		// 10**16-1 is an exact midpoint of float64 values.
+		{exact64(16), exact64(1e16)},
+		{exact64(math.Inf(1)), exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), exact64(-1)},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10m1()
+		if !eq64(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
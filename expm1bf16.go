@@ -0,0 +1,33 @@
+package floats
+
+import "math"
+
+// Expm1 returns e**a - 1, the base-e exponential of a minus 1.
+// It is more accurate than Exp(a) - 1 when a is near zero.
+//
+// Special cases are:
+//
+//	+Inf.Expm1() = +Inf
+//	-Inf.Expm1() = -1
+//	NaN.Expm1() = NaN
+//
+// Very large values overflow to -1 or +Inf.
+func (a BFloat16) Expm1() BFloat16 {
+	return NewBFloat16(math.Expm1(a.Float64().BuiltIn()))
+}
+
+// Exp2m1 returns 2**a - 1, the base-2 exponential of a minus 1.
+// It is more accurate than Exp2(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a BFloat16) Exp2m1() BFloat16 {
+	return NewBFloat16(a.Float64().Exp2m1().BuiltIn())
+}
+
+// Exp10m1 returns 10**a - 1, the base-10 exponential of a minus 1.
+// It is more accurate than Exp10(a) - 1 when a is near zero.
+//
+// Special cases are the same as [Expm1].
+func (a BFloat16) Exp10m1() BFloat16 {
+	return NewBFloat16(a.Float64().Exp10m1().BuiltIn())
+}
@@ -0,0 +1,131 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Expm1(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Expm1(0)},
+		{exactBF16(0x1p-24), math.Expm1(0x1p-24)},
+		{exactBF16(1), math.Expm1(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Expm1()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Expm1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(-1)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Expm1()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Expm1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Exp2m1(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-1), -0.5},
+		{exactBF16(-0.25), -0.15910358474628547},
+		{exactBF16(-0x1p-10), -0.0006766724973492476},
+		{exactBF16(0x1p-10), 0.0006771306930663567},
+		{exactBF16(0.5), 0.41421356237309503},
+		{exactBF16(1.5), 1.82842712474619},
+		{exactBF16(3.5), 10.313708498984761},
+		{exactBF16(10), 1023.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp2m1()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(1)},
+		{exactBF16(3), exactBF16(7)},
+		{exactBF16(4), exactBF16(15)},
+		{exactBF16(-1), exactBF16(-0.5)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(-1)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp2m1()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Exp2m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Exp10m1(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-1), -0.9},
+		{exactBF16(-0.125), -0.2501057906675442},
+		{exactBF16(-0x1p-10), -0.0022460920067262483},
+		{exactBF16(0x1p-10), 0.0022511482929129154},
+		{exactBF16(0.5), 2.1622776601683795},
+		{exactBF16(1.5), 30.622776601683793},
+		{exactBF16(4), 9999.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp10m1()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(9)},
+		{exactBF16(2), exactBF16(99)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(-1)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp10m1()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Exp10m1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
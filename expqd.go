@@ -0,0 +1,61 @@
+package floats
+
+import "math"
+
+var (
+	// ln2QD = ln(2) ~ 0.693147180559945309417232121458176568075500134360255254120680009493393621969694715605863326996418687542
+	ln2QD = QuadDouble{0x1.62e42fefa39efp-1, 0x1.abc9e3b39803fp-56, 0x1.7b57a079a1934p-111, -0x1.ace93a4ebe5d1p-165}
+)
+
+// Exp returns e**x, the base-e exponential of a.
+//
+// Special cases are:
+//
+//	+Inf.Exp() = +Inf
+//	NaN.Exp() = NaN
+//
+// Very large values overflow to 0 or +Inf.
+func (a QuadDouble) Exp() QuadDouble {
+	const (
+		// ln(max float64 + 0.5ulp)
+		Overflow = 709.782712893384
+		// ln(min float64 - 0.5ulp)
+		Underflow = -745.1332191019412
+	)
+
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(1):
+		return a
+	case a[0] > Overflow:
+		return NewQuadDoubleInf(1)
+	case a[0] < Underflow:
+		return QuadDouble{} // 0
+	case a.IsZero():
+		return NewQuadDouble(1)
+	}
+
+	// reduce; a = k*ln2 + r, |r| <= ln2/2
+	k := math.Round(a[0] / ln2QD[0])
+	r := a.Sub(ln2QD.mulFloat64(k))
+
+	// scale r down more to make the series converge faster,
+	// and scale the result up with expm1(2x) = 2*expm1(x) + expm1(x)²
+	const scale = 12
+	r = r.ldexp(-scale)
+
+	// compute expm1(r) = r + r²/2! + r³/3! + ...
+	s, t := r, r
+	for n := 2.0; n < 50; n++ {
+		t = t.Mul(r).Quo(NewQuadDouble(n))
+		s = s.Add(t)
+		if math.Abs(t[0]) <= math.Abs(s[0])*0x1p-215 {
+			break
+		}
+	}
+	for range scale {
+		s = s.ldexp(1).Add(s.Mul(s))
+	}
+	s = s.Add(NewQuadDouble(1))
+	return s.ldexp(int(k))
+}
@@ -0,0 +1,51 @@
+package floats
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestQuadDouble_Exp(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(-1), "0.367879441171442321595523770161460867445811131031767834507836801697461496"},
+		{NewQuadDouble(0.5), "1.64872127070012814684865078781416357165377610071014801157507931164066102"},
+		{NewQuadDouble(1), "2.71828182845904523536028747135266249775724709369995957496696762772407662"},
+		{NewQuadDouble(10), "22026.4657948067165169579006452842443663535126185567810742354263552252035"},
+		{NewQuadDouble(700), "1.014232054735004509455329595231267615204679572243073348780536281249351233e+304"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Exp()
+		if !closeQD(got, tt.want) {
+			t.Errorf("Exp(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want QuadDouble
+	}{
+		{NewQuadDouble(0), NewQuadDouble(1)},
+		{NewQuadDouble(710), NewQuadDoubleInf(1)},
+		{NewQuadDouble(-746), NewQuadDouble(0)},
+		{NewQuadDoubleInf(1), NewQuadDoubleInf(1)},
+		{NewQuadDoubleInf(-1), NewQuadDouble(0)},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Exp()
+		if !eqQD(got, tt.want) {
+			t.Errorf("Exp(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Exp(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Exp())
+	}
+}
@@ -311,6 +311,7 @@ func roundShortest128(d *decimal, frac ints.Uint128, exp int) {
 var _ json.Marshaler = Float128{}
 
 // MarshalJSON implements [json.Marshaler].
+// It returns an error for NaN and ±Inf; use [JSONString], [JSONNull] or [JSONHex] to encode them.
 func (a Float128) MarshalJSON() ([]byte, error) {
 	if a.IsNaN() || a.IsInf(0) {
 		return nil, fmt.Errorf("floats: cannot marshal %v to JSON", a)
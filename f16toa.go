@@ -341,6 +341,7 @@ func roundShortest16(d *decimal, frac uint16, exp int) {
 var _ json.Marshaler = Float16(0)
 
 // MarshalJSON implements [json.Marshaler].
+// It returns an error for NaN and ±Inf; use [JSONString], [JSONNull] or [JSONHex] to encode them.
 func (a Float16) MarshalJSON() ([]byte, error) {
 	// JSON does not support NaN and Inf values.
 	if a.IsNaN() || a.IsInf(0) {
@@ -45,6 +45,10 @@ func TestFloat16_Format(t *testing.T) {
 		// verb "%v"
 		{"%v", exact16(0.5), "0.5"},
 		{"%v", exact16(math.NaN()), "NaN"},
+		{"%+v", exact16(math.NaN()), "+NaN"},
+		{"%5v", exact16(math.NaN()), "  NaN"},
+		{"%+v", exact16(math.Inf(1)), "+Inf"},
+		{"% v", exact16(math.Inf(-1)), "-Inf"},
 	}
 
 	for _, tt := range tests {
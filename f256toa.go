@@ -240,88 +240,13 @@ func roundShortest256(d *decimal, frac ints.Uint256, exp int) {
 	// would round to the original mantissa and not the neighbors.
 	inclusive := frac.And(one).IsZero()
 
-	// As we walk the digits we want to know whether rounding up would fall
-	// within the upper bound. This is tracked by upperdelta:
-	//
-	// If upperdelta == 0, the digits of d and upper are the same so far.
-	//
-	// If upperdelta == 1, we saw a difference of 1 between d and upper on a
-	// previous digit and subsequently only 9s for d and 0s for upper.
-	// (Thus rounding up may fall outside the bound, if it is exclusive.)
-	//
-	// If upperdelta == 2, then the difference is greater than 1
-	// and we know that rounding up falls within the bound.
-	var upperdelta uint8
-
-	// Now we can figure out the minimum number of digits required.
-	// Walk along until d has distinguished itself from upper and lower.
-	for ui := 0; ; ui++ {
-		// lower, d, and upper may have the decimal points at different
-		// places. In this case upper is the longest, so we iterate from
-		// ui==0 and start li and mi at (possibly) -1.
-		mi := ui - upper.dp + d.dp
-		if mi >= d.nd {
-			break
-		}
-		li := ui - upper.dp + lower.dp
-		l := byte('0') // lower digit
-		if li >= 0 && li < lower.nd {
-			l = lower.d[li]
-		}
-		m := byte('0') // middle digit
-		if mi >= 0 {
-			m = d.d[mi]
-		}
-		u := byte('0') // upper digit
-		if ui < upper.nd {
-			u = upper.d[ui]
-		}
-
-		// Okay to round down (truncate) if lower has a different digit
-		// or if lower is inclusive and is exactly the result of rounding
-		// down (i.e., and we have reached the final digit of lower).
-		okdown := l != m || inclusive && li+1 == lower.nd
-
-		switch {
-		case upperdelta == 0 && m+1 < u:
-			// Example:
-			// m = 12345xxx
-			// u = 12347xxx
-			upperdelta = 2
-		case upperdelta == 0 && m != u:
-			// Example:
-			// m = 12345xxx
-			// u = 12346xxx
-			upperdelta = 1
-		case upperdelta == 1 && (m != '9' || u != '0'):
-			// Example:
-			// m = 1234598x
-			// u = 1234600x
-			upperdelta = 2
-		}
-		// Okay to round up if upper has a different digit and either upper
-		// is inclusive or upper is bigger than the result of rounding up.
-		okup := upperdelta > 0 && (inclusive || upperdelta > 1 || ui+1 < upper.nd)
-
-		// If it's okay to do either, then round to the nearest one.
-		// If it's okay to do only one, do it.
-		switch {
-		case okdown && okup:
-			d.Round(mi + 1)
-			return
-		case okdown:
-			d.RoundDown(mi + 1)
-			return
-		case okup:
-			d.RoundUp(mi + 1)
-			return
-		}
-	}
+	roundShortestBetween(d, lower, upper, inclusive)
 }
 
 var _ json.Marshaler = Float256{}
 
 // MarshalJSON implements [json.Marshaler].
+// It returns an error for NaN and ±Inf; use [JSONString], [JSONNull] or [JSONHex] to encode them.
 func (a Float256) MarshalJSON() ([]byte, error) {
 	if a.IsNaN() || a.IsInf(0) {
 		return nil, fmt.Errorf("floats: cannot marshal %v to JSON", a)
@@ -34,6 +34,7 @@ func (a Float32) Append(dst []byte, fmt byte, prec int) []byte {
 var _ json.Marshaler = Float32(0)
 
 // MarshalJSON implements [json.Marshaler].
+// It returns an error for NaN and ±Inf; use [JSONString], [JSONNull] or [JSONHex] to encode them.
 func (a Float32) MarshalJSON() ([]byte, error) {
 	if a.IsNaN() || a.IsInf(0) {
 		return nil, fmt.Errorf("floats: cannot marshal %v to JSON", a)
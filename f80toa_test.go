@@ -0,0 +1,196 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat80_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      Float80
+		want   string
+	}{
+		// verb "%b"
+		{"%b", exact80(0), "0p-16445"},
+		{"%b", exact80(1), "9223372036854775808p-63"},
+
+		// verb "%f"
+		{"%f", exact80(0.5), "0.5"},
+		{"%+f", exact80(0.5), "+0.5"},
+		{"% f", exact80(-0.5), "-0.5"},
+		{"%8f", exact80(0.5), "     0.5"},
+		{"%-8f", exact80(0.5), "0.5     "},
+		{"%.2f", exact80(0.5), "0.50"},
+
+		// verb "%e"
+		{"%.6e", exact80(0.5), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", exact80(0.5), "0.5"},
+		{"%.1g", exact80(0.25), "0.2"},
+
+		// verb "%x"
+		{"%x", exact80(0.5), "0x1p-01"},
+		{"%.1x", exact80(0.5), "0x1.0p-01"},
+		{"%x", NewFloat80FromBits(0x3fff, 0xffff_ffff_ffff_ffff), "0x1.fffffffffffffffep+00"},
+		{"%.3x", NewFloat80FromBits(0x3fff, 0xffff_ffff_ffff_ffff), "0x1.000p+01"},
+
+		// verb "%X"
+		{"%X", exact80(0.5), "0X1P-01"},
+
+		// verb "%v"
+		{"%v", exact80(0.5), "0.5"},
+		{"%v", exact80(math.NaN()), "NaN"},
+		{"%v", NewFloat80FromBits(0x3fff, 0), "NaN"}, // unnormal
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestFloat80_Text(t *testing.T) {
+	tests := []struct {
+		x    Float80
+		fmt  byte
+		prec int
+		want string
+	}{
+		{exact80(0), 'g', -1, "0"},
+		{exact80(math.Copysign(0, -1)), 'g', -1, "-0"},
+		{exact80(1), 'g', -1, "1"},
+		{exact80(0.1), 'g', -1, "0.10000000000000000555"},
+		{exact80(1e23), 'e', -1, "9.999999999999999161e+22"},
+		{NewFloat80FromBits(0x3ffb, 0xcccc_cccc_cccc_cccd), 'g', -1, "0.1"},
+		{NewFloat80FromBits(0x3ffd, 0xaaaa_aaaa_aaaa_aaab), 'g', -1, "0.33333333333333333334"},
+		{NewFloat80FromBits(0x3ffd, 0xaaaa_aaaa_aaaa_aaab), 'g', 5, "0.33333"},
+		{NewFloat80FromBits(0x3ffd, 0xaaaa_aaaa_aaaa_aaab), 'f', 25, "0.3333333333333333333423684"},
+
+		// max finite value
+		{NewFloat80FromBits(0x7ffe, 0xffff_ffff_ffff_ffff), 'g', -1, "1.189731495357231765e+4932"},
+		{NewFloat80FromBits(0x7ffe, 0xffff_ffff_ffff_ffff), 'x', -1, "0x1.fffffffffffffffep+16383"},
+
+		// min normal value
+		{NewFloat80FromBits(0x0001, 0x8000_0000_0000_0000), 'g', -1, "3.3621031431120935063e-4932"},
+
+		// pseudo-denormal has the same value as the min normal value
+		{NewFloat80FromBits(0x0000, 0x8000_0000_0000_0000), 'g', -1, "3.3621031431120935063e-4932"},
+		{NewFloat80FromBits(0x0000, 0x8000_0000_0000_0000), 'x', -1, "0x1p-16382"},
+
+		// denormal values
+		{NewFloat80FromBits(0x0000, 0x0000_0000_0000_0001), 'g', -1, "4e-4951"},
+		{NewFloat80FromBits(0x0000, 0x0000_0000_0000_0001), 'e', 10, "3.6451995319e-4951"},
+		{NewFloat80FromBits(0x0000, 0x0000_0000_0000_0001), 'x', -1, "0x1p-16445"},
+		{NewFloat80FromBits(0x0000, 0x7fff_ffff_ffff_ffff), 'g', -1, "3.362103143112093506e-4932"},
+
+		// special values
+		{NewFloat80Inf(1), 'g', -1, "+Inf"},
+		{NewFloat80Inf(-1), 'g', -1, "-Inf"},
+		{NewFloat80NaN(), 'g', -1, "NaN"},
+		{NewFloat80FromBits(0x7fff, 0), 'g', -1, "NaN"}, // pseudo-infinity
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.want {
+			t.Errorf("Float80(%04x_%016x).Text(%q, %d) = %s, want %s", tt.x.se, tt.x.frac, tt.fmt, tt.prec, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_Text_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 80))
+	for range 200 {
+		se := uint16(r.IntN(mask80))
+		frac := r.Uint64()
+		if se != 0 {
+			frac |= intBit80
+		}
+		x := NewFloat80FromBits(se|uint16(r.IntN(2))<<15, frac)
+		s := x.Text('g', -1)
+		got, err := ParseFloat80(s)
+		if err != nil {
+			t.Errorf("ParseFloat80(%q) returned error: %v", s, err)
+			continue
+		}
+		if got != x {
+			t.Errorf("ParseFloat80(%q) = %04x_%016x, want %04x_%016x", s, got.se, got.frac, x.se, x.frac)
+		}
+	}
+}
+
+func BenchmarkFloat80_Text(b *testing.B) {
+	x := NewFloat80FromBits(0x3ffd, 0xaaaa_aaaa_aaaa_aaab)
+	for b.Loop() {
+		x.Text('g', -1)
+	}
+}
+
+func TestFloat80_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    Float80
+		want string
+	}{
+		{exact80(0), "0"},
+		{exact80(1), "1"},
+		{exact80(-1), "-1"},
+		{exact80(0.5), "0.5"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN and Inf values.
+	for _, x := range []Float80{NewFloat80NaN(), NewFloat80Inf(1), NewFloat80Inf(-1), NewFloat80FromBits(0x3fff, 0)} {
+		if _, err := x.MarshalJSON(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	}
+}
+
+func TestFloat80_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    Float80
+		want string
+	}{
+		{exact80(0), "0"},
+		{exact80(-1), "-1"},
+		{exact80(0.5), "0.5"},
+		{NewFloat80Inf(1), "+Inf"},
+		{NewFloat80Inf(-1), "-Inf"},
+		{NewFloat80NaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+		got, err = tt.x.AppendText([]byte("x="))
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != "x="+tt.want {
+			t.Errorf("expected x=%s, got %s", tt.want, got)
+		}
+	}
+}
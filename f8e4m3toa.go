@@ -0,0 +1,314 @@
+// convert Float8E4M3 to string
+
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+var _ fmt.Formatter = Float8E4M3(0)
+
+// Format implements [fmt.Formatter].
+func (a Float8E4M3) Format(s fmt.State, verb rune) {
+	format(a, s, verb)
+}
+
+var _ fmt.Stringer = Float8E4M3(0)
+
+// String returns the string representation of a.
+func (a Float8E4M3) String() string {
+	return a.Text('g', -1)
+}
+
+// Text returns the string representation of a in the given format and precision.
+func (a Float8E4M3) Text(fmt byte, prec int) string {
+	return string(a.Append(make([]byte, 0, 8), fmt, prec))
+}
+
+// Append appends the string representation of a in the given format and precision to buf and returns the extended buffer.
+func (a Float8E4M3) Append(dst []byte, fmt byte, prec int) []byte {
+	// special numbers
+	if a.IsNaN() {
+		return append(dst, "NaN"...)
+	}
+
+	switch fmt {
+	case 'b':
+		return a.appendBin(dst)
+	case 'x', 'X':
+		return a.appendHex(dst, fmt, prec)
+	case 'f', 'e', 'E', 'g', 'G':
+		return a.append(dst, fmt, prec)
+	}
+
+	// unknown format
+	return append(dst, '%', fmt)
+}
+
+func (a Float8E4M3) appendBin(dst []byte) []byte {
+	sign, exp, frac := a.split()
+	exp -= shiftE4M3
+
+	if sign != 0 {
+		dst = append(dst, '-')
+	}
+
+	if frac >= 10 {
+		dst = append(dst, byte(frac/10)+'0')
+	}
+	dst = append(dst, byte(frac%10)+'0')
+
+	dst = append(dst, 'p')
+	if exp >= 0 {
+		dst = append(dst, '+')
+	} else {
+		dst = append(dst, '-')
+		exp = -exp
+	}
+
+	if exp >= 10 {
+		dst = append(dst, byte(exp/10)+'0')
+	}
+	dst = append(dst, byte(exp%10)+'0')
+	return dst
+}
+
+func (a Float8E4M3) appendHex(dst []byte, fmt byte, prec int) []byte {
+	sign, exp, frac := a.normalize()
+	if sign != 0 {
+		dst = append(dst, '-')
+	}
+	dst = append(dst, '0', fmt) // 0x or 0X
+	if a.IsZero() {
+		dst = append(dst, '0')
+		if prec >= 1 {
+			dst = append(dst, '.')
+			for range prec {
+				dst = append(dst, '0')
+			}
+		}
+		dst = append(dst, fmt-('x'-'p')) // 'p' or 'P'
+		return append(dst, "+00"...)
+	}
+
+	hex := lowerHex
+	if fmt == 'X' {
+		hex = upperHex
+	}
+
+	switch {
+	case prec < 0:
+		dst = append(dst, '1')
+		if frac&fracMaskE4M3 != 0 {
+			dst = append(dst, '.')
+			dst = append(dst, hex[(frac<<1)&0xF])
+		}
+	case prec == 0:
+		// round to nearest even
+		frac += 1 << (shiftE4M3 - 1)
+		if frac >= 1<<(shiftE4M3+1) {
+			exp++
+		}
+		dst = append(dst, '1')
+	default:
+		dst = append(dst, '1', '.')
+		dst = append(dst, hex[(frac<<1)&0xF])
+		for i := 1; i < prec; i++ {
+			dst = append(dst, '0')
+		}
+	}
+
+	dst = append(dst, fmt-('x'-'p'))
+	if exp >= 0 {
+		dst = append(dst, '+')
+	} else {
+		dst = append(dst, '-')
+		exp = -exp
+	}
+	dst = append(dst, byte(exp/10)+'0', byte(exp%10)+'0')
+	return dst
+}
+
+func (a Float8E4M3) append(dst []byte, fmt byte, prec int) []byte {
+	sign, exp, frac := a.split()
+
+	var buf [decimalDigitsE4M3]byte
+	d := &decimal{d: buf[:]}
+	d.AssignUint64(uint64(frac))
+	d.Shift(exp - shiftE4M3)
+	shortest := prec < 0
+	if shortest {
+		roundShortestE4M3(d, frac, exp)
+		// Precision for shortest representation mode.
+		switch fmt {
+		case 'e', 'E':
+			prec = d.nd - 1
+		case 'f':
+			prec = max(d.nd-d.dp, 0)
+		case 'g', 'G':
+			prec = d.nd
+		}
+	} else {
+		// Round appropriately.
+		switch fmt {
+		case 'e', 'E':
+			d.Round(prec + 1)
+		case 'f':
+			d.Round(d.dp + prec)
+		case 'g', 'G':
+			if prec == 0 {
+				prec = 1
+			}
+			d.Round(prec)
+		}
+	}
+	return formatDigits(dst, sign != 0, d, shortest, prec, fmt)
+}
+
+func roundShortestE4M3(d *decimal, frac uint8, exp int) {
+	// If mantissa is zero, the number is zero; stop now.
+	if frac == 0 {
+		d.nd = 0
+		return
+	}
+
+	minexp := -biasE4M3 + 1 // minimum possible exponent
+
+	// d = frac << (exp - shiftE4M3)
+	// Next highest floating point number is frac+1 << exp-shiftE4M3.
+	// Our upper bound is halfway between, frac*2+1 << exp-shiftE4M3-1.
+	var upperBuf [decimalDigitsE4M3]byte
+	upper := &decimal{d: upperBuf[:]}
+	upper.AssignUint64(uint64(frac*2 + 1))
+	upper.Shift(exp - shiftE4M3 - 1)
+
+	// d = frac << (exp - shiftE4M3)
+	// Next lowest floating point number is frac-1 << exp-shiftE4M3,
+	// unless frac-1 drops the significant bit and exp is not the minimum exp,
+	// in which case the next lowest is frac*2-1 << exp-shiftE4M3-1.
+	// Either way, call it fraclo << explo-shiftE4M3.
+	// Our lower bound is halfway between, fraclo*2+1 << explo-shiftE4M3-1.
+	var fraclo uint8
+	var explo int
+	if frac > 1<<shiftE4M3 || exp == minexp {
+		fraclo = frac - 1
+		explo = exp
+	} else {
+		fraclo = frac*2 - 1
+		explo = exp - 1
+	}
+	var lowerBuf [decimalDigitsE4M3]byte
+	lower := &decimal{d: lowerBuf[:]}
+	lower.AssignUint64(uint64(fraclo*2 + 1))
+	lower.Shift(explo - shiftE4M3 - 1)
+
+	// The upper and lower bounds are possible outputs only if
+	// the original mantissa is even, so that IEEE round-to-even
+	// would round to the original mantissa and not the neighbors.
+	inclusive := frac%2 == 0
+
+	// As we walk the digits we want to know whether rounding up would fall
+	// within the upper bound. This is tracked by upperdelta:
+	//
+	// If upperdelta == 0, the digits of d and upper are the same so far.
+	//
+	// If upperdelta == 1, we saw a difference of 1 between d and upper on a
+	// previous digit and subsequently only 9s for d and 0s for upper.
+	// (Thus rounding up may fall outside the bound, if it is exclusive.)
+	//
+	// If upperdelta == 2, then the difference is greater than 1
+	// and we know that rounding up falls within the bound.
+	var upperdelta uint8
+
+	// Now we can figure out the minimum number of digits required.
+	// Walk along until d has distinguished itself from upper and lower.
+	for ui := 0; ; ui++ {
+		// lower, d, and upper may have the decimal points at different
+		// places. In this case upper is the longest, so we iterate from
+		// ui==0 and start li and mi at (possibly) -1.
+		mi := ui - upper.dp + d.dp
+		if mi >= d.nd {
+			break
+		}
+		li := ui - upper.dp + lower.dp
+		l := byte('0') // lower digit
+		if li >= 0 && li < lower.nd {
+			l = lower.d[li]
+		}
+		m := byte('0') // middle digit
+		if mi >= 0 {
+			m = d.d[mi]
+		}
+		u := byte('0') // upper digit
+		if ui < upper.nd {
+			u = upper.d[ui]
+		}
+
+		// Okay to round down (truncate) if lower has a different digit
+		// or if lower is inclusive and is exactly the result of rounding
+		// down (i.e., and we have reached the final digit of lower).
+		okdown := l != m || inclusive && li+1 == lower.nd
+
+		switch {
+		case upperdelta == 0 && m+1 < u:
+			// Example:
+			// m = 12345xxx
+			// u = 12347xxx
+			upperdelta = 2
+		case upperdelta == 0 && m != u:
+			// Example:
+			// m = 12345xxx
+			// u = 12346xxx
+			upperdelta = 1
+		case upperdelta == 1 && (m != '9' || u != '0'):
+			// Example:
+			// m = 1234598x
+			// u = 1234600x
+			upperdelta = 2
+		}
+		// Okay to round up if upper has a different digit and either upper
+		// is inclusive or upper is bigger than the result of rounding up.
+		okup := upperdelta > 0 && (inclusive || upperdelta > 1 || ui+1 < upper.nd)
+
+		// If it's okay to do either, then round to the nearest one.
+		// If it's okay to do only one, do it.
+		switch {
+		case okdown && okup:
+			d.Round(mi + 1)
+			return
+		case okdown:
+			d.RoundDown(mi + 1)
+			return
+		case okup:
+			d.RoundUp(mi + 1)
+			return
+		}
+	}
+}
+
+var _ json.Marshaler = Float8E4M3(0)
+
+// MarshalJSON implements [json.Marshaler].
+func (a Float8E4M3) MarshalJSON() ([]byte, error) {
+	// JSON does not support NaN values.
+	if a.IsNaN() {
+		return nil, fmt.Errorf("floats: cannot marshal %v to JSON", a)
+	}
+	return a.Append(nil, 'g', -1), nil
+}
+
+var _ encoding.TextMarshaler = Float8E4M3(0)
+
+// MarshalText implements [encoding.TextMarshaler].
+func (a Float8E4M3) MarshalText() ([]byte, error) {
+	return a.Append(nil, 'g', -1), nil
+}
+
+var _ encoding.TextAppender = Float8E4M3(0)
+
+// AppendText implements [encoding.TextAppender].
+func (a Float8E4M3) AppendText(dst []byte) ([]byte, error) {
+	return a.Append(dst, 'g', -1), nil
+}
@@ -0,0 +1,193 @@
+package floats
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestFloat8E4M3_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      Float8E4M3
+		want   string
+	}{
+		// verb "%b"
+		{"%b", exactE4M3(0), "0p-9"},
+		{"%b", exactE4M3(1), "8p-3"},
+
+		// verb "%f"
+		{"%f", exactE4M3(0.5), "0.5"},
+		{"%f", exactE4M3(-0.5), "-0.5"},
+		{"%+f", exactE4M3(0.5), "+0.5"},
+		{"%8f", exactE4M3(0.5), "     0.5"},
+		{"%.2f", exactE4M3(0.5), "0.50"},
+
+		// verb "%e"
+		{"%.6e", exactE4M3(0.5), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", exactE4M3(0.5), "0.5"},
+		{"%.1g", exactE4M3(0.25), "0.2"},
+
+		// verb "%x"
+		{"%x", exactE4M3(0.5), "0x1p-01"},
+		{"%.1x", exactE4M3(0.5), "0x1.0p-01"},
+		{"%X", exactE4M3(0.5), "0X1P-01"},
+
+		// verb "%v"
+		{"%v", exactE4M3(0.5), "0.5"},
+		{"%v", uvnanE4M3, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestFloat8E4M3_Text(t *testing.T) {
+	tests := []struct {
+		x    Float8E4M3
+		fmt  byte
+		prec int
+		s    string
+	}{
+		/****** decimal exponent formats ******/
+		{exactE4M3(0), 'e', 8, "0.00000000e+00"},
+		{exactE4M3(0x1p-9), 'e', 8, "1.95312500e-03"},
+		{exactE4M3(0x7p-9), 'e', 8, "1.36718750e-02"},
+		{exactE4M3(0x1p-6), 'e', 8, "1.56250000e-02"},
+
+		{exactE4M3(0x1p-9), 'e', -1, "2e-03"},
+		{exactE4M3(0x7p-9), 'e', -1, "1.4e-02"},
+		{exactE4M3(0x1p-6), 'e', -1, "1.6e-02"},
+		{exactE4M3(10), 'e', -1, "1e+01"},
+		{exactE4M3(96), 'e', -1, "1e+02"},
+		{exactE4M3(448), 'e', -1, "4.5e+02"},
+
+		/****** decimal formats ******/
+		{0, 'f', -1, "0"},
+		{0x80, 'f', -1, "-0"},
+		{uvnanE4M3, 'f', -1, "NaN"},
+		{uvnanE4M3 | signMaskE4M3, 'f', -1, "NaN"},
+
+		{exactE4M3(3.25), 'f', -1, "3.2"},
+		{exactE4M3(3.25), 'f', 2, "3.25"},
+		{exactE4M3(0.40625), 'f', -1, "0.4"},
+		{exactE4M3(448), 'f', -1, "450"},
+		{exactE4M3(448), 'f', 0, "448"},
+		{exactE4M3(0x1p-9), 'f', -1, "0.002"},
+
+		{exactE4M3(448), 'g', -1, "450"},
+		{exactE4M3(0x1p-9), 'g', -1, "0.002"},
+
+		/****** binary exponent formats ******/
+		{exactE4M3(0x1p-9), 'b', -1, "1p-9"},
+		{exactE4M3(0x1p-6), 'b', -1, "8p-9"},
+		{exactE4M3(448), 'b', -1, "14p+5"},
+
+		/****** hexadecimal formats ******/
+		{exactE4M3(448), 'x', -1, "0x1.cp+08"},
+		{exactE4M3(448), 'x', 0, "0x1p+09"},
+		{exactE4M3(448), 'x', 3, "0x1.c00p+08"},
+		{exactE4M3(0x1p-9), 'x', -1, "0x1p-09"},
+		{exactE4M3(0x7p-9), 'x', -1, "0x1.cp-07"},
+		{exactE4M3(0x1.2p0), 'x', 0, "0x1p+00"},
+		{0, 'X', -1, "0X0P+00"},
+		{exactE4M3(-0x1.ap0), 'X', -1, "-0X1.AP+00"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.s {
+			t.Errorf("%#v: expected %s, got %s", tt, tt.s, got)
+		}
+	}
+}
+
+// TestFloat8E4M3_Text_All checks formats with an explicit precision against strconv,
+// and checks that the shortest formats round-trip.
+func TestFloat8E4M3_Text_All(t *testing.T) {
+	for i := range 256 {
+		a := Float8E4M3(i)
+		if a.IsNaN() {
+			continue
+		}
+		f := a.Float64().BuiltIn()
+
+		// formats with fixed precision match strconv.
+		for _, format := range []byte{'e', 'f', 'g', 'x'} {
+			for prec := range 6 {
+				got := a.Text(format, prec)
+				want := strconv.FormatFloat(f, format, prec, 64)
+				if got != want {
+					t.Errorf("Float8E4M3(%x).Text(%q, %d) = %q, want %q", i, format, prec, got, want)
+				}
+			}
+		}
+
+		// the shortest representation can be parsed back to the same value.
+		s := a.Text('g', -1)
+		if got, err := ParseFloat8E4M3(s); err != nil || got != a {
+			t.Errorf("ParseFloat8E4M3(%q) = %x, %v, want %x", s, got, err, a)
+		}
+	}
+}
+
+func TestFloat8E4M3_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    Float8E4M3
+		want string
+	}{
+		{exactE4M3(0), "0"},
+		{exactE4M3(1), "1"},
+		{exactE4M3(-1), "-1"},
+		{exactE4M3(0.5), "0.5"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN values.
+	_, err := Float8E4M3(uvnanE4M3).MarshalJSON()
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestFloat8E4M3_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    Float8E4M3
+		want string
+	}{
+		{exactE4M3(0), "0"},
+		{exactE4M3(1), "1"},
+		{exactE4M3(-1), "-1"},
+		{exactE4M3(0.5), "0.5"},
+
+		// special values
+		{uvnanE4M3, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
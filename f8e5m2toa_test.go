@@ -0,0 +1,197 @@
+package floats
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestFloat8E5M2_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      Float8E5M2
+		want   string
+	}{
+		// verb "%b"
+		{"%b", exactE5M2(0), "0p-16"},
+		{"%b", exactE5M2(1), "4p-2"},
+
+		// verb "%f"
+		{"%f", exactE5M2(0.5), "0.5"},
+		{"%f", exactE5M2(-0.5), "-0.5"},
+		{"%+f", exactE5M2(0.5), "+0.5"},
+		{"%8f", exactE5M2(0.5), "     0.5"},
+		{"%.2f", exactE5M2(0.5), "0.50"},
+
+		// verb "%e"
+		{"%.6e", exactE5M2(0.5), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", exactE5M2(0.5), "0.5"},
+		{"%.1g", exactE5M2(0.25), "0.2"},
+
+		// verb "%x"
+		{"%x", exactE5M2(0.5), "0x1p-01"},
+		{"%.1x", exactE5M2(0.5), "0x1.0p-01"},
+		{"%X", exactE5M2(0.5), "0X1P-01"},
+
+		// verb "%v"
+		{"%v", exactE5M2(0.5), "0.5"},
+		{"%v", uvnanE5M2, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestFloat8E5M2_Text(t *testing.T) {
+	tests := []struct {
+		x    Float8E5M2
+		fmt  byte
+		prec int
+		s    string
+	}{
+		/****** decimal exponent formats ******/
+		{exactE5M2(0), 'e', 8, "0.00000000e+00"},
+		{exactE5M2(0x1p-16), 'e', 8, "1.52587891e-05"},
+		{exactE5M2(0x3p-16), 'e', 8, "4.57763672e-05"},
+		{exactE5M2(0x1p-14), 'e', 8, "6.10351562e-05"},
+
+		{exactE5M2(0x1p-16), 'e', -1, "2e-05"},
+		{exactE5M2(0x3p-16), 'e', -1, "5e-05"},
+		{exactE5M2(0x1p-14), 'e', -1, "6e-05"},
+		{exactE5M2(10), 'e', -1, "1e+01"},
+		{exactE5M2(1024), 'e', -1, "1e+03"},
+		{exactE5M2(57344), 'e', -1, "6e+04"},
+
+		/****** decimal formats ******/
+		{0, 'f', -1, "0"},
+		{0x80, 'f', -1, "-0"},
+		{uvinfE5M2, 'f', -1, "+Inf"},
+		{uvneginfE5M2, 'f', -1, "-Inf"},
+		{uvnanE5M2, 'f', -1, "NaN"},
+
+		{exactE5M2(3.5), 'f', -1, "3.5"},
+		{exactE5M2(0.09375), 'f', -1, "0.1"},
+		{exactE5M2(0.09375), 'f', 5, "0.09375"},
+		{exactE5M2(57344), 'f', -1, "60000"},
+		{exactE5M2(57344), 'f', 0, "57344"},
+		{exactE5M2(0x1p-16), 'f', -1, "0.00002"},
+
+		{exactE5M2(57344), 'g', -1, "60000"},
+		{exactE5M2(0x1p-16), 'g', -1, "2e-05"},
+
+		/****** binary exponent formats ******/
+		{exactE5M2(0x1p-16), 'b', -1, "1p-16"},
+		{exactE5M2(0x1p-14), 'b', -1, "4p-16"},
+		{exactE5M2(57344), 'b', -1, "7p+13"},
+
+		/****** hexadecimal formats ******/
+		{exactE5M2(57344), 'x', -1, "0x1.cp+15"},
+		{exactE5M2(57344), 'x', 0, "0x1p+16"},
+		{exactE5M2(57344), 'x', 3, "0x1.c00p+15"},
+		{exactE5M2(0x1p-16), 'x', -1, "0x1p-16"},
+		{exactE5M2(0x3p-16), 'x', -1, "0x1.8p-15"},
+		{exactE5M2(0x1.4p0), 'x', 0, "0x1p+00"},
+		{0, 'X', -1, "0X0P+00"},
+		{exactE5M2(-0x1.cp0), 'X', -1, "-0X1.CP+00"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.s {
+			t.Errorf("%#v: expected %s, got %s", tt, tt.s, got)
+		}
+	}
+}
+
+// TestFloat8E5M2_Text_All checks formats with an explicit precision against strconv,
+// and checks that the shortest formats round-trip.
+func TestFloat8E5M2_Text_All(t *testing.T) {
+	for i := range 256 {
+		a := Float8E5M2(i)
+		if a.IsNaN() || a.IsInf(0) {
+			continue
+		}
+		f := a.Float64().BuiltIn()
+
+		// formats with fixed precision match strconv.
+		for _, format := range []byte{'e', 'f', 'g', 'x'} {
+			for prec := range 6 {
+				got := a.Text(format, prec)
+				want := strconv.FormatFloat(f, format, prec, 64)
+				if got != want {
+					t.Errorf("Float8E5M2(%x).Text(%q, %d) = %q, want %q", i, format, prec, got, want)
+				}
+			}
+		}
+
+		// the shortest representation can be parsed back to the same value.
+		s := a.Text('g', -1)
+		if got, err := ParseFloat8E5M2(s); err != nil || got != a {
+			t.Errorf("ParseFloat8E5M2(%q) = %x, %v, want %x", s, got, err, a)
+		}
+	}
+}
+
+func TestFloat8E5M2_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    Float8E5M2
+		want string
+	}{
+		{exactE5M2(0), "0"},
+		{exactE5M2(1), "1"},
+		{exactE5M2(-1), "-1"},
+		{exactE5M2(0.5), "0.5"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN and Inf values.
+	for _, x := range []Float8E5M2{uvnanE5M2, uvinfE5M2, uvneginfE5M2} {
+		if _, err := x.MarshalJSON(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	}
+}
+
+func TestFloat8E5M2_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    Float8E5M2
+		want string
+	}{
+		{exactE5M2(0), "0"},
+		{exactE5M2(1), "1"},
+		{exactE5M2(-1), "-1"},
+		{exactE5M2(0.5), "0.5"},
+
+		// special values
+		{uvinfE5M2, "+Inf"},
+		{uvneginfE5M2, "-Inf"},
+		{uvnanE5M2, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestBFloat16_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      BFloat16
+		want   string
+	}{
+		// verb "%b"
+		{"%b", exactBF16(0), "0p-133"},
+		{"%b", exactBF16(1), "128p-7"},
+
+		// verb "%f"
+		{"%f", exactBF16(0.5), "0.5"},
+		{"%f", exactBF16(-0.5), "-0.5"},
+		{"%+f", exactBF16(0.5), "+0.5"},
+		{"%+f", exactBF16(-0.5), "-0.5"},
+		{"% f", exactBF16(0.5), " 0.5"},
+		{"% f", exactBF16(-0.5), "-0.5"},
+		{"%8f", exactBF16(0.5), "     0.5"},
+		{"%-8f", exactBF16(0.5), "0.5     "},
+		{"%.2f", exactBF16(0.5), "0.50"},
+
+		// verb "%e"
+		{"%.6e", exactBF16(0.5), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", exactBF16(0.5), "0.5"},
+		{"%.1g", exactBF16(0.25), "0.2"},
+		// verb "%x"
+		{"%x", exactBF16(0.5), "0x1p-01"},
+		{"%#x", exactBF16(0.5), "0x1p-01"},
+		{"%.1x", exactBF16(0.5), "0x1.0p-01"},
+
+		// verb "%X"
+		{"%X", exactBF16(0.5), "0X1P-01"},
+		{"%#X", exactBF16(0.5), "0X1P-01"},
+		{"%.1X", exactBF16(0.5), "0X1.0P-01"},
+
+		// verb "%v"
+		{"%v", exactBF16(0.5), "0.5"},
+		{"%v", exactBF16(math.NaN()), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestBFloat16_Text(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		fmt  byte
+		prec int
+		s    string
+	}{
+		/****** decimal exponent formats ******/
+		{exactBF16(0), 'e', 16, "0.0000000000000000e+00"},
+		{exactBF16(0x1p-133), 'e', 16, "9.1835496157991212e-41"},
+		{exactBF16(0x2p-133), 'e', 16, "1.8367099231598242e-40"},
+		{exactBF16(0x3p-133), 'e', 16, "2.7550648847397363e-40"},
+		{exactBF16(0x7fp-133), 'e', 16, "1.1663108012064884e-38"},
+		{exactBF16(0x1p-126), 'e', 16, "1.1754943508222875e-38"},
+
+		{exactBF16(0x1p-133), 'e', -1, "1e-40"},
+		{exactBF16(0x2p-133), 'e', -1, "2e-40"},
+		{exactBF16(0x3p-133), 'e', -1, "3e-40"},
+		{exactBF16(0x7fp-133), 'e', -1, "1.17e-38"},
+		{exactBF16(0x1p-126), 'e', -1, "1.18e-38"},
+		{exactBF16(10), 'e', -1, "1e+01"},
+		{exactBF16(100), 'e', -1, "1e+02"},
+		{exactBF16(1000), 'e', -1, "1e+03"},
+		{exactBF16(9984), 'e', -1, "1e+04"},
+		{exactBF16(0x1.fep127), 'e', -1, "3.39e+38"},
+
+		// random numbers
+		{0x816f, 'e', -1, "-4.39e-38"},
+		{0xadaa, 'e', -1, "-1.93e-11"},
+		{0x92dc, 'e', -1, "-1.39e-27"},
+		{0xf4ca, 'e', -1, "-1.28e+32"},
+		{0x7aa7, 'e', -1, "4.34e+35"},
+		{0x3734, 'e', -1, "1.07e-05"},
+		{0x2ac3, 'e', -1, "3.46e-13"},
+		{0xe6d6, 'e', -1, "-5.05e+23"},
+		{0x700f, 'e', -1, "1.77e+29"},
+		{0x3dcd, 'e', -1, "1e-01"},
+		{0xc2f7, 'e', -1, "-1.235e+02"},
+
+		/****** decimal formats ******/
+		{0, 'f', -1, "0"},
+		{0x8000, 'f', -1, "-0"},
+		{exactBF16(math.Inf(1)), 'f', -1, "+Inf"},
+		{exactBF16(math.Inf(-1)), 'f', -1, "-Inf"},
+		{exactBF16(math.NaN()), 'f', -1, "NaN"},
+
+		{exactBF16(3.140625), 'f', -1, "3.14"},
+		{exactBF16(3.140625), 'f', 6, "3.140625"},
+		{exactBF16(9984), 'f', -1, "10000"},
+		{exactBF16(9984), 'f', 0, "9984"},
+		{exactBF16(0x1.2cp126), 'f', -1, "100000000000000000000000000000000000000"},
+		{exactBF16(0x1p-133), 'f', -1, "0.0000000000000000000000000000000000000001"},
+
+		{exactBF16(9984), 'g', -1, "10000"},
+		{exactBF16(0x1.2cp126), 'g', -1, "1e+38"},
+		{exactBF16(0x1p-133), 'g', -1, "1e-40"},
+
+		/****** binary exponent formats ******/
+		{exactBF16(0x1p-133), 'b', -1, "1p-133"},
+		{exactBF16(0x1p-126), 'b', -1, "128p-133"},
+		{exactBF16(1), 'b', -1, "128p-7"},
+		{exactBF16(0x1.fep127), 'b', -1, "255p+120"},
+
+		/****** hexadecimal formats ******/
+		{exactBF16(0x1.fep127), 'x', -1, "0x1.fep+127"},
+		{exactBF16(0x1.fep127), 'x', 1, "0x1.0p+128"},
+		{exactBF16(0x1.fep127), 'x', 4, "0x1.fe00p+127"},
+		{exactBF16(0x1p-133), 'x', -1, "0x1p-133"},
+		{exactBF16(0x1p-126), 'x', -1, "0x1p-126"},
+		{exactBF16(0x1.9ap-4), 'x', 0, "0x1p-03"},
+		{exactBF16(0x1.9ap-4), 'x', 1, "0x1.ap-04"},
+		{exactBF16(0x1.88p0), 'x', 1, "0x1.8p+00"},
+		{exactBF16(0x1.98p0), 'x', 1, "0x1.ap+00"},
+
+		{exactBF16(0x1p0), 'x', -1, "0x1p+00"},
+		{exactBF16(0x1.8p0), 'x', -1, "0x1.8p+00"},
+		{exactBF16(0x1.08p0), 'x', -1, "0x1.08p+00"},
+		{exactBF16(0x1.02p0), 'x', -1, "0x1.02p+00"},
+
+		{0, 'X', -1, "0X0P+00"},
+		{exactBF16(0x1.fep0), 'X', 3, "0X1.FE0P+00"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.s {
+			t.Errorf("%#v: expected %s, got %s", tt, tt.s, got)
+		}
+	}
+}
+
+// TestBFloat16_Text_All checks formats with an explicit precision against strconv.
+// Every BFloat16 is exactly representable in float32, so they must agree.
+func TestBFloat16_Text_All(t *testing.T) {
+	for i := range 0x10000 {
+		x := BFloat16(i)
+		f := x.Float32().BuiltIn()
+		for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+			for _, prec := range []int{0, 1, 2, 5} {
+				got := x.Text(fmt, prec)
+				want := strconv.FormatFloat(float64(f), fmt, prec, 32)
+				if got != want {
+					t.Fatalf("BFloat16(%#04x).Text(%q, %d) = %s, want %s", i, fmt, prec, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestBFloat16_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want string
+	}{
+		{exactBF16(0), "0"},
+		{exactBF16(-0), "0"},
+		{exactBF16(1), "1"},
+		{exactBF16(-1), "-1"},
+		{exactBF16(0.5), "0.5"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN and Inf values.
+	var err error
+	_, err = exactBF16(math.NaN()).MarshalJSON()
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+	_, err = exactBF16(math.Inf(1)).MarshalJSON()
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+	_, err = exactBF16(math.Inf(-1)).MarshalJSON()
+	if err == nil {
+		t.Errorf("expected error, got nil")
+	}
+}
+
+func TestBFloat16_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want string
+	}{
+		{exactBF16(0), "0"},
+		{exactBF16(-0), "0"},
+		{exactBF16(1), "1"},
+		{exactBF16(-1), "-1"},
+		{exactBF16(0.5), "0.5"},
+
+		// special values
+		{exactBF16(math.Inf(1)), "+Inf"},
+		{exactBF16(math.Inf(-1)), "-Inf"},
+		{exactBF16(math.NaN()), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestBFloat16_AppendText(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want string
+	}{
+		{exactBF16(0), "0"},
+		{exactBF16(-0), "0"},
+		{exactBF16(1), "1"},
+		{exactBF16(-1), "-1"},
+		{exactBF16(0.5), "0.5"},
+
+		// special values
+		{exactBF16(math.Inf(1)), "+Inf"},
+		{exactBF16(math.Inf(-1)), "-Inf"},
+		{exactBF16(math.NaN()), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.AppendText(nil)
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+var _ fmt.Formatter = Decimal64(0)
+
+// Format implements [fmt.Formatter].
+func (a Decimal64) Format(s fmt.State, verb rune) {
+	format(a, s, verb)
+}
+
+var _ fmt.Stringer = Decimal64(0)
+
+// String returns the string representation of a.
+// It keeps the exponent of a, e.g. 1.20 is formatted as "1.20".
+func (a Decimal64) String() string {
+	return a.Text('g', -1)
+}
+
+// Text returns the string representation of a in the given format and precision.
+func (a Decimal64) Text(fmt byte, prec int) string {
+	return string(a.Append(make([]byte, 0, 32), fmt, prec))
+}
+
+// Append appends the string representation of a in the given format and precision to buf and returns the extended buffer.
+//
+// The formats 'e', 'E', 'f', 'g', and 'G' are the same as [strconv.FormatFloat].
+// The precision -1 uses all the digits of the coefficient including the trailing zeros,
+// so that the result is parsed back to the same member of the cohort:
+// 'e' uses the exponent notation, 'f' never uses it,
+// and 'g' uses it only if the exponent is positive or the value is less than 1e-6,
+// as to-scientific-string of the General Decimal Arithmetic Specification does.
+func (a Decimal64) Append(dst []byte, fmt byte, prec int) []byte {
+	return a.unpack().append(dst, fmt, prec)
+}
+
+var _ json.Marshaler = Decimal64(0)
+
+// MarshalJSON implements [json.Marshaler].
+func (a Decimal64) MarshalJSON() ([]byte, error) {
+	if a.IsNaN() || a.IsInf(0) {
+		return nil, fmt.Errorf("floats: cannot marshal %v to JSON", a)
+	}
+	return a.Append(nil, 'g', -1), nil
+}
+
+var _ encoding.TextMarshaler = Decimal64(0)
+
+// MarshalText implements [encoding.TextMarshaler].
+func (a Decimal64) MarshalText() ([]byte, error) {
+	return a.Append(nil, 'g', -1), nil
+}
+
+var _ encoding.TextAppender = Decimal64(0)
+
+// AppendText implements [encoding.TextAppender].
+func (a Decimal64) AppendText(dst []byte) ([]byte, error) {
+	return a.Append(dst, 'g', -1), nil
+}
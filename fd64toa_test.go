@@ -0,0 +1,141 @@
+package floats
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDecimal64_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      Decimal64
+		want   string
+	}{
+		// verb "%f"
+		{"%f", NewDecimal64(120, -2), "1.20"},
+		{"%+f", NewDecimal64(5, -1), "+0.5"},
+		{"%8f", NewDecimal64(5, -1), "     0.5"},
+		{"%.2f", NewDecimal64(5, -1), "0.50"},
+		{"%f", NewDecimal64(1, 3), "1000"},
+
+		// verb "%e"
+		{"%e", NewDecimal64(120, -2), "1.20e+00"},
+		{"%.6e", NewDecimal64(5, -1), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", NewDecimal64(1, 3), "1e+03"},
+		{"%.1g", NewDecimal64(25, -2), "0.2"},
+		{"%.1g", NewDecimal64(35, -2), "0.4"},
+
+		// verb "%v"
+		{"%v", NewDecimal64(120, -2), "1.20"},
+		{"%v", NewDecimal64NaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestDecimal64_Text(t *testing.T) {
+	tests := []struct {
+		x    Decimal64
+		fmt  byte
+		prec int
+		want string
+	}{
+		{NewDecimal64(0, 0), 'g', -1, "0"},
+		{NewDecimal64(0, -2), 'g', -1, "0.00"},
+		{NewDecimal64(0, 2), 'g', -1, "0e+02"},
+		{NewDecimal64(0, -2).Neg(), 'g', -1, "-0.00"},
+		{NewDecimal64(1, 0), 'g', -1, "1"},
+		{NewDecimal64(123, 0), 'g', -1, "123"},
+		{NewDecimal64(-123, -1), 'g', -1, "-12.3"},
+		{NewDecimal64(123, 1), 'g', -1, "1.23e+03"},
+		{NewDecimal64(123, -5), 'g', -1, "0.00123"},
+		{NewDecimal64(123, -8), 'g', -1, "0.00000123"},
+		{NewDecimal64(123, -10), 'g', -1, "1.23e-08"},
+		{NewDecimal64(123, -10), 'G', -1, "1.23E-08"},
+		{NewDecimal64(1, -398), 'g', -1, "1e-398"},
+		{NewDecimal64(9999999999999999, 369), 'g', -1, "9.999999999999999e+384"},
+
+		{NewDecimal64(123, 1), 'f', -1, "1230"},
+		{NewDecimal64(123, -5), 'f', -1, "0.00123"},
+		{NewDecimal64(123, -5), 'e', -1, "1.23e-03"},
+		{NewDecimal64(12300, -2), 'e', -1, "1.2300e+02"},
+		{NewDecimal64(12345, -2), 'f', 1, "123.4"},
+		{NewDecimal64(12355, -2), 'f', 1, "123.6"},
+		{NewDecimal64(12345, -2), 'e', 2, "1.23e+02"},
+		{NewDecimal64(12345, -2), 'g', 4, "123.4"},
+
+		// special values
+		{NewDecimal64Inf(1), 'g', -1, "+Inf"},
+		{NewDecimal64Inf(-1), 'g', -1, "-Inf"},
+		{NewDecimal64NaN(), 'g', -1, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.want {
+			t.Errorf("Decimal64(%x).Text(%q, %d) = %s, want %s", tt.x.Bits(), tt.fmt, tt.prec, got, tt.want)
+		}
+	}
+}
+
+func TestDecimal64_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    Decimal64
+		want string
+	}{
+		{NewDecimal64(0, 0), "0"},
+		{NewDecimal64(-1, 0), "-1"},
+		{NewDecimal64(150, -2), "1.50"},
+		{NewDecimal64(1, 3), "1e+03"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN and Inf values.
+	for _, x := range []Decimal64{NewDecimal64NaN(), NewDecimal64Inf(1), NewDecimal64Inf(-1)} {
+		if _, err := x.MarshalJSON(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	}
+}
+
+func TestDecimal64_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    Decimal64
+		want string
+	}{
+		{NewDecimal64(0, 0), "0"},
+		{NewDecimal64(-1, 0), "-1"},
+		{NewDecimal64(150, -2), "1.50"},
+		{NewDecimal64Inf(1), "+Inf"},
+		{NewDecimal64Inf(-1), "-Inf"},
+		{NewDecimal64NaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
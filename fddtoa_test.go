@@ -0,0 +1,169 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestDoubleDouble_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      DoubleDouble
+		want   string
+	}{
+		// verb "%f"
+		{"%f", NewDoubleDouble(0.5), "0.5"},
+		{"%+f", NewDoubleDouble(0.5), "+0.5"},
+		{"%8f", NewDoubleDouble(0.5), "     0.5"},
+		{"%.2f", NewDoubleDouble(0.5), "0.50"},
+
+		// verb "%e"
+		{"%.6e", NewDoubleDouble(0.5), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", DoubleDouble{1, 0x1p-80}, "1.0000000000000000000000008271806125530277"},
+		{"%.1g", NewDoubleDouble(0.25), "0.2"},
+
+		// verb "%x"
+		{"%x", DoubleDouble{1, 0x1p-80}, "0x1.00000000000000000001p+00"},
+
+		// verb "%v"
+		{"%v", NewDoubleDouble(0.5), "0.5"},
+		{"%v", NewDoubleDoubleNaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestDoubleDouble_Text(t *testing.T) {
+	third := NewDoubleDouble(1).Quo(NewDoubleDouble(3))
+	tests := []struct {
+		x    DoubleDouble
+		fmt  byte
+		prec int
+		want string
+	}{
+		{NewDoubleDouble(0), 'g', -1, "0"},
+		{NewDoubleDouble(math.Copysign(0, -1)), 'g', -1, "-0"},
+		{NewDoubleDouble(1), 'g', -1, "1"},
+
+		// the second component is zero, so the value is printed exactly.
+		{NewDoubleDouble(0.1), 'g', -1, "0.1000000000000000055511151231257827021181583404541015625"},
+		{NewDoubleDouble(0.1).Add(NewDoubleDouble(0x1p-80)), 'g', -1, "0.1000000000000000055511159503063952551458"},
+		{NewDoubleDouble(1e23), 'e', -1, "9.9999999999999991611392e+22"},
+		{third, 'g', -1, "0.333333333333333333333333333333332"},
+		{third, 'e', -1, "3.33333333333333333333333333333332e-01"},
+		{third, 'g', 5, "0.33333"},
+		{third, 'e', 10, "3.3333333333e-01"},
+		{third, 'f', 40, "0.3333333333333333333333333333333323061707"},
+		{third, 'x', -1, "0x1.555555555555555555555555554p-02"},
+
+		// the smallest denormal
+		{NewDoubleDouble(5e-324), 'g', -1, "4.94065645841246544176568792868221372365059802614324764425585682500675507e-324"},
+
+		// special values
+		{NewDoubleDoubleInf(1), 'g', -1, "+Inf"},
+		{NewDoubleDoubleInf(-1), 'g', -1, "-Inf"},
+		{NewDoubleDoubleNaN(), 'g', -1, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.want {
+			t.Errorf("DoubleDouble(%v).Text(%q, %d) = %s, want %s", [2]float64(tt.x), tt.fmt, tt.prec, got, tt.want)
+		}
+	}
+}
+
+func TestDoubleDouble_Text_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 106))
+	for range 200 {
+		// 105-bit values
+		exp := r.IntN(1024) - 512
+		hi := math.Ldexp(float64(r.Uint64()>>11|1<<52), exp+52)
+		lo := math.Ldexp(float64(r.Uint64()>>12), exp)
+		x := NewDoubleDouble(hi).Add(NewDoubleDouble(lo))
+		if r.IntN(2) == 0 {
+			x = x.Neg()
+		}
+
+		s := x.Text('g', -1)
+		got, err := ParseDoubleDouble(s)
+		if err != nil {
+			t.Errorf("ParseDoubleDouble(%q) returned error: %v", s, err)
+			continue
+		}
+		if !eqDD(got, x) {
+			t.Errorf("ParseDoubleDouble(%q) = %v, want %v", s, [2]float64(got), [2]float64(x))
+		}
+	}
+}
+
+func BenchmarkDoubleDouble_Text(b *testing.B) {
+	x := NewDoubleDouble(1).Quo(NewDoubleDouble(3))
+	for b.Loop() {
+		x.Text('g', -1)
+	}
+}
+
+func TestDoubleDouble_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    DoubleDouble
+		want string
+	}{
+		{NewDoubleDouble(0), "0"},
+		{NewDoubleDouble(1), "1"},
+		{NewDoubleDouble(-1), "-1"},
+		{DoubleDouble{0.5, 0x1p-60}, "0.5000000000000000008673617379884035"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN and Inf values.
+	for _, x := range []DoubleDouble{NewDoubleDoubleNaN(), NewDoubleDoubleInf(1), NewDoubleDoubleInf(-1)} {
+		if _, err := x.MarshalJSON(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	}
+}
+
+func TestDoubleDouble_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    DoubleDouble
+		want string
+	}{
+		{NewDoubleDouble(0), "0"},
+		{NewDoubleDouble(-1), "-1"},
+		{NewDoubleDouble(0.5), "0.5"},
+		{NewDoubleDoubleInf(1), "+Inf"},
+		{NewDoubleDoubleInf(-1), "-Inf"},
+		{NewDoubleDoubleNaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package floats
+
+import "strings"
+
+// Flags is a set of IEEE 754 exception flags.
+type Flags byte
+
+// These constants define the IEEE 754 exceptions.
+// The values are the same as the flags of Berkeley SoftFloat and TestFloat.
+const (
+	// Inexact is raised when the rounded result differs from the exact result.
+	Inexact Flags = 1 << iota
+
+	// Underflow is raised when the result is tiny and inexact.
+	// Tininess is detected after rounding.
+	Underflow
+
+	// Overflow is raised when the rounded result is too large to be represented as a finite number.
+	Overflow
+
+	// DivByZero is raised when a finite nonzero number is divided by zero.
+	DivByZero
+
+	// Invalid is raised when an operation has no meaningful result, e.g. 0/0 or ∞-∞,
+	// or when an operand is a signaling NaN.
+	Invalid
+)
+
+var flagNames = []string{
+	"Inexact",
+	"Underflow",
+	"Overflow",
+	"DivByZero",
+	"Invalid",
+}
+
+// String returns the names of the flags in f joined by "|".
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	var buf strings.Builder
+	for i, name := range flagNames {
+		if f&(1<<i) == 0 {
+			continue
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('|')
+		}
+		buf.WriteString(name)
+	}
+	return buf.String()
+}
@@ -0,0 +1,20 @@
+package floats
+
+import "testing"
+
+func TestFlags_String(t *testing.T) {
+	tests := []struct {
+		f    Flags
+		want string
+	}{
+		{0, "0"},
+		{Inexact, "Inexact"},
+		{Overflow | Inexact, "Inexact|Overflow"},
+		{Inexact | Underflow | Overflow | DivByZero | Invalid, "Inexact|Underflow|Overflow|DivByZero|Invalid"},
+	}
+	for _, tt := range tests {
+		if got := tt.f.String(); got != tt.want {
+			t.Errorf("Flags(%d).String() = %q, want %q", tt.f, got, tt.want)
+		}
+	}
+}
@@ -18,6 +18,9 @@ var (
 
 	signMask128 = ints.Uint128{0x8000_0000_0000_0000, 0x0000_0000_0000_0000} // mask for sign bit
 	fracMask128 = ints.Uint128{0x0000_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}
+
+	quietMask128   = ints.Uint128{0x0000_8000_0000_0000, 0x0000_0000_0000_0000} // mask for the quiet bit of NaN
+	payloadMask128 = ints.Uint128{0x0000_7fff_ffff_ffff, 0xffff_ffff_ffff_ffff} // mask for the payload of NaN
 )
 
 // Float128 is a 128-bit floating-point number.
@@ -33,6 +36,54 @@ func NewFloat128FromBits(b ints.Uint128) Float128 {
 	return Float128(b)
 }
 
+// NewFloat128FromInt64 converts x to Float128.
+// The result is exact.
+func NewFloat128FromInt64(x int64) Float128 {
+	if x < 0 {
+		return newFloat128FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newFloat128FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewFloat128FromUint64 converts x to Float128.
+// The result is exact.
+func NewFloat128FromUint64(x uint64) Float128 {
+	return newFloat128FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewFloat128FromInt128 returns the Float128 value nearest to x, rounding ties to even.
+func NewFloat128FromInt128(x ints.Int128) Float128 {
+	if x.Sign() < 0 {
+		return newFloat128FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newFloat128FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewFloat128FromUint128 returns the Float128 value nearest to x, rounding ties to even.
+func NewFloat128FromUint128(x ints.Uint128) Float128 {
+	return newFloat128FromInt(false, x.Uint256())
+}
+
+// NewFloat128FromInt256 returns the Float128 value nearest to x, rounding ties to even.
+func NewFloat128FromInt256(x ints.Int256) Float128 {
+	if x.Sign() < 0 {
+		return newFloat128FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newFloat128FromInt(false, ints.Uint256(x))
+}
+
+// NewFloat128FromUint256 returns the Float128 value nearest to x, rounding ties to even.
+func NewFloat128FromUint256(x ints.Uint256) Float128 {
+	return newFloat128FromInt(false, x)
+}
+
+// newFloat128FromInt returns the Float128 value nearest to (-1)**neg × x.
+func newFloat128FromInt(neg bool, x ints.Uint256) Float128 {
+	r, _ := float256FromUint256(neg, x, ToNearestEven|toOdd)
+	ret, _ := r.float128(ToNearestEven)
+	return ret
+}
+
 // NewFloat128NaN returns a NaN Float128 value.
 func NewFloat128NaN() Float128 {
 	return Float128(uvnan128)
@@ -57,6 +108,72 @@ func (a Float128) IsNaN() bool {
 		!ints.Uint128(a).And(fracMask128).IsZero()
 }
 
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a Float128) IsSignalingNaN() bool {
+	return a.IsNaN() && a[0]&quietMask128[0] == 0
+}
+
+// Payload returns the payload of a as a non-negative integer.
+// If a is not NaN, Payload returns -1.
+// It is the getPayload operation of IEEE 754-2019.
+func (a Float128) Payload() Float128 {
+	if !a.IsNaN() {
+		return Float128{0xbfff_0000_0000_0000, 0} // -1
+	}
+	return payloadFloat256(a.nanPayload()).Float128()
+}
+
+// SetPayload128 returns a quiet NaN with the payload.
+// If payload is not an integer in [0, 2**111), SetPayload128 returns +0.
+// It is the setPayload operation of IEEE 754-2019.
+func SetPayload128(payload Float128) Float128 {
+	p, ok := payload.Float256().payloadInt(shift128 - 1)
+	if !ok {
+		return Float128{}
+	}
+	return nan128(false, p)
+}
+
+// SetPayloadSignaling128 returns a signaling NaN with the payload.
+// If payload is not an integer in [1, 2**111), SetPayloadSignaling128 returns +0.
+// It is the setPayloadSignaling operation of IEEE 754-2019.
+func SetPayloadSignaling128(payload Float128) Float128 {
+	p, ok := payload.Float256().payloadInt(shift128 - 1)
+	if !ok || p.IsZero() {
+		return Float128{}
+	}
+	return Float128{uvinf128[0] | p[2], p[3]}
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a Float128) nanPayload() ints.Uint256 {
+	return ints.Uint256{0, 0, a[0] & payloadMask128[0], a[1] & payloadMask128[1]}
+}
+
+// nan128 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float128, it is replaced by zero.
+func nan128(neg bool, payload ints.Uint256) Float128 {
+	ret := Float128(uvnan128)
+	if payload.BitLen() < shift128 {
+		ret[0] |= payload[2]
+		ret[1] = payload[3]
+	}
+	if neg {
+		ret[0] |= signMask128[0]
+	}
+	return ret
+}
+
+// propagateNaN128 returns the first NaN of a and b as a quiet NaN.
+// The payload of the first NaN operand is preserved.
+func propagateNaN128(a, b Float128) Float128 {
+	if !a.IsNaN() {
+		a = b
+	}
+	return Float128{a[0] | quietMask128[0], a[1]}
+}
+
 // IsInf reports whether a is an infinity, according to sign.
 // If sign > 0, IsInf reports whether a is positive infinity.
 // If sign < 0, IsInf reports whether a is negative infinity.
@@ -179,7 +296,7 @@ func (a Float128) Mul(b Float128) Float128 {
 	if a.IsNaN() || b.IsNaN() {
 		// a * NaN = NaN
 		// NaN * b = NaN
-		return Float128(uvnan128)
+		return propagateNaN128(a, b)
 	}
 
 	signA, expA, fracA := a.normalize()
@@ -249,7 +366,7 @@ func (a Float128) Quo(b Float128) Float128 {
 	if a.IsNaN() || b.IsNaN() {
 		// a / NaN = NaN
 		// NaN / b = NaN
-		return Float128(uvnan128)
+		return propagateNaN128(a, b)
 	}
 
 	signA, expA, fracA := a.normalize()
@@ -327,7 +444,7 @@ func (a Float128) Add(b Float128) Float128 {
 	if a.IsNaN() || b.IsNaN() {
 		// a + NaN = NaN
 		// NaN + b = NaN
-		return Float128(uvnan128)
+		return propagateNaN128(a, b)
 	}
 	if a.IsZero() {
 		if b.IsZero() {
@@ -426,6 +543,10 @@ func (a Float128) Add(b Float128) Float128 {
 
 // Sub returns the difference of a and b.
 func (a Float128) Sub(b Float128) Float128 {
+	if b.IsNaN() {
+		// keep the sign of the NaN
+		return a.Add(b)
+	}
 	return a.Add(b.Neg())
 }
 
@@ -439,7 +560,9 @@ func (a Float128) Sub(b Float128) Float128 {
 //	Sqrt(NaN) = NaN
 func (a Float128) Sqrt() Float128 {
 	switch {
-	case a.IsZero() || a.IsNaN() || a.IsInf(1):
+	case a.IsNaN():
+		return Float128{a[0] | quietMask128[0], a[1]}
+	case a.IsZero() || a.IsInf(1):
 		return a
 	case a[0]&signMask128[0] != 0:
 		return Float128(uvnan128)
@@ -595,6 +718,10 @@ func (a Float128) comparable() ints.Int128 {
 // FMA128 returns x * y + z, computed with only one rounding.
 // (That is, FMA128 returns the fused multiply-add of x, y, and z.)
 func FMA128(x, y, z Float128) Float128 {
+	if x.IsNaN() || y.IsNaN() || z.IsNaN() {
+		return propagateNaN128(x, propagateNaN128(y, z))
+	}
+
 	if x.IsZero() || y.IsZero() || x[0]&(mask128<<(shift128-64)) == (mask128<<(shift128-64)) || y[0]&(mask128<<(shift128-64)) == (mask128<<(shift128-64)) {
 		return x.Mul(y).Add(z)
 	}
@@ -2,6 +2,8 @@ package floats
 
 import (
 	"math"
+	"math/big"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 
@@ -34,6 +36,108 @@ func TestFloat128_IsNaN(t *testing.T) {
 	}
 }
 
+func TestFloat128_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		a    Float128
+		want bool
+	}{
+		{Float128{0x7fff_8000_0000_0000, 0x0000}, false},
+		{Float128{0x7fff_8000_0000_0000, 0x0001}, false},
+		{Float128{0x7fff_0000_0000_0000, 0x0001}, true},
+		{Float128{0xffff_0000_0000_0000, 0x0001}, true},
+		{NewFloat128Inf(1), false},
+		{exact128(1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsSignalingNaN(); got != tt.want {
+			t.Errorf("Float128(%x).IsSignalingNaN() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Payload(t *testing.T) {
+	tests := []struct {
+		a    Float128
+		want Float128
+	}{
+		{Float128{0x7fff_8000_0000_0000, 0x0000}, exact128(0)},
+		{Float128{0x7fff_8000_0000_0000, 0x002a}, exact128(42)},
+		{Float128{0xffff_8000_0000_0000, 0x002a}, exact128(42)},
+		{Float128{0x7fff_0000_0000_0000, 0x002a}, exact128(42)},
+		{NewFloat128Inf(1), exact128(-1)},
+		{exact128(1), exact128(-1)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Payload(); !eq128(got, tt.want) {
+			t.Errorf("Float128(%x).Payload() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayload128(t *testing.T) {
+	tests := []struct {
+		payload Float128
+		want    Float128
+	}{
+		{exact128(0), Float128{0x7fff_8000_0000_0000, 0x0000}},
+		{exact128(42), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{exact128(0x1p111).Sub(exact128(1)), Float128{0x7fff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{exact128(0x1p111), exact128(0)},
+		{exact128(1.5), exact128(0)},
+		{exact128(-1), exact128(0)},
+		{NewFloat128Inf(1), exact128(0)},
+		{NewFloat128NaN(), exact128(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayload128(tt.payload); got != tt.want {
+			t.Errorf("SetPayload128(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadSignaling128(t *testing.T) {
+	tests := []struct {
+		payload Float128
+		want    Float128
+	}{
+		{exact128(0), exact128(0)},
+		{exact128(42), Float128{0x7fff_0000_0000_0000, 0x002a}},
+		{exact128(0x1p111).Sub(exact128(1)), Float128{0x7fff_7fff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{exact128(0x1p111), exact128(0)},
+		{exact128(1.5), exact128(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadSignaling128(tt.payload); got != tt.want {
+			t.Errorf("SetPayloadSignaling128(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_NaNPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float128
+		want Float128
+	}{
+		{"Add(qNaN, 1)", Float128{0x7fff_8000_0000_0000, 0x002a}.Add(exact128(1)), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{"Add(1, sNaN)", exact128(1).Add(Float128{0x7fff_0000_0000_0000, 0x0007}), Float128{0x7fff_8000_0000_0000, 0x0007}},
+		{"Add(qNaN, qNaN)", Float128{0x7fff_8000_0000_0000, 0x002a}.Add(Float128{0x7fff_8000_0000_0000, 0x0007}), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{"Add(sNaN, qNaN)", Float128{0x7fff_0000_0000_0000, 0x0007}.Add(Float128{0x7fff_8000_0000_0000, 0x002a}), Float128{0x7fff_8000_0000_0000, 0x0007}},
+		{"Sub(1, qNaN)", exact128(1).Sub(Float128{0x7fff_8000_0000_0000, 0x002a}), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{"Mul(-qNaN, 1)", Float128{0xffff_8000_0000_0000, 0x002a}.Mul(exact128(1)), Float128{0xffff_8000_0000_0000, 0x002a}},
+		{"Quo(1, qNaN)", exact128(1).Quo(Float128{0x7fff_8000_0000_0000, 0x002a}), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{"Sqrt(sNaN)", Float128{0x7fff_0000_0000_0000, 0x0007}.Sqrt(), Float128{0x7fff_8000_0000_0000, 0x0007}},
+		{"FMA(1, qNaN, sNaN)", FMA128(exact128(1), Float128{0x7fff_8000_0000_0000, 0x002a}, Float128{0x7fff_0000_0000_0000, 0x0007}), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{"FMA(0, Inf, qNaN)", FMA128(exact128(0), NewFloat128Inf(1), Float128{0x7fff_8000_0000_0000, 0x002a}), Float128{0x7fff_8000_0000_0000, 0x002a}},
+		{"Quo(0, 0)", exact128(0).Quo(exact128(0)), Float128{0x7fff_8000_0000_0000, 0x0000}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
 func TestFloat128_IsInf(t *testing.T) {
 	inf := Float128{0x7fff_0000_0000_0000, 0x0000_0000_0000_0000}
 	neginf := Float128{0xffff_0000_0000_0000, 0x0000_0000_0000_0000}
@@ -1462,3 +1566,52 @@ func TestFloat128_Remainder(t *testing.T) {
 		}
 	}
 }
+
+func TestNewFloat128FromInt(t *testing.T) {
+	// the integers of 113 bits are exact, and the larger ones are rounded ties to even.
+	one := ints.Uint256{0, 0, 0, 1}
+	tests := []struct {
+		in   ints.Uint256
+		want ints.Uint256
+	}{
+		{ints.Uint256{}, ints.Uint256{}},
+		{one, one},
+		{one.Lsh(113).Sub(one), one.Lsh(113).Sub(one)},
+		{one.Lsh(113).Add(one), one.Lsh(113)},
+		{one.Lsh(113).Add(ints.Uint256{0, 0, 0, 3}), one.Lsh(113).Add(ints.Uint256{0, 0, 0, 4})},
+	}
+
+	for _, tt := range tests {
+		got := NewFloat128FromUint256(tt.in)
+		want, _ := NewFloat128FromBigInt(bigIntFromUint256(new(big.Int), tt.want))
+		if got != want {
+			t.Errorf("NewFloat128FromUint256(%x) = %v, want %v", tt.in, got, want)
+		}
+	}
+	// 2**256 - 1 rounds up to 2**256.
+	if got, want := NewFloat128FromUint256(ints.Uint256{}.Sub(one)), NewFloat128(0x1p256); got != want {
+		t.Errorf("NewFloat128FromUint256(2**256-1) = %v, want %v", got, want)
+	}
+	if got, want := NewFloat128FromInt64(math.MinInt64), NewFloat128(-0x1p63); got != want {
+		t.Errorf("NewFloat128FromInt64(math.MinInt64) = %v, want %v", got, want)
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 113))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []Float128{
+			NewFloat128FromInt64(v.i64),
+			NewFloat128FromUint64(v.u64),
+			NewFloat128FromInt128(v.i128),
+			NewFloat128FromUint128(v.u128),
+			NewFloat128FromInt256(v.i256),
+			NewFloat128FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewFloat128FromBigInt(x); got[i] != want {
+				t.Errorf("NewFloat128FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
@@ -17,6 +17,9 @@ const (
 	bias16     = 15         // bias for exponent
 	signMask16 = 1 << 15    // mask for sign bit
 	fracMask16 = 1<<shift16 - 1
+
+	quietMask16   = 1 << (shift16 - 1) // mask for the quiet bit of NaN
+	payloadMask16 = quietMask16 - 1    // mask for the payload of NaN
 )
 
 // Float16 is a 16-bit floating-point number.
@@ -32,6 +35,58 @@ func NewFloat16FromBits(b uint16) Float16 {
 	return Float16(b)
 }
 
+// NewFloat16FromInt64 returns the Float16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float16, the result is ±Inf.
+func NewFloat16FromInt64(x int64) Float16 {
+	if x < 0 {
+		return newFloat16FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newFloat16FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewFloat16FromUint64 returns the Float16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float16, the result is +Inf.
+func NewFloat16FromUint64(x uint64) Float16 {
+	return newFloat16FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewFloat16FromInt128 returns the Float16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float16, the result is ±Inf.
+func NewFloat16FromInt128(x ints.Int128) Float16 {
+	if x.Sign() < 0 {
+		return newFloat16FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newFloat16FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewFloat16FromUint128 returns the Float16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float16, the result is +Inf.
+func NewFloat16FromUint128(x ints.Uint128) Float16 {
+	return newFloat16FromInt(false, x.Uint256())
+}
+
+// NewFloat16FromInt256 returns the Float16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float16, the result is ±Inf.
+func NewFloat16FromInt256(x ints.Int256) Float16 {
+	if x.Sign() < 0 {
+		return newFloat16FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newFloat16FromInt(false, ints.Uint256(x))
+}
+
+// NewFloat16FromUint256 returns the Float16 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float16, the result is +Inf.
+func NewFloat16FromUint256(x ints.Uint256) Float16 {
+	return newFloat16FromInt(false, x)
+}
+
+// newFloat16FromInt returns the Float16 value nearest to (-1)**neg × x.
+func newFloat16FromInt(neg bool, x ints.Uint256) Float16 {
+	r, _ := float256FromUint256(neg, x, ToNearestEven|toOdd)
+	ret, _ := r.float16(ToNearestEven)
+	return ret
+}
+
 // NewFloat16NaN returns a NaN Float16 value.
 func NewFloat16NaN() Float16 {
 	return uvnan16
@@ -55,6 +110,71 @@ func (a Float16) IsNaN() bool {
 	return a&(mask16<<shift16) == (mask16<<shift16) && a&fracMask16 != 0
 }
 
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a Float16) IsSignalingNaN() bool {
+	return a.IsNaN() && a&quietMask16 == 0
+}
+
+// Payload returns the payload of a as a non-negative integer.
+// If a is not NaN, Payload returns -1.
+// It is the getPayload operation of IEEE 754-2019.
+func (a Float16) Payload() Float16 {
+	if !a.IsNaN() {
+		return 0xbc00 // -1
+	}
+	return Float64(a & payloadMask16).Float16()
+}
+
+// SetPayload16 returns a quiet NaN with the payload.
+// If payload is not an integer in [0, 2**9), SetPayload16 returns +0.
+// It is the setPayload operation of IEEE 754-2019.
+func SetPayload16(payload Float16) Float16 {
+	p, ok := payload.Float256().payloadInt(shift16 - 1)
+	if !ok {
+		return 0
+	}
+	return nan16(false, p)
+}
+
+// SetPayloadSignaling16 returns a signaling NaN with the payload.
+// If payload is not an integer in [1, 2**9), SetPayloadSignaling16 returns +0.
+// It is the setPayloadSignaling operation of IEEE 754-2019.
+func SetPayloadSignaling16(payload Float16) Float16 {
+	p, ok := payload.Float256().payloadInt(shift16 - 1)
+	if !ok || p.IsZero() {
+		return 0
+	}
+	return uvinf16 | Float16(p[3])
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a Float16) nanPayload() ints.Uint256 {
+	return ints.Uint256{0, 0, 0, uint64(a & payloadMask16)}
+}
+
+// nan16 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float16, it is replaced by zero.
+func nan16(neg bool, payload ints.Uint256) Float16 {
+	ret := Float16(uvnan16)
+	if payload.BitLen() < shift16 {
+		ret |= Float16(payload[3])
+	}
+	if neg {
+		ret |= signMask16
+	}
+	return ret
+}
+
+// propagateNaN16 returns the first NaN of a and b as a quiet NaN.
+// The payload of the first NaN operand is preserved.
+func propagateNaN16(a, b Float16) Float16 {
+	if !a.IsNaN() {
+		a = b
+	}
+	return a | quietMask16
+}
+
 // IsInf reports whether a is an infinity, according to sign.
 // If sign > 0, IsInf reports whether a is positive infinity.
 // If sign < 0, IsInf reports whether a is negative infinity.
@@ -151,7 +271,7 @@ func (a Float16) Mul(b Float16) Float16 {
 	if a.IsNaN() || b.IsNaN() {
 		// a * NaN = NaN
 		// NaN * b = NaN
-		return uvnan16
+		return propagateNaN16(a, b)
 	}
 	signA, expA, fracA := a.normalize()
 	signB, expB, fracB := b.normalize()
@@ -215,7 +335,7 @@ func (a Float16) Quo(b Float16) Float16 {
 	if a.IsNaN() || b.IsNaN() {
 		// a / NaN = NaN
 		// NaN / b = NaN
-		return uvnan16
+		return propagateNaN16(a, b)
 	}
 
 	signA, expA, fracA := a.normalize()
@@ -284,7 +404,7 @@ func (a Float16) Add(b Float16) Float16 {
 	if a.IsNaN() || b.IsNaN() {
 		// a + NaN = NaN
 		// NaN + b = NaN
-		return uvnan16
+		return propagateNaN16(a, b)
 	}
 	if a.IsZero() {
 		if b.IsZero() {
@@ -386,6 +506,10 @@ func (a Float16) Add(b Float16) Float16 {
 
 // Sub returns the difference of a and b.
 func (a Float16) Sub(b Float16) Float16 {
+	if b.IsNaN() {
+		// keep the sign of the NaN
+		return a.Add(b)
+	}
 	return a.Add(b.Neg())
 }
 
@@ -400,7 +524,9 @@ func (a Float16) Sub(b Float16) Float16 {
 func (a Float16) Sqrt() Float16 {
 	// special cases
 	switch {
-	case a.IsZero() || a.IsNaN() || a.IsInf(1):
+	case a.IsNaN():
+		return a | quietMask16
+	case a.IsZero() || a.IsInf(1):
 		return a
 	case a&signMask16 != 0:
 		return uvnan16
@@ -551,6 +677,10 @@ func (a Float16) comparable() int16 {
 // FMA16 returns x * y + z, computed with only one rounding.
 // (That is, FMA16 returns the fused multiply-add of x, y, and z.)
 func FMA16(x, y, z Float16) Float16 {
+	if x.IsNaN() || y.IsNaN() || z.IsNaN() {
+		return propagateNaN16(x, propagateNaN16(y, z))
+	}
+
 	// Inf or NaN involved. At most one rounding will occur.
 	if x.IsZero() || y.IsZero() || x&uvinf16 == uvinf16 || y&uvinf16 == uvinf16 {
 		return x.Mul(y).Add(z)
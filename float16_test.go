@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 
@@ -34,6 +35,108 @@ func TestFloat16_IsNaN(t *testing.T) {
 	}
 }
 
+func TestFloat16_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		a    Float16
+		want bool
+	}{
+		{Float16(0x7e00), false},
+		{Float16(0x7e01), false},
+		{Float16(0x7c01), true},
+		{Float16(0xfc01), true},
+		{NewFloat16Inf(1), false},
+		{exact16(1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsSignalingNaN(); got != tt.want {
+			t.Errorf("Float16(%x).IsSignalingNaN() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Payload(t *testing.T) {
+	tests := []struct {
+		a    Float16
+		want Float16
+	}{
+		{Float16(0x7e00), exact16(0)},
+		{Float16(0x7e2a), exact16(42)},
+		{Float16(0xfe2a), exact16(42)},
+		{Float16(0x7c2a), exact16(42)},
+		{NewFloat16Inf(1), exact16(-1)},
+		{exact16(1), exact16(-1)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Payload(); !eq16(got, tt.want) {
+			t.Errorf("Float16(%x).Payload() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayload16(t *testing.T) {
+	tests := []struct {
+		payload Float16
+		want    Float16
+	}{
+		{exact16(0), Float16(0x7e00)},
+		{exact16(42), Float16(0x7e2a)},
+		{exact16(511), Float16(0x7fff)},
+		{exact16(512), exact16(0)},
+		{exact16(1.5), exact16(0)},
+		{exact16(-1), exact16(0)},
+		{NewFloat16Inf(1), exact16(0)},
+		{NewFloat16NaN(), exact16(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayload16(tt.payload); got != tt.want {
+			t.Errorf("SetPayload16(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadSignaling16(t *testing.T) {
+	tests := []struct {
+		payload Float16
+		want    Float16
+	}{
+		{exact16(0), exact16(0)},
+		{exact16(42), Float16(0x7c2a)},
+		{exact16(511), Float16(0x7dff)},
+		{exact16(512), exact16(0)},
+		{exact16(1.5), exact16(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadSignaling16(tt.payload); got != tt.want {
+			t.Errorf("SetPayloadSignaling16(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_NaNPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float16
+		want Float16
+	}{
+		{"Add(qNaN, 1)", Float16(0x7e2a).Add(exact16(1)), Float16(0x7e2a)},
+		{"Add(1, sNaN)", exact16(1).Add(Float16(0x7c07)), Float16(0x7e07)},
+		{"Add(qNaN, qNaN)", Float16(0x7e2a).Add(Float16(0x7e07)), Float16(0x7e2a)},
+		{"Add(sNaN, qNaN)", Float16(0x7c07).Add(Float16(0x7e2a)), Float16(0x7e07)},
+		{"Sub(1, qNaN)", exact16(1).Sub(Float16(0x7e2a)), Float16(0x7e2a)},
+		{"Mul(-qNaN, 1)", Float16(0xfe2a).Mul(exact16(1)), Float16(0xfe2a)},
+		{"Quo(1, qNaN)", exact16(1).Quo(Float16(0x7e2a)), Float16(0x7e2a)},
+		{"Sqrt(sNaN)", Float16(0x7c07).Sqrt(), Float16(0x7e07)},
+		{"FMA(1, qNaN, sNaN)", FMA16(exact16(1), Float16(0x7e2a), Float16(0x7c07)), Float16(0x7e2a)},
+		{"FMA(0, Inf, qNaN)", FMA16(exact16(0), NewFloat16Inf(1), Float16(0x7e2a)), Float16(0x7e2a)},
+		{"Quo(0, 0)", exact16(0).Quo(exact16(0)), Float16(0x7e00)},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
 func TestFloat16_IsInf(t *testing.T) {
 	tests := []struct {
 		in   Float16
@@ -933,3 +1036,48 @@ func TestFloat16_Remainder(t *testing.T) {
 		}
 	}
 }
+
+func TestNewFloat16FromInt(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want float64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, -1},
+		{1<<11 + 1, 1 << 11},
+		{1<<11 + 3, 1<<11 + 4},
+		{-(1<<11 + 3), -(1<<11 + 4)},
+		{65519, 65504},
+		{65520, math.Inf(1)},
+		{-65520, math.Inf(-1)},
+		{math.MaxInt64, 0x1p63},
+		{math.MinInt64, -0x1p63},
+	}
+
+	for _, tt := range tests {
+		got := NewFloat16FromInt64(tt.in)
+		if want := NewFloat16(tt.want); got != want {
+			t.Errorf("NewFloat16FromInt64(%d) = %v, want %v", tt.in, got, want)
+		}
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 11))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []Float16{
+			NewFloat16FromInt64(v.i64),
+			NewFloat16FromUint64(v.u64),
+			NewFloat16FromInt128(v.i128),
+			NewFloat16FromUint128(v.u128),
+			NewFloat16FromInt256(v.i256),
+			NewFloat16FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewFloat16FromBigInt(x); got[i] != want {
+				t.Errorf("NewFloat16FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
@@ -36,6 +36,16 @@ var (
 		0x0000_0fff_ffff_ffff, 0xffff_ffff_ffff_ffff,
 		0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
 	}
+	// mask for the quiet bit of NaN
+	quietMask256 = ints.Uint256{
+		0x0000_0800_0000_0000, 0x0000_0000_0000_0000,
+		0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+	}
+	// mask for the payload of NaN
+	payloadMask256 = ints.Uint256{
+		0x0000_07ff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+		0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff,
+	}
 )
 
 // Float256 is a 256-bit floating-point number.
@@ -51,6 +61,55 @@ func NewFloat256FromBits(b ints.Uint256) Float256 {
 	return Float256(b)
 }
 
+// NewFloat256FromInt64 converts x to Float256.
+// The result is exact.
+func NewFloat256FromInt64(x int64) Float256 {
+	if x < 0 {
+		return newFloat256FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newFloat256FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewFloat256FromUint64 converts x to Float256.
+// The result is exact.
+func NewFloat256FromUint64(x uint64) Float256 {
+	return newFloat256FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewFloat256FromInt128 converts x to Float256.
+// The result is exact.
+func NewFloat256FromInt128(x ints.Int128) Float256 {
+	if x.Sign() < 0 {
+		return newFloat256FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newFloat256FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewFloat256FromUint128 converts x to Float256.
+// The result is exact.
+func NewFloat256FromUint128(x ints.Uint128) Float256 {
+	return newFloat256FromInt(false, x.Uint256())
+}
+
+// NewFloat256FromInt256 returns the Float256 value nearest to x, rounding ties to even.
+func NewFloat256FromInt256(x ints.Int256) Float256 {
+	if x.Sign() < 0 {
+		return newFloat256FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newFloat256FromInt(false, ints.Uint256(x))
+}
+
+// NewFloat256FromUint256 returns the Float256 value nearest to x, rounding ties to even.
+func NewFloat256FromUint256(x ints.Uint256) Float256 {
+	return newFloat256FromInt(false, x)
+}
+
+// newFloat256FromInt returns the Float256 value nearest to (-1)**neg × x.
+func newFloat256FromInt(neg bool, x ints.Uint256) Float256 {
+	ret, _ := float256FromUint256(neg, x, ToNearestEven)
+	return ret
+}
+
 // NewFloat256NaN returns a NaN Float256 value.
 func NewFloat256NaN() Float256 {
 	return Float256(uvnan256)
@@ -75,6 +134,108 @@ func (a Float256) IsNaN() bool {
 		!ints.Uint256(a).And(fracMask256).IsZero()
 }
 
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a Float256) IsSignalingNaN() bool {
+	return a.IsNaN() && a[0]&quietMask256[0] == 0
+}
+
+// Payload returns the payload of a as a non-negative integer.
+// If a is not NaN, Payload returns -1.
+// It is the getPayload operation of IEEE 754-2019.
+func (a Float256) Payload() Float256 {
+	if !a.IsNaN() {
+		return Float256{0xbfff_f000_0000_0000, 0, 0, 0} // -1
+	}
+	return payloadFloat256(a.nanPayload())
+}
+
+// SetPayload256 returns a quiet NaN with the payload.
+// If payload is not an integer in [0, 2**235), SetPayload256 returns +0.
+// It is the setPayload operation of IEEE 754-2019.
+func SetPayload256(payload Float256) Float256 {
+	p, ok := payload.payloadInt(shift256 - 1)
+	if !ok {
+		return Float256{}
+	}
+	return nan256(false, p)
+}
+
+// SetPayloadSignaling256 returns a signaling NaN with the payload.
+// If payload is not an integer in [1, 2**235), SetPayloadSignaling256 returns +0.
+// It is the setPayloadSignaling operation of IEEE 754-2019.
+func SetPayloadSignaling256(payload Float256) Float256 {
+	p, ok := payload.payloadInt(shift256 - 1)
+	if !ok || p.IsZero() {
+		return Float256{}
+	}
+	return Float256(ints.Uint256(uvinf256).Or(p))
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a Float256) nanPayload() ints.Uint256 {
+	return ints.Uint256(a).And(payloadMask256)
+}
+
+// nan256 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float256, it is replaced by zero.
+func nan256(neg bool, payload ints.Uint256) Float256 {
+	ret := Float256(uvnan256)
+	if payload.BitLen() < shift256 {
+		ret = Float256(ints.Uint256(ret).Or(payload))
+	}
+	if neg {
+		ret[0] |= signMask256[0]
+	}
+	return ret
+}
+
+// propagateNaN256 returns the first NaN of a and b as a quiet NaN.
+// The payload of the first NaN operand is preserved.
+func propagateNaN256(a, b Float256) Float256 {
+	if !a.IsNaN() {
+		a = b
+	}
+	return Float256{a[0] | quietMask256[0], a[1], a[2], a[3]}
+}
+
+// payloadInt returns a as an integer payload of the given number of bits.
+// ok is false if a is not an integer in [0, 2**bits).
+func (a Float256) payloadInt(bits uint) (payload ints.Uint256, ok bool) {
+	if a.IsZero() {
+		return ints.Uint256{}, true
+	}
+	if a.Signbit() || a[0]&uvinf256[0] == uvinf256[0] {
+		// negative, infinity, or NaN
+		return ints.Uint256{}, false
+	}
+	_, exp, frac := a.normalize()
+	if exp < 0 || exp >= int(bits) {
+		return ints.Uint256{}, false
+	}
+	one := ints.Uint256{0, 0, 0, 1}
+	shift := uint(shift256 - exp)
+	if !frac.And(one.Lsh(shift).Sub(one)).IsZero() {
+		// a is not an integer
+		return ints.Uint256{}, false
+	}
+	return frac.Rsh(shift), true
+}
+
+// payloadFloat256 converts the integer payload to Float256.
+func payloadFloat256(payload ints.Uint256) Float256 {
+	ret, _ := pack256(0, 0, payload.Uint512(), ToNearestEven)
+	return ret
+}
+
+// nanFlags256 returns [Invalid] if a or b is a signaling NaN.
+func nanFlags256(a, b Float256) Flags {
+	if a.IsSignalingNaN() || b.IsSignalingNaN() {
+		return Invalid
+	}
+	return 0
+}
+
 // IsInf reports whether a is an infinity, according to sign.
 // If sign > 0, IsInf reports whether a is positive infinity.
 // If sign < 0, IsInf reports whether a is negative infinity.
@@ -185,10 +346,15 @@ func (a Float256) Abs() Float256 {
 
 // Mul returns the product of a and b.
 func (a Float256) Mul(b Float256) Float256 {
+	r, _ := a.mul(b, ToNearestEven)
+	return r
+}
+
+func (a Float256) mul(b Float256, mode RoundingMode) (Float256, Flags) {
 	if a.IsNaN() || b.IsNaN() {
 		// a * NaN = NaN
 		// NaN * b = NaN
-		return Float256(uvnan256)
+		return propagateNaN256(a, b), nanFlags256(a, b)
 	}
 	signA, expA, fracA := a.normalize()
 	signB, expB, fracB := b.normalize()
@@ -199,72 +365,46 @@ func (a Float256) Mul(b Float256) Float256 {
 		// NaN check is done above; a is ±inf
 		if b.IsZero() {
 			// ±inf * 0 = NaN
-			return Float256(uvnan256)
+			return Float256(uvnan256), Invalid
 		} else {
 			// ±inf * +finite = ±inf
 			// ±inf * -finite = ∓inf
-			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}, 0
 		}
 	}
 	if expB == mask256-bias256 {
 		// NaN check is done above; b is ±inf
 		if a.IsZero() {
 			// 0 * ±inf = NaN
-			return Float256(uvnan256)
+			return Float256(uvnan256), Invalid
 		} else {
 			// +finite * ±inf = ±inf
 			// -finite * ±inf = ∓inf
-			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}, 0
 		}
 	}
 	if a.IsZero() || b.IsZero() {
 		// +0 * ±finite = ±0
 		// -0 * ±finite = ∓0
-		return Float256{sign, 0, 0, 0}
+		return Float256{sign, 0, 0, 0}, 0
 	}
 
 	// normal case
-	exp := expA + expB
 	frac := fracA.Mul512(fracB)
-	shift := frac.BitLen() - (shift256 + 1)
-	exp += shift - shift256
-
-	if exp < -(bias256 + shift256) {
-		// underflow
-		return Float256{sign, 0, 0, 0}
-	} else if exp <= -bias256 {
-		// the result is subnormal
-		// normalize
-		shift := shift256 - (expA + expB + bias256) + 1
-		frac = roundToNearestEven512(frac, uint(shift))
-		frac = frac.Rsh(uint(shift))
-		return Float256{sign | frac[4], frac[5], frac[6], frac[7]}
-	}
-
-	exp = expA + expB + bias256
-	frac = roundToNearestEven512(frac, uint(shift))
-	shift = frac.BitLen() - (shift256 + 1)
-	exp += shift - shift256
-	if exp >= mask256 {
-		// overflow
-		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
-	}
-
-	frac = frac.Rsh(uint(shift))
-	return Float256{
-		sign | uint64(exp)<<(shift256-192) | frac[4]&fracMask256[0],
-		frac[5],
-		frac[6],
-		frac[7],
-	}
+	return pack256(sign, expA+expB-2*shift256, frac, mode)
 }
 
 // Quo returns the quotient of a and b.
 func (a Float256) Quo(b Float256) Float256 {
+	r, _ := a.quo(b, ToNearestEven)
+	return r
+}
+
+func (a Float256) quo(b Float256, mode RoundingMode) (Float256, Flags) {
 	if a.IsNaN() || b.IsNaN() {
 		// a / NaN = NaN
 		// NaN / b = NaN
-		return Float256(uvnan256)
+		return propagateNaN256(a, b), nanFlags256(a, b)
 	}
 
 	signA, expA, fracA := a.normalize()
@@ -274,100 +414,72 @@ func (a Float256) Quo(b Float256) Float256 {
 	if b.IsZero() {
 		if a.IsZero() {
 			// 0 / 0 = NaN
-			return Float256(uvnan256)
+			return Float256(uvnan256), Invalid
+		}
+		if expA == mask256-bias256 {
+			// ±inf / 0 = ±inf
+			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}, 0
 		}
 		// ±finite / 0 = ±inf
-		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}, DivByZero
 	}
 	if a.IsZero() {
 		// 0 / finite = 0
-		return Float256{sign, 0, 0, 0}
+		return Float256{sign, 0, 0, 0}, 0
 	}
 	if expA == mask256-bias256 {
 		// NaN check is done above; a is ±inf
 		if expB == mask256-bias256 {
 			// ±inf / ±inf = NaN
-			return Float256(uvnan256)
+			return Float256(uvnan256), Invalid
 		}
 		// ±inf / finite = ±inf
-		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}, 0
 	}
 	if expB == mask256-bias256 {
 		// NaN check is done above; b is ±inf
 		// NaN and Inf checks are done above; a is finite.
 		// ±finite / ±inf = 0
-		return Float256{sign, 0, 0, 0}
-	}
-
-	exp := expA - expB + bias256
-	if fracA.Cmp(fracB) < 0 {
-		exp--
-		fracA = fracA.Lsh(1)
-	}
-	if exp >= mask256 {
-		// overflow
-		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+		return Float256{sign, 0, 0, 0}, 0
 	}
 
 	shift := shift256 + 3 // 1 for the implicit bit, 1 for the rounding bit, 1 for the guard bit
 	fracA512 := fracA.Uint512().Lsh(uint(shift))
 	fracB512 := fracB.Uint512()
-	frac512, mod := fracA512.DivMod(fracB512)
-	frac512[7] |= squash512(mod)
-	frac := frac512.Uint256()
-
-	if exp <= 0 {
-		// the result is subnormal
-		shift := -exp + 3 + 1
-		frac = roundToNearestEven256(frac, uint(shift))
-		return Float256{
-			sign | frac[0],
-			frac[1],
-			frac[2],
-			frac[3],
-		}
-	}
-
-	// round-to-nearest-even (guard+round+sticky are in the low 3 bits)
-	frac = roundToNearestEven256(frac, 3)
-	// detect carry-out caused by rounding
-	if frac.BitLen() > shift256+1 {
-		frac = frac.Rsh(1)
-		exp++
-		if exp >= mask256 {
-			// overflow
-			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
-		}
-	}
-	return Float256{
-		sign | uint64(exp)<<(shift256-192) | frac[0]&fracMask256[0],
-		frac[1],
-		frac[2],
-		frac[3],
-	}
+	frac, mod := fracA512.DivMod(fracB512)
+	frac[7] |= squash512(mod)
+	return pack256(sign, expA-expB-shift, frac, mode)
 }
 
 // Add returns the sum of a and b.
 func (a Float256) Add(b Float256) Float256 {
+	r, _ := a.add(b, ToNearestEven)
+	return r
+}
+
+func (a Float256) add(b Float256, mode RoundingMode) (Float256, Flags) {
 	if a.IsNaN() || b.IsNaN() {
 		// a + NaN = NaN
 		// NaN + b = NaN
-		return Float256(uvnan256)
+		return propagateNaN256(a, b), nanFlags256(a, b)
 	}
 	if a.IsZero() {
 		if b.IsZero() {
 			//  0 +  0 =  0
-			//  0 + -0 =  0
-			// -0 +  0 =  0
+			//  0 + -0 =  0 (-0 if rounding toward -inf)
+			// -0 +  0 =  0 (-0 if rounding toward -inf)
 			// -0 + -0 = -0
-			return Float256{a[0] & b[0], 0, 0, 0}
+			if mode.negativeZero() {
+				return Float256{a[0] | b[0], 0, 0, 0}, 0
+			}
+			return Float256{a[0] & b[0], 0, 0, 0}, 0
 		}
 		// ±0 + b = b
-		return b
+		return b, 0
 	}
 	if b.IsZero() {
 		// a + ±0 = a
-		return a
+		return a, 0
 	}
 
 	signA, expA, fracA := a.normalize()
@@ -379,18 +491,18 @@ func (a Float256) Add(b Float256) Float256 {
 		if expB == mask256-bias256 {
 			if signA == signB {
 				// ±inf + ±inf = ±inf
-				return Float256{signA | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+				return Float256{signA | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}, 0
 			}
 			// ±inf + ∓inf = NaN
-			return Float256(uvnan256)
+			return Float256(uvnan256), Invalid
 		}
 		// b is finite, the result is ±inf
-		return a
+		return a, 0
 	}
 	if expB == mask256-bias256 {
 		// NaN check is done above; b is ±inf
 		// NaN and Inf checks are done above; a is finite.
-		return b
+		return b, 0
 	}
 
 	if expA < expB {
@@ -404,7 +516,7 @@ func (a Float256) Add(b Float256) Float256 {
 	const offset = 256
 	fracA512 := ints.Uint512{fracA[0], fracA[1], fracA[2], fracA[3], 0, 0, 0, 0}
 	fracB512 := ints.Uint512{fracB[0], fracB[1], fracB[2], fracB[3], 0, 0, 0, 0}
-	fracB512 = fracB512.Rsh(uint(expA - expB))
+	fracB512 = shrcompress512(fracB512, uint(expA-expB))
 	if signA != 0 {
 		fracA512 = fracA512.Neg()
 	}
@@ -418,47 +530,28 @@ func (a Float256) Add(b Float256) Float256 {
 		frac512 = frac512.Neg()
 	}
 
-	shift := frac512.BitLen() - (shift256 + 1)
-	exp := expA + shift - offset
-
-	if frac512.IsZero() || exp < -(bias256+shift256) {
-		// underflow
-		return Float256{sign, 0, 0, 0}
-	}
-	if exp <= -bias256 {
-		// the result is subnormal
-		shift := offset - (expA + bias256) + 1
-		frac512 = roundToNearestEven512(frac512, uint(shift))
-		frac512 = frac512.Rsh(uint(shift))
-		return Float256{sign | frac512[4], frac512[5], frac512[6], frac512[7]}
-	}
-	if exp >= mask256-bias256 {
-		// overflow
-		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
-	}
-
-	frac512 = roundToNearestEven512(frac512, uint(shift))
-	// detect carry-out caused by rounding
-	if frac512.BitLen() > shift256+shift+1 {
-		frac512 = frac512.Rsh(1)
-		exp++
-		if exp >= mask256 {
-			// overflow
-			return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+	if frac512.IsZero() {
+		// a + (-a) = 0 (-0 if rounding toward -inf)
+		if mode.negativeZero() {
+			return Float256{signMask256[0], 0, 0, 0}, 0
 		}
+		return Float256{0, 0, 0, 0}, 0
 	}
-	frac512 = frac512.Rsh(uint(shift))
-	return Float256{
-		sign | uint64(exp+bias256)<<(shift256-192) | frac512[4]&fracMask256[0],
-		frac512[5],
-		frac512[6],
-		frac512[7],
-	}
+	return pack256(sign, expA-shift256-offset, frac512, mode)
 }
 
 // Sub returns the difference of a and b.
 func (a Float256) Sub(b Float256) Float256 {
-	return a.Add(b.Neg())
+	r, _ := a.sub(b, ToNearestEven)
+	return r
+}
+
+func (a Float256) sub(b Float256, mode RoundingMode) (Float256, Flags) {
+	if b.IsNaN() {
+		// keep the sign of the NaN
+		return a.add(b, mode)
+	}
+	return a.add(b.Neg(), mode)
 }
 
 // Sqrt returns the square root of a.
@@ -470,11 +563,18 @@ func (a Float256) Sub(b Float256) Float256 {
 //	Sqrt(x < 0) = NaN
 //	Sqrt(NaN) = NaN
 func (a Float256) Sqrt() Float256 {
+	r, _ := a.sqrt(ToNearestEven)
+	return r
+}
+
+func (a Float256) sqrt(mode RoundingMode) (Float256, Flags) {
 	switch {
-	case a.IsZero() || a.IsNaN() || a.IsInf(1):
-		return a
+	case a.IsNaN():
+		return Float256{a[0] | quietMask256[0], a[1], a[2], a[3]}, nanFlags256(a, a)
+	case a.IsZero() || a.IsInf(1):
+		return a, 0
 	case a[0]&signMask256[0] != 0:
-		return Float256(uvnan256)
+		return Float256(uvnan256), Invalid
 	}
 
 	_, exp, frac := a.normalize()
@@ -500,13 +600,11 @@ func (a Float256) Sqrt() Float256 {
 		r = r.Rsh(1)
 	}
 
-	// final rounding
-	if !frac.IsZero() {
-		q = q.Add(q.And(ints.Uint256{0, 0, 0, 1}))
-	}
-	q = q.Rsh(1)
-	q = q.Add(ints.Uint256{uint64(exp-1+bias256) << (shift256 - 192), 0, 0, 0})
-	return Float256(q)
+	// q has one more bit than the result.
+	// the remainder becomes the sticky bit.
+	q512 := q.Uint512().Lsh(1)
+	q512[7] |= nonzero64(frac[0] | frac[1] | frac[2] | frac[3])
+	return pack256(0, exp-shift256-2, q512, mode)
 }
 
 // Eq returns a == b.
@@ -614,6 +712,13 @@ func (a Float256) split() (sign uint64, exp int, frac ints.Uint256) {
 	return
 }
 
+// assignDecimal assigns the absolute value of a to d.
+func (a Float256) assignDecimal(d *decimal) {
+	_, exp, frac := a.split()
+	d.AssignUint256(frac)
+	d.Shift(exp - shift256)
+}
+
 // comparable returns a comparable value for a.
 func (a Float256) comparable() ints.Int256 {
 	i := ints.Int256(a)
@@ -625,19 +730,115 @@ func (a Float256) comparable() ints.Int256 {
 	return i
 }
 
+// pack256 rounds frac × 2**exp to Float256 according to mode,
+// and returns it with the sign and the raised exception flags.
+func pack256(sign uint64, exp int, frac ints.Uint512, mode RoundingMode) (Float256, Flags) {
+	if frac.IsZero() {
+		return Float256{sign, 0, 0, 0}, 0
+	}
+
+	// the exponent of the least significant bit of the result
+	lsb := exp + frac.BitLen() - 1 - shift256
+	tiny := false
+	if minLSB := -bias256 + 1 - shift256; lsb < minLSB {
+		// the result is subnormal before rounding.
+		// it is tiny if it is still subnormal after rounding with unbounded exponent range.
+		tiny = true
+		if lsb == minLSB-1 && lsb > exp {
+			q, _ := round512(frac, uint(lsb-exp), sign != 0, mode)
+			tiny = q.BitLen() <= shift256+1
+		}
+		lsb = minLSB
+	}
+	var flags Flags
+	if lsb > exp {
+		var inexact bool
+		frac, inexact = round512(frac, uint(lsb-exp), sign != 0, mode)
+		if inexact {
+			flags |= Inexact
+			if tiny {
+				flags |= Underflow
+			}
+		}
+	} else {
+		frac = frac.Lsh(uint(exp - lsb))
+	}
+	if frac.BitLen() > shift256+1 {
+		// carry-out caused by rounding
+		frac = frac.Rsh(1)
+		lsb++
+	}
+
+	e := lsb + shift256 + bias256
+	if e >= mask256 {
+		// overflow
+		return overflow256(sign, mode), Overflow | Inexact
+	}
+	if frac.BitLen() <= shift256 {
+		// the result is subnormal
+		e = 0
+	}
+	return Float256{
+		sign | uint64(e)<<(shift256-192) | frac[4]&fracMask256[0],
+		frac[5],
+		frac[6],
+		frac[7],
+	}, flags
+}
+
+// float256FromUint256 rounds (-1)**neg × x to Float256 according to mode,
+// and returns it with the raised exception flags.
+func float256FromUint256(neg bool, x ints.Uint256, mode RoundingMode) (Float256, Flags) {
+	var sign uint64
+	if neg {
+		sign = signMask256[0]
+	}
+	return pack256(sign, 0, x.Uint512(), mode)
+}
+
+// overflow256 returns the result of an overflow with the sign according to mode.
+func overflow256(sign uint64, mode RoundingMode) Float256 {
+	if mode.overflowToInf(sign != 0) {
+		return Float256{sign | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
+	}
+	// the largest finite value
+	return Float256{
+		sign | (uvinf256[0] - 1<<(shift256-192)) | fracMask256[0],
+		fracMask256[1],
+		fracMask256[2],
+		fracMask256[3],
+	}
+}
+
 // FMA256 returns x * y + z, computed with only one rounding.
 // (That is, FMA256 returns the fused multiply-add of x, y, and z.)
 func FMA256(x, y, z Float256) Float256 {
+	r, _ := fma256(x, y, z, ToNearestEven)
+	return r
+}
+
+func fma256(x, y, z Float256, mode RoundingMode) (Float256, Flags) {
+	if x.IsNaN() || y.IsNaN() || z.IsNaN() {
+		flags := nanFlags256(x, y) | nanFlags256(z, z)
+		if x.IsZero() && y.IsInf(0) || x.IsInf(0) && y.IsZero() {
+			// 0 * ±inf + NaN is invalid
+			flags |= Invalid
+		}
+		return propagateNaN256(x, propagateNaN256(y, z)), flags
+	}
 	if x.IsZero() || y.IsZero() || x[0]&(mask256<<(shift256-192)) == mask256<<(shift256-192) || y[0]&(mask256<<(shift256-192)) == mask256<<(shift256-192) {
-		return x.Mul(y).Add(z)
+		// x * y is exact.
+		p, flagsP := x.mul(y, mode)
+		r, flags := p.add(z, mode)
+		return r, flagsP | flags
 	}
 	if z.IsZero() {
-		return x.Mul(y)
+		return x.mul(y, mode)
 	}
 	// Handle non-finite z separately. Evaluating x*y+z where
 	// x and y are finite, but z is infinite, should always result in z.
 	if z[0]&(mask256<<(shift256-192)) == mask256<<(shift256-192) {
-		return z
+		return z, 0
 	}
 
 	// Split x, y, z into sign, exponent, mantissa.
@@ -665,8 +866,12 @@ func FMA256(x, y, z Float256) Float256 {
 	}
 
 	// Special case: if p == -z the result is always +0 since neither operand is zero.
+	// (-0 if rounding toward -inf)
 	if signP != signZ && expP == expZ && fracP.Cmp(fracZ) == 0 {
-		return Float256{0, 0, 0, 0}
+		if mode.negativeZero() {
+			return Float256{signMask256[0], 0, 0, 0}, 0
+		}
+		return Float256{0, 0, 0, 0}, 0
 	}
 
 	// Align mantissa
@@ -687,30 +892,8 @@ func FMA256(x, y, z Float256) Float256 {
 		frac = shrcompress512(fracP.Lsh(uint(nz)), 256).Uint256()
 	}
 
-	// check for underflow
-	expP += bias256
-	if expP <= 0 {
-		n := uint(1 - expP)
-		frac = roundToNearestEven256(frac, n+18)
-		return Float256{signP | frac[0], frac[1], frac[2], frac[3]}
-	}
-
-	// Round and break ties to even
-	frac = roundToNearestEven256(frac, 18)
-	if frac[0]&(1<<(shift256+1-192)) != 0 {
-		expP++
-		frac = frac.Rsh(1)
-	}
-	if expP >= mask256 {
-		// Overflow
-		return Float256{signP | uvinf256[0], uvinf256[1], uvinf256[2], uvinf256[3]}
-	}
-	return Float256{
-		signP | uint64(expP)<<(shift256-192) | frac[0]&fracMask256[0],
-		frac[1],
-		frac[2],
-		frac[3],
-	}
+	// the leading bit of frac is at bit 254, and its exponent is expP.
+	return pack256(signP, expP-254, frac.Uint512(), mode)
 }
 
 // Nextafter returns the next representable float256 value after a towards b.
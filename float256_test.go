@@ -2,6 +2,8 @@ package floats
 
 import (
 	"math"
+	"math/big"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 
@@ -44,6 +46,108 @@ func TestFloat256_IsNaN(t *testing.T) {
 	}
 }
 
+func TestFloat256_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		a    Float256
+		want bool
+	}{
+		{Float256{0x7fff_f800_0000_0000, 0, 0, 0x0000}, false},
+		{Float256{0x7fff_f800_0000_0000, 0, 0, 0x0001}, false},
+		{Float256{0x7fff_f000_0000_0000, 0, 0, 0x0001}, true},
+		{Float256{0xffff_f000_0000_0000, 0, 0, 0x0001}, true},
+		{NewFloat256Inf(1), false},
+		{exact256(1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsSignalingNaN(); got != tt.want {
+			t.Errorf("Float256(%x).IsSignalingNaN() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFloat256_Payload(t *testing.T) {
+	tests := []struct {
+		a    Float256
+		want Float256
+	}{
+		{Float256{0x7fff_f800_0000_0000, 0, 0, 0x0000}, exact256(0)},
+		{Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}, exact256(42)},
+		{Float256{0xffff_f800_0000_0000, 0, 0, 0x002a}, exact256(42)},
+		{Float256{0x7fff_f000_0000_0000, 0, 0, 0x002a}, exact256(42)},
+		{NewFloat256Inf(1), exact256(-1)},
+		{exact256(1), exact256(-1)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Payload(); !eq256(got, tt.want) {
+			t.Errorf("Float256(%x).Payload() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayload256(t *testing.T) {
+	tests := []struct {
+		payload Float256
+		want    Float256
+	}{
+		{exact256(0), Float256{0x7fff_f800_0000_0000, 0, 0, 0x0000}},
+		{exact256(42), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{exact256(0x1p235).Sub(exact256(1)), Float256{0x7fff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{exact256(0x1p235), exact256(0)},
+		{exact256(1.5), exact256(0)},
+		{exact256(-1), exact256(0)},
+		{NewFloat256Inf(1), exact256(0)},
+		{NewFloat256NaN(), exact256(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayload256(tt.payload); got != tt.want {
+			t.Errorf("SetPayload256(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadSignaling256(t *testing.T) {
+	tests := []struct {
+		payload Float256
+		want    Float256
+	}{
+		{exact256(0), exact256(0)},
+		{exact256(42), Float256{0x7fff_f000_0000_0000, 0, 0, 0x002a}},
+		{exact256(0x1p235).Sub(exact256(1)), Float256{0x7fff_f7ff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}},
+		{exact256(0x1p235), exact256(0)},
+		{exact256(1.5), exact256(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadSignaling256(tt.payload); got != tt.want {
+			t.Errorf("SetPayloadSignaling256(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestFloat256_NaNPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float256
+		want Float256
+	}{
+		{"Add(qNaN, 1)", Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}.Add(exact256(1)), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{"Add(1, sNaN)", exact256(1).Add(Float256{0x7fff_f000_0000_0000, 0, 0, 0x0007}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x0007}},
+		{"Add(qNaN, qNaN)", Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}.Add(Float256{0x7fff_f800_0000_0000, 0, 0, 0x0007}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{"Add(sNaN, qNaN)", Float256{0x7fff_f000_0000_0000, 0, 0, 0x0007}.Add(Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x0007}},
+		{"Sub(1, qNaN)", exact256(1).Sub(Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{"Mul(-qNaN, 1)", Float256{0xffff_f800_0000_0000, 0, 0, 0x002a}.Mul(exact256(1)), Float256{0xffff_f800_0000_0000, 0, 0, 0x002a}},
+		{"Quo(1, qNaN)", exact256(1).Quo(Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{"Sqrt(sNaN)", Float256{0x7fff_f000_0000_0000, 0, 0, 0x0007}.Sqrt(), Float256{0x7fff_f800_0000_0000, 0, 0, 0x0007}},
+		{"FMA(1, qNaN, sNaN)", FMA256(exact256(1), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}, Float256{0x7fff_f000_0000_0000, 0, 0, 0x0007}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{"FMA(0, Inf, qNaN)", FMA256(exact256(0), NewFloat256Inf(1), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}), Float256{0x7fff_f800_0000_0000, 0, 0, 0x002a}},
+		{"Quo(0, 0)", exact256(0).Quo(exact256(0)), Float256{0x7fff_f800_0000_0000, 0, 0, 0x0000}},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
 func TestFloat256_IsInf(t *testing.T) {
 	inf := Float256{
 		0x7fff_f000_0000_0000,
@@ -3033,3 +3137,52 @@ func TestFloat256_Remainder(t *testing.T) {
 		}
 	}
 }
+
+func TestNewFloat256FromInt(t *testing.T) {
+	// the integers of 237 bits are exact, and the larger ones are rounded ties to even.
+	one := ints.Uint256{0, 0, 0, 1}
+	tests := []struct {
+		in   ints.Uint256
+		want ints.Uint256
+	}{
+		{ints.Uint256{}, ints.Uint256{}},
+		{one, one},
+		{one.Lsh(237).Sub(one), one.Lsh(237).Sub(one)},
+		{one.Lsh(237).Add(one), one.Lsh(237)},
+		{one.Lsh(237).Add(ints.Uint256{0, 0, 0, 3}), one.Lsh(237).Add(ints.Uint256{0, 0, 0, 4})},
+	}
+
+	for _, tt := range tests {
+		got := NewFloat256FromUint256(tt.in)
+		want, _ := NewFloat256FromBigInt(bigIntFromUint256(new(big.Int), tt.want))
+		if got != want {
+			t.Errorf("NewFloat256FromUint256(%x) = %v, want %v", tt.in, got, want)
+		}
+	}
+	// 2**256 - 1 rounds up to 2**256.
+	if got, want := NewFloat256FromUint256(ints.Uint256{}.Sub(one)), NewFloat256(0x1p256); got != want {
+		t.Errorf("NewFloat256FromUint256(2**256-1) = %v, want %v", got, want)
+	}
+	if got, want := NewFloat256FromInt64(math.MinInt64), NewFloat256(-0x1p63); got != want {
+		t.Errorf("NewFloat256FromInt64(math.MinInt64) = %v, want %v", got, want)
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 237))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []Float256{
+			NewFloat256FromInt64(v.i64),
+			NewFloat256FromUint64(v.u64),
+			NewFloat256FromInt128(v.i128),
+			NewFloat256FromUint128(v.u128),
+			NewFloat256FromInt256(v.i256),
+			NewFloat256FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewFloat256FromBigInt(x); got[i] != want {
+				t.Errorf("NewFloat256FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
@@ -17,6 +17,9 @@ const (
 	bias32     = 127        // bias for exponent
 	signMask32 = 1 << 31    // mask for sign bit
 	fracMask32 = 1<<shift32 - 1
+
+	quietMask32   = 1 << (shift32 - 1) // mask for the quiet bit of NaN
+	payloadMask32 = quietMask32 - 1    // mask for the payload of NaN
 )
 
 // Float32 is a 32-bit floating-point number.
@@ -32,6 +35,55 @@ func NewFloat32FromBits(b uint32) Float32 {
 	return Float32(math.Float32frombits(b))
 }
 
+// NewFloat32FromInt64 returns the Float32 value nearest to x, rounding ties to even.
+func NewFloat32FromInt64(x int64) Float32 {
+	if x < 0 {
+		return newFloat32FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newFloat32FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewFloat32FromUint64 returns the Float32 value nearest to x, rounding ties to even.
+func NewFloat32FromUint64(x uint64) Float32 {
+	return newFloat32FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewFloat32FromInt128 returns the Float32 value nearest to x, rounding ties to even.
+func NewFloat32FromInt128(x ints.Int128) Float32 {
+	if x.Sign() < 0 {
+		return newFloat32FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newFloat32FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewFloat32FromUint128 returns the Float32 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float32, the result is +Inf.
+func NewFloat32FromUint128(x ints.Uint128) Float32 {
+	return newFloat32FromInt(false, x.Uint256())
+}
+
+// NewFloat32FromInt256 returns the Float32 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float32, the result is ±Inf.
+func NewFloat32FromInt256(x ints.Int256) Float32 {
+	if x.Sign() < 0 {
+		return newFloat32FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newFloat32FromInt(false, ints.Uint256(x))
+}
+
+// NewFloat32FromUint256 returns the Float32 value nearest to x, rounding ties to even.
+// If x is too large to be represented in Float32, the result is +Inf.
+func NewFloat32FromUint256(x ints.Uint256) Float32 {
+	return newFloat32FromInt(false, x)
+}
+
+// newFloat32FromInt returns the Float32 value nearest to (-1)**neg × x.
+func newFloat32FromInt(neg bool, x ints.Uint256) Float32 {
+	r, _ := float256FromUint256(neg, x, ToNearestEven|toOdd)
+	ret, _ := r.float32(ToNearestEven)
+	return ret
+}
+
 // NewFloat32NaN returns a NaN Float32 value.
 func NewFloat32NaN() Float32 {
 	return Float32(math.Float32frombits(uvnan32))
@@ -60,6 +112,71 @@ func (a Float32) IsNaN() bool {
 	return a != a
 }
 
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a Float32) IsSignalingNaN() bool {
+	return a.IsNaN() && a.Bits()&quietMask32 == 0
+}
+
+// Payload returns the payload of a as a non-negative integer.
+// If a is not NaN, Payload returns -1.
+// It is the getPayload operation of IEEE 754-2019.
+func (a Float32) Payload() Float32 {
+	if !a.IsNaN() {
+		return -1
+	}
+	return Float32(a.Bits() & payloadMask32)
+}
+
+// SetPayload32 returns a quiet NaN with the payload.
+// If payload is not an integer in [0, 2**22), SetPayload32 returns +0.
+// It is the setPayload operation of IEEE 754-2019.
+func SetPayload32(payload Float32) Float32 {
+	p, ok := payload.Float256().payloadInt(shift32 - 1)
+	if !ok {
+		return 0
+	}
+	return nan32(false, p)
+}
+
+// SetPayloadSignaling32 returns a signaling NaN with the payload.
+// If payload is not an integer in [1, 2**22), SetPayloadSignaling32 returns +0.
+// It is the setPayloadSignaling operation of IEEE 754-2019.
+func SetPayloadSignaling32(payload Float32) Float32 {
+	p, ok := payload.Float256().payloadInt(shift32 - 1)
+	if !ok || p.IsZero() {
+		return 0
+	}
+	return NewFloat32FromBits(uvinf32 | uint32(p[3]))
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a Float32) nanPayload() ints.Uint256 {
+	return ints.Uint256{0, 0, 0, uint64(a.Bits() & payloadMask32)}
+}
+
+// nan32 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float32, it is replaced by zero.
+func nan32(neg bool, payload ints.Uint256) Float32 {
+	ret := uint32(uvnan32)
+	if payload.BitLen() < shift32 {
+		ret |= uint32(payload[3])
+	}
+	if neg {
+		ret |= signMask32
+	}
+	return NewFloat32FromBits(ret)
+}
+
+// propagateNaN32 returns the first NaN of a and b as a quiet NaN.
+// The payload of the first NaN operand is preserved.
+func propagateNaN32(a, b Float32) Float32 {
+	if !a.IsNaN() {
+		a = b
+	}
+	return NewFloat32FromBits(a.Bits() | quietMask32)
+}
+
 // IsInf reports whether a is an infinity, according to sign.
 // If sign > 0, IsInf reports whether a is positive infinity.
 // If sign < 0, IsInf reports whether a is negative infinity.
@@ -168,21 +285,33 @@ func (a Float32) Abs() Float32 {
 
 // Mul returns the product of a and b.
 func (a Float32) Mul(b Float32) Float32 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN32(a, b)
+	}
 	return a * b
 }
 
 // Quo returns the quotient of a and b.
 func (a Float32) Quo(b Float32) Float32 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN32(a, b)
+	}
 	return a / b
 }
 
 // Add returns the sum of a and b.
 func (a Float32) Add(b Float32) Float32 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN32(a, b)
+	}
 	return a + b
 }
 
 // Sub returns the difference of a and b.
 func (a Float32) Sub(b Float32) Float32 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN32(a, b)
+	}
 	return a - b
 }
 
@@ -195,6 +324,9 @@ func (a Float32) Sub(b Float32) Float32 {
 //	Sqrt(x < 0) = NaN
 //	Sqrt(NaN) = NaN
 func (a Float32) Sqrt() Float32 {
+	if a.IsNaN() {
+		return propagateNaN32(a, a)
+	}
 	// This operation involves two rounds of rounding, so it is technically incorrect.
 	// However, it always returns the correct result in practice.
 	return Float32(math.Sqrt(float64(a)))
@@ -278,6 +410,10 @@ func (a Float32) normalize() (sign uint32, exp int, frac uint32) {
 // FMA32 returns x * y + z, computed with only one rounding.
 // (That is, FMA32 returns the fused multiply-add of x, y, and z.)
 func FMA32(x, y, z Float32) Float32 {
+	if x.IsNaN() || y.IsNaN() || z.IsNaN() {
+		return propagateNaN32(x, propagateNaN32(y, z))
+	}
+
 	// Split x, y, z into sign, exponent, mantissa.
 	signX, expX, fracX := x.normalize()
 	signY, expY, fracY := y.normalize()
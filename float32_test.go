@@ -35,6 +35,107 @@ func TestFloat32_IsNaN(t *testing.T) {
 	}
 }
 
+func TestFloat32_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		a    Float32
+		want bool
+	}{
+		{NewFloat32FromBits(0x7fc00000), false},
+		{NewFloat32FromBits(0x7fc00001), false},
+		{NewFloat32FromBits(0x7f800001), true},
+		{NewFloat32FromBits(0xff800001), true},
+		{NewFloat32Inf(1), false},
+		{exact32(1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsSignalingNaN(); got != tt.want {
+			t.Errorf("Float32(%x).IsSignalingNaN() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Payload(t *testing.T) {
+	tests := []struct {
+		a    Float32
+		want Float32
+	}{
+		{NewFloat32FromBits(0x7fc00000), exact32(0)},
+		{NewFloat32FromBits(0x7fc0002a), exact32(42)},
+		{NewFloat32FromBits(0xffc0002a), exact32(42)},
+		{NewFloat32FromBits(0x7f80002a), exact32(42)},
+		{NewFloat32Inf(1), exact32(-1)},
+		{exact32(1), exact32(-1)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Payload(); !eq32(got, tt.want) {
+			t.Errorf("Float32(%x).Payload() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayload32(t *testing.T) {
+	tests := []struct {
+		payload Float32
+		want    Float32
+	}{
+		{exact32(0), NewFloat32FromBits(0x7fc00000)},
+		{exact32(42), NewFloat32FromBits(0x7fc0002a)},
+		{exact32(4194303), NewFloat32FromBits(0x7fffffff)},
+		{exact32(4194304), exact32(0)},
+		{exact32(1.5), exact32(0)},
+		{exact32(-1), exact32(0)},
+		{NewFloat32Inf(1), exact32(0)},
+		{NewFloat32NaN(), exact32(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayload32(tt.payload); got.Bits() != tt.want.Bits() {
+			t.Errorf("SetPayload32(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadSignaling32(t *testing.T) {
+	tests := []struct {
+		payload Float32
+		want    Float32
+	}{
+		{exact32(0), exact32(0)},
+		{exact32(42), NewFloat32FromBits(0x7f80002a)},
+		{exact32(4194303), NewFloat32FromBits(0x7fbfffff)},
+		{exact32(4194304), exact32(0)},
+		{exact32(1.5), exact32(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadSignaling32(tt.payload); got.Bits() != tt.want.Bits() {
+			t.Errorf("SetPayloadSignaling32(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_NaNPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float32
+		want Float32
+	}{
+		{"Add(qNaN, 1)", NewFloat32FromBits(0x7fc0002a).Add(exact32(1)), NewFloat32FromBits(0x7fc0002a)},
+		{"Add(1, sNaN)", exact32(1).Add(NewFloat32FromBits(0x7f800007)), NewFloat32FromBits(0x7fc00007)},
+		{"Add(qNaN, qNaN)", NewFloat32FromBits(0x7fc0002a).Add(NewFloat32FromBits(0x7fc00007)), NewFloat32FromBits(0x7fc0002a)},
+		{"Add(sNaN, qNaN)", NewFloat32FromBits(0x7f800007).Add(NewFloat32FromBits(0x7fc0002a)), NewFloat32FromBits(0x7fc00007)},
+		{"Sub(1, qNaN)", exact32(1).Sub(NewFloat32FromBits(0x7fc0002a)), NewFloat32FromBits(0x7fc0002a)},
+		{"Mul(-qNaN, 1)", NewFloat32FromBits(0xffc0002a).Mul(exact32(1)), NewFloat32FromBits(0xffc0002a)},
+		{"Quo(1, qNaN)", exact32(1).Quo(NewFloat32FromBits(0x7fc0002a)), NewFloat32FromBits(0x7fc0002a)},
+		{"Sqrt(sNaN)", NewFloat32FromBits(0x7f800007).Sqrt(), NewFloat32FromBits(0x7fc00007)},
+		{"FMA(1, qNaN, sNaN)", FMA32(exact32(1), NewFloat32FromBits(0x7fc0002a), NewFloat32FromBits(0x7f800007)), NewFloat32FromBits(0x7fc0002a)},
+		{"FMA(0, Inf, qNaN)", FMA32(exact32(0), NewFloat32Inf(1), NewFloat32FromBits(0x7fc0002a)), NewFloat32FromBits(0x7fc0002a)},
+	}
+	for _, tt := range tests {
+		if tt.got.Bits() != tt.want.Bits() {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
 func TestFloat32_IsInf(t *testing.T) {
 	inf := Float32(math.Inf(1))
 	neginf := Float32(math.Inf(-1))
@@ -983,3 +1084,45 @@ func TestFloat32_Remainder(t *testing.T) {
 		}
 	}
 }
+
+func TestNewFloat32FromInt(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want float64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, -1},
+		{1<<24 + 1, 1 << 24},
+		{1<<24 + 3, 1<<24 + 4},
+		{-(1<<24 + 3), -(1<<24 + 4)},
+		{math.MaxInt64, 0x1p63},
+		{math.MinInt64, -0x1p63},
+	}
+
+	for _, tt := range tests {
+		got := NewFloat32FromInt64(tt.in)
+		if want := NewFloat32(tt.want); got != want {
+			t.Errorf("NewFloat32FromInt64(%d) = %v, want %v", tt.in, got, want)
+		}
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 24))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []Float32{
+			NewFloat32FromInt64(v.i64),
+			NewFloat32FromUint64(v.u64),
+			NewFloat32FromInt128(v.i128),
+			NewFloat32FromUint128(v.u128),
+			NewFloat32FromInt256(v.i256),
+			NewFloat32FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewFloat32FromBigInt(x); got[i] != want {
+				t.Errorf("NewFloat32FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
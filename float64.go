@@ -17,6 +17,9 @@ const (
 	bias64     = 1023               // bias for exponent
 	signMask64 = 1 << 63            // mask for sign bit
 	fracMask64 = 1<<shift64 - 1
+
+	quietMask64   = 1 << (shift64 - 1) // mask for the quiet bit of NaN
+	payloadMask64 = quietMask64 - 1    // mask for the payload of NaN
 )
 
 // Float64 is a 64-bit floating-point number.
@@ -32,6 +35,52 @@ func NewFloat64FromBits(b uint64) Float64 {
 	return Float64(math.Float64frombits(b))
 }
 
+// NewFloat64FromInt64 returns the Float64 value nearest to x, rounding ties to even.
+func NewFloat64FromInt64(x int64) Float64 {
+	if x < 0 {
+		return newFloat64FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newFloat64FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewFloat64FromUint64 returns the Float64 value nearest to x, rounding ties to even.
+func NewFloat64FromUint64(x uint64) Float64 {
+	return newFloat64FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewFloat64FromInt128 returns the Float64 value nearest to x, rounding ties to even.
+func NewFloat64FromInt128(x ints.Int128) Float64 {
+	if x.Sign() < 0 {
+		return newFloat64FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newFloat64FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewFloat64FromUint128 returns the Float64 value nearest to x, rounding ties to even.
+func NewFloat64FromUint128(x ints.Uint128) Float64 {
+	return newFloat64FromInt(false, x.Uint256())
+}
+
+// NewFloat64FromInt256 returns the Float64 value nearest to x, rounding ties to even.
+func NewFloat64FromInt256(x ints.Int256) Float64 {
+	if x.Sign() < 0 {
+		return newFloat64FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newFloat64FromInt(false, ints.Uint256(x))
+}
+
+// NewFloat64FromUint256 returns the Float64 value nearest to x, rounding ties to even.
+func NewFloat64FromUint256(x ints.Uint256) Float64 {
+	return newFloat64FromInt(false, x)
+}
+
+// newFloat64FromInt returns the Float64 value nearest to (-1)**neg × x.
+func newFloat64FromInt(neg bool, x ints.Uint256) Float64 {
+	r, _ := float256FromUint256(neg, x, ToNearestEven|toOdd)
+	ret, _ := r.float64(ToNearestEven)
+	return ret
+}
+
 // NewFloat64NaN returns a NaN Float64 value.
 func NewFloat64NaN() Float64 {
 	return Float64(math.NaN())
@@ -57,6 +106,71 @@ func (a Float64) IsNaN() bool {
 	return a != a
 }
 
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a Float64) IsSignalingNaN() bool {
+	return a.IsNaN() && a.Bits()&quietMask64 == 0
+}
+
+// Payload returns the payload of a as a non-negative integer.
+// If a is not NaN, Payload returns -1.
+// It is the getPayload operation of IEEE 754-2019.
+func (a Float64) Payload() Float64 {
+	if !a.IsNaN() {
+		return -1
+	}
+	return Float64(a.Bits() & payloadMask64)
+}
+
+// SetPayload64 returns a quiet NaN with the payload.
+// If payload is not an integer in [0, 2**51), SetPayload64 returns +0.
+// It is the setPayload operation of IEEE 754-2019.
+func SetPayload64(payload Float64) Float64 {
+	p, ok := payload.Float256().payloadInt(shift64 - 1)
+	if !ok {
+		return 0
+	}
+	return nan64(false, p)
+}
+
+// SetPayloadSignaling64 returns a signaling NaN with the payload.
+// If payload is not an integer in [1, 2**51), SetPayloadSignaling64 returns +0.
+// It is the setPayloadSignaling operation of IEEE 754-2019.
+func SetPayloadSignaling64(payload Float64) Float64 {
+	p, ok := payload.Float256().payloadInt(shift64 - 1)
+	if !ok || p.IsZero() {
+		return 0
+	}
+	return NewFloat64FromBits(uvinf64 | p[3])
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a Float64) nanPayload() ints.Uint256 {
+	return ints.Uint256{0, 0, 0, a.Bits() & payloadMask64}
+}
+
+// nan64 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float64, it is replaced by zero.
+func nan64(neg bool, payload ints.Uint256) Float64 {
+	ret := uint64(uvnan64)
+	if payload.BitLen() < shift64 {
+		ret |= payload[3]
+	}
+	if neg {
+		ret |= signMask64
+	}
+	return NewFloat64FromBits(ret)
+}
+
+// propagateNaN64 returns the first NaN of a and b as a quiet NaN.
+// The payload of the first NaN operand is preserved.
+func propagateNaN64(a, b Float64) Float64 {
+	if !a.IsNaN() {
+		a = b
+	}
+	return NewFloat64FromBits(a.Bits() | quietMask64)
+}
+
 // IsInf reports whether a is an infinity, according to sign.
 // If sign > 0, IsInf reports whether a is positive infinity.
 // If sign < 0, IsInf reports whether a is negative infinity.
@@ -168,21 +282,33 @@ func (a Float64) Abs() Float64 {
 
 // Mul returns the product of a and b.
 func (a Float64) Mul(b Float64) Float64 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN64(a, b)
+	}
 	return a * b
 }
 
 // Quo returns the quotient of a and b.
 func (a Float64) Quo(b Float64) Float64 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN64(a, b)
+	}
 	return a / b
 }
 
 // Add returns the sum of a and b.
 func (a Float64) Add(b Float64) Float64 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN64(a, b)
+	}
 	return a + b
 }
 
 // Sub returns the difference of a and b.
 func (a Float64) Sub(b Float64) Float64 {
+	if a.IsNaN() || b.IsNaN() {
+		return propagateNaN64(a, b)
+	}
 	return a - b
 }
 
@@ -195,6 +321,9 @@ func (a Float64) Sub(b Float64) Float64 {
 //	Sqrt(x < 0) = NaN
 //	Sqrt(NaN) = NaN
 func (a Float64) Sqrt() Float64 {
+	if a.IsNaN() {
+		return propagateNaN64(a, a)
+	}
 	return Float64(math.Sqrt(float64(a)))
 }
 
@@ -275,6 +404,9 @@ func (a Float64) normalize() (sign uint64, exp int, frac uint64) {
 // FMA64 returns x * y + z, computed with only one rounding.
 // (That is, FMA64 returns the fused multiply-add of x, y, and z.)
 func FMA64(x, y, z Float64) Float64 {
+	if x.IsNaN() || y.IsNaN() || z.IsNaN() {
+		return propagateNaN64(x, propagateNaN64(y, z))
+	}
 	return Float64(math.FMA(float64(x), float64(y), float64(z)))
 }
 
@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 
@@ -34,6 +35,107 @@ func TestFloat64_IsNaN(t *testing.T) {
 	}
 }
 
+func TestFloat64_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		a    Float64
+		want bool
+	}{
+		{NewFloat64FromBits(0x7ff8000000000000), false},
+		{NewFloat64FromBits(0x7ff8000000000001), false},
+		{NewFloat64FromBits(0x7ff0000000000001), true},
+		{NewFloat64FromBits(0xfff0000000000001), true},
+		{NewFloat64Inf(1), false},
+		{exact64(1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.IsSignalingNaN(); got != tt.want {
+			t.Errorf("Float64(%x).IsSignalingNaN() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Payload(t *testing.T) {
+	tests := []struct {
+		a    Float64
+		want Float64
+	}{
+		{NewFloat64FromBits(0x7ff8000000000000), exact64(0)},
+		{NewFloat64FromBits(0x7ff800000000002a), exact64(42)},
+		{NewFloat64FromBits(0xfff800000000002a), exact64(42)},
+		{NewFloat64FromBits(0x7ff000000000002a), exact64(42)},
+		{NewFloat64Inf(1), exact64(-1)},
+		{exact64(1), exact64(-1)},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Payload(); !eq64(got, tt.want) {
+			t.Errorf("Float64(%x).Payload() = %x, want %x", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayload64(t *testing.T) {
+	tests := []struct {
+		payload Float64
+		want    Float64
+	}{
+		{exact64(0), NewFloat64FromBits(0x7ff8000000000000)},
+		{exact64(42), NewFloat64FromBits(0x7ff800000000002a)},
+		{exact64(2251799813685247), NewFloat64FromBits(0x7fffffffffffffff)},
+		{exact64(2251799813685248), exact64(0)},
+		{exact64(1.5), exact64(0)},
+		{exact64(-1), exact64(0)},
+		{NewFloat64Inf(1), exact64(0)},
+		{NewFloat64NaN(), exact64(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayload64(tt.payload); got.Bits() != tt.want.Bits() {
+			t.Errorf("SetPayload64(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestSetPayloadSignaling64(t *testing.T) {
+	tests := []struct {
+		payload Float64
+		want    Float64
+	}{
+		{exact64(0), exact64(0)},
+		{exact64(42), NewFloat64FromBits(0x7ff000000000002a)},
+		{exact64(2251799813685247), NewFloat64FromBits(0x7ff7ffffffffffff)},
+		{exact64(2251799813685248), exact64(0)},
+		{exact64(1.5), exact64(0)},
+	}
+	for _, tt := range tests {
+		if got := SetPayloadSignaling64(tt.payload); got.Bits() != tt.want.Bits() {
+			t.Errorf("SetPayloadSignaling64(%x) = %x, want %x", tt.payload, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_NaNPropagation(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Float64
+		want Float64
+	}{
+		{"Add(qNaN, 1)", NewFloat64FromBits(0x7ff800000000002a).Add(exact64(1)), NewFloat64FromBits(0x7ff800000000002a)},
+		{"Add(1, sNaN)", exact64(1).Add(NewFloat64FromBits(0x7ff0000000000007)), NewFloat64FromBits(0x7ff8000000000007)},
+		{"Add(qNaN, qNaN)", NewFloat64FromBits(0x7ff800000000002a).Add(NewFloat64FromBits(0x7ff8000000000007)), NewFloat64FromBits(0x7ff800000000002a)},
+		{"Add(sNaN, qNaN)", NewFloat64FromBits(0x7ff0000000000007).Add(NewFloat64FromBits(0x7ff800000000002a)), NewFloat64FromBits(0x7ff8000000000007)},
+		{"Sub(1, qNaN)", exact64(1).Sub(NewFloat64FromBits(0x7ff800000000002a)), NewFloat64FromBits(0x7ff800000000002a)},
+		{"Mul(-qNaN, 1)", NewFloat64FromBits(0xfff800000000002a).Mul(exact64(1)), NewFloat64FromBits(0xfff800000000002a)},
+		{"Quo(1, qNaN)", exact64(1).Quo(NewFloat64FromBits(0x7ff800000000002a)), NewFloat64FromBits(0x7ff800000000002a)},
+		{"Sqrt(sNaN)", NewFloat64FromBits(0x7ff0000000000007).Sqrt(), NewFloat64FromBits(0x7ff8000000000007)},
+		{"FMA(1, qNaN, sNaN)", FMA64(exact64(1), NewFloat64FromBits(0x7ff800000000002a), NewFloat64FromBits(0x7ff0000000000007)), NewFloat64FromBits(0x7ff800000000002a)},
+		{"FMA(0, Inf, qNaN)", FMA64(exact64(0), NewFloat64Inf(1), NewFloat64FromBits(0x7ff800000000002a)), NewFloat64FromBits(0x7ff800000000002a)},
+	}
+	for _, tt := range tests {
+		if tt.got.Bits() != tt.want.Bits() {
+			t.Errorf("%s = %x, want %x", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
 func TestFloat64_IsInf(t *testing.T) {
 	inf := Float64(math.Inf(1))
 	neginf := Float64(math.Inf(-1))
@@ -948,3 +1050,45 @@ func TestFloat64_Remainder(t *testing.T) {
 		}
 	}
 }
+
+func TestNewFloat64FromInt(t *testing.T) {
+	tests := []struct {
+		in   int64
+		want float64
+	}{
+		{0, 0},
+		{1, 1},
+		{-1, -1},
+		{1<<53 + 1, 1 << 53},
+		{1<<53 + 3, 1<<53 + 4},
+		{-(1<<53 + 3), -(1<<53 + 4)},
+		{math.MaxInt64, 0x1p63},
+		{math.MinInt64, -0x1p63},
+	}
+
+	for _, tt := range tests {
+		got := NewFloat64FromInt64(tt.in)
+		if want := NewFloat64(tt.want); got != want {
+			t.Errorf("NewFloat64FromInt64(%d) = %v, want %v", tt.in, got, want)
+		}
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 53))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []Float64{
+			NewFloat64FromInt64(v.i64),
+			NewFloat64FromUint64(v.u64),
+			NewFloat64FromInt128(v.i128),
+			NewFloat64FromUint128(v.u128),
+			NewFloat64FromInt256(v.i256),
+			NewFloat64FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewFloat64FromBigInt(x); got[i] != want {
+				t.Errorf("NewFloat64FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
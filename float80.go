@@ -0,0 +1,354 @@
+package floats
+
+import (
+	"math/bits"
+
+	"github.com/shogo82148/ints"
+)
+
+const (
+	mask80     = 0x7fff  // mask for exponent
+	shift80    = 64 - 1  // shift for exponent, the number of fraction bits after the explicit integer bit
+	bias80     = 16383   // bias for exponent
+	signMask80 = 1 << 15 // mask for sign bit in the sign and exponent
+	intBit80   = 1 << 63 // mask for the explicit integer bit
+	fracMask80 = intBit80 - 1
+
+	quietMask80   = 1 << (shift80 - 1) // mask for the quiet bit of NaN
+	payloadMask80 = quietMask80 - 1    // mask for the payload of NaN
+)
+
+var (
+	uvnan80    = Float80{se: mask80, frac: intBit80 | quietMask80} // NaN value for Float80
+	uvinf80    = Float80{se: mask80, frac: intBit80}               // Infinity value for Float80
+	uvneginf80 = Float80{se: signMask80 | mask80, frac: intBit80}  // Negative Infinity value for Float80
+)
+
+// Float80 is an 80-bit extended precision floating-point number of the x87 FPU.
+// It has 1 sign bit, 15 exponent bits, and 64 significand bits
+// including the explicit integer bit.
+//
+// Encodings that have an inconsistent integer bit are handled as the 80387 and later do:
+//   - pseudo-denormals (the exponent is zero and the integer bit is set)
+//     are accepted as operands, and have the same value as the normal number with the exponent one.
+//   - pseudo-NaNs, pseudo-infinities (the exponent is all ones and the integer bit is clear),
+//     and unnormals (the exponent is neither zero nor all ones and the integer bit is clear)
+//     are invalid operands. They are treated as signaling NaNs,
+//     and operations on them return the default NaN.
+type Float80 struct {
+	se   uint16 // the sign and the exponent
+	frac uint64 // the significand with the explicit integer bit
+}
+
+// NewFloat80 converts f to Float80.
+func NewFloat80(f float64) Float80 {
+	return Float64(f).Float80()
+}
+
+// NewFloat80FromBits converts the x87 binary representation to Float80.
+// se is the sign and the exponent, and frac is the significand with the explicit integer bit.
+func NewFloat80FromBits(se uint16, frac uint64) Float80 {
+	return Float80{se: se, frac: frac}
+}
+
+// NewFloat80FromInt64 converts x to Float80.
+// The result is exact.
+func NewFloat80FromInt64(x int64) Float80 {
+	if x < 0 {
+		return newFloat80FromInt(true, ints.Uint256{0, 0, 0, -uint64(x)})
+	}
+	return newFloat80FromInt(false, ints.Uint256{0, 0, 0, uint64(x)})
+}
+
+// NewFloat80FromUint64 converts x to Float80.
+// The result is exact.
+func NewFloat80FromUint64(x uint64) Float80 {
+	return newFloat80FromInt(false, ints.Uint256{0, 0, 0, x})
+}
+
+// NewFloat80FromInt128 returns the Float80 value nearest to x, rounding ties to even.
+func NewFloat80FromInt128(x ints.Int128) Float80 {
+	if x.Sign() < 0 {
+		return newFloat80FromInt(true, ints.Uint128(x.Neg()).Uint256())
+	}
+	return newFloat80FromInt(false, ints.Uint128(x).Uint256())
+}
+
+// NewFloat80FromUint128 returns the Float80 value nearest to x, rounding ties to even.
+func NewFloat80FromUint128(x ints.Uint128) Float80 {
+	return newFloat80FromInt(false, x.Uint256())
+}
+
+// NewFloat80FromInt256 returns the Float80 value nearest to x, rounding ties to even.
+func NewFloat80FromInt256(x ints.Int256) Float80 {
+	if x.Sign() < 0 {
+		return newFloat80FromInt(true, ints.Uint256(x.Neg()))
+	}
+	return newFloat80FromInt(false, ints.Uint256(x))
+}
+
+// NewFloat80FromUint256 returns the Float80 value nearest to x, rounding ties to even.
+func NewFloat80FromUint256(x ints.Uint256) Float80 {
+	return newFloat80FromInt(false, x)
+}
+
+// newFloat80FromInt returns the Float80 value nearest to (-1)**neg × x.
+func newFloat80FromInt(neg bool, x ints.Uint256) Float80 {
+	r, _ := float256FromUint256(neg, x, ToNearestEven|toOdd)
+	ret, _ := r.float80(ToNearestEven)
+	return ret
+}
+
+// NewFloat80NaN returns a NaN Float80 value.
+func NewFloat80NaN() Float80 {
+	return uvnan80
+}
+
+// NewFloat80Inf positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewFloat80Inf(sign int) Float80 {
+	if sign >= 0 {
+		return uvinf80
+	}
+	return uvneginf80
+}
+
+// Bits returns the x87 binary representation of a.
+// se is the sign and the exponent, and frac is the significand with the explicit integer bit.
+func (a Float80) Bits() (se uint16, frac uint64) {
+	return a.se, a.frac
+}
+
+// IsNaN reports whether a is a “not-a-number” value.
+// It also reports true for invalid encodings, see [Float80.IsInvalid].
+func (a Float80) IsNaN() bool {
+	if a.IsInvalid() {
+		return true
+	}
+	return a.se&mask80 == mask80 && a.frac&fracMask80 != 0
+}
+
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+// Invalid encodings are also treated as signaling NaNs, see [Float80.IsInvalid].
+func (a Float80) IsSignalingNaN() bool {
+	return a.IsNaN() && (a.IsInvalid() || a.frac&quietMask80 == 0)
+}
+
+// IsInvalid reports whether a is a pseudo-NaN, a pseudo-infinity, or an unnormal.
+// These encodings have a clear integer bit with a non-zero exponent,
+// and the 80387 and later reject them as invalid operands.
+func (a Float80) IsInvalid() bool {
+	return a.se&mask80 != 0 && a.frac&intBit80 == 0
+}
+
+// IsPseudoDenormal reports whether a is a pseudo-denormal,
+// a denormal encoding with the integer bit set.
+func (a Float80) IsPseudoDenormal() bool {
+	return a.se&mask80 == 0 && a.frac&intBit80 != 0
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+// Invalid encodings have no payload.
+func (a Float80) nanPayload() ints.Uint256 {
+	if a.IsInvalid() {
+		return ints.Uint256{}
+	}
+	return ints.Uint256{0, 0, 0, a.frac & payloadMask80}
+}
+
+// nan80 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float80, it is replaced by zero.
+func nan80(neg bool, payload ints.Uint256) Float80 {
+	ret := uvnan80
+	if payload.BitLen() < shift80-1 {
+		ret.frac |= payload[3]
+	}
+	if neg {
+		ret.se |= signMask80
+	}
+	return ret
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a Float80) IsInf(sign int) bool {
+	return sign >= 0 && a == uvinf80 || sign <= 0 && a == uvneginf80
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (a Float80) Signbit() bool {
+	return a.se&signMask80 != 0
+}
+
+// Copysign returns a value with the magnitude of a
+// and the sign of sign.
+func (a Float80) Copysign(sign Float80) Float80 {
+	a.se = (a.se &^ signMask80) | (sign.se & signMask80)
+	return a
+}
+
+// IsZero reports whether a is zero (+0 or -0).
+func (a Float80) IsZero() bool {
+	return a.se&mask80 == 0 && a.frac == 0
+}
+
+// Neg returns the negation of a.
+func (a Float80) Neg() Float80 {
+	a.se ^= signMask80
+	return a
+}
+
+// Abs returns the absolute value of a.
+//
+// Special cases:
+//
+//	Abs(±Inf) = +Inf
+//	Abs(NaN) = NaN
+func (a Float80) Abs() Float80 {
+	a.se &^= signMask80
+	return a
+}
+
+// The arithmetic operations of Float80 are evaluated in Float256 with rounding to odd,
+// and the results are rounded to Float80 only once.
+
+// Mul returns the product of a and b.
+func (a Float80) Mul(b Float80) Float80 {
+	return round80(a.Float256().mul(b.Float256(), toOdd))
+}
+
+// Quo returns the quotient of a and b.
+func (a Float80) Quo(b Float80) Float80 {
+	return round80(a.Float256().quo(b.Float256(), toOdd))
+}
+
+// Add returns the sum of a and b.
+func (a Float80) Add(b Float80) Float80 {
+	return round80(a.Float256().add(b.Float256(), toOdd))
+}
+
+// Sub returns the difference of a and b.
+func (a Float80) Sub(b Float80) Float80 {
+	return round80(a.Float256().sub(b.Float256(), toOdd))
+}
+
+// Sqrt returns the square root of a.
+//
+// Special cases are:
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func (a Float80) Sqrt() Float80 {
+	return round80(a.Float256().sqrt(toOdd))
+}
+
+// FMA80 returns x * y + z, computed with only one rounding.
+// (That is, FMA80 returns the fused multiply-add of x, y, and z.)
+func FMA80(x, y, z Float80) Float80 {
+	return round80(fma256(x.Float256(), y.Float256(), z.Float256(), toOdd))
+}
+
+// round80 rounds x, which is rounded to odd, to the nearest Float80.
+// The exception flags are ignored.
+func round80(x Float256, _ Flags) Float80 {
+	ret, _ := x.float80(ToNearestEven)
+	return ret
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a Float80) Eq(b Float80) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.Float256().Eq(b.Float256())
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a Float80) Ne(b Float80) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a Float80) Lt(b Float80) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.Float256().Lt(b.Float256())
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a Float80) Gt(b Float80) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a Float80) Le(b Float80) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.Float256().Le(b.Float256())
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a Float80) Ge(b Float80) bool {
+	return b.Le(a)
+}
+
+// normalize returns the sign, exponent, and normalized significand of a,
+// which must be finite.
+// The integer bit of frac is at bit shift80.
+func (a Float80) normalize() (sign uint16, exp int, frac uint64) {
+	sign, exp, frac = a.split()
+	if frac == 0 {
+		return
+	}
+
+	// a is denormal
+	l := bits.Len64(frac)
+	frac <<= uint(shift80 + 1 - l)
+	exp -= shift80 + 1 - l
+	return
+}
+
+// split returns the sign, exponent, and significand of a, which must be finite.
+// The value of a is frac × 2**(exp - shift80).
+// Pseudo-denormals are split into the same values as the normal numbers.
+func (a Float80) split() (sign uint16, exp int, frac uint64) {
+	sign = a.se & signMask80
+	exp = int(a.se&mask80) - bias80
+	frac = a.frac
+
+	if exp == -bias80 {
+		// a is denormal or pseudo-denormal
+		exp++
+	}
+	return
+}
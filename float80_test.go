@@ -0,0 +1,345 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"runtime"
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+func TestFloat80_Classify(t *testing.T) {
+	tests := []struct {
+		se                                    uint16
+		frac                                  uint64
+		nan, snan, invalid, pseudo, inf, zero bool
+	}{
+		{0x0000, 0x0000_0000_0000_0000, false, false, false, false, false, true},  // zero
+		{0x8000, 0x0000_0000_0000_0000, false, false, false, false, false, true},  // -zero
+		{0x0000, 0x0000_0000_0000_0001, false, false, false, false, false, false}, // denormal
+		{0x0000, 0x8000_0000_0000_0000, false, false, false, true, false, false},  // pseudo-denormal
+		{0x3fff, 0x8000_0000_0000_0000, false, false, false, false, false, false}, // normal
+		{0x3fff, 0x4000_0000_0000_0000, true, true, true, false, false, false},    // unnormal
+		{0x0001, 0x0000_0000_0000_0000, true, true, true, false, false, false},    // unnormal zero
+		{0x7fff, 0x8000_0000_0000_0000, false, false, false, false, true, false},  // infinity
+		{0x7fff, 0x0000_0000_0000_0000, true, true, true, false, false, false},    // pseudo-infinity
+		{0x7fff, 0xc000_0000_0000_0000, true, false, false, false, false, false},  // quiet NaN
+		{0x7fff, 0x8000_0000_0000_0001, true, true, false, false, false, false},   // signaling NaN
+		{0x7fff, 0x4000_0000_0000_0000, true, true, true, false, false, false},    // pseudo-NaN
+	}
+
+	for _, tt := range tests {
+		a := NewFloat80FromBits(tt.se, tt.frac)
+		if got := a.IsNaN(); got != tt.nan {
+			t.Errorf("Float80(%04x_%016x).IsNaN() = %v, want %v", tt.se, tt.frac, got, tt.nan)
+		}
+		if got := a.IsSignalingNaN(); got != tt.snan {
+			t.Errorf("Float80(%04x_%016x).IsSignalingNaN() = %v, want %v", tt.se, tt.frac, got, tt.snan)
+		}
+		if got := a.IsInvalid(); got != tt.invalid {
+			t.Errorf("Float80(%04x_%016x).IsInvalid() = %v, want %v", tt.se, tt.frac, got, tt.invalid)
+		}
+		if got := a.IsPseudoDenormal(); got != tt.pseudo {
+			t.Errorf("Float80(%04x_%016x).IsPseudoDenormal() = %v, want %v", tt.se, tt.frac, got, tt.pseudo)
+		}
+		if got := a.IsInf(0); got != tt.inf {
+			t.Errorf("Float80(%04x_%016x).IsInf(0) = %v, want %v", tt.se, tt.frac, got, tt.inf)
+		}
+		if got := a.IsZero(); got != tt.zero {
+			t.Errorf("Float80(%04x_%016x).IsZero() = %v, want %v", tt.se, tt.frac, got, tt.zero)
+		}
+	}
+}
+
+func TestFloat80_IsInf(t *testing.T) {
+	tests := []struct {
+		a    Float80
+		sign int
+		want bool
+	}{
+		{NewFloat80Inf(1), 1, true},
+		{NewFloat80Inf(1), 0, true},
+		{NewFloat80Inf(1), -1, false},
+		{NewFloat80Inf(-1), 1, false},
+		{NewFloat80Inf(-1), 0, true},
+		{NewFloat80Inf(-1), -1, true},
+		{exact80(1), 0, false},
+		{NewFloat80NaN(), 0, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.IsInf(tt.sign); got != tt.want {
+			t.Errorf("Float80(%v).IsInf(%d) = %v, want %v", tt.a, tt.sign, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_Sign(t *testing.T) {
+	a := exact80(1.5)
+	if a.Signbit() {
+		t.Errorf("Float80(%v).Signbit() = true, want false", a)
+	}
+	if got := a.Neg(); got != exact80(-1.5) {
+		t.Errorf("Float80(%v).Neg() = %v, want -1.5", a, got)
+	}
+	if got := a.Neg().Abs(); got != a {
+		t.Errorf("Float80(%v).Abs() = %v, want 1.5", a.Neg(), got)
+	}
+	if got := a.Copysign(exact80(math.Copysign(0, -1))); got != exact80(-1.5) {
+		t.Errorf("Float80(%v).Copysign(-0) = %v, want -1.5", a, got)
+	}
+}
+
+func TestFloat80_Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want Float80
+	}{
+		{exact80(1), exact80(0), exact80(0)},
+		{exact80(1.5), exact80(-3), exact80(-4.5)},
+		{exact80(math.Copysign(0, -1)), exact80(1), exact80(math.Copysign(0, -1))},
+
+		// the product is rounded to 64 bits
+		{
+			NewFloat80FromBits(0x3fff, 0xffff_ffff_ffff_ffff), // 2 - 2**-63
+			NewFloat80FromBits(0x3fff, 0xffff_ffff_ffff_ffff), // 2 - 2**-63
+			NewFloat80FromBits(0x4000, 0xffff_ffff_ffff_fffe), // 4 - 2**-61
+		},
+
+		// underflow to denormal
+		{NewFloat80FromBits(0x0001, 0x8000_0000_0000_0000), exact80(0.5), NewFloat80FromBits(0x0000, 0x4000_0000_0000_0000)},
+		{NewFloat80FromBits(0x0000, 0x0000_0000_0000_0001), exact80(0.5), exact80(0)}, // ties to even
+		{NewFloat80FromBits(0x0000, 0x0000_0000_0000_0003), exact80(0.5), NewFloat80FromBits(0x0000, 2)},
+
+		// pseudo-denormal operands are accepted
+		{NewFloat80FromBits(0x0000, 0x8000_0000_0000_0000), exact80(2), NewFloat80FromBits(0x0002, 0x8000_0000_0000_0000)},
+
+		// overflow
+		{NewFloat80FromBits(0x7ffe, 0x8000_0000_0000_0000), exact80(2), NewFloat80Inf(1)},
+
+		// handling infinity and NaN
+		{NewFloat80Inf(1), exact80(-1), NewFloat80Inf(-1)},
+		{NewFloat80Inf(1), exact80(0), NewFloat80NaN()},
+		{NewFloat80NaN(), exact80(1), NewFloat80NaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Mul(tt.b)
+		if !eq80(got, tt.want) {
+			t.Errorf("Float80(%v).Mul(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkFloat80_Mul(b *testing.B) {
+	f := exact80(1)
+	for b.Loop() {
+		runtime.KeepAlive(f.Mul(f))
+	}
+}
+
+func TestFloat80_Quo(t *testing.T) {
+	tests := []struct {
+		a, b, want Float80
+	}{
+		{exact80(1), exact80(2), exact80(0.5)},
+		{exact80(1), exact80(3), NewFloat80FromBits(0x3ffd, 0xaaaa_aaaa_aaaa_aaab)},
+		{exact80(2), exact80(3), NewFloat80FromBits(0x3ffe, 0xaaaa_aaaa_aaaa_aaab)},
+		{exact80(1), exact80(0), NewFloat80Inf(1)},
+		{exact80(-1), exact80(0), NewFloat80Inf(-1)},
+		{exact80(0), exact80(0), NewFloat80NaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Quo(tt.b)
+		if !eq80(got, tt.want) {
+			t.Errorf("Float80(%v).Quo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_Add(t *testing.T) {
+	tests := []struct {
+		a, b, want Float80
+	}{
+		{exact80(1), exact80(2), exact80(3)},
+		{exact80(1), exact80(-1), exact80(0)},
+		{exact80(math.Copysign(0, -1)), exact80(math.Copysign(0, -1)), exact80(math.Copysign(0, -1))},
+
+		// 1 + 2**-64 is a tie, and rounds to even
+		{exact80(1), exact80(0x1p-64), exact80(1)},
+		{exact80(1), exact80(0x1p-63), NewFloat80FromBits(0x3fff, 0x8000_0000_0000_0001)},
+		{NewFloat80FromBits(0x3fff, 0x8000_0000_0000_0001), exact80(0x1p-64), NewFloat80FromBits(0x3fff, 0x8000_0000_0000_0002)},
+
+		// denormal + denormal may be normal
+		{NewFloat80FromBits(0x0000, 0x4000_0000_0000_0000), NewFloat80FromBits(0x0000, 0x4000_0000_0000_0000), NewFloat80FromBits(0x0001, 0x8000_0000_0000_0000)},
+
+		// handling infinity
+		{NewFloat80Inf(1), NewFloat80Inf(-1), NewFloat80NaN()},
+		{NewFloat80Inf(1), exact80(1), NewFloat80Inf(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Add(tt.b)
+		if !eq80(got, tt.want) {
+			t.Errorf("Float80(%v).Add(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_Sub(t *testing.T) {
+	tests := []struct {
+		a, b, want Float80
+	}{
+		{exact80(3), exact80(1), exact80(2)},
+		{exact80(1), exact80(1), exact80(0)},
+		{exact80(1), NewFloat80FromBits(0x3fff, 0x8000_0000_0000_0001), exact80(-0x1p-63)},
+		{NewFloat80Inf(1), NewFloat80Inf(1), NewFloat80NaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sub(tt.b)
+		if !eq80(got, tt.want) {
+			t.Errorf("Float80(%v).Sub(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_Sqrt(t *testing.T) {
+	tests := []struct {
+		a, want Float80
+	}{
+		{exact80(4), exact80(2)},
+		{exact80(2), NewFloat80FromBits(0x3fff, 0xb504_f333_f9de_6484)},
+		{exact80(math.Copysign(0, -1)), exact80(math.Copysign(0, -1))},
+		{exact80(-1), NewFloat80NaN()},
+		{NewFloat80Inf(1), NewFloat80Inf(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sqrt()
+		if !eq80(got, tt.want) {
+			t.Errorf("Float80(%v).Sqrt() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestFMA80(t *testing.T) {
+	tests := []struct {
+		x, y, z, want Float80
+	}{
+		{exact80(2), exact80(3), exact80(4), exact80(10)},
+
+		// (1 + 2**-63) * (1 - 2**-64) - 1 = 2**-64 - 2**-127 is not affected by the rounding of the product
+		{
+			NewFloat80FromBits(0x3fff, 0x8000_0000_0000_0001),
+			NewFloat80FromBits(0x3ffe, 0xffff_ffff_ffff_ffff),
+			exact80(-1),
+			NewFloat80FromBits(0x3fbe, 0xffff_ffff_ffff_fffe),
+		},
+	}
+
+	for _, tt := range tests {
+		got := FMA80(tt.x, tt.y, tt.z)
+		if !eq80(got, tt.want) {
+			t.Errorf("FMA80(%v, %v, %v) = %v, want %v", tt.x, tt.y, tt.z, got, tt.want)
+		}
+	}
+}
+
+func TestFloat80_InvalidOperands(t *testing.T) {
+	invalids := []Float80{
+		NewFloat80FromBits(0x7fff, 0x0000_0000_0000_0000), // pseudo-infinity
+		NewFloat80FromBits(0xffff, 0x0000_0000_0000_0001), // pseudo-NaN
+		NewFloat80FromBits(0x3fff, 0x4000_0000_0000_0000), // unnormal
+	}
+
+	for _, a := range invalids {
+		results := []Float80{
+			a.Add(exact80(1)),
+			exact80(1).Mul(a),
+			a.Quo(exact80(1)),
+			a.Sqrt(),
+		}
+		for _, got := range results {
+			if got != uvnan80 {
+				t.Errorf("operation on %x = %x, want the default NaN", a, got)
+			}
+		}
+		if a.Eq(a) || a.Lt(exact80(1)) || a.Ge(exact80(1)) {
+			t.Errorf("%x is ordered", a)
+		}
+	}
+}
+
+func TestFloat80_Compare(t *testing.T) {
+	pseudo := NewFloat80FromBits(0x0000, 0x8000_0000_0000_0000)
+	minNormal := NewFloat80FromBits(0x0001, 0x8000_0000_0000_0000)
+	maxDenormal := NewFloat80FromBits(0x0000, 0x7fff_ffff_ffff_ffff)
+
+	if !pseudo.Eq(minNormal) {
+		t.Errorf("%x != %x", pseudo, minNormal)
+	}
+	if !maxDenormal.Lt(pseudo) || !pseudo.Gt(maxDenormal) {
+		t.Errorf("%x >= %x", maxDenormal, pseudo)
+	}
+	if !exact80(0).Eq(exact80(math.Copysign(0, -1))) {
+		t.Errorf("0 != -0")
+	}
+	if !exact80(-1).Le(exact80(-1)) || !exact80(-1).Lt(exact80(0)) || exact80(-1).Ge(exact80(0)) {
+		t.Errorf("-1 compares wrongly with 0")
+	}
+	if NewFloat80NaN().Eq(NewFloat80NaN()) || !NewFloat80NaN().Ne(NewFloat80NaN()) {
+		t.Errorf("NaN == NaN")
+	}
+}
+
+func TestNewFloat80FromInt(t *testing.T) {
+	// the integers of 64 bits are exact, and the larger ones are rounded ties to even.
+	one := ints.Uint256{0, 0, 0, 1}
+	tests := []struct {
+		in   ints.Uint256
+		want ints.Uint256
+	}{
+		{ints.Uint256{}, ints.Uint256{}},
+		{one, one},
+		{one.Lsh(64).Sub(one), one.Lsh(64).Sub(one)},
+		{one.Lsh(64).Add(one), one.Lsh(64)},
+		{one.Lsh(64).Add(ints.Uint256{0, 0, 0, 3}), one.Lsh(64).Add(ints.Uint256{0, 0, 0, 4})},
+	}
+
+	for _, tt := range tests {
+		got := NewFloat80FromUint256(tt.in)
+		want, _ := NewFloat80FromBigInt(bigIntFromUint256(new(big.Int), tt.want))
+		if got != want {
+			t.Errorf("NewFloat80FromUint256(%x) = %v, want %v", tt.in, got, want)
+		}
+	}
+	// 2**256 - 1 rounds up to 2**256.
+	if got, want := NewFloat80FromUint256(ints.Uint256{}.Sub(one)), NewFloat80(0x1p256); got != want {
+		t.Errorf("NewFloat80FromUint256(2**256-1) = %v, want %v", got, want)
+	}
+	if got, want := NewFloat80FromInt64(math.MinInt64), NewFloat80(-0x1p63); got != want {
+		t.Errorf("NewFloat80FromInt64(math.MinInt64) = %v, want %v", got, want)
+	}
+
+	// compare with the conversion from math/big.
+	r := rand.New(rand.NewPCG(11, 64))
+	for range 1000 {
+		v := newRandInts(r)
+		got := []Float80{
+			NewFloat80FromInt64(v.i64),
+			NewFloat80FromUint64(v.u64),
+			NewFloat80FromInt128(v.i128),
+			NewFloat80FromUint128(v.u128),
+			NewFloat80FromInt256(v.i256),
+			NewFloat80FromUint256(v.u256),
+		}
+		for i, x := range v.big {
+			if want, _ := NewFloat80FromBigInt(x); got[i] != want {
+				t.Errorf("NewFloat80FromXxx(%s) = %v, want %v", x, got[i], want)
+			}
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package floats
+
+import (
+	"math/bits"
+)
+
+const (
+	uvnanE4M3    = 0x7f      // NaN value for Float8E4M3
+	uvmaxE4M3    = 0x7e      // Largest finite value for Float8E4M3
+	uvoneE4M3    = 0x38      // One value for Float8E4M3
+	maskE4M3     = 0xf       // mask for exponent
+	shiftE4M3    = 8 - 4 - 1 // shift for exponent
+	biasE4M3     = 7         // bias for exponent
+	signMaskE4M3 = 1 << 7    // mask for sign bit
+	fracMaskE4M3 = 1<<shiftE4M3 - 1
+)
+
+// Float8E4M3 is an 8-bit floating-point number in the E4M3 format
+// of the OCP 8-bit Floating Point Specification (OFP8).
+//
+// It has 1 sign bit, 4 exponent bits, and 3 fraction bits.
+// Unlike IEEE 754 formats, it has no infinities,
+// and the only NaN encodings are S.1111.111.
+// The exponent 1111 is used for normal numbers, so the largest finite value is 448.
+type Float8E4M3 uint8
+
+// NewFloat8E4M3 converts f to Float8E4M3.
+// Values that are too large to be represented, including infinities, are converted to NaN.
+func NewFloat8E4M3(f float64) Float8E4M3 {
+	return Float64(f).Float8E4M3()
+}
+
+// NewFloat8E4M3FromBits converts the OFP8 binary representation b to Float8E4M3.
+func NewFloat8E4M3FromBits(b uint8) Float8E4M3 {
+	return Float8E4M3(b)
+}
+
+// NewFloat8E4M3NaN returns a NaN Float8E4M3 value.
+func NewFloat8E4M3NaN() Float8E4M3 {
+	return uvnanE4M3
+}
+
+// Bits returns the OFP8 binary representation of a.
+func (a Float8E4M3) Bits() uint8 {
+	return uint8(a)
+}
+
+// IsNaN reports whether a is a “not-a-number” value.
+func (a Float8E4M3) IsNaN() bool {
+	return a&^signMaskE4M3 == uvnanE4M3
+}
+
+// nanE4M3 returns the NaN with the sign.
+// Float8E4M3 has no room for NaN payloads.
+func nanE4M3(neg bool) Float8E4M3 {
+	if neg {
+		return uvnanE4M3 | signMaskE4M3
+	}
+	return uvnanE4M3
+}
+
+// IsInf reports whether a is an infinity.
+// It always returns false because Float8E4M3 has no infinities.
+func (a Float8E4M3) IsInf(sign int) bool {
+	return false
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (a Float8E4M3) Signbit() bool {
+	return a&signMaskE4M3 != 0
+}
+
+// Copysign returns a value with the magnitude of a
+// and the sign of sign.
+func (a Float8E4M3) Copysign(sign Float8E4M3) Float8E4M3 {
+	return (a &^ signMaskE4M3) | (sign & signMaskE4M3)
+}
+
+// IsZero reports whether a is zero (+0 or -0).
+func (a Float8E4M3) IsZero() bool {
+	return a&^signMaskE4M3 == 0
+}
+
+// Neg returns the negation of a.
+func (a Float8E4M3) Neg() Float8E4M3 {
+	return a ^ signMaskE4M3
+}
+
+// Abs returns the absolute value of a.
+//
+// Special cases:
+//
+//	Abs(NaN) = NaN
+func (a Float8E4M3) Abs() Float8E4M3 {
+	return a &^ signMaskE4M3
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a Float8E4M3) Eq(b Float8E4M3) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	if a == b {
+		// a and b have the same bit pattern.
+		return true
+	}
+
+	// check -0 == 0
+	return (a|b)&^signMaskE4M3 == 0
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a Float8E4M3) Ne(b Float8E4M3) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a Float8E4M3) Lt(b Float8E4M3) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.comparable() < b.comparable()
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a Float8E4M3) Gt(b Float8E4M3) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a Float8E4M3) Le(b Float8E4M3) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.comparable() <= b.comparable()
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a Float8E4M3) Ge(b Float8E4M3) bool {
+	return b.Le(a)
+}
+
+// normalize returns the sign, exponent, and normalized fraction of a.
+func (a Float8E4M3) normalize() (sign uint8, exp int, frac uint8) {
+	sign = uint8(a & signMaskE4M3)
+	exp = int((a>>shiftE4M3)&maskE4M3) - biasE4M3
+	frac = uint8(a & fracMaskE4M3)
+
+	if exp == -biasE4M3 {
+		// a is subnormal
+		// normalize
+		l := bits.Len8(frac)
+		frac <<= uint(shiftE4M3 + 1 - l)
+		exp = l - (biasE4M3 + shiftE4M3)
+		return
+	}
+
+	// a is normal
+	frac |= 1 << shiftE4M3
+	return
+}
+
+func (a Float8E4M3) split() (sign uint8, exp int, frac uint8) {
+	sign = uint8(a & signMaskE4M3)
+	exp = int((a>>shiftE4M3)&maskE4M3) - biasE4M3
+	frac = uint8(a & fracMaskE4M3)
+
+	if exp == -biasE4M3 {
+		// a is subnormal
+		exp++
+	} else {
+		// a is normal
+		frac |= 1 << shiftE4M3
+	}
+	return
+}
+
+// comparable converts a to a comparable form.
+func (a Float8E4M3) comparable() int8 {
+	i := int8(a)
+	i ^= (i >> 7) & 0x7f
+	i += int8(a >> 7) // normalize -0 to 0
+	return i
+}
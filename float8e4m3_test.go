@@ -0,0 +1,116 @@
+package floats
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestFloat8E4M3_IsNaN(t *testing.T) {
+	tests := []struct {
+		in   Float8E4M3
+		want bool
+	}{
+		{0x00, false}, // +0
+		{0x80, false}, // -0
+		{0x38, false}, // 1
+		{0x7e, false}, // 448, the largest finite value
+		{0x78, false}, // 256, the exponent 1111 is used for normal numbers
+		{0x7f, true},  // NaN
+		{0xff, true},  // -NaN
+	}
+	for _, tt := range tests {
+		if got := tt.in.IsNaN(); got != tt.want {
+			t.Errorf("Float8E4M3(%x).IsNaN() = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFloat8E4M3_Signbit(t *testing.T) {
+	tests := []struct {
+		in   Float8E4M3
+		want bool
+	}{
+		{0x00, false},
+		{0x80, true},
+		{0x38, false},
+		{0xb8, true},
+		{0x7f, false},
+		{0xff, true},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Signbit(); got != tt.want {
+			t.Errorf("Float8E4M3(%x).Signbit() = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFloat8E4M3_Neg(t *testing.T) {
+	tests := []struct {
+		in, want Float8E4M3
+	}{
+		{0x00, 0x80},
+		{0x80, 0x00},
+		{0x38, 0xb8},
+		{0xfe, 0x7e},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Neg(); got != tt.want {
+			t.Errorf("Float8E4M3(%x).Neg() = %x, want %x", tt.in, got, tt.want)
+		}
+		if got := tt.in.Abs(); got != tt.want&^signMaskE4M3 {
+			t.Errorf("Float8E4M3(%x).Abs() = %x, want %x", tt.in, got, tt.want&^signMaskE4M3)
+		}
+	}
+}
+
+func TestFloat8E4M3_Eq(t *testing.T) {
+	tests := []struct {
+		a, b Float8E4M3
+		want bool
+	}{
+		{0x38, 0x38, true},            // 1.0 == 1.0
+		{0x38, 0x00, false},           // 1.0 == 0.0
+		{0x00, 0x80, true},            // 0.0 == -0.0
+		{uvnanE4M3, uvnanE4M3, false}, // NaN == NaN
+	}
+	for _, tt := range tests {
+		if got := tt.a.Eq(tt.b); got != tt.want {
+			t.Errorf("Float8E4M3(%x).Eq(%x) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+		if got := tt.a.Ne(tt.b); got == tt.want {
+			t.Errorf("Float8E4M3(%x).Ne(%x) = %v, want %v", tt.a, tt.b, got, !tt.want)
+		}
+	}
+}
+
+func TestFloat8E4M3_Compare(t *testing.T) {
+	// compare all pairs with the results of float64
+	for i := range 256 {
+		for j := range 256 {
+			a, b := Float8E4M3(i), Float8E4M3(j)
+			fa, fb := a.Float64().BuiltIn(), b.Float64().BuiltIn()
+			if got, want := a.Eq(b), fa == fb; got != want {
+				t.Errorf("Float8E4M3(%x).Eq(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Lt(b), fa < fb; got != want {
+				t.Errorf("Float8E4M3(%x).Lt(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Le(b), fa <= fb; got != want {
+				t.Errorf("Float8E4M3(%x).Le(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Gt(b), fa > fb; got != want {
+				t.Errorf("Float8E4M3(%x).Gt(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Ge(b), fa >= fb; got != want {
+				t.Errorf("Float8E4M3(%x).Ge(%x) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat8E4M3_Lt(b *testing.B) {
+	f := Float8E4M3(0x38) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Lt(f))
+	}
+}
@@ -0,0 +1,239 @@
+package floats
+
+import (
+	"math/bits"
+
+	"github.com/shogo82148/ints"
+)
+
+const (
+	uvnanE5M2    = 0x7e      // NaN value for Float8E5M2
+	uvinfE5M2    = 0x7c      // Infinity value for Float8E5M2
+	uvneginfE5M2 = 0xfc      // Negative Infinity value for Float8E5M2
+	uvmaxE5M2    = 0x7b      // Largest finite value for Float8E5M2
+	uvoneE5M2    = 0x3c      // One value for Float8E5M2
+	maskE5M2     = 0x1f      // mask for exponent
+	shiftE5M2    = 8 - 5 - 1 // shift for exponent
+	biasE5M2     = 15        // bias for exponent
+	signMaskE5M2 = 1 << 7    // mask for sign bit
+	fracMaskE5M2 = 1<<shiftE5M2 - 1
+
+	quietMaskE5M2   = 1 << (shiftE5M2 - 1) // mask for the quiet bit of NaN
+	payloadMaskE5M2 = quietMaskE5M2 - 1    // mask for the payload of NaN
+)
+
+// Float8E5M2 is an 8-bit floating-point number in the E5M2 format
+// of the OCP 8-bit Floating Point Specification (OFP8).
+//
+// It has 1 sign bit, 5 exponent bits, and 2 fraction bits.
+// It follows the IEEE 754 conventions for infinities and NaNs,
+// and it has the same bit layout as the upper half of Float16.
+type Float8E5M2 uint8
+
+// NewFloat8E5M2 converts f to Float8E5M2.
+func NewFloat8E5M2(f float64) Float8E5M2 {
+	return Float64(f).Float8E5M2()
+}
+
+// NewFloat8E5M2FromBits converts the OFP8 binary representation b to Float8E5M2.
+func NewFloat8E5M2FromBits(b uint8) Float8E5M2 {
+	return Float8E5M2(b)
+}
+
+// NewFloat8E5M2NaN returns a NaN Float8E5M2 value.
+func NewFloat8E5M2NaN() Float8E5M2 {
+	return uvnanE5M2
+}
+
+// NewFloat8E5M2Inf positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewFloat8E5M2Inf(sign int) Float8E5M2 {
+	if sign >= 0 {
+		return uvinfE5M2
+	}
+	return uvneginfE5M2
+}
+
+// Bits returns the OFP8 binary representation of a.
+func (a Float8E5M2) Bits() uint8 {
+	return uint8(a)
+}
+
+// IsNaN reports whether a is an IEEE 754 “not-a-number” value.
+func (a Float8E5M2) IsNaN() bool {
+	return a&(maskE5M2<<shiftE5M2) == (maskE5M2<<shiftE5M2) && a&fracMaskE5M2 != 0
+}
+
+// IsSignalingNaN reports whether a is a signaling NaN.
+// The most significant bit of the fraction is zero in signaling NaNs.
+func (a Float8E5M2) IsSignalingNaN() bool {
+	return a.IsNaN() && a&quietMaskE5M2 == 0
+}
+
+// nanPayload returns the payload of a, which must be NaN.
+func (a Float8E5M2) nanPayload() ints.Uint256 {
+	return ints.Uint256{0, 0, 0, uint64(a & payloadMaskE5M2)}
+}
+
+// nanE5M2 returns a quiet NaN with the sign and the payload.
+// If the payload does not fit in Float8E5M2, it is replaced by zero.
+func nanE5M2(neg bool, payload ints.Uint256) Float8E5M2 {
+	ret := Float8E5M2(uvnanE5M2)
+	if payload.BitLen() < shiftE5M2 {
+		ret |= Float8E5M2(payload[3])
+	}
+	if neg {
+		ret |= signMaskE5M2
+	}
+	return ret
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a Float8E5M2) IsInf(sign int) bool {
+	return sign >= 0 && a == uvinfE5M2 || sign <= 0 && a == uvneginfE5M2
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (a Float8E5M2) Signbit() bool {
+	return a&signMaskE5M2 != 0
+}
+
+// Copysign returns a value with the magnitude of a
+// and the sign of sign.
+func (a Float8E5M2) Copysign(sign Float8E5M2) Float8E5M2 {
+	return (a &^ signMaskE5M2) | (sign & signMaskE5M2)
+}
+
+// IsZero reports whether a is zero (+0 or -0).
+func (a Float8E5M2) IsZero() bool {
+	return a&^signMaskE5M2 == 0
+}
+
+// Neg returns the negation of a.
+func (a Float8E5M2) Neg() Float8E5M2 {
+	return a ^ signMaskE5M2
+}
+
+// Abs returns the absolute value of a.
+//
+// Special cases:
+//
+//	Abs(±Inf) = +Inf
+//	Abs(NaN) = NaN
+func (a Float8E5M2) Abs() Float8E5M2 {
+	return a &^ signMaskE5M2
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a Float8E5M2) Eq(b Float8E5M2) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	if a == b {
+		// a and b have the same bit pattern.
+		return true
+	}
+
+	// check -0 == 0
+	return (a|b)&^signMaskE5M2 == 0
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a Float8E5M2) Ne(b Float8E5M2) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a Float8E5M2) Lt(b Float8E5M2) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.comparable() < b.comparable()
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a Float8E5M2) Gt(b Float8E5M2) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a Float8E5M2) Le(b Float8E5M2) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return a.comparable() <= b.comparable()
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a Float8E5M2) Ge(b Float8E5M2) bool {
+	return b.Le(a)
+}
+
+// normalize returns the sign, exponent, and normalized fraction of a.
+func (a Float8E5M2) normalize() (sign uint8, exp int, frac uint8) {
+	sign = uint8(a & signMaskE5M2)
+	exp = int((a>>shiftE5M2)&maskE5M2) - biasE5M2
+	frac = uint8(a & fracMaskE5M2)
+
+	if exp == -biasE5M2 {
+		// a is subnormal
+		// normalize
+		l := bits.Len8(frac)
+		frac <<= uint(shiftE5M2 + 1 - l)
+		exp = l - (biasE5M2 + shiftE5M2)
+		return
+	}
+
+	// a is normal
+	frac |= 1 << shiftE5M2
+	return
+}
+
+func (a Float8E5M2) split() (sign uint8, exp int, frac uint8) {
+	sign = uint8(a & signMaskE5M2)
+	exp = int((a>>shiftE5M2)&maskE5M2) - biasE5M2
+	frac = uint8(a & fracMaskE5M2)
+
+	if exp == -biasE5M2 {
+		// a is subnormal
+		exp++
+	} else {
+		// a is normal
+		frac |= 1 << shiftE5M2
+	}
+	return
+}
+
+// comparable converts a to a comparable form.
+func (a Float8E5M2) comparable() int8 {
+	i := int8(a)
+	i ^= (i >> 7) & 0x7f
+	i += int8(a >> 7) // normalize -0 to 0
+	return i
+}
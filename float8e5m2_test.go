@@ -0,0 +1,118 @@
+package floats
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestFloat8E5M2_IsNaN(t *testing.T) {
+	tests := []struct {
+		in   Float8E5M2
+		want bool
+	}{
+		{0x00, false}, // +0
+		{0x80, false}, // -0
+		{0x3c, false}, // 1
+		{0x7b, false}, // 57344, the largest finite value
+		{0x7c, false}, // +Inf
+		{0xfc, false}, // -Inf
+		{0x7d, true},  // signaling NaN
+		{0x7e, true},  // quiet NaN
+		{0xff, true},  // -NaN
+	}
+	for _, tt := range tests {
+		if got := tt.in.IsNaN(); got != tt.want {
+			t.Errorf("Float8E5M2(%x).IsNaN() = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFloat8E5M2_IsSignalingNaN(t *testing.T) {
+	tests := []struct {
+		in   Float8E5M2
+		want bool
+	}{
+		{0x7c, false}, // +Inf
+		{0x7d, true},  // signaling NaN
+		{0x7e, false}, // quiet NaN
+		{0x7f, false}, // quiet NaN with payload
+	}
+	for _, tt := range tests {
+		if got := tt.in.IsSignalingNaN(); got != tt.want {
+			t.Errorf("Float8E5M2(%x).IsSignalingNaN() = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFloat8E5M2_IsInf(t *testing.T) {
+	tests := []struct {
+		in   Float8E5M2
+		sign int
+		want bool
+	}{
+		{0x7c, 0, true},
+		{0xfc, 0, true},
+		{0x7c, 1, true},
+		{0xfc, 1, false},
+		{0x7c, -1, false},
+		{0xfc, -1, true},
+		{0x7b, 0, false},
+		{0x7e, 0, false},
+	}
+	for _, tt := range tests {
+		if got := tt.in.IsInf(tt.sign); got != tt.want {
+			t.Errorf("Float8E5M2(%x).IsInf(%d) = %v, want %v", tt.in, tt.sign, got, tt.want)
+		}
+	}
+}
+
+func TestFloat8E5M2_Neg(t *testing.T) {
+	tests := []struct {
+		in, want Float8E5M2
+	}{
+		{0x00, 0x80},
+		{0x80, 0x00},
+		{0x3c, 0xbc},
+		{0xfc, 0x7c},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Neg(); got != tt.want {
+			t.Errorf("Float8E5M2(%x).Neg() = %x, want %x", tt.in, got, tt.want)
+		}
+		if got := tt.in.Abs(); got != tt.want&^signMaskE5M2 {
+			t.Errorf("Float8E5M2(%x).Abs() = %x, want %x", tt.in, got, tt.want&^signMaskE5M2)
+		}
+	}
+}
+
+func TestFloat8E5M2_Compare(t *testing.T) {
+	// compare all pairs with the results of float64
+	for i := range 256 {
+		for j := range 256 {
+			a, b := Float8E5M2(i), Float8E5M2(j)
+			fa, fb := a.Float64().BuiltIn(), b.Float64().BuiltIn()
+			if got, want := a.Eq(b), fa == fb; got != want {
+				t.Errorf("Float8E5M2(%x).Eq(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Lt(b), fa < fb; got != want {
+				t.Errorf("Float8E5M2(%x).Lt(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Le(b), fa <= fb; got != want {
+				t.Errorf("Float8E5M2(%x).Le(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Gt(b), fa > fb; got != want {
+				t.Errorf("Float8E5M2(%x).Gt(%x) = %v, want %v", a, b, got, want)
+			}
+			if got, want := a.Ge(b), fa >= fb; got != want {
+				t.Errorf("Float8E5M2(%x).Ge(%x) = %v, want %v", a, b, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkFloat8E5M2_Lt(b *testing.B) {
+	f := Float8E5M2(0x3c) // 1.0
+	for b.Loop() {
+		runtime.KeepAlive(f.Lt(f))
+	}
+}
@@ -0,0 +1,58 @@
+package floats
+
+import "math"
+
+// Floor returns the greatest integer value less than or equal to a.
+//
+// Special cases are:
+//
+//	±0.Floor() = ±0
+//	±Inf.Floor() = ±Inf
+//	NaN.Floor() = NaN
+func (a BFloat16) Floor() BFloat16 {
+	return NewBFloat16(math.Floor(a.Float64().BuiltIn()))
+}
+
+// Ceil returns the least integer value greater than or equal to a.
+//
+// Special cases are:
+//
+//	±0.Ceil() = ±0
+//	±Inf.Ceil() = ±Inf
+//	NaN.Ceil() = NaN
+func (a BFloat16) Ceil() BFloat16 {
+	return NewBFloat16(math.Ceil(a.Float64().BuiltIn()))
+}
+
+// Trunc returns the integer value of a.
+//
+// Special cases are:
+//
+//	±0.Trunc() = ±0
+//	±Inf.Trunc() = ±Inf
+//	NaN.Trunc() = NaN
+func (a BFloat16) Trunc() BFloat16 {
+	return NewBFloat16(math.Trunc(a.Float64().BuiltIn()))
+}
+
+// Round returns the nearest integer, rounding half away from zero.
+//
+// Special cases are:
+//
+//	±0.Round() = ±0
+//	±Inf.Round() = ±Inf
+//	NaN.Round() = NaN
+func (a BFloat16) Round() BFloat16 {
+	return NewBFloat16(math.Round(a.Float64().BuiltIn()))
+}
+
+// RoundToEven returns the nearest integer, rounding ties to even.
+//
+// Special cases are:
+//
+//	±0.RoundToEven() = ±0
+//	±Inf.RoundToEven() = ±Inf
+//	NaN.RoundToEven() = NaN
+func (a BFloat16) RoundToEven() BFloat16 {
+	return NewBFloat16(math.RoundToEven(a.Float64().BuiltIn()))
+}
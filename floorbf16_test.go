@@ -0,0 +1,140 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Floor(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(3.0), exactBF16(3)},
+		{exactBF16(3.5), exactBF16(3)},
+		{exactBF16(-3.0), exactBF16(-3)},
+		{exactBF16(-3.5), exactBF16(-4)},
+
+		// Special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Floor()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Floor(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Ceil(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(3.0), exactBF16(3)},
+		{exactBF16(3.5), exactBF16(4)},
+		{exactBF16(-3.0), exactBF16(-3)},
+		{exactBF16(-3.5), exactBF16(-3)},
+
+		// Special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Ceil()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Ceil(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Trunc(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(3.0), exactBF16(3)},
+		{exactBF16(3.5), exactBF16(3)},
+		{exactBF16(-3.0), exactBF16(-3)},
+		{exactBF16(-3.5), exactBF16(-3)},
+
+		// Special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Trunc()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Trunc(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Round(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(3.0), exactBF16(3)},
+		{exactBF16(3.5), exactBF16(4)},
+		{exactBF16(4.5), exactBF16(5)},
+		{exactBF16(-3.0), exactBF16(-3)},
+		{exactBF16(-3.5), exactBF16(-4)},
+		{exactBF16(-4.5), exactBF16(-5)},
+
+		// Special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Round()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.Round(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_RoundToEven(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(3.0), exactBF16(3)},
+		{exactBF16(3.5), exactBF16(4)},
+		{exactBF16(4.5), exactBF16(4)},
+		{exactBF16(-3.0), exactBF16(-3)},
+		{exactBF16(-3.5), exactBF16(-4)},
+		{exactBF16(-4.5), exactBF16(-4)},
+
+		// Special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.RoundToEven()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("BFloat16.RoundToEven(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
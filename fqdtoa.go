@@ -0,0 +1,69 @@
+package floats
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+var _ fmt.Formatter = QuadDouble{}
+
+// Format implements [fmt.Formatter].
+func (a QuadDouble) Format(s fmt.State, verb rune) {
+	format(a, s, verb)
+}
+
+var _ fmt.Stringer = QuadDouble{}
+
+// String returns the string representation of a.
+func (a QuadDouble) String() string {
+	return a.Text('g', -1)
+}
+
+// Text returns the string representation of a in the given format and precision.
+func (a QuadDouble) Text(fmt byte, prec int) string {
+	return string(a.Append(make([]byte, 0, 80), fmt, prec))
+}
+
+// Append appends the string representation of a in the given format and precision to buf and returns the extended buffer.
+//
+// The formats are the same as [Float256.Append] of the sum of the components,
+// except that the precision -1 uses the smallest number of digits
+// necessary to parse back to the same components by [ParseQuadDouble].
+func (a QuadDouble) Append(dst []byte, fmt byte, prec int) []byte {
+	// special numbers
+	switch {
+	case a.IsNaN():
+		return append(dst, "NaN"...)
+	case a.IsInf(1):
+		return append(dst, "+Inf"...)
+	case a.IsInf(-1):
+		return append(dst, "-Inf"...)
+	}
+
+	return appendComponents(dst, a.Float256(), a[:], fmt, prec)
+}
+
+var _ json.Marshaler = QuadDouble{}
+
+// MarshalJSON implements [json.Marshaler].
+func (a QuadDouble) MarshalJSON() ([]byte, error) {
+	if a.IsNaN() || a.IsInf(0) {
+		return nil, fmt.Errorf("floats: cannot marshal %v to JSON", a)
+	}
+	return a.Append(nil, 'g', -1), nil
+}
+
+var _ encoding.TextMarshaler = QuadDouble{}
+
+// MarshalText implements [encoding.TextMarshaler].
+func (a QuadDouble) MarshalText() ([]byte, error) {
+	return a.Append(nil, 'g', -1), nil
+}
+
+var _ encoding.TextAppender = QuadDouble{}
+
+// AppendText implements [encoding.TextAppender].
+func (a QuadDouble) AppendText(dst []byte) ([]byte, error) {
+	return a.Append(dst, 'g', -1), nil
+}
@@ -0,0 +1,166 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestQuadDouble_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		x      QuadDouble
+		want   string
+	}{
+		// verb "%f"
+		{"%f", NewQuadDouble(0.5), "0.5"},
+		{"%+f", NewQuadDouble(0.5), "+0.5"},
+		{"%8f", NewQuadDouble(0.5), "     0.5"},
+		{"%.2f", NewQuadDouble(0.5), "0.50"},
+
+		// verb "%e"
+		{"%.6e", NewQuadDouble(0.5), "5.000000e-01"},
+
+		// verb "%g"
+		{"%g", QuadDouble{1, 0x1p-80, 0, 0}, "1.0000000000000000000000008271806125530276748714086920699628535658121109"},
+		{"%.1g", NewQuadDouble(0.25), "0.2"},
+
+		// verb "%x"
+		{"%x", QuadDouble{1, 0x1p-80, 0, 0}, "0x1.00000000000000000001p+00"},
+
+		// verb "%v"
+		{"%v", NewQuadDouble(0.5), "0.5"},
+		{"%v", NewQuadDoubleNaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := fmt.Sprintf(tt.format, tt.x)
+		if got != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
+
+func TestQuadDouble_Text(t *testing.T) {
+	third := NewQuadDouble(1).Quo(NewQuadDouble(3))
+	tests := []struct {
+		x    QuadDouble
+		fmt  byte
+		prec int
+		want string
+	}{
+		{NewQuadDouble(0), 'g', -1, "0"},
+		{NewQuadDouble(math.Copysign(0, -1)), 'g', -1, "-0"},
+		{NewQuadDouble(1), 'g', -1, "1"},
+		{third, 'g', -1, "0.33333333333333333333333333333333333333333333333333333333333333333"},
+		{third, 'e', -1, "3.3333333333333333333333333333333333333333333333333333333333333333e-01"},
+		{third, 'g', 5, "0.33333"},
+		{third, 'e', 10, "3.3333333333e-01"},
+		{third, 'f', 70, "0.3333333333333333333333333333333333333333333333333333333333333333301681"},
+		{third, 'x', -1, "0x1.555555555555555555555555555555555555555555555555555554p-02"},
+		{QuadDouble{0.5, 0x1p-60, 0x1p-120, 0x1p-180}, 'g', -1, "0.5000000000000000008673617379884035479582786252222173748931468306220897"},
+
+		// the last component is zero, so the shortest Float256 representation is used.
+		{NewQuadDouble(0.1), 'g', -1, "0.1000000000000000055511151231257827021181583404541015625"},
+
+		// special values
+		{NewQuadDoubleInf(1), 'g', -1, "+Inf"},
+		{NewQuadDoubleInf(-1), 'g', -1, "-Inf"},
+		{NewQuadDoubleNaN(), 'g', -1, "NaN"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Text(tt.fmt, tt.prec)
+		if got != tt.want {
+			t.Errorf("QuadDouble(%v).Text(%q, %d) = %s, want %s", [4]float64(tt.x), tt.fmt, tt.prec, got, tt.want)
+		}
+	}
+}
+
+func TestQuadDouble_Text_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 212))
+	for range 200 {
+		// 210-bit values
+		exp := r.IntN(1024) - 512
+		x := NewQuadDouble(math.Ldexp(float64(r.Uint64()>>11|1<<52), exp+157))
+		for i := 2; i >= 0; i-- {
+			x = x.Add(NewQuadDouble(math.Ldexp(float64(r.Uint64()>>12), exp+52*i)))
+		}
+		if r.IntN(2) == 0 {
+			x = x.Neg()
+		}
+
+		s := x.Text('g', -1)
+		got, err := ParseQuadDouble(s)
+		if err != nil {
+			t.Errorf("ParseQuadDouble(%q) returned error: %v", s, err)
+			continue
+		}
+		if !eqQD(got, x) {
+			t.Errorf("ParseQuadDouble(%q) = %v, want %v", s, [4]float64(got), [4]float64(x))
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Text(b *testing.B) {
+	x := NewQuadDouble(1).Quo(NewQuadDouble(3))
+	for b.Loop() {
+		x.Text('g', -1)
+	}
+}
+
+func TestQuadDouble_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0), "0"},
+		{NewQuadDouble(1), "1"},
+		{NewQuadDouble(-1), "-1"},
+		{NewQuadDouble(0.5), "0.5"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalJSON()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+
+	// JSON does not support NaN and Inf values.
+	for _, x := range []QuadDouble{NewQuadDoubleNaN(), NewQuadDoubleInf(1), NewQuadDoubleInf(-1)} {
+		if _, err := x.MarshalJSON(); err == nil {
+			t.Errorf("expected error, got nil")
+		}
+	}
+}
+
+func TestQuadDouble_MarshalText(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0), "0"},
+		{NewQuadDouble(-1), "-1"},
+		{NewQuadDouble(0.5), "0.5"},
+		{NewQuadDoubleInf(1), "+Inf"},
+		{NewQuadDoubleInf(-1), "-Inf"},
+		{NewQuadDoubleNaN(), "NaN"},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.x.MarshalText()
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("expected %s, got %s", tt.want, got)
+		}
+	}
+}
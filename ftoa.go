@@ -2,46 +2,43 @@ package floats
 
 import (
 	"fmt"
-	"io"
+	"math"
+	"slices"
 )
 
 type floatN interface {
 	IsNaN() bool
-	Signbit() bool
 	Append(dst []byte, fmt byte, prec int) []byte
 }
 
 func format(x floatN, s fmt.State, verb rune) {
-	if x.IsNaN() {
-		_, _ = io.WriteString(s, "NaN")
-		return
-	}
-
 	var prefix []byte
 	var data []byte
 
-	// sign
-	if !x.Signbit() {
-		if s.Flag('+') {
-			prefix = append(prefix, '+')
-		} else if s.Flag(' ') {
-			prefix = append(prefix, ' ')
-		}
-	}
-
-	switch verb {
-	case 'b':
+	switch {
+	case x.IsNaN():
+		data = append(data, "NaN"...)
+	case verb == 'b':
 		data = x.Append(data, 'b', -1)
-	case 'f', 'e', 'E', 'g', 'G', 'x', 'X':
+	case verb == 'f', verb == 'e', verb == 'E', verb == 'g', verb == 'G', verb == 'x', verb == 'X':
 		if prec, ok := s.Precision(); ok {
 			data = x.Append(data, byte(verb), prec)
 		} else {
 			data = x.Append(data, byte(verb), -1)
 		}
-	case 'v':
+	case verb == 'v':
 		data = x.Append(data, 'g', -1)
 	}
 
+	// sign
+	if len(data) == 0 || (data[0] != '+' && data[0] != '-') {
+		if s.Flag('+') {
+			prefix = append(prefix, '+')
+		} else if s.Flag(' ') {
+			prefix = append(prefix, ' ')
+		}
+	}
+
 	if w, ok := s.Width(); ok {
 		var buf [1]byte
 		if s.Flag('-') {
@@ -194,3 +191,160 @@ func fmtF(dst []byte, neg bool, d *decimal, prec int) []byte {
 
 	return dst
 }
+
+// roundShortestBetween rounds d to the shortest decimal between lower and upper.
+// The bounds are possible outputs only if inclusive is true.
+func roundShortestBetween(d, lower, upper *decimal, inclusive bool) {
+	// As we walk the digits we want to know whether rounding up would fall
+	// within the upper bound. This is tracked by upperdelta:
+	//
+	// If upperdelta == 0, the digits of d and upper are the same so far.
+	//
+	// If upperdelta == 1, we saw a difference of 1 between d and upper on a
+	// previous digit and subsequently only 9s for d and 0s for upper.
+	// (Thus rounding up may fall outside the bound, if it is exclusive.)
+	//
+	// If upperdelta == 2, then the difference is greater than 1
+	// and we know that rounding up falls within the bound.
+	var upperdelta uint8
+
+	// Now we can figure out the minimum number of digits required.
+	// Walk along until d has distinguished itself from upper and lower.
+	for ui := 0; ; ui++ {
+		// lower, d, and upper may have the decimal points at different
+		// places. In this case upper is the longest, so we iterate from
+		// ui==0 and start li and mi at (possibly) -1.
+		mi := ui - upper.dp + d.dp
+		if mi >= d.nd {
+			break
+		}
+		li := ui - upper.dp + lower.dp
+		l := byte('0') // lower digit
+		if li >= 0 && li < lower.nd {
+			l = lower.d[li]
+		}
+		m := byte('0') // middle digit
+		if mi >= 0 {
+			m = d.d[mi]
+		}
+		u := byte('0') // upper digit
+		if ui < upper.nd {
+			u = upper.d[ui]
+		}
+
+		// Okay to round down (truncate) if lower has a different digit
+		// or if lower is inclusive and is exactly the result of rounding
+		// down (i.e., and we have reached the final digit of lower).
+		okdown := l != m || inclusive && li+1 == lower.nd
+
+		switch {
+		case upperdelta == 0 && m+1 < u:
+			// Example:
+			// m = 12345xxx
+			// u = 12347xxx
+			upperdelta = 2
+		case upperdelta == 0 && m != u:
+			// Example:
+			// m = 12345xxx
+			// u = 12346xxx
+			upperdelta = 1
+		case upperdelta == 1 && (m != '9' || u != '0'):
+			// Example:
+			// m = 1234598x
+			// u = 1234600x
+			upperdelta = 2
+		}
+		// Okay to round up if upper has a different digit and either upper
+		// is inclusive or upper is bigger than the result of rounding up.
+		okup := upperdelta > 0 && (inclusive || upperdelta > 1 || ui+1 < upper.nd)
+
+		// If it's okay to do either, then round to the nearest one.
+		// If it's okay to do only one, do it.
+		switch {
+		case okdown && okup:
+			d.Round(mi + 1)
+			return
+		case okdown:
+			d.RoundDown(mi + 1)
+			return
+		case okup:
+			d.RoundUp(mi + 1)
+			return
+		}
+	}
+}
+
+// appendComponents appends the string representation of v,
+// the sum of the non-overlapping float64 components c, to dst.
+//
+// In the shortest representation mode, it uses the smallest number of digits
+// that is parsed back to the same components.
+func appendComponents(dst []byte, v Float256, c []float64, fmt byte, prec int) []byte {
+	switch fmt {
+	case 'f', 'e', 'E', 'g', 'G':
+		if prec < 0 {
+			break
+		}
+		fallthrough
+	default:
+		return v.Append(dst, fmt, prec)
+	}
+
+	// If the last component is zero, a decimal number is parsed back to the same components
+	// only if it is rounded to v in Float256.
+	// Neither can the components be recovered if v is not exact.
+	last := c[len(c)-1]
+	var buf [4]float64
+	split := buf[:len(c)]
+	v.splitFloat64(split)
+	if last == 0 || !slices.Equal(split, c) {
+		return v.Append(dst, fmt, prec)
+	}
+
+	// Otherwise, the decimal number is parsed back to the same components
+	// if it is rounded to a Float256 value between the halfway points
+	// of the last component and its neighbors.
+	neg := v.Signbit()
+	if neg {
+		v = v.Neg()
+		last = -last
+	}
+	half := NewFloat256(0.5)
+	lo := v.Add(NewFloat256(math.Nextafter(last, math.Inf(-1)) - last).Mul(half)).Nextafter(v)
+	hi := v.Add(NewFloat256(math.Nextafter(last, math.Inf(1)) - last).Mul(half)).Nextafter(v)
+	if !lo.Lt(v) || !hi.Gt(v) {
+		// The halfway points are too close to v for Float256.
+		if neg {
+			v = v.Neg()
+		}
+		return v.Append(dst, fmt, prec)
+	}
+
+	bufp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(bufp)
+	d := &decimal{d: bufp[:]}
+	v.assignDecimal(d)
+
+	lowerp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(lowerp)
+	lower := &decimal{d: lowerp[:]}
+	lo.assignDecimal(lower)
+
+	upperp := decimalPool256.Get().(*[decimalDigits256]byte)
+	defer decimalPool256.Put(upperp)
+	upper := &decimal{d: upperp[:]}
+	hi.assignDecimal(upper)
+
+	roundShortestBetween(d, lower, upper, false)
+
+	// Precision for shortest representation mode.
+	switch fmt {
+	case 'e', 'E':
+		prec = d.nd - 1
+	case 'f':
+		prec = max(d.nd-d.dp, 0)
+	case 'g', 'G':
+		prec = d.nd
+	}
+	return formatDigits(dst, neg, d, true, prec, fmt)
+}
@@ -0,0 +1,17 @@
+package floats
+
+import "math"
+
+// Gamma returns the Gamma function of a.
+//
+// Special cases are:
+//
+//	+Inf.Gamma() = +Inf
+//	+0.Gamma() = +Inf
+//	-0.Gamma() = -Inf
+//	x.Gamma() = NaN for integer x < 0
+//	-Inf.Gamma() = NaN
+//	NaN.Gamma() = NaN
+func (a BFloat16) Gamma() BFloat16 {
+	return NewBFloat16(math.Gamma(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,49 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Gamma(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-0.5), math.Gamma(-0.5)},
+		{exactBF16(0.5), math.Gamma(0.5)},
+		{exactBF16(1), math.Gamma(1)},
+		{exactBF16(1.5), math.Gamma(1.5)},
+		{exactBF16(2), math.Gamma(2)},
+		{exactBF16(2.5), math.Gamma(2.5)},
+		{exactBF16(3), math.Gamma(3)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Gamma()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Gamma(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(-1))},
+		{exactBF16(-1), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Gamma()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Gamma(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
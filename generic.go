@@ -0,0 +1,95 @@
+package floats
+
+// Float is the set of binary floating-point types with arithmetic operations.
+// The type parameter T is the type itself, so that generic code can call the methods:
+//
+//	func Square[T floats.Float[T]](x T) T {
+//		return x.Mul(x)
+//	}
+//
+// The zero value of each type is +0.
+type Float[T any] interface {
+	Float16 | BFloat16 | Float32 | Float64 | Float80 | Float128 | Float256
+
+	Add(b T) T
+	Sub(b T) T
+	Mul(b T) T
+	Quo(b T) T
+	Sqrt() T
+	Neg() T
+	Abs() T
+
+	Eq(b T) bool
+	Ne(b T) bool
+	Lt(b T) bool
+	Le(b T) bool
+	Gt(b T) bool
+	Ge(b T) bool
+
+	IsNaN() bool
+	IsInf(sign int) bool
+	IsZero() bool
+	Signbit() bool
+
+	Float64() Float64
+	Float256() Float256
+	String() string
+	Append(dst []byte, fmt byte, prec int) []byte
+}
+
+// FromFloat64 converts f to T, rounding to nearest even.
+func FromFloat64[T Float[T]](f float64) T {
+	return FromFloat256[T](NewFloat256(f))
+}
+
+// FromInt64 converts x to T, rounding to nearest even.
+func FromInt64[T Float[T]](x int64) T {
+	return FromFloat256[T](NewFloat256FromInt64(x))
+}
+
+// FromFloat256 converts x to T, rounding to nearest even.
+// Every value of T converts to Float256 exactly,
+// so it can be used to convert between two generic types.
+func FromFloat256[T Float[T]](x Float256) T {
+	var ret T
+	switch p := any(&ret).(type) {
+	case *Float16:
+		*p = x.Float16()
+	case *BFloat16:
+		*p = x.BFloat16()
+	case *Float32:
+		*p = x.Float32()
+	case *Float64:
+		*p = x.Float64()
+	case *Float80:
+		*p = x.Float80()
+	case *Float128:
+		*p = x.Float128()
+	case *Float256:
+		*p = x
+	}
+	return ret
+}
+
+// FMA returns x * y + z, computed with only one rounding.
+// It calls [FMA16], [FMABF16], [FMA32], [FMA64], [FMA80], [FMA128] or [FMA256] according to T.
+func FMA[T Float[T]](x, y, z T) T {
+	var ret T
+	switch p := any(&ret).(type) {
+	case *Float16:
+		*p = FMA16(any(x).(Float16), any(y).(Float16), any(z).(Float16))
+	case *BFloat16:
+		*p = FMABF16(any(x).(BFloat16), any(y).(BFloat16), any(z).(BFloat16))
+	case *Float32:
+		*p = FMA32(any(x).(Float32), any(y).(Float32), any(z).(Float32))
+	case *Float64:
+		*p = FMA64(any(x).(Float64), any(y).(Float64), any(z).(Float64))
+	case *Float80:
+		*p = FMA80(any(x).(Float80), any(y).(Float80), any(z).(Float80))
+	case *Float128:
+		*p = FMA128(any(x).(Float128), any(y).(Float128), any(z).(Float128))
+	case *Float256:
+		*p = FMA256(any(x).(Float256), any(y).(Float256), any(z).(Float256))
+	}
+	return ret
+}
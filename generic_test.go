@@ -0,0 +1,109 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func testFromFloat64[T Float[T]](t *testing.T) {
+	tests := []float64{0, math.Copysign(0, -1), 1, -1.5, 0.1, 1e300, math.Inf(1), math.Inf(-1)}
+	for _, f := range tests {
+		got := FromFloat64[T](f).Float256()
+		want := FromFloat256[T](NewFloat256(f)).Float256()
+		if !eq256(got, want) {
+			t.Errorf("FromFloat64(%v) = %v; want %v", f, got, want)
+		}
+	}
+
+	if got := FromFloat64[T](math.NaN()); !got.IsNaN() {
+		t.Errorf("FromFloat64(NaN) = %v; want NaN", got)
+	}
+}
+
+func TestFromFloat64(t *testing.T) {
+	t.Run("Float16", testFromFloat64[Float16])
+	t.Run("BFloat16", testFromFloat64[BFloat16])
+	t.Run("Float32", testFromFloat64[Float32])
+	t.Run("Float64", testFromFloat64[Float64])
+	t.Run("Float80", testFromFloat64[Float80])
+	t.Run("Float128", testFromFloat64[Float128])
+	t.Run("Float256", testFromFloat64[Float256])
+}
+
+func TestFromFloat256(t *testing.T) {
+	x := Float256Pi()
+	if got, want := FromFloat256[Float16](x), Float16Pi; !eq16(got, want) {
+		t.Errorf("FromFloat256[Float16](Pi) = %v; want %v", got, want)
+	}
+	if got, want := FromFloat256[BFloat16](x), BFloat16Pi; !eqBF16(got, want) {
+		t.Errorf("FromFloat256[BFloat16](Pi) = %v; want %v", got, want)
+	}
+	if got, want := FromFloat256[Float32](x), Float32Pi; !eq32(got, want) {
+		t.Errorf("FromFloat256[Float32](Pi) = %v; want %v", got, want)
+	}
+	if got, want := FromFloat256[Float64](x), Float64Pi; !eq64(got, want) {
+		t.Errorf("FromFloat256[Float64](Pi) = %v; want %v", got, want)
+	}
+	if got, want := FromFloat256[Float80](x), Float80Pi(); !eq80(got, want) {
+		t.Errorf("FromFloat256[Float80](Pi) = %v; want %v", got, want)
+	}
+	if got, want := FromFloat256[Float128](x), Float128Pi(); !eq128(got, want) {
+		t.Errorf("FromFloat256[Float128](Pi) = %v; want %v", got, want)
+	}
+	if got, want := FromFloat256[Float256](x), Float256Pi(); !eq256(got, want) {
+		t.Errorf("FromFloat256[Float256](Pi) = %v; want %v", got, want)
+	}
+}
+
+func testFromInt64[T Float[T]](t *testing.T) {
+	tests := []int64{0, 1, -1, 2049, 1<<53 + 1, math.MaxInt64, math.MinInt64}
+	for _, x := range tests {
+		got := FromInt64[T](x).Float256()
+		want := FromFloat256[T](NewFloat256FromInt64(x)).Float256()
+		if !eq256(got, want) {
+			t.Errorf("FromInt64(%d) = %v; want %v", x, got, want)
+		}
+	}
+}
+
+func TestFromInt64(t *testing.T) {
+	t.Run("Float16", testFromInt64[Float16])
+	t.Run("BFloat16", testFromInt64[BFloat16])
+	t.Run("Float32", testFromInt64[Float32])
+	t.Run("Float64", testFromInt64[Float64])
+	t.Run("Float80", testFromInt64[Float80])
+	t.Run("Float128", testFromInt64[Float128])
+	t.Run("Float256", testFromInt64[Float256])
+}
+
+func testFMA[T Float[T]](t *testing.T) {
+	tests := []struct {
+		x, y, z float64
+	}{
+		{1, 1, 1},
+		{1.5, 2.5, -3.75},
+		{0.1, 10, -1},
+		{math.Inf(1), 0, 1},
+		{-2, 3, math.Copysign(0, -1)},
+	}
+	for _, tt := range tests {
+		x, y, z := FromFloat64[T](tt.x), FromFloat64[T](tt.y), FromFloat64[T](tt.z)
+
+		// x×y+z is rounded only once.
+		want := FromFloat256[T](FMA256(x.Float256(), y.Float256(), z.Float256()))
+		got := FMA(x, y, z)
+		if !eq256(got.Float256(), want.Float256()) {
+			t.Errorf("FMA(%v, %v, %v) = %v; want %v", x, y, z, got, want)
+		}
+	}
+}
+
+func TestFMA(t *testing.T) {
+	t.Run("Float16", testFMA[Float16])
+	t.Run("BFloat16", testFMA[BFloat16])
+	t.Run("Float32", testFMA[Float32])
+	t.Run("Float64", testFMA[Float64])
+	t.Run("Float80", testFMA[Float80])
+	t.Run("Float128", testFMA[Float128])
+	t.Run("Float256", testFMA[Float256])
+}
@@ -0,0 +1,32 @@
+package floats
+
+// HypotBF16 returns [Sqrt](p*p + q*q), taking care to avoid
+// unnecessary overflow and underflow.
+//
+// Special cases are:
+//
+//	HypotBF16(±Inf, q) = +Inf
+//	HypotBF16(p, ±Inf) = +Inf
+//	HypotBF16(NaN, q) = NaN
+//	HypotBF16(p, NaN) = NaN
+func HypotBF16(p, q BFloat16) BFloat16 {
+	p = p.Abs()
+	q = q.Abs()
+
+	// special cases
+	switch {
+	case p.IsInf(1) || q.IsInf(1):
+		return NewBFloat16Inf(1)
+	case p.IsNaN() || q.IsNaN():
+		return NewBFloat16NaN()
+	}
+
+	if p.Lt(q) {
+		p, q = q, p
+	}
+	if p.IsZero() {
+		return 0
+	}
+	q = q.Quo(p)
+	return p.Mul(BFloat16(uvoneBF16).Add(q.Mul(q)).Sqrt())
+}
@@ -0,0 +1,47 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHypotBF16(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		y    BFloat16
+		want float64
+	}{
+		{exactBF16(3), exactBF16(4), 5},
+		{exactBF16(5), exactBF16(12), 13},
+		{exactBF16(1), exactBF16(1), math.Sqrt(2)},
+	}
+
+	for _, tt := range tests {
+		got := HypotBF16(tt.x, tt.y)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("HypotBF16(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		y    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Inf(1)), exactBF16(1), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(1), exactBF16(math.Inf(1))},
+		{exactBF16(1), exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(1), exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := HypotBF16(tt.x, tt.y)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("HypotBF16(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -25,6 +25,9 @@ const (
 
 var count atomic.Int64
 
+// ctx is the context of the operations under test.
+var ctx floats.Context
+
 func showProgress() {
 	start := time.Now()
 	ticker := time.NewTicker(3 * time.Second)
@@ -38,7 +41,14 @@ func main() {
 	go showProgress()
 
 	if len(os.Args) < 2 {
-		log.Fatalf("usage: %s <test-name>", filepath.Base(os.Args[0]))
+		log.Fatalf("usage: %s <test-name> [<rounding-mode>]", filepath.Base(os.Args[0]))
+	}
+	if len(os.Args) >= 3 {
+		mode, err := parseRoundingMode(os.Args[2])
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx.Mode = mode
 	}
 
 	switch os.Args[1] {
@@ -127,19 +137,19 @@ func main() {
 
 	// Float16 operations
 	case "f16_mul":
-		if err := f16x3("Mul", floats.Float16.Mul); err != nil {
+		if err := f16x3("Mul", floats.Float16.Mul, ctx.Mul16); err != nil {
 			log.Fatal(err)
 		}
 	case "f16_div":
-		if err := f16x3("Div", floats.Float16.Quo); err != nil {
+		if err := f16x3("Div", floats.Float16.Quo, ctx.Quo16); err != nil {
 			log.Fatal(err)
 		}
 	case "f16_add":
-		if err := f16x3("Add", floats.Float16.Add); err != nil {
+		if err := f16x3("Add", floats.Float16.Add, ctx.Add16); err != nil {
 			log.Fatal(err)
 		}
 	case "f16_sub":
-		if err := f16x3("Sub", floats.Float16.Sub); err != nil {
+		if err := f16x3("Sub", floats.Float16.Sub, ctx.Sub16); err != nil {
 			log.Fatal(err)
 		}
 	case "f16_sqrt":
@@ -165,19 +175,19 @@ func main() {
 
 	// Float32 operations
 	case "f32_mul":
-		if err := f32x3("Mul", floats.Float32.Mul); err != nil {
+		if err := f32x3("Mul", floats.Float32.Mul, ctx.Mul32); err != nil {
 			log.Fatal(err)
 		}
 	case "f32_div":
-		if err := f32x3("Div", floats.Float32.Quo); err != nil {
+		if err := f32x3("Div", floats.Float32.Quo, ctx.Quo32); err != nil {
 			log.Fatal(err)
 		}
 	case "f32_add":
-		if err := f32x3("Add", floats.Float32.Add); err != nil {
+		if err := f32x3("Add", floats.Float32.Add, ctx.Add32); err != nil {
 			log.Fatal(err)
 		}
 	case "f32_sub":
-		if err := f32x3("Sub", floats.Float32.Sub); err != nil {
+		if err := f32x3("Sub", floats.Float32.Sub, ctx.Sub32); err != nil {
 			log.Fatal(err)
 		}
 	case "f32_sqrt":
@@ -203,19 +213,19 @@ func main() {
 
 	// Float64 operations
 	case "f64_mul":
-		if err := f64x3("Mul", floats.Float64.Mul); err != nil {
+		if err := f64x3("Mul", floats.Float64.Mul, ctx.Mul64); err != nil {
 			log.Fatal(err)
 		}
 	case "f64_div":
-		if err := f64x3("Div", floats.Float64.Quo); err != nil {
+		if err := f64x3("Div", floats.Float64.Quo, ctx.Quo64); err != nil {
 			log.Fatal(err)
 		}
 	case "f64_add":
-		if err := f64x3("Add", floats.Float64.Add); err != nil {
+		if err := f64x3("Add", floats.Float64.Add, ctx.Add64); err != nil {
 			log.Fatal(err)
 		}
 	case "f64_sub":
-		if err := f64x3("Sub", floats.Float64.Sub); err != nil {
+		if err := f64x3("Sub", floats.Float64.Sub, ctx.Sub64); err != nil {
 			log.Fatal(err)
 		}
 	case "f64_sqrt":
@@ -241,19 +251,19 @@ func main() {
 
 	// Float128 operations
 	case "f128_mul":
-		if err := f128x3("Mul", floats.Float128.Mul); err != nil {
+		if err := f128x3("Mul", floats.Float128.Mul, ctx.Mul128); err != nil {
 			log.Fatal(err)
 		}
 	case "f128_div":
-		if err := f128x3("Div", floats.Float128.Quo); err != nil {
+		if err := f128x3("Div", floats.Float128.Quo, ctx.Quo128); err != nil {
 			log.Fatal(err)
 		}
 	case "f128_add":
-		if err := f128x3("Add", floats.Float128.Add); err != nil {
+		if err := f128x3("Add", floats.Float128.Add, ctx.Add128); err != nil {
 			log.Fatal(err)
 		}
 	case "f128_sub":
-		if err := f128x3("Sub", floats.Float128.Sub); err != nil {
+		if err := f128x3("Sub", floats.Float128.Sub, ctx.Sub128); err != nil {
 			log.Fatal(err)
 		}
 	case "f128_sqrt":
@@ -303,11 +313,23 @@ func f16_to_f32() error {
 			return err
 		}
 
-		got := f16.Float32()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f16.Float32()
+			if !eq32(got, f32) {
+				log.Printf("f16: %s, f32: %s", s16, s32)
+				log.Printf("got: %x, want: %x", got, f32)
+				return fmt.Errorf("f16(%x).Float32() = %x, want %x", f16, got, f32)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float32(f16.Float256())
 		if !eq32(got, f32) {
 			log.Printf("f16: %s, f32: %s", s16, s32)
 			log.Printf("got: %x, want: %x", got, f32)
-			return fmt.Errorf("f16(%x).Float32() = %x, want %x", f16, got, f32)
+			return fmt.Errorf("%v: f16(%x).Float32() = %x, want %x", ctx.Mode, f16, got, f32)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f16(%x).Float32(): %w", ctx.Mode, f16, err)
 		}
 		count.Add(1)
 	}
@@ -334,11 +356,23 @@ func f16_to_f64() error {
 			return err
 		}
 
-		got := f16.Float64()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f16.Float64()
+			if !eq64(got, f64) {
+				log.Printf("f16: %s, f64: %s", s16, s64)
+				log.Printf("got: %x, want: %x", got, f64)
+				return fmt.Errorf("f16(%x).Float64() = %x, want %x", f16, got, f64)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float64(f16.Float256())
 		if !eq64(got, f64) {
 			log.Printf("f16: %s, f64: %s", s16, s64)
 			log.Printf("got: %x, want: %x", got, f64)
-			return fmt.Errorf("f16(%x).Float64() = %x, want %x", f16, got, f64)
+			return fmt.Errorf("%v: f16(%x).Float64() = %x, want %x", ctx.Mode, f16, got, f64)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f16(%x).Float64(): %w", ctx.Mode, f16, err)
 		}
 		count.Add(1)
 	}
@@ -365,11 +399,23 @@ func f16_to_f128() error {
 			return err
 		}
 
-		got := f16.Float128()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f16.Float128()
+			if !eq128(got, f128) {
+				log.Printf("f16: %s, f64: %s", s16, s128)
+				log.Printf("got: %x, want: %x", got, f128)
+				return fmt.Errorf("f16(%x).Float128() = %x, want %x", f16, got, f128)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float128(f16.Float256())
 		if !eq128(got, f128) {
 			log.Printf("f16: %s, f64: %s", s16, s128)
 			log.Printf("got: %x, want: %x", got, f128)
-			return fmt.Errorf("f16(%x).Float128() = %x, want %x", f16, got, f128)
+			return fmt.Errorf("%v: f16(%x).Float128() = %x, want %x", ctx.Mode, f16, got, f128)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f16(%x).Float128(): %w", ctx.Mode, f16, err)
 		}
 		count.Add(1)
 	}
@@ -396,11 +442,23 @@ func f32_to_f16() error {
 			return err
 		}
 
-		got := f32.Float16()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f32.Float16()
+			if !eq16(got, f16) {
+				log.Printf("f32: %s, f16: %s", s32, s16)
+				log.Printf("got: %x, want: %x", got, f16)
+				return fmt.Errorf("f32(%x).Float16() = %x, want %x", f32, got, f16)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float16(f32.Float256())
 		if !eq16(got, f16) {
 			log.Printf("f32: %s, f16: %s", s32, s16)
 			log.Printf("got: %x, want: %x", got, f16)
-			return fmt.Errorf("f32(%x).Float16() = %x, want %x", f32, got, f16)
+			return fmt.Errorf("%v: f32(%x).Float16() = %x, want %x", ctx.Mode, f32, got, f16)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f32(%x).Float16(): %w", ctx.Mode, f32, err)
 		}
 		count.Add(1)
 	}
@@ -427,11 +485,23 @@ func f32_to_f64() error {
 			return err
 		}
 
-		got := f32.Float64()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f32.Float64()
+			if !eq64(got, f64) {
+				log.Printf("f32: %s, f64: %s", s32, s64)
+				log.Printf("got: %x, want: %x", got, f64)
+				return fmt.Errorf("f32(%x).Float64() = %x, want %x", f32, got, f64)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float64(f32.Float256())
 		if !eq64(got, f64) {
 			log.Printf("f32: %s, f64: %s", s32, s64)
 			log.Printf("got: %x, want: %x", got, f64)
-			return fmt.Errorf("f32(%x).Float64() = %x, want %x", f32, got, f64)
+			return fmt.Errorf("%v: f32(%x).Float64() = %x, want %x", ctx.Mode, f32, got, f64)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f32(%x).Float64(): %w", ctx.Mode, f32, err)
 		}
 		count.Add(1)
 	}
@@ -458,11 +528,23 @@ func f32_to_f128() error {
 			return err
 		}
 
-		got := f32.Float128()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f32.Float128()
+			if !eq128(got, f128) {
+				log.Printf("f32: %s, f128: %s", s32, s128)
+				log.Printf("got: %x, want: %x", got, f128)
+				return fmt.Errorf("f32(%x).Float128() = %x, want %x", f32, got, f128)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float128(f32.Float256())
 		if !eq128(got, f128) {
 			log.Printf("f32: %s, f128: %s", s32, s128)
 			log.Printf("got: %x, want: %x", got, f128)
-			return fmt.Errorf("f32(%x).Float128() = %x, want %x", f32, got, f128)
+			return fmt.Errorf("%v: f32(%x).Float128() = %x, want %x", ctx.Mode, f32, got, f128)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f32(%x).Float128(): %w", ctx.Mode, f32, err)
 		}
 		count.Add(1)
 	}
@@ -489,11 +571,23 @@ func f64_to_f16() error {
 			return err
 		}
 
-		got := f64.Float16()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f64.Float16()
+			if !eq16(got, f16) {
+				log.Printf("f64: %s, f16: %s", s64, s16)
+				log.Printf("got: %x, want: %x", got, f16)
+				return fmt.Errorf("f64(%x).Float16() = %x, want %x", f64, got, f16)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float16(f64.Float256())
 		if !eq16(got, f16) {
 			log.Printf("f64: %s, f16: %s", s64, s16)
 			log.Printf("got: %x, want: %x", got, f16)
-			return fmt.Errorf("f64(%x).Float16() = %x, want %x", f64, got, f16)
+			return fmt.Errorf("%v: f64(%x).Float16() = %x, want %x", ctx.Mode, f64, got, f16)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f64(%x).Float16(): %w", ctx.Mode, f64, err)
 		}
 		count.Add(1)
 	}
@@ -520,11 +614,23 @@ func f64_to_f32() error {
 			return err
 		}
 
-		got := f64.Float32()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f64.Float32()
+			if !eq32(got, f32) {
+				log.Printf("f64: %s, f32: %s", s64, s32)
+				log.Printf("got: %x, want: %x", got, f32)
+				return fmt.Errorf("f64(%x).Float32() = %x, want %x", f64, got, f32)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float32(f64.Float256())
 		if !eq32(got, f32) {
 			log.Printf("f64: %s, f32: %s", s64, s32)
 			log.Printf("got: %x, want: %x", got, f32)
-			return fmt.Errorf("f64(%x).Float32() = %x, want %x", f64, got, f32)
+			return fmt.Errorf("%v: f64(%x).Float32() = %x, want %x", ctx.Mode, f64, got, f32)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f64(%x).Float32(): %w", ctx.Mode, f64, err)
 		}
 		count.Add(1)
 	}
@@ -551,11 +657,23 @@ func f64_to_f128() error {
 			return err
 		}
 
-		got := f64.Float128()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f64.Float128()
+			if !eq128(got, f128) {
+				log.Printf("f64: %s, f128: %s", s64, s128)
+				log.Printf("got: %x, want: %x", got, f128)
+				return fmt.Errorf("f64(%x).Float128() = %x, want %x", f64, got, f128)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float128(f64.Float256())
 		if !eq128(got, f128) {
 			log.Printf("f64: %s, f128: %s", s64, s128)
 			log.Printf("got: %x, want: %x", got, f128)
-			return fmt.Errorf("f64(%x).Float128() = %x, want %x", f64, got, f128)
+			return fmt.Errorf("%v: f64(%x).Float128() = %x, want %x", ctx.Mode, f64, got, f128)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f64(%x).Float128(): %w", ctx.Mode, f64, err)
 		}
 		count.Add(1)
 	}
@@ -582,11 +700,23 @@ func f128_to_f16() error {
 			return err
 		}
 
-		got := f128.Float16()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f128.Float16()
+			if !eq16(got, f16) {
+				log.Printf("f128: %s, f16: %s", s128, s16)
+				log.Printf("got: %x, want: %x", got, f16)
+				return fmt.Errorf("f128(%x).Float16() = %x, want %x", f128, got, f16)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float16(f128.Float256())
 		if !eq16(got, f16) {
 			log.Printf("f128: %s, f16: %s", s128, s16)
 			log.Printf("got: %x, want: %x", got, f16)
-			return fmt.Errorf("f128(%x).Float16() = %x, want %x", f128, got, f16)
+			return fmt.Errorf("%v: f128(%x).Float16() = %x, want %x", ctx.Mode, f128, got, f16)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f128(%x).Float16(): %w", ctx.Mode, f128, err)
 		}
 		count.Add(1)
 	}
@@ -613,11 +743,23 @@ func f128_to_f32() error {
 			return err
 		}
 
-		got := f128.Float32()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f128.Float32()
+			if !eq32(got, f32) {
+				log.Printf("f128: %s, f32: %s", s128, s32)
+				log.Printf("got: %x, want: %x", got, f32)
+				return fmt.Errorf("f128(%x).Float32() = %x, want %x", f128, got, f32)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float32(f128.Float256())
 		if !eq32(got, f32) {
 			log.Printf("f128: %s, f32: %s", s128, s32)
 			log.Printf("got: %x, want: %x", got, f32)
-			return fmt.Errorf("f128(%x).Float32() = %x, want %x", f128, got, f32)
+			return fmt.Errorf("%v: f128(%x).Float32() = %x, want %x", ctx.Mode, f128, got, f32)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f128(%x).Float32(): %w", ctx.Mode, f128, err)
 		}
 		count.Add(1)
 	}
@@ -644,11 +786,23 @@ func f128_to_f64() error {
 			return err
 		}
 
-		got := f128.Float64()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f128.Float64()
+			if !eq64(got, f64) {
+				log.Printf("f128: %s, f64: %s", s128, s64)
+				log.Printf("got: %x, want: %x", got, f64)
+				return fmt.Errorf("f128(%x).Float64() = %x, want %x", f128, got, f64)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Float64(f128.Float256())
 		if !eq64(got, f64) {
 			log.Printf("f128: %s, f64: %s", s128, s64)
 			log.Printf("got: %x, want: %x", got, f64)
-			return fmt.Errorf("f128(%x).Float64() = %x, want %x", f128, got, f64)
+			return fmt.Errorf("%v: f128(%x).Float64() = %x, want %x", ctx.Mode, f128, got, f64)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f128(%x).Float64(): %w", ctx.Mode, f128, err)
 		}
 		count.Add(1)
 	}
@@ -669,10 +823,6 @@ func f16_to_i64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f16, err := parseFloat16(s16)
 		if err != nil {
@@ -685,11 +835,26 @@ func f16_to_i64() error {
 		}
 		i64v := int64(u64)
 
-		got := f16.Int64()
-		if got != i64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f16.Int64()
+			if got != i64v {
+				log.Printf("f16: %s, i64: %s", s16, i64)
+				log.Printf("got: %x, want: %x", got, i64v)
+				return fmt.Errorf("f16(%x).Int64() = %x, want %x", f16, got, i64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Int64(f16.Float256())
+		if f&invalid == 0 && got != i64v {
 			log.Printf("f16: %s, i64: %s", s16, i64)
 			log.Printf("got: %x, want: %x", got, i64v)
-			return fmt.Errorf("f16(%x).Int64() = %x, want %x", f16, got, i64v)
+			return fmt.Errorf("%v: f16(%x).Int64() = %x, want %x", ctx.Mode, f16, got, i64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f16(%x).Int64(): %w", ctx.Mode, f16, err)
 		}
 		count.Add(1)
 	}
@@ -710,10 +875,6 @@ func f32_to_i64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f32, err := parseFloat32(s32)
 		if err != nil {
@@ -726,11 +887,26 @@ func f32_to_i64() error {
 		}
 		i64v := int64(u64)
 
-		got := f32.Int64()
-		if got != i64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f32.Int64()
+			if got != i64v {
+				log.Printf("f32: %s, i64: %s", s32, i64)
+				log.Printf("got: %x, want: %x", got, i64v)
+				return fmt.Errorf("f32(%x).Int64() = %x, want %x", f32, got, i64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Int64(f32.Float256())
+		if f&invalid == 0 && got != i64v {
 			log.Printf("f32: %s, i64: %s", s32, i64)
 			log.Printf("got: %x, want: %x", got, i64v)
-			return fmt.Errorf("f32(%x).Int64() = %x, want %x", f32, got, i64v)
+			return fmt.Errorf("%v: f32(%x).Int64() = %x, want %x", ctx.Mode, f32, got, i64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f32(%x).Int64(): %w", ctx.Mode, f32, err)
 		}
 		count.Add(1)
 	}
@@ -751,10 +927,6 @@ func f64_to_i64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f64, err := parseFloat64(s64)
 		if err != nil {
@@ -767,11 +939,26 @@ func f64_to_i64() error {
 		}
 		i64v := int64(u64)
 
-		got := f64.Int64()
-		if got != i64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f64.Int64()
+			if got != i64v {
+				log.Printf("f64: %s, i64: %s", s64, i64)
+				log.Printf("got: %x, want: %x", got, i64v)
+				return fmt.Errorf("f64(%x).Int64() = %x, want %x", f64, got, i64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Int64(f64.Float256())
+		if f&invalid == 0 && got != i64v {
 			log.Printf("f64: %s, i64: %s", s64, i64)
 			log.Printf("got: %x, want: %x", got, i64v)
-			return fmt.Errorf("f64(%x).Int64() = %x, want %x", f64, got, i64v)
+			return fmt.Errorf("%v: f64(%x).Int64() = %x, want %x", ctx.Mode, f64, got, i64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f64(%x).Int64(): %w", ctx.Mode, f64, err)
 		}
 		count.Add(1)
 	}
@@ -792,10 +979,6 @@ func f128_to_i64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f128, err := parseFloat128(s128)
 		if err != nil {
@@ -808,11 +991,26 @@ func f128_to_i64() error {
 		}
 		i64v := int64(u64)
 
-		got := f128.Int64()
-		if got != i64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f128.Int64()
+			if got != i64v {
+				log.Printf("f128: %s, i64: %s", s128, i64)
+				log.Printf("got: %x, want: %x", got, i64v)
+				return fmt.Errorf("f128(%x).Int64() = %x, want %x", f128, got, i64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Int64(f128.Float256())
+		if f&invalid == 0 && got != i64v {
 			log.Printf("f128: %s, i64: %s", s128, i64)
 			log.Printf("got: %x, want: %x", got, i64v)
-			return fmt.Errorf("f128(%x).Int64() = %x, want %x", f128, got, i64v)
+			return fmt.Errorf("%v: f128(%x).Int64() = %x, want %x", ctx.Mode, f128, got, i64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f128(%x).Int64(): %w", ctx.Mode, f128, err)
 		}
 		count.Add(1)
 	}
@@ -833,10 +1031,6 @@ func f16_to_ui64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f16, err := parseFloat16(s16)
 		if err != nil {
@@ -848,11 +1042,26 @@ func f16_to_ui64() error {
 			return err
 		}
 
-		got := f16.Uint64()
-		if got != u64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f16.Uint64()
+			if got != u64v {
+				log.Printf("f16: %s, i64: %s", s16, u64)
+				log.Printf("got: %x, want: %x", got, u64v)
+				return fmt.Errorf("f16(%x).Uint64() = %x, want %x", f16, got, u64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Uint64(f16.Float256())
+		if f&invalid == 0 && got != u64v {
 			log.Printf("f16: %s, i64: %s", s16, u64)
 			log.Printf("got: %x, want: %x", got, u64v)
-			return fmt.Errorf("f16(%x).Uint64() = %x, want %x", f16, got, u64v)
+			return fmt.Errorf("%v: f16(%x).Uint64() = %x, want %x", ctx.Mode, f16, got, u64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f16(%x).Uint64(): %w", ctx.Mode, f16, err)
 		}
 		count.Add(1)
 	}
@@ -873,10 +1082,6 @@ func f32_to_ui64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f32, err := parseFloat32(s32)
 		if err != nil {
@@ -888,11 +1093,26 @@ func f32_to_ui64() error {
 			return err
 		}
 
-		got := f32.Uint64()
-		if got != u64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f32.Uint64()
+			if got != u64v {
+				log.Printf("f32: %s, i64: %s", s32, u64)
+				log.Printf("got: %x, want: %x", got, u64v)
+				return fmt.Errorf("f32(%x).Uint64() = %x, want %x", f32, got, u64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Uint64(f32.Float256())
+		if f&invalid == 0 && got != u64v {
 			log.Printf("f32: %s, i64: %s", s32, u64)
 			log.Printf("got: %x, want: %x", got, u64v)
-			return fmt.Errorf("f32(%x).Uint64() = %x, want %x", f32, got, u64v)
+			return fmt.Errorf("%v: f32(%x).Uint64() = %x, want %x", ctx.Mode, f32, got, u64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f32(%x).Uint64(): %w", ctx.Mode, f32, err)
 		}
 		count.Add(1)
 	}
@@ -913,10 +1133,6 @@ func f64_to_ui64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f64, err := parseFloat64(s64)
 		if err != nil {
@@ -928,11 +1144,26 @@ func f64_to_ui64() error {
 			return err
 		}
 
-		got := f64.Uint64()
-		if got != u64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f64.Uint64()
+			if got != u64v {
+				log.Printf("f64: %s, i64: %s", s64, u64)
+				log.Printf("got: %x, want: %x", got, u64v)
+				return fmt.Errorf("f64(%x).Uint64() = %x, want %x", f64, got, u64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Uint64(f64.Float256())
+		if f&invalid == 0 && got != u64v {
 			log.Printf("f64: %s, i64: %s", s64, u64)
 			log.Printf("got: %x, want: %x", got, u64v)
-			return fmt.Errorf("f64(%x).Uint64() = %x, want %x", f64, got, u64v)
+			return fmt.Errorf("%v: f64(%x).Uint64() = %x, want %x", ctx.Mode, f64, got, u64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f64(%x).Uint64(): %w", ctx.Mode, f64, err)
 		}
 		count.Add(1)
 	}
@@ -953,10 +1184,6 @@ func f128_to_ui64() error {
 		if err != nil {
 			return err
 		}
-		if f&invalid != 0 {
-			// The behavior when the conversion is invalid is undefined.
-			continue
-		}
 
 		f128, err := parseFloat128(s128)
 		if err != nil {
@@ -968,18 +1195,33 @@ func f128_to_ui64() error {
 			return err
 		}
 
-		got := f128.Uint64()
-		if got != u64v {
+		if f&invalid == 0 && ctx.Mode == floats.ToZero {
+			got := f128.Uint64()
+			if got != u64v {
+				log.Printf("f128: %s, i64: %s", s128, u64)
+				log.Printf("got: %x, want: %x", got, u64v)
+				return fmt.Errorf("f128(%x).Uint64() = %x, want %x", f128, got, u64v)
+			}
+		}
+
+		// The result of an invalid conversion is implementation-defined,
+		// so only the flags are checked.
+		ctx.Flags = 0
+		got, _ := ctx.Uint64(f128.Float256())
+		if f&invalid == 0 && got != u64v {
 			log.Printf("f128: %s, i64: %s", s128, u64)
 			log.Printf("got: %x, want: %x", got, u64v)
-			return fmt.Errorf("f128(%x).Uint64() = %x, want %x", f128, got, u64v)
+			return fmt.Errorf("%v: f128(%x).Uint64() = %x, want %x", ctx.Mode, f128, got, u64v)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: f128(%x).Uint64(): %w", ctx.Mode, f128, err)
 		}
 		count.Add(1)
 	}
 	return nil
 }
 
-func f16x3(name string, f func(a, b floats.Float16) floats.Float16) error {
+func f16x3(name string, f, fctx func(a, b floats.Float16) floats.Float16) error {
 	for {
 		var a, b, want, flag string
 		if _, err := fmt.Scanf("%s %s %s %s", &a, &b, &want, &flag); err != nil {
@@ -1001,11 +1243,23 @@ func f16x3(name string, f func(a, b floats.Float16) floats.Float16) error {
 		if err != nil {
 			return err
 		}
-		got := f(f16a, f16b)
+		if ctx.Mode == floats.ToNearestEven {
+			got := f(f16a, f16b)
+			if !eq16(got, wantf) {
+				log.Printf("a: %s, b: %s, want: %s", a, b, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float16(%x).%s(%x) = %x, want %x", f16a, name, f16b, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := fctx(f16a, f16b)
 		if !eq16(got, wantf) {
 			log.Printf("a: %s, b: %s, want: %s", a, b, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float16(%x).%s(%x) = %x, want %x", f16a, name, f16b, got, wantf)
+			return fmt.Errorf("%v: Float16(%x).%s(%x) = %x, want %x", ctx.Mode, f16a, name, f16b, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float16(%x).%s(%x): %w", ctx.Mode, f16a, name, f16b, err)
 		}
 		count.Add(1)
 	}
@@ -1030,11 +1284,23 @@ func f16_sqrt() error {
 		if err != nil {
 			return err
 		}
-		got := f16a.Sqrt()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f16a.Sqrt()
+			if !eq16(got, wantf) {
+				log.Printf("a: %s, want: %s", a, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float16(%x).Sqrt() = %x, want %x", f16a, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Sqrt16(f16a)
 		if !eq16(got, wantf) {
 			log.Printf("a: %s, want: %s", a, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float16(%x).Sqrt() = %x, want %x", f16a, got, wantf)
+			return fmt.Errorf("%v: Float16(%x).Sqrt() = %x, want %x", ctx.Mode, f16a, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float16(%x).Sqrt(): %w", ctx.Mode, f16a, err)
 		}
 		count.Add(1)
 	}
@@ -1097,18 +1363,30 @@ func f16_mulAdd() error {
 		if err != nil {
 			return err
 		}
-		got := floats.FMA16(f16a, f16b, f16c)
+		if ctx.Mode == floats.ToNearestEven {
+			got := floats.FMA16(f16a, f16b, f16c)
+			if !eq16(got, wantf) {
+				log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("FMA(%x, %x, %x) = %x, want %x", f16a, f16b, f16c, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.FMA16(f16a, f16b, f16c)
 		if !eq16(got, wantf) {
 			log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("FMA(%x, %x, %x) = %x, want %x", f16a, f16b, f16c, got, wantf)
+			return fmt.Errorf("%v: FMA(%x, %x, %x) = %x, want %x", ctx.Mode, f16a, f16b, f16c, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: FMA(%x, %x, %x): %w", ctx.Mode, f16a, f16b, f16c, err)
 		}
 		count.Add(1)
 	}
 	return nil
 }
 
-func f32x3(name string, f func(a, b floats.Float32) floats.Float32) error {
+func f32x3(name string, f, fctx func(a, b floats.Float32) floats.Float32) error {
 	for {
 		var a, b, want, flag string
 		if _, err := fmt.Scanf("%s %s %s %s", &a, &b, &want, &flag); err != nil {
@@ -1130,11 +1408,23 @@ func f32x3(name string, f func(a, b floats.Float32) floats.Float32) error {
 		if err != nil {
 			return err
 		}
-		got := f(f32a, f32b)
+		if ctx.Mode == floats.ToNearestEven {
+			got := f(f32a, f32b)
+			if !eq32(got, wantf) {
+				log.Printf("a: %s, b: %s, want: %s", a, b, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float32(%x).%s(%x) = %x, want %x", f32a, name, f32b, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := fctx(f32a, f32b)
 		if !eq32(got, wantf) {
 			log.Printf("a: %s, b: %s, want: %s", a, b, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float32(%x).%s(%x) = %x, want %x", f32a, name, f32b, got, wantf)
+			return fmt.Errorf("%v: Float32(%x).%s(%x) = %x, want %x", ctx.Mode, f32a, name, f32b, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float32(%x).%s(%x): %w", ctx.Mode, f32a, name, f32b, err)
 		}
 		count.Add(1)
 	}
@@ -1159,11 +1449,23 @@ func f32_sqrt() error {
 		if err != nil {
 			return err
 		}
-		got := f32a.Sqrt()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f32a.Sqrt()
+			if !eq32(got, wantf) {
+				log.Printf("a: %s, want: %s", a, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float32(%x).Sqrt() = %x, want %x", f32a, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Sqrt32(f32a)
 		if !eq32(got, wantf) {
 			log.Printf("a: %s, want: %s", a, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float32(%x).Sqrt() = %x, want %x", f32a, got, wantf)
+			return fmt.Errorf("%v: Float32(%x).Sqrt() = %x, want %x", ctx.Mode, f32a, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float32(%x).Sqrt(): %w", ctx.Mode, f32a, err)
 		}
 		count.Add(1)
 	}
@@ -1225,18 +1527,30 @@ func f32_mulAdd() error {
 		if err != nil {
 			return err
 		}
-		got := floats.FMA32(f32a, f32b, f32c)
+		if ctx.Mode == floats.ToNearestEven {
+			got := floats.FMA32(f32a, f32b, f32c)
+			if !eq32(got, wantf) {
+				log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("FMA32(%x, %x, %x) = %x, want %x", f32a, f32b, f32c, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.FMA32(f32a, f32b, f32c)
 		if !eq32(got, wantf) {
 			log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("FMA32(%x, %x, %x) = %x, want %x", f32a, f32b, f32c, got, wantf)
+			return fmt.Errorf("%v: FMA32(%x, %x, %x) = %x, want %x", ctx.Mode, f32a, f32b, f32c, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: FMA32(%x, %x, %x): %w", ctx.Mode, f32a, f32b, f32c, err)
 		}
 		count.Add(1)
 	}
 	return nil
 }
 
-func f64x3(name string, f func(a, b floats.Float64) floats.Float64) error {
+func f64x3(name string, f, fctx func(a, b floats.Float64) floats.Float64) error {
 	for {
 		var a, b, want, flag string
 		if _, err := fmt.Scanf("%s %s %s %s", &a, &b, &want, &flag); err != nil {
@@ -1258,11 +1572,23 @@ func f64x3(name string, f func(a, b floats.Float64) floats.Float64) error {
 		if err != nil {
 			return err
 		}
-		got := f(f64a, f64b)
+		if ctx.Mode == floats.ToNearestEven {
+			got := f(f64a, f64b)
+			if !eq64(got, wantf) {
+				log.Printf("a: %s, b: %s, want: %s", a, b, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float64(%x).%s(%x) = %x, want %x", f64a, name, f64b, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := fctx(f64a, f64b)
 		if !eq64(got, wantf) {
 			log.Printf("a: %s, b: %s, want: %s", a, b, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float64(%x).%s(%x) = %x, want %x", f64a, name, f64b, got, wantf)
+			return fmt.Errorf("%v: Float64(%x).%s(%x) = %x, want %x", ctx.Mode, f64a, name, f64b, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float64(%x).%s(%x): %w", ctx.Mode, f64a, name, f64b, err)
 		}
 		count.Add(1)
 	}
@@ -1287,11 +1613,23 @@ func f64_sqrt() error {
 		if err != nil {
 			return err
 		}
-		got := f64a.Sqrt()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f64a.Sqrt()
+			if !eq64(got, wantf) {
+				log.Printf("a: %s, want: %s", a, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float64(%x).Sqrt() = %x, want %x", f64a, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Sqrt64(f64a)
 		if !eq64(got, wantf) {
 			log.Printf("a: %s, want: %s", a, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float64(%x).Sqrt() = %x, want %x", f64a, got, wantf)
+			return fmt.Errorf("%v: Float64(%x).Sqrt() = %x, want %x", ctx.Mode, f64a, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float64(%x).Sqrt(): %w", ctx.Mode, f64a, err)
 		}
 		count.Add(1)
 	}
@@ -1353,18 +1691,30 @@ func f64_mulAdd() error {
 		if err != nil {
 			return err
 		}
-		got := floats.FMA64(f64a, f64b, f64c)
+		if ctx.Mode == floats.ToNearestEven {
+			got := floats.FMA64(f64a, f64b, f64c)
+			if !eq64(got, wantf) {
+				log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("FMA64(%x, %x, %x) = %x, want %x", f64a, f64b, f64c, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.FMA64(f64a, f64b, f64c)
 		if !eq64(got, wantf) {
 			log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("FMA64(%x, %x, %x) = %x, want %x", f64a, f64b, f64c, got, wantf)
+			return fmt.Errorf("%v: FMA64(%x, %x, %x) = %x, want %x", ctx.Mode, f64a, f64b, f64c, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: FMA64(%x, %x, %x): %w", ctx.Mode, f64a, f64b, f64c, err)
 		}
 		count.Add(1)
 	}
 	return nil
 }
 
-func f128x3(name string, f func(a, b floats.Float128) floats.Float128) error {
+func f128x3(name string, f, fctx func(a, b floats.Float128) floats.Float128) error {
 	for {
 		var a, b, want, flag string
 		if _, err := fmt.Scanf("%s %s %s %s", &a, &b, &want, &flag); err != nil {
@@ -1386,11 +1736,23 @@ func f128x3(name string, f func(a, b floats.Float128) floats.Float128) error {
 		if err != nil {
 			return err
 		}
-		got := f(f128a, f128b)
+		if ctx.Mode == floats.ToNearestEven {
+			got := f(f128a, f128b)
+			if !eq128(got, wantf) {
+				log.Printf("a: %s, b: %s, want: %s", a, b, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float128(%x).%s(%x) = %x, want %x", f128a, name, f128b, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := fctx(f128a, f128b)
 		if !eq128(got, wantf) {
 			log.Printf("a: %s, b: %s, want: %s", a, b, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float128(%x).%s(%x) = %x, want %x", f128a, name, f128b, got, wantf)
+			return fmt.Errorf("%v: Float128(%x).%s(%x) = %x, want %x", ctx.Mode, f128a, name, f128b, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float128(%x).%s(%x): %w", ctx.Mode, f128a, name, f128b, err)
 		}
 		count.Add(1)
 	}
@@ -1415,11 +1777,23 @@ func f128_sqrt() error {
 		if err != nil {
 			return err
 		}
-		got := f128a.Sqrt()
+		if ctx.Mode == floats.ToNearestEven {
+			got := f128a.Sqrt()
+			if !eq128(got, wantf) {
+				log.Printf("a: %s, want: %s", a, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("Float128(%x).Sqrt = %x, want %x", f128a, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.Sqrt128(f128a)
 		if !eq128(got, wantf) {
 			log.Printf("a: %s, want: %s", a, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("Float128(%x).Sqrt = %x, want %x", f128a, got, wantf)
+			return fmt.Errorf("%v: Float128(%x).Sqrt = %x, want %x", ctx.Mode, f128a, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: Float128(%x).Sqrt: %w", ctx.Mode, f128a, err)
 		}
 		count.Add(1)
 	}
@@ -1481,11 +1855,23 @@ func f128_mulAdd() error {
 		if err != nil {
 			return err
 		}
-		got := floats.FMA128(f128a, f128b, f128c)
+		if ctx.Mode == floats.ToNearestEven {
+			got := floats.FMA128(f128a, f128b, f128c)
+			if !eq128(got, wantf) {
+				log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
+				log.Printf("got: %x, want: %x", got, wantf)
+				return fmt.Errorf("FMA128(%x, %x, %x) = %x, want %x", f128a, f128b, f128c, got, wantf)
+			}
+		}
+		ctx.Flags = 0
+		got := ctx.FMA128(f128a, f128b, f128c)
 		if !eq128(got, wantf) {
 			log.Printf("a: %s, b: %s, c: %s, want: %s", a, b, c, want)
 			log.Printf("got: %x, want: %x", got, wantf)
-			return fmt.Errorf("FMA128(%x, %x, %x) = %x, want %x", f128a, f128b, f128c, got, wantf)
+			return fmt.Errorf("%v: FMA128(%x, %x, %x) = %x, want %x", ctx.Mode, f128a, f128b, f128c, got, wantf)
+		}
+		if err := checkFlags(flag); err != nil {
+			return fmt.Errorf("%v: FMA128(%x, %x, %x): %w", ctx.Mode, f128a, f128b, f128c, err)
 		}
 		count.Add(1)
 	}
@@ -1528,6 +1914,35 @@ func parseFloat128(s string) (floats.Float128, error) {
 	return floats.Float128{a0, a1}, nil
 }
 
+// parseRoundingMode parses the rounding mode names of TestFloat.
+func parseRoundingMode(s string) (floats.RoundingMode, error) {
+	switch s {
+	case "near_even":
+		return floats.ToNearestEven, nil
+	case "near_maxMag":
+		return floats.ToNearestAway, nil
+	case "minMag":
+		return floats.ToZero, nil
+	case "min":
+		return floats.ToNegativeInf, nil
+	case "max":
+		return floats.ToPositiveInf, nil
+	}
+	return 0, fmt.Errorf("unknown rounding mode: %q", s)
+}
+
+// checkFlags reports an error if the exception flags raised in ctx differ from s.
+func checkFlags(s string) error {
+	want, err := parseFlag(s)
+	if err != nil {
+		return err
+	}
+	if ctx.Flags != floats.Flags(want) {
+		return fmt.Errorf("flags = %v, want %v", ctx.Flags, floats.Flags(want))
+	}
+	return nil
+}
+
 func parseFlag(s string) (byte, error) {
 	b, err := strconv.ParseUint(s, 16, 8)
 	if err != nil {
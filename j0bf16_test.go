@@ -0,0 +1,18 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_J0(t *testing.T) {
+	tests := []float64{-10, -1, -0.5, 0, 0.5, 1, 2, 5, 10, 50}
+
+	for _, x := range tests {
+		want := math.J0(x)
+		got := exactBF16(x).J0()
+		if !closeBF16(got, want) {
+			t.Errorf("J0(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
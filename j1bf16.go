@@ -0,0 +1,13 @@
+package floats
+
+import "math"
+
+// J1 returns the order-one Bessel function of the first kind.
+//
+// Special cases are:
+//
+//	J1(±Inf) = 0
+//	J1(NaN) = NaN
+func (a BFloat16) J1() BFloat16 {
+	return NewBFloat16(math.J1(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,13 @@
+package floats
+
+import "math"
+
+// Jn returns the order-n Bessel function of the first kind.
+//
+// Special cases are:
+//
+//	Jn(n, ±Inf) = 0
+//	Jn(n, NaN) = NaN
+func (a BFloat16) Jn(n int) BFloat16 {
+	return NewBFloat16(math.Jn(n, a.Float64().BuiltIn()))
+}
@@ -0,0 +1,294 @@
+package floats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONFormat specifies how [AppendJSON] encodes a floating-point number.
+type JSONFormat int
+
+const (
+	// JSONFormatNumber encodes numbers as JSON numbers in the shortest decimal representation.
+	// NaN and ±Inf are not supported, and an error is returned.
+	// It is the same as the MarshalJSON methods.
+	JSONFormatNumber JSONFormat = iota
+
+	// JSONFormatString encodes numbers as JSON strings in the shortest decimal representation
+	// that parses back to exactly the same value, such as "0.1".
+	// It is not the exact decimal expansion of the binary value, which may have thousands of digits,
+	// but clients that parse the strings with [ParseFloat128] and its friends don't lose precision,
+	// while they would if the numbers were parsed as float64 JSON numbers.
+	// NaN and ±Inf are encoded as "NaN", "Infinity" and "-Infinity".
+	JSONFormatString
+
+	// JSONFormatNull encodes numbers as JSON numbers, and NaN and ±Inf as null.
+	JSONFormatNull
+
+	// JSONFormatHex encodes numbers as JSON strings in the hexadecimal representation, such as "0x1.8p+01".
+	// NaN and ±Inf are encoded as "NaN", "Infinity" and "-Infinity".
+	JSONFormatHex
+)
+
+// String returns the name of f.
+func (f JSONFormat) String() string {
+	switch f {
+	case JSONFormatNumber:
+		return "JSONFormatNumber"
+	case JSONFormatString:
+		return "JSONFormatString"
+	case JSONFormatNull:
+		return "JSONFormatNull"
+	case JSONFormatHex:
+		return "JSONFormatHex"
+	}
+	return fmt.Sprintf("JSONFormat(%d)", int(f))
+}
+
+// AppendJSON appends the JSON encoding of x in the given format to dst and returns the extended buffer.
+// If x can't be encoded in the format, it returns dst unchanged and an error.
+func AppendJSON[T Float[T]](dst []byte, x T, format JSONFormat) ([]byte, error) {
+	switch format {
+	case JSONFormatNumber, JSONFormatNull:
+		if x.IsNaN() || x.IsInf(0) {
+			if format == JSONFormatNull {
+				return append(dst, "null"...), nil
+			}
+			return dst, fmt.Errorf("floats: cannot marshal %v to JSON", x)
+		}
+		return x.Append(dst, 'g', -1), nil
+
+	case JSONFormatString, JSONFormatHex:
+		dst = append(dst, '"')
+		switch {
+		case x.IsNaN():
+			dst = append(dst, "NaN"...)
+		case x.IsInf(1):
+			dst = append(dst, "Infinity"...)
+		case x.IsInf(-1):
+			dst = append(dst, "-Infinity"...)
+		case format == JSONFormatHex:
+			dst = x.Append(dst, 'x', -1)
+		default:
+			dst = x.Append(dst, 'g', -1)
+		}
+		return append(dst, '"'), nil
+	}
+	return dst, fmt.Errorf("floats: unknown JSON format %v", format)
+}
+
+const fnParseJSON = "ParseJSON"
+
+// ParseJSON parses the JSON encoding of a floating-point number.
+// It accepts all the formats of [AppendJSON]: JSON numbers,
+// and JSON strings of decimal or hexadecimal numbers, "NaN", "Infinity" and "-Infinity".
+// The JSON null is parsed as NaN.
+// The other spellings that [ParseFloat16] and its friends accept, such as NaN without quotes, "Inf" and "1_000", are rejected.
+func ParseJSON[T Float[T]](data []byte) (T, error) {
+	if string(data) == "null" {
+		return nanOf[T](), nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		s := data[1 : len(data)-1]
+		if bytes.IndexByte(s, '\\') >= 0 {
+			// the string has escape sequences.
+			var unquoted string
+			if err := json.Unmarshal(data, &unquoted); err != nil {
+				var zero T
+				return zero, err
+			}
+			s = []byte(unquoted)
+		}
+		switch {
+		case string(s) == "NaN", string(s) == "Infinity", string(s) == "-Infinity",
+			isJSONNumber(s), isHexJSON(s):
+			return parseFloat[T](string(s))
+		}
+		var zero T
+		return zero, syntaxError(fnParseJSON, string(data))
+	}
+	if !isJSONNumber(data) {
+		var zero T
+		return zero, syntaxError(fnParseJSON, string(data))
+	}
+	return parseFloat[T](string(data))
+}
+
+// isJSONNumber reports whether s follows the grammar of JSON numbers in RFC 8259:
+//
+//	number = [ "-" ] ( "0" / digit1-9 *digit ) [ "." 1*digit ] [ ( "e" / "E" ) [ "-" / "+" ] 1*digit ]
+func isJSONNumber(s []byte) bool {
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	i := 0
+	if i < len(s) && s[i] == '-' {
+		i++
+	}
+
+	// integer part
+	switch {
+	case i < len(s) && s[i] == '0':
+		i++
+	case i < len(s) && '1' <= s[i] && s[i] <= '9':
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	default:
+		return false
+	}
+
+	// fraction part
+	if i < len(s) && s[i] == '.' {
+		i++
+		if i >= len(s) || !isDigit(s[i]) {
+			return false
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+
+	// exponent part
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '-' || s[i] == '+') {
+			i++
+		}
+		if i >= len(s) || !isDigit(s[i]) {
+			return false
+		}
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	return i == len(s)
+}
+
+// isHexJSON reports whether s is in the form of [JSONFormatHex]:
+// an optional minus sign, "0x" and the hexadecimal mantissa and exponent without underscores.
+// The mantissa and the exponent are validated by the parser.
+func isHexJSON(s []byte) bool {
+	s = bytes.TrimPrefix(s, []byte("-"))
+	if len(s) < 2 || s[0] != '0' || lower(s[1]) != 'x' {
+		return false
+	}
+	return bytes.IndexByte(s, '_') < 0
+}
+
+// unmarshalJSON is the implementation of the UnmarshalJSON methods of the floating-point types.
+// The quoted forms are parsed by [ParseJSON],
+// and the others by [ParseFloat16] and its friends as before they were supported,
+// so NaN and hexadecimal numbers without quotes are accepted, and the JSON null is an error.
+func unmarshalJSON[T Float[T]](a *T, data []byte) error {
+	var ret T
+	var err error
+	if len(data) > 0 && data[0] == '"' {
+		ret, err = ParseJSON[T](data)
+	} else {
+		ret, err = parseFloat[T](string(data))
+	}
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+// decodeJSON is the implementation of the UnmarshalJSON methods of [JSONString] and [JSONHex].
+// Following the convention of encoding/json, the JSON null is a no-op.
+func decodeJSON[T Float[T]](a *T, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	ret, err := ParseJSON[T](data)
+	if err != nil {
+		return err
+	}
+	*a = ret
+	return nil
+}
+
+// parseFloat parses s as T.
+// It calls [ParseFloat16], [ParseBFloat16], [ParseFloat32], [ParseFloat64], [ParseFloat80], [ParseFloat128] or [ParseFloat256] according to T.
+func parseFloat[T Float[T]](s string) (T, error) {
+	var ret T
+	var err error
+	switch p := any(&ret).(type) {
+	case *Float16:
+		*p, err = ParseFloat16(s)
+	case *BFloat16:
+		*p, err = ParseBFloat16(s)
+	case *Float32:
+		*p, err = ParseFloat32(s)
+	case *Float64:
+		*p, err = ParseFloat64(s)
+	case *Float80:
+		*p, err = ParseFloat80(s)
+	case *Float128:
+		*p, err = ParseFloat128(s)
+	case *Float256:
+		*p, err = ParseFloat256(s)
+	}
+	return ret, err
+}
+
+// nanOf returns a quiet NaN of T.
+func nanOf[T Float[T]]() T {
+	return FromFloat256[T](NewFloat256NaN())
+}
+
+// JSONString is a floating-point number encoded in JSON with [JSONFormatString] format.
+// Use it as a field of structs to opt in the format.
+type JSONString[T Float[T]] struct {
+	Value T
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (x JSONString[T]) MarshalJSON() ([]byte, error) {
+	return AppendJSON(nil, x.Value, JSONFormatString)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It accepts all the formats of [ParseJSON], and the JSON null is a no-op.
+func (x *JSONString[T]) UnmarshalJSON(data []byte) error {
+	return decodeJSON(&x.Value, data)
+}
+
+// JSONNull is a floating-point number encoded in JSON with [JSONFormatNull] format.
+// Use it as a field of structs to opt in the format.
+type JSONNull[T Float[T]] struct {
+	Value T
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (x JSONNull[T]) MarshalJSON() ([]byte, error) {
+	return AppendJSON(nil, x.Value, JSONFormatNull)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It accepts all the formats of [ParseJSON], and the JSON null is decoded as NaN.
+func (x *JSONNull[T]) UnmarshalJSON(data []byte) error {
+	ret, err := ParseJSON[T](data)
+	if err != nil {
+		return err
+	}
+	x.Value = ret
+	return nil
+}
+
+// JSONHex is a floating-point number encoded in JSON with [JSONFormatHex] format.
+// Use it as a field of structs to opt in the format.
+type JSONHex[T Float[T]] struct {
+	Value T
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (x JSONHex[T]) MarshalJSON() ([]byte, error) {
+	return AppendJSON(nil, x.Value, JSONFormatHex)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+// It accepts all the formats of [ParseJSON], and the JSON null is a no-op.
+func (x *JSONHex[T]) UnmarshalJSON(data []byte) error {
+	return decodeJSON(&x.Value, data)
+}
@@ -0,0 +1,212 @@
+package floats
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestAppendJSON(t *testing.T) {
+	tests := []struct {
+		x      float64
+		format JSONFormat
+		want   string
+	}{
+		{1.5, JSONFormatNumber, `1.5`},
+		{-0.0, JSONFormatNumber, `0`},
+		{math.Copysign(0, -1), JSONFormatNumber, `-0`},
+		{0x1p100, JSONFormatNumber, `1.267650600228229401496703205376e+30`},
+
+		{1.5, JSONFormatString, `"1.5"`},
+		{math.NaN(), JSONFormatString, `"NaN"`},
+		{math.Inf(1), JSONFormatString, `"Infinity"`},
+		{math.Inf(-1), JSONFormatString, `"-Infinity"`},
+
+		{1.5, JSONFormatNull, `1.5`},
+		{math.NaN(), JSONFormatNull, `null`},
+		{math.Inf(1), JSONFormatNull, `null`},
+
+		{1.5, JSONFormatHex, `"0x1.8p+00"`},
+		{-0.25, JSONFormatHex, `"-0x1p-02"`},
+		{math.Inf(-1), JSONFormatHex, `"-Infinity"`},
+	}
+	for _, tt := range tests {
+		got, err := AppendJSON(nil, NewFloat128(tt.x), tt.format)
+		if err != nil {
+			t.Errorf("AppendJSON(%v, %v) returns error: %v", tt.x, tt.format, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("AppendJSON(%v, %v) = %s; want %s", tt.x, tt.format, got, tt.want)
+		}
+	}
+
+	// on error, dst is returned unchanged.
+	dst := []byte("[")
+	if got, err := AppendJSON(dst, NewFloat128(math.NaN()), JSONFormatNumber); err == nil || string(got) != "[" {
+		t.Errorf("AppendJSON(NaN, JSONFormatNumber) = %q, %v; want %q and error", got, err, "[")
+	}
+	if got, err := AppendJSON(dst, NewFloat128(1), JSONFormat(-1)); err == nil || string(got) != "[" {
+		t.Errorf("AppendJSON(1, JSONFormat(-1)) = %q, %v; want %q and error", got, err, "[")
+	}
+}
+
+func testParseJSON[T Float[T]](t *testing.T) {
+	f := FromFloat64[T]
+	tests := []struct {
+		data string
+		want T
+	}{
+		{`1.5`, f(1.5)},
+		{`-2`, f(-2)},
+		{`"1.5"`, f(1.5)},
+		{`"0x1.8p+00"`, f(1.5)},
+		{`"Infinity"`, f(math.Inf(1))},
+		{`"-Infinity"`, f(math.Inf(-1))},
+		{`"NaN"`, f(math.NaN())},
+		{`null`, f(math.NaN())},
+		{`"\u0031.5"`, f(1.5)},
+		{`0`, f(0)},
+		{`-0.5e+1`, f(-5)},
+		{`1E2`, f(100)},
+		{`"-0x1p-02"`, f(-0.25)},
+		{`"2.5e-1"`, f(0.25)},
+	}
+	for _, tt := range tests {
+		got, err := ParseJSON[T]([]byte(tt.data))
+		if err != nil {
+			t.Errorf("ParseJSON(%s) returns error: %v", tt.data, err)
+			continue
+		}
+		if !eq256(got.Float256(), tt.want.Float256()) {
+			t.Errorf("ParseJSON(%s) = %v; want %v", tt.data, got, tt.want)
+		}
+	}
+
+	invalid := []string{
+		``, `"`, `""`, `"1.5`, `true`, `"1.5x"`, `[1]`,
+
+		// they are not JSON numbers.
+		`NaN`, `Inf`, `Infinity`, `-Infinity`, `0x1p3`, `1_000`,
+		`+1`, `01`, `1.`, `.5`, `1e`, `-`, ` 1`,
+
+		// the quoted forms accept only the spellings of AppendJSON.
+		`"Inf"`, `"nan"`, `"+Infinity"`, `"1_000"`, `"0x1_0p0"`, `"+1"`, `"0x1"`,
+	}
+	for _, data := range invalid {
+		if got, err := ParseJSON[T]([]byte(data)); err == nil {
+			t.Errorf("ParseJSON(%s) = %v; want error", data, got)
+		}
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	t.Run("Float16", testParseJSON[Float16])
+	t.Run("BFloat16", testParseJSON[BFloat16])
+	t.Run("Float32", testParseJSON[Float32])
+	t.Run("Float64", testParseJSON[Float64])
+	t.Run("Float80", testParseJSON[Float80])
+	t.Run("Float128", testParseJSON[Float128])
+	t.Run("Float256", testParseJSON[Float256])
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	type T struct {
+		Number Float128
+		String JSONString[Float128]
+		Null   JSONNull[Float256]
+		Hex    JSONHex[Float64]
+	}
+
+	// 0.1 in Float128 has more digits than float64.
+	tenth, err := ParseFloat128("0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := T{
+		Number: tenth,
+		String: JSONString[Float128]{tenth},
+		Null:   JSONNull[Float256]{NewFloat256NaN()},
+		Hex:    JSONHex[Float64]{Float64(math.Inf(-1))},
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"Number":0.1,"String":"0.1","Null":null,"Hex":"-Infinity"}`
+	if string(data) != want {
+		t.Errorf("json.Marshal() = %s; want %s", data, want)
+	}
+
+	var out T
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !out.Number.Eq(tenth) || !out.String.Value.Eq(tenth) || !out.Null.Value.IsNaN() || !out.Hex.Value.IsInf(-1) {
+		t.Errorf("json.Unmarshal(%s) = %v; want %v", data, out, in)
+	}
+}
+
+func TestUnmarshalJSON_Quoted(t *testing.T) {
+	var x struct {
+		A Float128
+		B Float16
+	}
+	data := `{"A":"Infinity","B":"0x1p-24"}`
+	if err := json.Unmarshal([]byte(data), &x); err != nil {
+		t.Fatal(err)
+	}
+	if !x.A.IsInf(1) {
+		t.Errorf("A = %v; want +Inf", x.A)
+	}
+	if x.B != 1 {
+		t.Errorf("B = %v; want 0x1p-24", x.B)
+	}
+
+	// null is a no-op for the wrappers.
+	y := struct{ C JSONString[Float64] }{JSONString[Float64]{42}}
+	if err := json.Unmarshal([]byte(`{"C":null}`), &y); err != nil {
+		t.Fatal(err)
+	}
+	if y.C.Value != 42 {
+		t.Errorf("C = %v; want 42", y.C.Value)
+	}
+}
+
+func TestUnmarshalJSON_Unquoted(t *testing.T) {
+	// the spellings that the parsers accept are also accepted without quotes.
+	tests := []struct {
+		data string
+		want Float64
+	}{
+		{"0x1p3", 8},
+		{"1_000", 1000},
+		{"Inf", Float64(math.Inf(1))},
+		{"-Infinity", Float64(math.Inf(-1))},
+		{"NaN", Float64(math.NaN())},
+	}
+	for _, tt := range tests {
+		var got Float64
+		if err := got.UnmarshalJSON([]byte(tt.data)); err != nil {
+			t.Errorf("UnmarshalJSON(%q) returns error: %v", tt.data, err)
+			continue
+		}
+		if got != tt.want && !(got.IsNaN() && tt.want.IsNaN()) {
+			t.Errorf("UnmarshalJSON(%q) = %v; want %v", tt.data, got, tt.want)
+		}
+	}
+
+	// the JSON null is an error.
+	got := Float64(42)
+	if err := got.UnmarshalJSON([]byte("null")); err == nil {
+		t.Errorf("UnmarshalJSON(null) doesn't return error")
+	}
+	if got != 42 {
+		t.Errorf("UnmarshalJSON(null) modifies the receiver: %v", got)
+	}
+
+	var x struct{ A Float16 }
+	if err := json.Unmarshal([]byte(`{"A":null}`), &x); err == nil {
+		t.Errorf("json.Unmarshal with null doesn't return error")
+	}
+}
@@ -1,7 +1,15 @@
 package floats
 
+import "math"
+
 // Log returns the natural logarithm of a.
 //
+// The result is computed in Float256 with a relative error less than 2⁻²²⁰,
+// and then rounded to the nearest Float128.
+// So the error is less than 0.5 + 2⁻¹⁰⁰ ulp,
+// and the result is correctly rounded unless log(a) is within the relative distance 2⁻²²⁰
+// of a midpoint between two Float128 values.
+//
 // Special cases are:
 //
 //	+Inf.Log() = +Inf
@@ -19,37 +27,54 @@ func (a Float128) Log() Float128 {
 		return NewFloat128Inf(-1)
 	}
 
-	var (
-		// Sqrt2quo2 = sqrt(2)/2 ~ 707106781186547524400844362104849
-		Sqrt2quo2 = Float128{0x3ffe_6a09_e667_f3bc, 0xc908_b2fb_1366_ea95}
+	return logKernel256(a.Float256()).Float128()
+}
 
-		// Ln2Hi = ln(2) ~ 0.6931471805599453094172321214581765
-		// Ln2Lo = ln(2) - Ln2Hi ~ 8.928835774481220748938623512047474e-35
-		Ln2Hi = Float128{0x3ffe_62e4_2fef_a39e, 0xf357_93c7_6730_07e5}
-		Ln2Lo = Float128{0x3f8d_dabd_03cd_0c99, 0xca62_d8b6_2834_5d6e}
+// logKernel256 returns the natural logarithm of finite x > 0.
+// The relative error is less than 2⁻²²⁸.
+func logKernel256(x Float256) Float256 {
+	var (
+		// Ln2Hi = ln(2) ~ 0.69314718055994530941723212145817656807550013436025525412068000949339362
+		// Ln2Lo = ln(2) - Ln2Hi ~ 1.68505384472783385591763704017160191700974868940429492048047E-72
+		Ln2Hi = Float256{
+			0x3fff_e62e_42fe_fa39, 0xef35_793c_7673_007e,
+			0x5ed5_e81e_6864_ce53, 0x16c5_b141_a2eb_7175,
+		}
+		Ln2Lo = Float256{
+			0x3ff1_07d1_5f3d_c3b1, 0x036f_5d64_c2ac_aa97,
+			0xda57_d0d8_8769_7571, 0xae09_c0c7_cb80_70d0,
+		}
 
-		// Two = 2.0
-		Two = Float128{0x4000_0000_0000_0000, 0x0000_0000_0000_0000}
+		// coefficients of log(1+t) = t - t²/2 + t³/3 - t⁴/4 + t⁵/5 - ...
+		L2 = Float256{0xbfff_e000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}
+		L3 = Float256{0x3fff_d555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555, 0x5555_5555_5555_5555}
+		L4 = Float256{0xbfff_d000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}
+		L5 = Float256{0x3fff_c999_9999_9999, 0x9999_9999_9999_9999, 0x9999_9999_9999_9999, 0x9999_9999_9999_999a}
 	)
+	one := Float256(uvone256)
 
-	// reduce
-	f1, ki := a.Frexp()
-	if f1.Lt(Sqrt2quo2) {
-		f1 = f1.Add(f1)
-		ki--
+	// reduce; x = m × 2**k, sqrt(2)/2 <= m < sqrt(2).
+	m, k := x.Frexp()
+	if m.Float64().BuiltIn() < math.Sqrt2/2 {
+		m = m.Ldexp(1)
+		k--
 	}
-	f := f1.Sub(Float128(uvone128)) // f := f1 - 1
-	k := NewFloat128(float64(ki))
+
+	// y0 is an approximation of log(m) in float64.
+	// Let m × e**-y0 = 1 + t; then log(m) = y0 + log(1+t),
+	// where t = (m-1) + m×(e**-y0 - 1) is smaller than 2⁻⁵⁰,
+	// and smaller than |m-1| if y0 is 0.
+	y0 := NewFloat256(math.Log(m.Float64().BuiltIn()))
+	t := FMA256(m, expm1Kernel256(y0.Neg()), m.Sub(one))
 
 	// compute
-	// Let s = f/(2+f); log(1+f) = log((1+s)/(1-s)) = 2s + 2/3 s³ + 2/5 s⁵ + 2/7 s⁷ + ...
-	// TODO: use a polynomial approximation
-	s := f.Quo(f.Add(Two))
-	var r Float128
-	for n := 39; n > 0; n -= 2 {
-		r = r.Add(power128(s, n).Quo(NewFloat128(float64(n))))
-	}
-	return k.Mul(Ln2Hi).Add(r.Add(r).Add(k.Mul(Ln2Lo)))
+	p := FMA256(L5, t, L4)
+	p = FMA256(p, t, L3)
+	p = FMA256(p, t, L2)
+	p = FMA256(p, t, one)
+	r := FMA256(p, t, y0)
+	fk := NewFloat256(float64(k))
+	return FMA256(fk, Ln2Hi, FMA256(fk, Ln2Lo, r))
 }
 
 // Log10 returns the decimal logarithm of a.
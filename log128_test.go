@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 )
@@ -47,6 +48,35 @@ func TestFloat128_Log(t *testing.T) {
 	}
 }
 
+func TestFloat128_Log_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(2, 15))
+	one := exact128(1)
+	inputs := []Float128{
+		one.Add(exact128(0x1p-112)),
+		one.Sub(exact128(0x1p-113)),
+		one.Add(exact128(0x1p-52)),
+		one.Sub(exact128(0x1p-53)),
+		exact128(math.Sqrt2 / 2),
+		Float128{0, 1},                                         // the smallest subnormal
+		Float128{0x7ffe_ffff_ffff_ffff, 0xffff_ffff_ffff_ffff}, // the largest finite value
+	}
+	for range 500 {
+		inputs = append(inputs, randFloat128(r, -16494, 16384))
+	}
+	for range 100 {
+		// near 1
+		inputs = append(inputs, one.Add(randFloat128(r, -113, -1)), one.Sub(randFloat128(r, -114, -2)))
+	}
+
+	for _, x := range inputs {
+		got := x.Log()
+		want := round128(logBig(x.BigFloat(nil)))
+		if !eq128(got, want) {
+			t.Errorf("Log(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
+
 func BenchmarkFloat128_Log(b *testing.B) {
 	x := exact128(1.5)
 	for b.Loop() {
@@ -11,17 +11,28 @@ import "math"
 //	(x < 0).Log() = NaN
 //	NaN.Log() = NaN
 func (a Float16) Log() Float16 {
-	return NewFloat16(math.Log(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Log(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Log().Float16()
 }
 
 // Log10 returns the decimal logarithm of a.
 // The special cases are the same as for [Log].
 func (a Float16) Log10() Float16 {
-	return NewFloat16(math.Log10(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Log10(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Log10().Float16()
 }
 
 // Log2 returns the binary logarithm of a.
 // The special cases are the same as for [Log].
 func (a Float16) Log2() Float16 {
-	return NewFloat16(math.Log2(a.Float64().BuiltIn()))
+	// math.Log2 adds the exponent to log2 of the fraction,
+	// which cancels for a near 1.
+	if ret, ok := roundFloat16(math.Log(a.Float64().BuiltIn()) * (1 / math.Ln2)); ok {
+		return ret
+	}
+	return a.Float256().Log2().Float16()
 }
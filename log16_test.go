@@ -143,3 +143,22 @@ func BenchmarkFloat16_Log2(b *testing.B) {
 		runtime.KeepAlive(x.Log2())
 	}
 }
+
+func TestFloat16_Log_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Log", Float16.Log, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Log()
+	})
+}
+
+func TestFloat16_Log10_CorrectlyRounded(t *testing.T) {
+	ln10 := NewDoubleDouble(10).Log()
+	testCorrectlyRounded16(t, "Log10", Float16.Log10, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Log().Quo(ln10)
+	})
+}
+
+func TestFloat16_Log2_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Log2", Float16.Log2, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Log().Quo(ln2DD)
+	})
+}
@@ -76,3 +76,19 @@ func (a Float128) Log1p() Float128 {
 	}
 	return r
 }
+
+// Log2p1 returns the binary logarithm of 1 plus its argument a.
+// It is more accurate than [Log2](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float128) Log2p1() Float128 {
+	return a.Float256().Log2p1().Float128()
+}
+
+// Log10p1 returns the decimal logarithm of 1 plus its argument a.
+// It is more accurate than [Log10](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float128) Log10p1() Float128 {
+	return a.Float256().Log10p1().Float128()
+}
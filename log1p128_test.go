@@ -58,3 +58,95 @@ func BenchmarkFloat128_Log1p(b *testing.B) {
 		runtime.KeepAlive(x.Log1p())
 	}
 }
+
+func TestFloat128_Log2p1(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(-0.875), "-3.000000000000000000000000000000000000000"},
+		{exact128(-0x1p-10), "-0.001409570254671353540774410150836108987932"},
+		{exact128(0x1p-10), "0.001408194392808388906610166501689052423331"},
+		{exact128(0.5), "0.5849625007211561814537389439478165087598"},
+		{exact128(1.5), "1.321928094887362347870319429489390175865"},
+		{exact128(2), "1.584962500721156181453738943947816508760"},
+		{exact128(100), "6.658211482751794737171659113490309499795"},
+		{exact128(1000), "9.967226258835993524038145018214318500973"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2p1()
+		if !close128(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(1)},
+		{exact128(3), exact128(2)},
+		{exact128(7), exact128(3)},
+		{exact128(255), exact128(8)},
+		{exact128(-0.5), exact128(-1)},
+		{exact128(-0.75), exact128(-2)},
+		{exact128(-1), exact128(math.Inf(-1))},
+		{exact128(-2), exact128(math.NaN())},
+		{exact128(math.Inf(1)), exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2p1()
+		if !eq128(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Log10p1(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(-0.875), "-0.9030899869919435856412166841734790803046"},
+		{exact128(-0x1p-10), "-0.0004243229276517944254569726283749944713662"},
+		{exact128(0x1p-10), "0.0004239087519611519445451132742644783122351"},
+		{exact128(0.5), "0.1760912590556812420812890085306222824319"},
+		{exact128(1), "0.3010299956639811952137388947244930267682"},
+		{exact128(9), "1.000000000000000000000000000000000000000"},
+		{exact128(99), "2.000000000000000000000000000000000000000"},
+		{exact128(1000), "3.000434077479318640668921387777988866020"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10p1()
+		if !close128(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(-1), exact128(math.Inf(-1))},
+		{exact128(-2), exact128(math.NaN())},
+		{exact128(math.Inf(1)), exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10p1()
+		if !eq128(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
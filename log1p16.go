@@ -13,5 +13,30 @@ import "math"
 //	(a < -1).Log1p() = NaN
 //	NaN.Log1p() = NaN
 func (a Float16) Log1p() Float16 {
-	return NewFloat16(math.Log1p(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Log1p(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Log1p().Float16()
+}
+
+// Log2p1 returns the binary logarithm of 1 plus its argument a.
+// It is more accurate than [Log2](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float16) Log2p1() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Log2p1().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Log2p1().Float16()
+}
+
+// Log10p1 returns the decimal logarithm of 1 plus its argument a.
+// It is more accurate than [Log10](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float16) Log10p1() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Log10p1().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Log10p1().Float16()
 }
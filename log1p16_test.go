@@ -52,3 +52,107 @@ func BenchmarkFloat16_Log1p(b *testing.B) {
 		runtime.KeepAlive(x.Log1p())
 	}
 }
+
+func TestFloat16_Log1p_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Log1p", Float16.Log1p, log1pRef)
+}
+
+func TestFloat16_Log2p1(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(-0.875), -3.0},
+		{exact16(-0x1p-10), -0.0014095702546713536},
+		{exact16(0x1p-10), 0.0014081943928083889},
+		{exact16(0.5), 0.5849625007211562},
+		{exact16(1.5), 1.3219280948873624},
+		{exact16(2), 1.584962500721156},
+		{exact16(100), 6.658211482751795},
+		{exact16(1000), 9.967226258835993},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2p1()
+		if !close16(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(1)},
+		{exact16(3), exact16(2)},
+		{exact16(7), exact16(3)},
+		{exact16(255), exact16(8)},
+		{exact16(-0.5), exact16(-1)},
+		{exact16(-0.75), exact16(-2)},
+		{exact16(-1), exact16(math.Inf(-1))},
+		{exact16(-2), exact16(math.NaN())},
+		{exact16(math.Inf(1)), exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2p1()
+		if !eq16(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Log10p1(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(-0.875), -0.9030899869919435},
+		{exact16(-0x1p-10), -0.00042432292765179444},
+		{exact16(0x1p-10), 0.00042390875196115195},
+		{exact16(0.5), 0.17609125905568124},
+		{exact16(1), 0.3010299956639812},
+		{exact16(9), 1.0},
+		{exact16(99), 2.0},
+		{exact16(1000), 3.000434077479319},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10p1()
+		if !close16(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(-1), exact16(math.Inf(-1))},
+		{exact16(-2), exact16(math.NaN())},
+		{exact16(math.Inf(1)), exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10p1()
+		if !eq16(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Log2p1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Log2p1", Float16.Log2p1, log2p1Ref)
+}
+
+func TestFloat16_Log10p1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Log10p1", Float16.Log10p1, log10p1Ref)
+}
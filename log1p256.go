@@ -91,3 +91,48 @@ func (a Float256) Log1p() Float256 {
 	}
 	return r
 }
+
+// Log2p1 returns the binary logarithm of 1 plus its argument a.
+// It is more accurate than [Log2](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float256) Log2p1() Float256 {
+	var (
+		// One = 1.0
+		One = Float256(uvone256)
+
+		// Half = 0.5
+		Half = Float256{
+			0x3fff_e000_0000_0000, 0x0000_0000_0000_0000,
+			0x0000_0000_0000_0000, 0x0000_0000_0000_0000,
+		}
+
+		// Ln2Inv = 1/ln(2)
+		// ~ 1.44269504088896340735992468100189213742664595415298593413544940693110922
+		Ln2Inv = Float256{
+			0x3fff_f715_4765_2b82, 0xfe17_77d0_ffda_0d23,
+			0xa7d1_1d6a_ef55_1bad, 0x2b4b_1164_a2cd_9a34,
+		}
+	)
+
+	// Make sure 2**k - 1 gives an exact answer.
+	if s := One.Add(a); !a.IsZero() && !s.IsInf(0) && s.Sub(One).Eq(a) {
+		if frac, exp := s.Frexp(); frac.Eq(Half) {
+			return NewFloat256(float64(exp - 1))
+		}
+	}
+	return a.Log1p().Mul(Ln2Inv)
+}
+
+// Log10p1 returns the decimal logarithm of 1 plus its argument a.
+// It is more accurate than [Log10](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float256) Log10p1() Float256 {
+	// 1/ln(10) ~ 0.43429448190325182765112891891660508229439700580366656611445378316586465
+	var Ln10Inv = Float256{
+		0x3fff_dbcb_7b15_26e5, 0x0e32_a6ab_7555_f5a6,
+		0x7b86_47dc_68c0_48b9, 0x3440_4747_e5a8_9ef2,
+	}
+	return a.Log1p().Mul(Ln10Inv)
+}
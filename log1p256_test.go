@@ -57,3 +57,95 @@ func BenchmarkFloat256_Log1p(b *testing.B) {
 		runtime.KeepAlive(x.Log1p())
 	}
 }
+
+func TestFloat256_Log2p1(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(-0.875), "-3.000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(-0x1p-10), "-0.001409570254671353540774410150836108987931745029370199364146977653300461"},
+		{exact256(0x1p-10), "0.001408194392808388906610166501689052423331171579346223559770905179283491"},
+		{exact256(0.5), "0.5849625007211561814537389439478165087598144076924810604557526545410982"},
+		{exact256(1.5), "1.321928094887362347870319429489390175864831393024580612054756395815935"},
+		{exact256(2), "1.584962500721156181453738943947816508759814407692481060455752654541098"},
+		{exact256(100), "6.658211482751794737171659113490309499794851538967917264265649045423312"},
+		{exact256(1000), "9.967226258835993524038145018214318500972694553438946224528556895639507"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2p1()
+		if !close256(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		{exact256(0), exact256(0)},
+		{exact256(math.Copysign(0, -1)), exact256(math.Copysign(0, -1))},
+		{exact256(1), exact256(1)},
+		{exact256(3), exact256(2)},
+		{exact256(7), exact256(3)},
+		{exact256(255), exact256(8)},
+		{exact256(-0.5), exact256(-1)},
+		{exact256(-0.75), exact256(-2)},
+		{exact256(-1), exact256(math.Inf(-1))},
+		{exact256(-2), exact256(math.NaN())},
+		{exact256(math.Inf(1)), exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), exact256(math.NaN())},
+		{exact256(math.NaN()), exact256(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2p1()
+		if !eq256(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat256_Log10p1(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(-0.875), "-0.9030899869919435856412166841734790803045696443863256239312823833813246"},
+		{exact256(-0x1p-10), "-0.0004243229276517944254569726283749944713662016597517977395202322105091000"},
+		{exact256(0x1p-10), "0.0004239087519611519445451132742644783122350562883845932822272123542143981"},
+		{exact256(0.5), "0.1760912590556812420812890085306222824319389827285873235194381791781210"},
+		{exact256(1), "0.3010299956639811952137388947244930267681898814621085413104274611271082"},
+		{exact256(9), "1.000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(99), "2.000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(1000), "3.000434077479318640668921387777988866020003775177486772901364947395560"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10p1()
+		if !close256(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		{exact256(0), exact256(0)},
+		{exact256(math.Copysign(0, -1)), exact256(math.Copysign(0, -1))},
+		{exact256(-1), exact256(math.Inf(-1))},
+		{exact256(-2), exact256(math.NaN())},
+		{exact256(math.Inf(1)), exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), exact256(math.NaN())},
+		{exact256(math.NaN()), exact256(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10p1()
+		if !eq256(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
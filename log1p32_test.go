@@ -59,3 +59,107 @@ func BenchmarkFloat32_Log1p(b *testing.B) {
 		runtime.KeepAlive(x.Log1p())
 	}
 }
+
+func TestFloat32_Log1p_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Log1p", Float32.Log1p, log1pRef, -149, 128, 0x1p-149, -0x1p-149, -0.99999994)
+}
+
+func TestFloat32_Log2p1(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(-0.875), -3.0},
+		{exact32(-0x1p-10), -0.0014095702546713536},
+		{exact32(0x1p-10), 0.0014081943928083889},
+		{exact32(0.5), 0.5849625007211562},
+		{exact32(1.5), 1.3219280948873624},
+		{exact32(2), 1.584962500721156},
+		{exact32(100), 6.658211482751795},
+		{exact32(1000), 9.967226258835993},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2p1()
+		if !close32(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(1)},
+		{exact32(3), exact32(2)},
+		{exact32(7), exact32(3)},
+		{exact32(255), exact32(8)},
+		{exact32(-0.5), exact32(-1)},
+		{exact32(-0.75), exact32(-2)},
+		{exact32(-1), exact32(math.Inf(-1))},
+		{exact32(-2), exact32(math.NaN())},
+		{exact32(math.Inf(1)), exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2p1()
+		if !eq32(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Log10p1(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(-0.875), -0.9030899869919435},
+		{exact32(-0x1p-10), -0.00042432292765179444},
+		{exact32(0x1p-10), 0.00042390875196115195},
+		{exact32(0.5), 0.17609125905568124},
+		{exact32(1), 0.3010299956639812},
+		{exact32(9), 1.0},
+		{exact32(99), 2.0},
+		{exact32(1000), 3.000434077479319},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10p1()
+		if !close32(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(-1), exact32(math.Inf(-1))},
+		{exact32(-2), exact32(math.NaN())},
+		{exact32(math.Inf(1)), exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10p1()
+		if !eq32(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Log2p1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Log2p1", Float32.Log2p1, log2p1Ref, -149, 128, 0x1p-149, -0x1p-149, -0.99999994)
+}
+
+func TestFloat32_Log10p1_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Log10p1", Float32.Log10p1, log10p1Ref, -149, 128, 0x1p-149, -0x1p-149, -0.99999994)
+}
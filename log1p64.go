@@ -15,3 +15,33 @@ import "math"
 func (a Float64) Log1p() Float64 {
 	return NewFloat64(math.Log1p(a.BuiltIn()))
 }
+
+// Log2p1 returns the binary logarithm of 1 plus its argument a.
+// It is more accurate than [Log2](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float64) Log2p1() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0 || x <= -1 || math.IsNaN(x) || math.IsInf(x, 1):
+		return NewFloat64(math.Log1p(x))
+	}
+	return log1pDD(NewDoubleDouble(x)).Quo(ln2DD).Float64()
+}
+
+// Log10p1 returns the decimal logarithm of 1 plus its argument a.
+// It is more accurate than [Log10](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a Float64) Log10p1() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0 || x <= -1 || math.IsNaN(x) || math.IsInf(x, 1):
+		return NewFloat64(math.Log1p(x))
+	}
+	return log1pDD(NewDoubleDouble(x)).Quo(ln10DD).Float64()
+}
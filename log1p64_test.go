@@ -52,3 +52,95 @@ func BenchmarkFloat64_Log1p(b *testing.B) {
 		runtime.KeepAlive(x.Log1p())
 	}
 }
+
+func TestFloat64_Log2p1(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(-0.875), -3.0},
+		{exact64(-0x1p-10), -0.0014095702546713536},
+		{exact64(0x1p-10), 0.0014081943928083889},
+		{exact64(0.5), 0.5849625007211562},
+		{exact64(1.5), 1.3219280948873624},
+		{exact64(2), 1.584962500721156},
+		{exact64(100), 6.658211482751795},
+		{exact64(1000), 9.967226258835993},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2p1()
+		if !close64(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(1)},
+		{exact64(3), exact64(2)},
+		{exact64(7), exact64(3)},
+		{exact64(255), exact64(8)},
+		{exact64(-0.5), exact64(-1)},
+		{exact64(-0.75), exact64(-2)},
+		{exact64(-1), exact64(math.Inf(-1))},
+		{exact64(-2), exact64(math.NaN())},
+		{exact64(math.Inf(1)), exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2p1()
+		if !eq64(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Log10p1(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(-0.875), -0.9030899869919435},
+		{exact64(-0x1p-10), -0.00042432292765179444},
+		{exact64(0x1p-10), 0.00042390875196115195},
+		{exact64(0.5), 0.17609125905568124},
+		{exact64(1), 0.3010299956639812},
+		{exact64(9), 1.0},
+		{exact64(99), 2.0},
+		{exact64(1000), 3.000434077479319},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10p1()
+		if !close64(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(-1), exact64(math.Inf(-1))},
+		{exact64(-2), exact64(math.NaN())},
+		{exact64(math.Inf(1)), exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10p1()
+		if !eq64(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package floats
+
+import "math"
+
+// Log1p returns the natural logarithm of 1 plus its argument a.
+// It is more accurate than [Log](1 + a) when a is near zero.
+//
+// Special cases are:
+//
+//	+Inf.Log1p() = +Inf
+//	±0.Log1p() = ±0
+//	-1.Log1p() = -Inf
+//	(a < -1).Log1p() = NaN
+//	NaN.Log1p() = NaN
+func (a BFloat16) Log1p() BFloat16 {
+	return NewBFloat16(math.Log1p(a.Float64().BuiltIn()))
+}
+
+// Log2p1 returns the binary logarithm of 1 plus its argument a.
+// It is more accurate than [Log2](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a BFloat16) Log2p1() BFloat16 {
+	return NewBFloat16(a.Float64().Log2p1().BuiltIn())
+}
+
+// Log10p1 returns the decimal logarithm of 1 plus its argument a.
+// It is more accurate than [Log10](1 + a) when a is near zero.
+//
+// Special cases are the same as [Log1p].
+func (a BFloat16) Log10p1() BFloat16 {
+	return NewBFloat16(a.Float64().Log10p1().BuiltIn())
+}
@@ -0,0 +1,146 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBFloat16_Log1p(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Log1p(1)},
+		{exactBF16(2), math.Log1p(2)},
+		{exactBF16(3), math.Log1p(3)},
+		{exactBF16(4), math.Log1p(4)},
+		{exactBF16(11), math.Log1p(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log1p()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Log1p(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(-1), exactBF16(math.Inf(-1))},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log1p()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Log1p(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Log1p(b *testing.B) {
+	x := NewBFloat16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Log1p())
+	}
+}
+
+func TestBFloat16_Log2p1(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-0.875), -3.0},
+		{exactBF16(-0x1p-10), -0.0014095702546713536},
+		{exactBF16(0x1p-10), 0.0014081943928083889},
+		{exactBF16(0.5), 0.5849625007211562},
+		{exactBF16(1.5), 1.3219280948873624},
+		{exactBF16(2), 1.584962500721156},
+		{exactBF16(100), 6.658211482751795},
+		{exactBF16(1000), 9.967226258835993},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2p1()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(1)},
+		{exactBF16(3), exactBF16(2)},
+		{exactBF16(7), exactBF16(3)},
+		{exactBF16(255), exactBF16(8)},
+		{exactBF16(-0.5), exactBF16(-1)},
+		{exactBF16(-0.75), exactBF16(-2)},
+		{exactBF16(-1), exactBF16(math.Inf(-1))},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2p1()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Log2p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Log10p1(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(-0.875), -0.9030899869919435},
+		{exactBF16(-0x1p-10), -0.00042432292765179444},
+		{exactBF16(0x1p-10), 0.00042390875196115195},
+		{exactBF16(0.5), 0.17609125905568124},
+		{exactBF16(1), 0.3010299956639812},
+		{exactBF16(9), 1.0},
+		{exactBF16(99), 2.0},
+		{exactBF16(1000), 3.000434077479319},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10p1()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(-1), exactBF16(math.Inf(-1))},
+		{exactBF16(-2), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10p1()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Log10p1(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -143,3 +143,32 @@ func BenchmarkFloat32_Log2(b *testing.B) {
 		runtime.KeepAlive(x.Log2())
 	}
 }
+
+// float32NearOne returns the Float32 values near 1, where log cancels.
+func float32NearOne() []float32 {
+	var ret []float32
+	for x, y := float32(1), float32(1); x < 1.0001; {
+		x, y = math.Nextafter32(x, 2), math.Nextafter32(y, 0)
+		ret = append(ret, x, y)
+	}
+	return ret
+}
+
+func TestFloat32_Log_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Log", Float32.Log, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Log()
+	}, -149, 128, float32NearOne()...)
+}
+
+func TestFloat32_Log10_CorrectlyRounded(t *testing.T) {
+	ln10 := NewDoubleDouble(10).Log()
+	testCorrectlyRounded32(t, "Log10", Float32.Log10, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Log().Quo(ln10)
+	}, -149, 128, float32NearOne()...)
+}
+
+func TestFloat32_Log2_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Log2", Float32.Log2, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Log().Quo(ln2DD)
+	}, -149, 128, float32NearOne()...)
+}
@@ -0,0 +1,75 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBFloat16_Logb(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(1), exactBF16(0)},
+		{exactBF16(2), exactBF16(1)},
+		{exactBF16(0.5), exactBF16(-1)},
+		{exactBF16(4), exactBF16(2)},
+		{exactBF16(0.25), exactBF16(-2)},
+		{exactBF16(0x1p-133), exactBF16(-133)}, // smallest positive subnormal number
+
+		// special values
+		{exactBF16(0), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Logb()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Logb(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Logb(b *testing.B) {
+	x := exactBF16(1)
+	for b.Loop() {
+		runtime.KeepAlive(x.Logb())
+	}
+}
+
+func TestBFloat16_Ilogb(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want int
+	}{
+		{exactBF16(1), 0},
+		{exactBF16(2), 1},
+		{exactBF16(0.5), -1},
+		{exactBF16(4), 2},
+		{exactBF16(0.25), -2},
+		{exactBF16(0x1p-133), -133}, // smallest positive subnormal number
+
+		// special values
+		{exactBF16(0), math.MinInt32},
+		{exactBF16(math.Inf(1)), math.MaxInt32},
+		{exactBF16(math.Inf(-1)), math.MaxInt32},
+		{exactBF16(math.NaN()), math.MaxInt32},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Ilogb()
+		if got != tt.want {
+			t.Errorf("Ilogb(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Ilogb(b *testing.B) {
+	x := exactBF16(1)
+	for b.Loop() {
+		runtime.KeepAlive(x.Ilogb())
+	}
+}
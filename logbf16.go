@@ -0,0 +1,27 @@
+package floats
+
+import "math"
+
+// Log returns the natural logarithm of a.
+//
+// Special cases are:
+//
+//	+Inf.Log() = +Inf
+//	0.Log() = -Inf
+//	(x < 0).Log() = NaN
+//	NaN.Log() = NaN
+func (a BFloat16) Log() BFloat16 {
+	return NewBFloat16(math.Log(a.Float64().BuiltIn()))
+}
+
+// Log10 returns the decimal logarithm of a.
+// The special cases are the same as for [Log].
+func (a BFloat16) Log10() BFloat16 {
+	return NewBFloat16(math.Log10(a.Float64().BuiltIn()))
+}
+
+// Log2 returns the binary logarithm of a.
+// The special cases are the same as for [Log].
+func (a BFloat16) Log2() BFloat16 {
+	return NewBFloat16(math.Log2(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,145 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBFloat16_Log(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Log(1)},
+		{exactBF16(2), math.Log(2)},
+		{exactBF16(3), math.Log(3)},
+		{exactBF16(4), math.Log(4)},
+		{exactBF16(11), math.Log(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Log(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(-1))},
+		{exactBF16(-1), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Log(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Log(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Log())
+	}
+}
+
+func TestBFloat16_Log10(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Log10(1)},
+		{exactBF16(2), math.Log10(2)},
+		{exactBF16(3), math.Log10(3)},
+		{exactBF16(4), math.Log10(4)},
+		{exactBF16(11), math.Log10(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log10()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Log10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(-1))},
+		{exactBF16(-1), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log10()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Log10(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Log10(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Log10())
+	}
+}
+
+func TestBFloat16_Log2(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Log2(1)},
+		{exactBF16(2), math.Log2(2)},
+		{exactBF16(3), math.Log2(3)},
+		{exactBF16(4), math.Log2(4)},
+		{exactBF16(11), math.Log2(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log2()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Log2(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(-1))},
+		{exactBF16(-1), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log2()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Log2(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Log2(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Log2())
+	}
+}
@@ -0,0 +1,50 @@
+package floats
+
+import "math"
+
+// Log returns the natural logarithm of a.
+//
+// Special cases are:
+//
+//	+Inf.Log() = +Inf
+//	0.Log() = -Inf
+//	(x < 0).Log() = NaN
+//	NaN.Log() = NaN
+func (a DoubleDouble) Log() DoubleDouble {
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(1):
+		return a
+	case a[0] < 0:
+		return NewDoubleDoubleNaN()
+	case a.IsZero():
+		return NewDoubleDoubleInf(-1)
+	case a.Eq(NewDoubleDouble(1)):
+		return DoubleDouble{} // 0
+	}
+
+	// reduce; a = f * 2**k, sqrt(2)/2 <= f < sqrt(2)
+	_, k := math.Frexp(a[0])
+	f := a.ldexp(-k)
+	if f[0] < math.Sqrt2/2 {
+		f = f.ldexp(1)
+		k--
+	}
+
+	// Newton's iteration x' = x + f*exp(-x) - 1 converges to log(f).
+	// It doubles the number of correct bits, so one iteration is enough from float64.
+	x := NewDoubleDouble(math.Log(f[0]))
+	x = x.Add(f.Mul(x.Neg().Exp())).Sub(NewDoubleDouble(1))
+	return x.Add(ln2DD.mulFloat64(float64(k)))
+}
+
+// log1pDD returns log(1+a) for finite a > -1.
+// Unlike NewDoubleDouble(1).Add(a).Log(), it is accurate even when a is near zero.
+func log1pDD(a DoubleDouble) DoubleDouble {
+	if math.Abs(a[0]) < 0x1p-40 {
+		// log(1+a) = a - a²/2 + a³/3 - ...
+		a2 := a.Mul(a)
+		return a.Sub(a2.ldexp(-1).Sub(a2.Mul(a).Quo(NewDoubleDouble(3))))
+	}
+	return NewDoubleDouble(1).Add(a).Log()
+}
@@ -0,0 +1,49 @@
+package floats
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestDoubleDouble_Log(t *testing.T) {
+	tests := []struct {
+		x    DoubleDouble
+		want string
+	}{
+		{NewDoubleDouble(0.5), "-0.69314718055994530941723212145817656807550013436025525412068000949339362"},
+		{NewDoubleDouble(2), "0.69314718055994530941723212145817656807550013436025525412068000949339362"},
+		{NewDoubleDouble(10), "2.30258509299404568401799145468436420760110148862877297603332790096757261"},
+		{NewDoubleDouble(1e300), "690.77552789821370525790219666051368115065999044149323155039437648317131"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log()
+		if !closeDD(got, tt.want) {
+			t.Errorf("Log(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want DoubleDouble
+	}{
+		{NewDoubleDouble(1), NewDoubleDouble(0)},
+		{NewDoubleDouble(0), NewDoubleDoubleInf(-1)},
+		{NewDoubleDouble(-1), NewDoubleDoubleNaN()},
+		{NewDoubleDoubleInf(1), NewDoubleDoubleInf(1)},
+		{NewDoubleDoubleNaN(), NewDoubleDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log()
+		if !eqDD(got, tt.want) {
+			t.Errorf("Log(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkDoubleDouble_Log(b *testing.B) {
+	x := NewDoubleDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Log())
+	}
+}
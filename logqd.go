@@ -0,0 +1,43 @@
+package floats
+
+import "math"
+
+// Log returns the natural logarithm of a.
+//
+// Special cases are:
+//
+//	+Inf.Log() = +Inf
+//	0.Log() = -Inf
+//	(x < 0).Log() = NaN
+//	NaN.Log() = NaN
+func (a QuadDouble) Log() QuadDouble {
+	one := NewQuadDouble(1)
+
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(1):
+		return a
+	case a[0] < 0:
+		return NewQuadDoubleNaN()
+	case a.IsZero():
+		return NewQuadDoubleInf(-1)
+	case a.Eq(one):
+		return QuadDouble{} // 0
+	}
+
+	// reduce; a = f * 2**k, sqrt(2)/2 <= f < sqrt(2)
+	_, k := math.Frexp(a[0])
+	f := a.ldexp(-k)
+	if f[0] < math.Sqrt2/2 {
+		f = f.ldexp(1)
+		k--
+	}
+
+	// Newton's iteration x' = x + f*exp(-x) - 1 converges to log(f).
+	// It doubles the number of correct bits, so two iterations are enough from float64.
+	x := NewQuadDouble(math.Log(f[0]))
+	for range 2 {
+		x = x.Add(f.Mul(x.Neg().Exp())).Sub(one)
+	}
+	return x.Add(ln2QD.mulFloat64(float64(k)))
+}
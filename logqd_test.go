@@ -0,0 +1,49 @@
+package floats
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestQuadDouble_Log(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0.5), "-0.69314718055994530941723212145817656807550013436025525412068000949339362"},
+		{NewQuadDouble(2), "0.69314718055994530941723212145817656807550013436025525412068000949339362"},
+		{NewQuadDouble(10), "2.30258509299404568401799145468436420760110148862877297603332790096757261"},
+		{NewQuadDouble(1e300), "690.77552789821370525790219666051368115065999044149323155039437648317131"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Log()
+		if !closeQD(got, tt.want) {
+			t.Errorf("Log(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want QuadDouble
+	}{
+		{NewQuadDouble(1), NewQuadDouble(0)},
+		{NewQuadDouble(0), NewQuadDoubleInf(-1)},
+		{NewQuadDouble(-1), NewQuadDoubleNaN()},
+		{NewQuadDoubleInf(1), NewQuadDoubleInf(1)},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Log()
+		if !eqQD(got, tt.want) {
+			t.Errorf("Log(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Log(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Log())
+	}
+}
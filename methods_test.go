@@ -0,0 +1,30 @@
+package floats
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestMethodParity checks that every exported method of Float16
+// is also available on the other binary floating-point types.
+// The method sets of the pointer types are compared,
+// so that the methods with pointer receivers, such as UnmarshalJSON, are also checked.
+func TestMethodParity(t *testing.T) {
+	base := reflect.PointerTo(reflect.TypeOf(Float16(0)))
+	types := []reflect.Type{
+		reflect.TypeOf(BFloat16(0)),
+		reflect.TypeOf(Float32(0)),
+		reflect.TypeOf(Float64(0)),
+		reflect.TypeOf(Float128{}),
+		reflect.TypeOf(Float256{}),
+	}
+	for _, typ := range types {
+		ptr := reflect.PointerTo(typ)
+		for i := range base.NumMethod() {
+			name := base.Method(i).Name
+			if _, ok := ptr.MethodByName(name); !ok {
+				t.Errorf("%s.%s is missing", typ.Name(), name)
+			}
+		}
+	}
+}
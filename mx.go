@@ -0,0 +1,354 @@
+package floats
+
+import (
+	"errors"
+	"math"
+)
+
+// MXBlockSize is the number of elements that share a scale in an OCP Microscaling (MX) block.
+const MXBlockSize = 32
+
+// Float8E8M0 is the shared scale of OCP Microscaling (MX) formats.
+// It is an unsigned exponent with no fraction bits:
+// the encoding b represents 2**(b-127), and 0xff represents NaN.
+type Float8E8M0 uint8
+
+const (
+	uvnanE8M0 = 0xff // NaN value for Float8E8M0
+	biasE8M0  = 127  // bias for exponent
+)
+
+// NewFloat8E8M0FromBits converts the binary representation b to Float8E8M0.
+func NewFloat8E8M0FromBits(b uint8) Float8E8M0 {
+	return Float8E8M0(b)
+}
+
+// Bits returns the binary representation of a.
+func (a Float8E8M0) Bits() uint8 {
+	return uint8(a)
+}
+
+// IsNaN reports whether a is a “not-a-number” value.
+func (a Float8E8M0) IsNaN() bool {
+	return a == uvnanE8M0
+}
+
+// Exp returns the exponent of a, which represents 2**Exp.
+// If a is NaN, the result is undefined.
+func (a Float8E8M0) Exp() int {
+	return int(a) - biasE8M0
+}
+
+// Float32 converts a to a Float32.
+func (a Float8E8M0) Float32() Float32 {
+	return a.Float64().Float32()
+}
+
+// Float64 converts a to a Float64.
+func (a Float8E8M0) Float64() Float64 {
+	if a.IsNaN() {
+		return Float64(math.NaN())
+	}
+	return Float64(math.Ldexp(1, a.Exp()))
+}
+
+// MXFormat is the element format of an OCP Microscaling (MX) block.
+type MXFormat uint8
+
+const (
+	// MXFP8E4M3 is MXFP8 with Float8E4M3 elements.
+	MXFP8E4M3 MXFormat = iota + 1
+
+	// MXFP8E5M2 is MXFP8 with Float8E5M2 elements.
+	MXFP8E5M2
+
+	// MXFP6E3M2 is MXFP6 with 6-bit elements of 3 exponent bits and 2 fraction bits.
+	// The elements have no infinities and NaNs, and the largest finite value is 28.
+	MXFP6E3M2
+
+	// MXFP6E2M3 is MXFP6 with 6-bit elements of 2 exponent bits and 3 fraction bits.
+	// The elements have no infinities and NaNs, and the largest finite value is 7.5.
+	MXFP6E2M3
+
+	// MXFP4E2M1 is MXFP4 with 4-bit elements of 2 exponent bits and 1 fraction bit.
+	// The elements have no infinities and NaNs, and the largest finite value is 6.
+	MXFP4E2M1
+
+	// MXINT8 is MXINT8 with 8-bit two's complement elements that have an implicit scale of 2**-6.
+	// Quantization saturates the elements symmetrically to [-127/64, 127/64],
+	// though the encoding 0x80 is decoded as -2.
+	MXINT8
+)
+
+// String returns the name of f.
+func (f MXFormat) String() string {
+	switch f {
+	case MXFP8E4M3:
+		return "MXFP8E4M3"
+	case MXFP8E5M2:
+		return "MXFP8E5M2"
+	case MXFP6E3M2:
+		return "MXFP6E3M2"
+	case MXFP6E2M3:
+		return "MXFP6E2M3"
+	case MXFP4E2M1:
+		return "MXFP4E2M1"
+	case MXINT8:
+		return "MXINT8"
+	}
+	return "MXFormat(invalid)"
+}
+
+// BitsPerElement returns the number of bits of an element in format f.
+func (f MXFormat) BitsPerElement() int {
+	switch f {
+	case MXFP8E4M3, MXFP8E5M2, MXINT8:
+		return 8
+	case MXFP6E3M2, MXFP6E2M3:
+		return 6
+	case MXFP4E2M1:
+		return 4
+	}
+	panic("floats: invalid MX format")
+}
+
+// layout returns the number of exponent and fraction bits of the element format.
+// It is zero for formats that are not handled as small floating-point numbers.
+func (f MXFormat) layout() (ebits, mbits uint) {
+	switch f {
+	case MXFP6E3M2:
+		return 3, 2
+	case MXFP6E2M3:
+		return 2, 3
+	case MXFP4E2M1:
+		return 2, 1
+	}
+	return 0, 0
+}
+
+// emax returns the exponent of the largest normal number of the element format.
+func (f MXFormat) emax() int {
+	switch f {
+	case MXFP8E4M3:
+		return 8
+	case MXFP8E5M2:
+		return 15
+	case MXFP6E3M2:
+		return 4
+	case MXFP6E2M3, MXFP4E2M1:
+		return 2
+	case MXINT8:
+		return 0
+	}
+	panic("floats: invalid MX format")
+}
+
+// MXBlock is a block of an OCP Microscaling (MX) format.
+// The value of the i-th element is Scale × (the value of Elements[i] in Format).
+type MXBlock struct {
+	// Format is the element format.
+	Format MXFormat
+
+	// Scale is the shared scale of the elements.
+	// If it is NaN, all elements are NaN.
+	Scale Float8E8M0
+
+	// Elements are the encodings of the elements.
+	// Elements narrower than 8 bits are stored in the low bits.
+	Elements [MXBlockSize]uint8
+}
+
+// NewMXBlockFromFloat32 quantizes x to an MX block in format.
+// If x has fewer than [MXBlockSize] elements, the rest of the block is filled with zeros.
+// It panics if x has more than [MXBlockSize] elements.
+//
+// The shared scale is the largest power of two of the maximum absolute value in x,
+// divided by the largest power of two of the element format.
+// Each element is rounded to nearest even and saturated to the largest finite value of the format.
+// If x contains NaN or infinity, the scale is NaN.
+func NewMXBlockFromFloat32(format MXFormat, x []Float32) MXBlock {
+	if len(x) > MXBlockSize {
+		panic("floats: too many elements for an MX block")
+	}
+	var v [MXBlockSize]float64
+	for i, f := range x {
+		v[i] = f.Float64().BuiltIn()
+	}
+	return newMXBlock(format, &v)
+}
+
+// NewMXBlockFromFloat16 quantizes x to an MX block in format.
+// See [NewMXBlockFromFloat32] for details.
+func NewMXBlockFromFloat16(format MXFormat, x []Float16) MXBlock {
+	if len(x) > MXBlockSize {
+		panic("floats: too many elements for an MX block")
+	}
+	var v [MXBlockSize]float64
+	for i, f := range x {
+		v[i] = f.Float64().BuiltIn()
+	}
+	return newMXBlock(format, &v)
+}
+
+func newMXBlock(format MXFormat, v *[MXBlockSize]float64) MXBlock {
+	emax := format.emax()
+	b := MXBlock{Format: format}
+
+	var amax float64
+	for _, f := range v {
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			b.Scale = uvnanE8M0
+			return b
+		}
+		amax = max(amax, math.Abs(f))
+	}
+
+	// select the shared exponent
+	exp := -biasE8M0
+	if amax != 0 {
+		exp = max(math.Ilogb(amax)-emax, -biasE8M0)
+	}
+	b.Scale = Float8E8M0(exp + biasE8M0)
+
+	for i, f := range v {
+		// dividing by the power of two is exact,
+		// so the element is rounded only once.
+		b.Elements[i] = format.quantize(math.Ldexp(f, -exp))
+	}
+	return b
+}
+
+// quantize converts v to an element in format f.
+func (f MXFormat) quantize(v float64) uint8 {
+	switch f {
+	case MXFP8E4M3:
+		return uint8(Float64(v).Float8E4M3Sat())
+	case MXFP8E5M2:
+		return uint8(Float64(v).Float8E5M2Sat())
+	case MXINT8:
+		q := math.RoundToEven(math.Ldexp(v, 6))
+		q = min(max(q, -127), 127)
+		return uint8(int8(q))
+	}
+
+	ebits, mbits := f.layout()
+	sign := uint8(0)
+	if math.Signbit(v) {
+		sign = 1 << (ebits + mbits)
+		v = -v
+	}
+	if v == 0 {
+		return sign
+	}
+
+	bias := 1<<(ebits-1) - 1
+	emin := 1 - bias
+	e := max(math.Ilogb(v), emin)
+
+	// count v in units of the least significant bit.
+	// the exponent field is added to the count,
+	// so a carry caused by rounding goes into the exponent naturally.
+	q := uint8(math.RoundToEven(math.Ldexp(v, int(mbits)-e)))
+	bits := uint8(e-emin)<<mbits + q
+	bits = min(bits, 1<<(ebits+mbits)-1) // saturate
+	return sign | bits
+}
+
+// value returns the value of the element bits in format f.
+func (f MXFormat) value(bits uint8) float64 {
+	switch f {
+	case MXFP8E4M3:
+		return Float8E4M3(bits).Float64().BuiltIn()
+	case MXFP8E5M2:
+		return Float8E5M2(bits).Float64().BuiltIn()
+	case MXINT8:
+		return math.Ldexp(float64(int8(bits)), -6)
+	}
+
+	ebits, mbits := f.layout()
+	bias := 1<<(ebits-1) - 1
+	exp := int(bits>>mbits) & (1<<ebits - 1)
+	frac := bits & (1<<mbits - 1)
+
+	var v float64
+	if exp == 0 {
+		// subnormal
+		v = math.Ldexp(float64(frac), 1-bias-int(mbits))
+	} else {
+		v = math.Ldexp(float64(frac|1<<mbits), exp-bias-int(mbits))
+	}
+	if bits&(1<<(ebits+mbits)) != 0 {
+		v = -v
+	}
+	return v
+}
+
+// Float64 returns the value of the i-th element of b.
+func (b *MXBlock) Float64(i int) Float64 {
+	if b.Scale.IsNaN() {
+		return Float64(math.NaN())
+	}
+	return Float64(math.Ldexp(b.Format.value(b.Elements[i]), b.Scale.Exp()))
+}
+
+// Float32s returns the values of the elements of b.
+// The values are rounded to nearest even if they are not exactly representable in Float32.
+func (b *MXBlock) Float32s() [MXBlockSize]Float32 {
+	var ret [MXBlockSize]Float32
+	for i := range ret {
+		ret[i] = b.Float64(i).Float32()
+	}
+	return ret
+}
+
+// Float16s returns the values of the elements of b.
+// The values are rounded to nearest even if they are not exactly representable in Float16.
+func (b *MXBlock) Float16s() [MXBlockSize]Float16 {
+	var ret [MXBlockSize]Float16
+	for i := range ret {
+		ret[i] = b.Float64(i).Float16()
+	}
+	return ret
+}
+
+// AppendPacked appends the elements of b packed with [MXFormat.BitsPerElement] bits to dst.
+// The i-th element occupies the bits [i×w, (i+1)×w) of the little-endian bit stream.
+// The scale is not included.
+func (b *MXBlock) AppendPacked(dst []byte) []byte {
+	w := uint(b.Format.BitsPerElement())
+	var acc uint32
+	var n uint
+	for _, e := range b.Elements {
+		acc |= uint32(e&(1<<w-1)) << n
+		n += w
+		for n >= 8 {
+			dst = append(dst, byte(acc))
+			acc >>= 8
+			n -= 8
+		}
+	}
+	return dst
+}
+
+// NewMXBlockFromPacked returns an MX block with the scale and the elements packed by [MXBlock.AppendPacked].
+func NewMXBlockFromPacked(format MXFormat, scale Float8E8M0, data []byte) (MXBlock, error) {
+	w := uint(format.BitsPerElement())
+	if len(data) != MXBlockSize*int(w)/8 {
+		return MXBlock{}, errors.New("floats: invalid length of packed MX block")
+	}
+
+	b := MXBlock{Format: format, Scale: scale}
+	var acc uint32
+	var n uint
+	for i := range b.Elements {
+		for n < w {
+			acc |= uint32(data[0]) << n
+			data = data[1:]
+			n += 8
+		}
+		b.Elements[i] = uint8(acc & (1<<w - 1))
+		acc >>= w
+		n -= w
+	}
+	return b, nil
+}
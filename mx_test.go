@@ -0,0 +1,206 @@
+package floats
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestFloat8E8M0(t *testing.T) {
+	tests := []struct {
+		in   Float8E8M0
+		want Float64
+	}{
+		{0x00, 0x1p-127},
+		{0x7f, 1},
+		{0x80, 2},
+		{0xfe, 0x1p127},
+		{0xff, Float64(math.NaN())},
+	}
+	for _, tt := range tests {
+		if got := tt.in.Float64(); !eq64(got, tt.want) {
+			t.Errorf("Float8E8M0(%x).Float64() = %x, want %x", tt.in, got, tt.want)
+		}
+		if got := tt.in.Float32(); !eq32(got, tt.want.Float32()) {
+			t.Errorf("Float8E8M0(%x).Float32() = %x, want %x", tt.in, got, tt.want.Float32())
+		}
+	}
+}
+
+func TestMXFormat_Elements(t *testing.T) {
+	tests := []struct {
+		format MXFormat
+		max    float64
+		values []float64 // the non-negative values in the order of the encodings
+	}{
+		{MXFP4E2M1, 6, []float64{0, 0.5, 1, 1.5, 2, 3, 4, 6}},
+		{MXFP6E2M3, 7.5, nil},
+		{MXFP6E3M2, 28, nil},
+		{MXFP8E4M3, 448, nil},
+		{MXFP8E5M2, 57344, nil},
+		{MXINT8, 127.0 / 64, nil},
+	}
+	for _, tt := range tests {
+		w := tt.format.BitsPerElement()
+		for i, v := range tt.values {
+			if got := tt.format.value(uint8(i)); got != v {
+				t.Errorf("%v: value(%#x) = %v, want %v", tt.format, i, got, v)
+			}
+		}
+
+		// all encodings round trip
+		var largest float64
+		for i := range 1 << w {
+			v := tt.format.value(uint8(i))
+			if math.IsNaN(v) || math.IsInf(v, 0) || v == 0 {
+				continue
+			}
+			if tt.format == MXINT8 && v == -2 {
+				// MXINT8 elements are saturated symmetrically.
+				continue
+			}
+			largest = max(largest, v)
+			if got := tt.format.quantize(v); got != uint8(i) {
+				t.Errorf("%v: quantize(%v) = %#x, want %#x", tt.format, v, got, i)
+			}
+		}
+		if largest != tt.max {
+			t.Errorf("%v: the largest value is %v, want %v", tt.format, largest, tt.max)
+		}
+
+		// saturation
+		if got := tt.format.value(tt.format.quantize(1e6)); got != tt.max {
+			t.Errorf("%v: quantize(1e6) = %v, want %v", tt.format, got, tt.max)
+		}
+		if got := tt.format.value(tt.format.quantize(-1e6)); got != -tt.max {
+			t.Errorf("%v: quantize(-1e6) = %v, want %v", tt.format, got, -tt.max)
+		}
+	}
+}
+
+func TestNewMXBlockFromFloat32(t *testing.T) {
+	tests := []struct {
+		format MXFormat
+		in     []Float32
+		scale  Float8E8M0
+		want   []Float32
+	}{
+		// exactly representable
+		{MXFP4E2M1, []Float32{1, -2, 3, 6, 0.5}, 0x7f, []Float32{1, -2, 3, 6, 0.5}},
+		{MXFP4E2M1, []Float32{24, -8, 2}, 0x81, []Float32{24, -8, 2}},
+
+		// round to nearest even
+		{MXFP4E2M1, []Float32{4, 1.25, 1.75, 2.5, 5}, 0x7f, []Float32{4, 1, 2, 2, 4}},
+
+		// saturation: 7 is in the same binade as 4, but larger than 6
+		{MXFP4E2M1, []Float32{7, -7.5}, 0x7f, []Float32{6, -6}},
+
+		// small values are flushed to zero
+		{MXFP4E2M1, []Float32{4, 0.2, 0.25, 0.26}, 0x7f, []Float32{4, 0, 0, 0.5}},
+
+		{MXFP6E2M3, []Float32{1, 0.1, 7.75}, 0x7f, []Float32{1, 0.125, 7.5}},
+		{MXFP6E3M2, []Float32{16, 0.1, 30}, 0x7f, []Float32{16, 0.125, 28}},
+		{MXFP8E4M3, []Float32{256, 0.1, 3.14159}, 0x7f, []Float32{256, 0.1015625, 3.25}},
+		{MXFP8E5M2, []Float32{1, 0x1p-20}, 0x70, []Float32{1, 0x1p-20}},
+		{MXINT8, []Float32{1, -1, 0.5, 1.999, 0x1p-7}, 0x7f, []Float32{1, -1, 0.5, 127.0 / 64, 0}},
+
+		// the scale is clamped
+		{MXFP4E2M1, []Float32{0x1p-140, 0}, 0x00, []Float32{0, 0}},
+		{MXFP8E4M3, []Float32{0x1p-130}, 0x00, []Float32{0x1p-130}},
+		{MXINT8, []Float32{0}, 0x00, []Float32{0}},
+	}
+
+	for _, tt := range tests {
+		b := NewMXBlockFromFloat32(tt.format, tt.in)
+		if b.Scale != tt.scale {
+			t.Errorf("%v %v: scale = %#x, want %#x", tt.format, tt.in, b.Scale, tt.scale)
+		}
+		got := b.Float32s()
+		for i, want := range tt.want {
+			if !eq32(got[i], want) {
+				t.Errorf("%v %v: element %d = %v, want %v", tt.format, tt.in, i, got[i], want)
+			}
+		}
+		for i := len(tt.want); i < MXBlockSize; i++ {
+			if !eq32(got[i], 0) {
+				t.Errorf("%v %v: element %d = %v, want 0", tt.format, tt.in, i, got[i])
+			}
+		}
+	}
+}
+
+func TestNewMXBlockFromFloat32_NaN(t *testing.T) {
+	for _, x := range []Float32{Float32(math.NaN()), Float32(math.Inf(1)), Float32(math.Inf(-1))} {
+		b := NewMXBlockFromFloat32(MXFP8E5M2, []Float32{1, x})
+		if !b.Scale.IsNaN() {
+			t.Errorf("scale of a block with %v = %#x, want NaN", x, b.Scale)
+		}
+		for i, f := range b.Float32s() {
+			if !f.IsNaN() {
+				t.Errorf("element %d of a block with %v = %v, want NaN", i, x, f)
+			}
+		}
+	}
+}
+
+func TestNewMXBlockFromFloat16(t *testing.T) {
+	in := []Float16{exact16(0x1p-24), exact16(0x1p-20), exact16(-0x1.8p-22), exact16(0x1.4p-21)}
+	b := NewMXBlockFromFloat16(MXFP4E2M1, in)
+	if got, want := b.Scale.Exp(), -22; got != want {
+		t.Errorf("scale = %d, want %d", got, want)
+	}
+	got := b.Float16s()
+	want := []Float16{0, exact16(0x1p-20), exact16(-0x1.8p-22), exact16(0x1p-21)}
+	for i := range want {
+		if !eq16(got[i], want[i]) {
+			t.Errorf("element %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMXBlock_Packed(t *testing.T) {
+	for _, format := range []MXFormat{MXFP8E4M3, MXFP8E5M2, MXFP6E3M2, MXFP6E2M3, MXFP4E2M1, MXINT8} {
+		var in [MXBlockSize]Float32
+		for i := range in {
+			in[i] = Float32(i-16) / 4
+		}
+		b := NewMXBlockFromFloat32(format, in[:])
+		data := b.AppendPacked(nil)
+		if got, want := len(data), MXBlockSize*format.BitsPerElement()/8; got != want {
+			t.Errorf("%v: len(AppendPacked) = %d, want %d", format, got, want)
+		}
+		got, err := NewMXBlockFromPacked(format, b.Scale, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != b {
+			t.Errorf("%v: NewMXBlockFromPacked(AppendPacked) = %v, want %v", format, got, b)
+		}
+		if _, err := NewMXBlockFromPacked(format, b.Scale, data[1:]); err == nil {
+			t.Errorf("%v: expected error, got nil", format)
+		}
+	}
+
+	// the first element is in the low bits
+	b := MXBlock{Format: MXFP4E2M1}
+	b.Elements[0] = 0x1
+	b.Elements[1] = 0xf
+	b.Elements[31] = 0x8
+	data := b.AppendPacked(nil)
+	want := make([]byte, 16)
+	want[0] = 0xf1
+	want[15] = 0x80
+	if !bytes.Equal(data, want) {
+		t.Errorf("AppendPacked() = %x, want %x", data, want)
+	}
+}
+
+func BenchmarkNewMXBlockFromFloat32(b *testing.B) {
+	var in [MXBlockSize]Float32
+	for i := range in {
+		in[i] = Float32(i) / 7
+	}
+	for b.Loop() {
+		NewMXBlockFromFloat32(MXFP8E4M3, in[:])
+	}
+}
@@ -0,0 +1,13 @@
+package floats
+
+import "math"
+
+// NewBFloat16Pow10 returns 10**n, the base-10 exponential of n.
+//
+// Special cases are:
+//
+//	NewBFloat16Pow10(n) =    0 for n < -7
+//	NewBFloat16Pow10(n) = +Inf for n > 4
+func NewBFloat16Pow10(n int) BFloat16 {
+	return NewBFloat16(math.Pow10(n))
+}
@@ -0,0 +1,45 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewBFloat16Pow10(t *testing.T) {
+	tests := []struct {
+		n    int
+		want float64
+	}{
+		{0, 1},
+		{1, 10},
+		{2, 100},
+		{3, 1000},
+		{4, 10000},
+		{-1, 0.1},
+		{-2, 0.01},
+		{-3, 0.001},
+		{-4, 0.0001},
+	}
+
+	for _, test := range tests {
+		got := NewBFloat16Pow10(test.n)
+		if !closeBF16(got, test.want) {
+			t.Errorf("NewBFloat16Pow10(%d) = %v; want %v", test.n, got, test.want)
+		}
+	}
+
+	strictTests := []struct {
+		n    int
+		want BFloat16
+	}{
+		{39, exactBF16(math.Inf(1))}, // overflow
+		{-42, exactBF16(0)},          // underflow
+	}
+
+	for _, tt := range strictTests {
+		got := NewBFloat16Pow10(tt.n)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("NewBFloat16Pow10(%d) = %v; want %v", tt.n, got, tt.want)
+		}
+	}
+}
@@ -1,7 +1,16 @@
 package floats
 
+import "math/big"
+
 // Pow returns a**b, the base-a exponential of b.
 //
+// The result is computed as e**(b×log(a)) in Float256 with a relative error less than 2⁻²¹⁰.
+// If the result can't be rounded to Float128 correctly from this approximation,
+// a**b is checked to be an exact midpoint between two Float128 values.
+// So the error is less than 0.5 + 2⁻⁹⁶ ulp,
+// and the result is correctly rounded unless a**b is within the relative distance 2⁻²¹⁰
+// of a midpoint without being equal to it.
+//
 // Special cases are (in order):
 //
 //	a.Pow(±0) = 1 for any a
@@ -34,9 +43,6 @@ func (a Float128) Pow(b Float128) Float128 {
 
 		// Half = 0.5
 		Half = Float128{0x3ffe_0000_0000_0000, 0x0000_0000_0000_0000}
-
-		// MaxInt64 = 2^63
-		MaxInt64 = Float128{0x403e_0000_0000_0000, 0x0000_0000_0000_0000}
 	)
 
 	switch {
@@ -80,72 +86,96 @@ func (a Float128) Pow(b Float128) Float128 {
 		}
 	case b.Eq(Half):
 		return a.Sqrt()
-	case b.Eq(Half.Neg()):
-		return One.Quo(a.Sqrt())
 	}
 
-	absy := b
-	flip := false
-	if absy.Lt(Zero) {
-		absy = absy.Neg()
-		flip = true
-	}
-	yi, yf := absy.Modf()
-	if !yf.IsZero() && a.Lt(Zero) {
-		return NewFloat128NaN()
+	neg := false
+	if a.Lt(Zero) {
+		if _, frac := b.Modf(); !frac.IsZero() {
+			return NewFloat128NaN()
+		}
+		a = a.Neg()
+		neg = isOddInt128(b)
 	}
-	if yi.Ge(MaxInt64) {
-		// yi is a large even int that will lead to overflow (or underflow to 0)
-		// for all x except -1 (x == 1 was handled earlier)
-		switch {
-		case a.Eq(One.Neg()):
-			return One
-		case a.Abs().Lt(One) == (b.Gt(Zero)):
-			return Zero
-		default:
-			return NewFloat128Inf(1)
+
+	// a**b = e**(b×log(a))
+	t := b.Float256().Mul(logKernel256(a.Float256()))
+	var ret Float128
+	switch tf := t.Float64().BuiltIn(); {
+	case tf > 11400:
+		ret = NewFloat128Inf(1)
+	case tf < -11500:
+		ret = Zero
+	default:
+		// the relative error of y is less than 2⁻²¹⁰.
+		y := expKernel256(t)
+		d := y.Ldexp(-210)
+		lo, hi := y.Sub(d).Float128(), y.Add(d).Float128()
+		if lo == hi {
+			ret = lo
+		} else if m, ok := powMidpoint128(a, b, lo, hi); ok {
+			ret = m.Float128()
+		} else {
+			ret = y.Float128()
 		}
 	}
+	if neg {
+		ret = ret.Neg()
+	}
+	return ret
+}
+
+// powMidpoint128 reports whether a**b is exactly the midpoint m of lo and hi,
+// where a > 0 and lo and hi are adjacent Float128 values.
+// Such a case can't be decided by any approximation, so it is checked with math/big.
+func powMidpoint128(a, b, lo, hi Float128) (m Float256, ok bool) {
+	if hi.IsInf(0) {
+		return Float256{}, false
+	}
+	m = lo.Float256().Add(hi.Float256()).Ldexp(-1)
 
-	// ans = a1 * 2**ae (= 1 for now).
-	a1 := One
-	ae := 0
+	// a = x × 2**ea, b = nb × 2**eb and m = xm × 2**em, where x, nb and xm are odd.
+	x, ea := oddPart(a.BigFloat(nil))
+	nb, eb := oddPart(b.BigFloat(nil))
+	xm, em := oddPart(m.BigFloat(nil))
 
-	// ans *= x**yf
-	if !yf.IsZero() {
-		if yf.Gt(Half) {
-			yf = yf.Sub(One)
-			yi = yi.Add(One)
-		}
-		a1 = (yf.Mul(a.Log())).Exp()
+	// let b = p / q; a**b = m if and only if a**p = m**q.
+	p, q := nb, big.NewInt(1)
+	if eb >= 0 {
+		p = new(big.Int).Lsh(nb, uint(eb))
+	} else {
+		q.Lsh(q, uint(-eb))
 	}
 
-	// ans *= x**yi
-	// by multiplying in successive squarings
-	// of x according to bits of yi.
-	// accumulate powers of two into exp.
-	x1, xe := a.Frexp()
-	for i := yi.Int64(); i != 0; i >>= 1 {
-		if i&1 != 0 {
-			a1 = a1.Mul(x1)
-			ae += xe
-		}
-		x1 = x1.Mul(x1)
-		xe <<= 1
-		if x1.Lt(Half) {
-			x1 = x1.Add(x1)
-			xe--
-		}
+	// check the exponents; ea × p = em × q.
+	lhs := new(big.Int).Mul(big.NewInt(int64(ea)), p)
+	rhs := new(big.Int).Mul(big.NewInt(int64(em)), q)
+	if lhs.Cmp(rhs) != 0 {
+		return Float256{}, false
 	}
 
-	// ans = a1*2**ae
-	// if flip { ans = 1 / ans }
-	// but in the opposite order
-	if flip {
-		a1 = One.Quo(a1)
-		ae = -ae
+	// check the odd parts; x**p = xm**q.
+	one := big.NewInt(1)
+	if x.Cmp(one) == 0 || xm.Cmp(one) == 0 {
+		return m, x.Cmp(xm) == 0
+	}
+	// x >= 3 and xm < 2¹¹⁴, so x**p = xm**q implies p < 114 × q.
+	// Moreover x is a (q)th power of an integer that is at least 3, so q < 72.
+	if p.Sign() < 0 || q.Cmp(big.NewInt(72)) >= 0 || p.Cmp(new(big.Int).Mul(big.NewInt(114), q)) >= 0 {
+		return Float256{}, false
 	}
-	return a1.Ldexp(ae)
+	lhs.Exp(x, p, nil)
+	rhs.Exp(xm, q, nil)
+	return m, lhs.Cmp(rhs) == 0
+}
+
+// oddPart returns the odd integer n and the exponent exp
+// such that x = n × 2**exp, where x is a finite non-zero number.
+func oddPart(x *big.Float) (n *big.Int, exp int) {
+	mant := new(big.Float)
+	exp = x.MantExp(mant)
+	prec := int(mant.MinPrec())
+	n, _ = mant.SetMantExp(mant, prec).Int(nil)
+	return n, exp - prec
 }
 
 func isOddInt128(x Float128) bool {
@@ -2,6 +2,8 @@ package floats
 
 import (
 	"math"
+	"math/big"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 )
@@ -133,6 +135,89 @@ func TestFloat128_Pow(t *testing.T) {
 	}
 }
 
+func TestFloat128_Pow_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 15))
+	type input struct {
+		x, y Float128
+	}
+	inputs := []input{
+		// x is very close to 1 and y is large.
+		{exact128(1).Add(exact128(0x1p-112)), exact128(0x1p112)},
+		{exact128(1).Sub(exact128(0x1p-113)), exact128(-0x1p120)},
+		{exact128(-1).Sub(exact128(0x1p-112)), exact128(0x1p100 + 1)},
+	}
+	for range 300 {
+		// |y×log(x)| < 11000 to avoid overflow and underflow.
+		x := randFloat128(r, -1000, 1000)
+		lx := math.Abs(math.Log(x.Float64().BuiltIn()))
+		y := randFloat128(r, -20, 32)
+		for y.Float64().BuiltIn()*lx > 11000 {
+			y = y.Ldexp(-8)
+		}
+		if r.IntN(2) == 0 {
+			y = y.Neg()
+		}
+		inputs = append(inputs, input{x, y})
+
+		// negative x with integer y
+		n := exact128(float64(r.IntN(1000) - 500))
+		inputs = append(inputs, input{x.Neg(), n})
+	}
+
+	for _, tt := range inputs {
+		got := tt.x.Pow(tt.y)
+		want := round128(expBig(new(big.Float).Mul(tt.y.BigFloat(nil), logBig(tt.x.Abs().BigFloat(nil)))))
+		if tt.x.Signbit() && isOddInt128(tt.y) {
+			want = want.Neg()
+		}
+		if !eq128(got, want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, want)
+		}
+	}
+}
+
+func TestFloat128_Pow_Midpoint(t *testing.T) {
+	one := exact128(1)
+
+	// (3×2⁵⁵+1)² = 9×2¹¹⁰ + 3×2⁵⁶ + 1 needs 114 bits, so it is a midpoint.
+	m := new(big.Int).Lsh(big.NewInt(3), 55)
+	m.Add(m, big.NewInt(1))
+	x, _ := NewFloat128FromBigInt(m)
+	want, _ := NewFloat128FromBigInt(new(big.Int).Mul(m, m))
+
+	// (2³⁸-1)³ needs 114 bits.
+	n := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 38), big.NewInt(1))
+	n2 := new(big.Int).Mul(n, n)
+	n3 := new(big.Int).Mul(n2, n)
+	x2, _ := NewFloat128FromBigInt(n2)
+	want3, _ := NewFloat128FromBigInt(n3)
+
+	tests := []struct {
+		x, y Float128
+		want Float128
+	}{
+		{x, exact128(2), want},
+		{x.Neg(), exact128(2), want},
+		{x.Ldexp(-100), exact128(2), want.Ldexp(-200)},
+		{x2, exact128(1.5), want3},
+		{x2.Ldexp(-2), exact128(1.5), want3.Ldexp(-3)},
+
+		// powers of two around the smallest subnormal
+		{exact128(2), exact128(-16494), Float128{0, 1}},
+		{exact128(2), exact128(-16495), Float128{}},
+		{exact128(0.5), exact128(16495), Float128{}},
+		{exact128(4), exact128(-8247.5), Float128{}},
+		{exact128(2), exact128(16383), one.Ldexp(16383)},
+		{exact128(2), exact128(16384), exact128(math.Inf(1))},
+	}
+	for _, tt := range tests {
+		got := tt.x.Pow(tt.y)
+		if !eq128(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
 func BenchmarkFloat128_Pow(b *testing.B) {
 	x := exact128(1.5)
 	for b.Loop() {
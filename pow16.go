@@ -27,5 +27,37 @@ import "math"
 //	-Inf.Pow(b) = (-0).Pow(-b)
 //	a.Pow(b) = NaN for finite a < 0 and finite non-integer b
 func (a Float16) Pow(b Float16) Float16 {
-	return NewFloat16(math.Pow(a.Float64().BuiltIn(), b.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(powApprox(a.Float64().BuiltIn(), b.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Pow(b.Float256()).Float16()
+}
+
+// powApprox returns an approximation of x**y with a relative error less than approxErr64
+// if the result is in the range of Float32.
+//
+// math.Pow multiplies by repeated squaring for the integer part of y,
+// and its error grows with y.
+// Instead, it is computed as exp(y*log|x|).
+// The absolute error of y*log|x| is about |y*log|x|| ulps,
+// and |y*log|x|| is less than 104 if the result is in the range of Float32.
+func powApprox(x, y float64) float64 {
+	// special cases
+	switch {
+	case x == 0 || y == 0 || x == 1 || y == 1,
+		math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0):
+		return math.Pow(x, y)
+	}
+
+	ret := math.Exp(y * math.Log(math.Abs(x)))
+	if x < 0 {
+		yi, yf := math.Modf(y)
+		if yf != 0 {
+			return math.NaN()
+		}
+		if math.Mod(yi, 2) != 0 {
+			ret = -ret
+		}
+	}
+	return ret
 }
@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 )
@@ -140,3 +141,66 @@ func BenchmarkFloat16_Pow(b *testing.B) {
 		runtime.KeepAlive(x.Pow(x))
 	}
 }
+
+func TestFloat16_Pow_CorrectlyRounded(t *testing.T) {
+	// exact results on the midpoint of two Float16 values
+	tests := []struct {
+		x, y, want Float16
+	}{
+		{exact16(47), exact16(2), exact16(2208)},
+		{exact16(-47), exact16(2), exact16(2208)},
+		{exact16(49), exact16(2), exact16(2400)},
+		{exact16(2), exact16(-25), exact16(0)},
+		{exact16(0.5), exact16(25), exact16(0)},
+		{exact16(-0.5), exact16(25), exact16(math.Copysign(0, -1))},
+		{exact16(0x1.8p-13), exact16(2), exact16(0x1p-24)},
+	}
+	for _, tt := range tests {
+		if got := tt.x.Pow(tt.y); !eq16(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	check := func(x, y Float16) {
+		want, ok := roundDD16(NewDoubleDouble(x.Float64().BuiltIn()).Pow(NewDoubleDouble(y.Float64().BuiltIn())))
+		if !ok {
+			// the result might be exact, which DoubleDouble can't tell.
+			return
+		}
+		if got := x.Pow(y); !eq16(got, want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", x, y, got, want)
+		}
+	}
+
+	// large exponents near 1, where the error of repeated squaring accumulates.
+	one := exact16(1)
+	for k := range 8 {
+		for _, x := range []Float16{one.Nextafter(exact16(2)), one.Nextafter(exact16(0))} {
+			for range k {
+				x = x.Nextafter(one.Add(one).Sub(x))
+			}
+			for y := exact16(1); !y.IsInf(0); y = y.Nextafter(exact16(math.Inf(1))).Add(exact16(1)) {
+				check(x, y)
+				check(x, y.Neg())
+			}
+		}
+	}
+
+	// random bases with exponents that keep the result in range.
+	r := rand.New(rand.NewPCG(16, 16))
+	for range 100000 {
+		x := NewFloat16FromBits(uint16(r.Uint32()))
+		if x.IsNaN() || x.IsInf(0) || x.IsZero() {
+			continue
+		}
+		lg := math.Log2(math.Abs(x.Float64().BuiltIn()))
+		if lg == 0 {
+			continue
+		}
+		y := NewFloat16((r.Float64()*41 - 25) / lg)
+		if x.Signbit() {
+			y = y.Trunc()
+		}
+		check(x, y)
+	}
+}
@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"testing"
 )
@@ -146,3 +147,69 @@ func BenchmarkFloat32_Pow(b *testing.B) {
 		runtime.KeepAlive(x.Pow(x))
 	}
 }
+
+// BenchmarkFloat32_Pow_Midpoint measures the slow path;
+// 4097² is a midpoint of two Float32 values, so it is recomputed in Float256.
+func BenchmarkFloat32_Pow_Midpoint(b *testing.B) {
+	x, y := exact32(4097), exact32(2)
+	for b.Loop() {
+		runtime.KeepAlive(x.Pow(y))
+	}
+}
+
+func TestFloat32_Pow_CorrectlyRounded(t *testing.T) {
+	// exact results on the midpoint of two Float32 values
+	tests := []struct {
+		x, y, want Float32
+	}{
+		{4097, 2, 16785408},
+		{-4097, 2, 16785408},
+		{2, -150, 0},
+		{-0.5, 150, 0},
+		{-0.5, 149, -0x1p-149},
+		{0x1.8p-74, 2, 0x1p-147},
+	}
+	for _, tt := range tests {
+		if got := tt.x.Pow(tt.y); !eq32(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	check := func(x, y float32) {
+		want, ok := roundDD32(NewDoubleDouble(float64(x)).Pow(NewDoubleDouble(float64(y))))
+		if !ok {
+			// the result might be exact, which DoubleDouble can't tell.
+			return
+		}
+		if got := Float32(x).Pow(Float32(y)); !eq32(got, want) {
+			t.Errorf("Pow(%v, %v) = %v, want %v", x, y, got, want)
+		}
+	}
+
+	// large exponents near 1, where the error of repeated squaring accumulates.
+	for _, x := range []float32{math.Nextafter32(1, 2), math.Nextafter32(1, 0), 1 + 0x1p-20, 1 - 0x1p-20} {
+		for y := float32(1); y < 1<<30; y *= 1.5 {
+			y = float32(math.Round(float64(y)))
+			check(x, y)
+			check(x, -y)
+		}
+	}
+
+	// random bases with exponents that keep the result in range.
+	r := rand.New(rand.NewPCG(32, 32))
+	for range 50000 {
+		x := math.Float32frombits(r.Uint32())
+		if x != x || math.IsInf(float64(x), 0) || x == 0 {
+			continue
+		}
+		lg := math.Log2(math.Abs(float64(x)))
+		if lg == 0 {
+			continue
+		}
+		y := float32((r.Float64()*277 - 150) / lg)
+		if x < 0 {
+			y = float32(math.Trunc(float64(y)))
+		}
+		check(x, y)
+	}
+}
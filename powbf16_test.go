@@ -0,0 +1,142 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBFloat16_Pow(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		y    BFloat16
+		want float64
+	}{
+		{exactBF16(2), exactBF16(3), math.Pow(2, 3)},
+		{exactBF16(5), exactBF16(0.5), math.Pow(5, 0.5)},
+		{exactBF16(5), exactBF16(1.5), math.Pow(5, 1.5)},
+		{exactBF16(5), exactBF16(-1.5), math.Pow(5, -1.5)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pow(tt.y)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		y    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		// a.Pow(±0) = 1 for any a
+		{exactBF16(2), exactBF16(0), exactBF16(1)},
+		{exactBF16(2), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(-2), exactBF16(0), exactBF16(1)},
+		{exactBF16(-2), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(math.Inf(1)), exactBF16(0), exactBF16(1)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(math.NaN()), exactBF16(0), exactBF16(1)},
+		{exactBF16(math.NaN()), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+
+		// 1.Pow(b) = 1 for any b
+		{exactBF16(1), exactBF16(3), exactBF16(1)},
+		{exactBF16(1), exactBF16(-3), exactBF16(1)},
+		{exactBF16(1), exactBF16(math.Inf(1)), exactBF16(1)},
+		{exactBF16(1), exactBF16(math.Inf(-1)), exactBF16(1)},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(1)},
+
+		// a.Pow(1) = a for any a
+		{exactBF16(2), exactBF16(1), exactBF16(2)},
+		{exactBF16(-2), exactBF16(1), exactBF16(-2)},
+		{exactBF16(math.Inf(1)), exactBF16(1), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(1), exactBF16(math.NaN())},
+
+		// NaN.Pow(b) = NaN
+		{exactBF16(math.NaN()), exactBF16(3), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(-3), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+
+		// a.Pow(NaN) = NaN
+		{exactBF16(2), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN()), exactBF16(math.NaN())},
+
+		// ±0.Pow(b) = ±Inf for b an odd integer < 0
+		{exactBF16(0), exactBF16(-3), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(-3), exactBF16(math.Inf(-1))},
+
+		// ±0.Pow(-Inf) = +Inf
+		{exactBF16(0), exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+
+		// ±0.Pow(+Inf) = +0
+		{exactBF16(0), exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(1)), exactBF16(0)},
+
+		// ±0.Pow(b) = +Inf for finite b < 0 and not an odd integer
+		{exactBF16(0), exactBF16(-2), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(-2), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(-0.5), exactBF16(math.Inf(1))},
+
+		// ±0.Pow(b) = ±0 for b an odd integer > 0
+		{exactBF16(0), exactBF16(3), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(3), exactBF16(math.Copysign(0, -1))},
+
+		// ±0.Pow(b) = +0 for finite b > 0 and not an odd integer
+		{exactBF16(0), exactBF16(2), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(2), exactBF16(0)},
+
+		// -1.Pow(±Inf) = 1
+		{exactBF16(-1), exactBF16(math.Inf(1)), exactBF16(1)},
+		{exactBF16(-1), exactBF16(math.Inf(-1)), exactBF16(1)},
+
+		// a.Pow(+Inf) = +Inf for |a| > 1
+		{exactBF16(2), exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(-2), exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+
+		// a.Pow(-Inf) = +0 for |a| > 1
+		{exactBF16(2), exactBF16(math.Inf(-1)), exactBF16(0)},
+		{exactBF16(-2), exactBF16(math.Inf(-1)), exactBF16(0)},
+
+		// a.Pow(+Inf) = +0 for |a| < 1
+		{exactBF16(0.5), exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(-0.5), exactBF16(math.Inf(1)), exactBF16(0)},
+
+		// a.Pow(-Inf) = +Inf for |a| < 1
+		{exactBF16(0.5), exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(-0.5), exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+
+		// +Inf.Pow(b) = +Inf for b > 0
+		{exactBF16(math.Inf(1)), exactBF16(2), exactBF16(math.Inf(1))},
+
+		// +Inf.Pow(b) = +0 for b < 0
+		{exactBF16(math.Inf(1)), exactBF16(-2), exactBF16(0)},
+
+		// -Inf.Pow(b) = (-0).Pow(-b)
+		{exactBF16(math.Inf(-1)), exactBF16(3), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(-1)), exactBF16(2), exactBF16(math.Inf(1))},
+
+		// a.Pow(b) = NaN for finite a < 0 and finite non-integer b
+		{exactBF16(-2), exactBF16(0.5), exactBF16(math.NaN())},
+		{exactBF16(-2), exactBF16(-0.5), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pow(tt.y)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Pow(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Pow(x))
+	}
+}
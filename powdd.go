@@ -0,0 +1,90 @@
+package floats
+
+import "math"
+
+// Pow returns a**b, the base-a exponential of b.
+//
+// Special cases are (in order):
+//
+//	a.Pow(±0) = 1 for any a
+//	1.Pow(b) = 1 for any b
+//	NaN.Pow(b) = NaN
+//	a.Pow(NaN) = NaN
+//	±0.Pow(b) = ±Inf for b an odd integer < 0
+//	±0.Pow(-Inf) = +Inf
+//	±0.Pow(+Inf) = +0
+//	±0.Pow(b) = +Inf for finite b < 0 and not an odd integer
+//	±0.Pow(b) = ±0 for b an odd integer > 0
+//	±0.Pow(b) = +0 for finite b > 0 and not an odd integer
+//	-1.Pow(±Inf) = 1
+//	a.Pow(+Inf) = +Inf for |a| > 1
+//	a.Pow(-Inf) = +0 for |a| > 1
+//	a.Pow(+Inf) = +0 for |a| < 1
+//	a.Pow(-Inf) = +Inf for |a| < 1
+//	+Inf.Pow(b) = +Inf for b > 0
+//	+Inf.Pow(b) = +0 for b < 0
+//	-Inf.Pow(b) = (-0).Pow(-b)
+//	a.Pow(b) = NaN for finite a < 0 and finite non-integer b
+//
+// The result is computed as exp(b*log(a)),
+// so the relative error grows with the magnitude of b*log(a).
+func (a DoubleDouble) Pow(b DoubleDouble) DoubleDouble {
+	one := NewDoubleDouble(1)
+	switch {
+	case b.IsZero() || a.Eq(one):
+		return one
+	case a.IsNaN() || b.IsNaN():
+		return NewDoubleDoubleNaN()
+	case b.IsInf(0):
+		// the sign of |a| - 1 decides the result.
+		switch d := a.Abs().Sub(one); {
+		case d.IsZero():
+			return one
+		case d[0] > 0:
+			return NewDoubleDouble(math.Pow(2, b[0]))
+		default:
+			return NewDoubleDouble(math.Pow(0.5, b[0]))
+		}
+	case a.IsZero() || a.IsInf(0):
+		// the magnitude of b doesn't matter, but the sign and the parity do.
+		return NewDoubleDouble(math.Pow(a[0], powExponentSign(b[:])))
+	}
+
+	neg := false
+	if a[0] < 0 {
+		isInt, isOdd := isIntComponents(b[:])
+		if !isInt {
+			return NewDoubleDoubleNaN()
+		}
+		a = a.Neg()
+		neg = isOdd
+	}
+	ret := b.Mul(a.Log()).Exp()
+	if neg {
+		ret = ret.Neg()
+	}
+	return ret
+}
+
+// isIntComponents reports whether the sum of the components x is an integer,
+// and whether it is odd.
+func isIntComponents(x []float64) (isInt, isOdd bool) {
+	var parity float64
+	for _, c := range x {
+		if math.Trunc(c) != c {
+			return false, false
+		}
+		// math.Mod is exact, and the parity of the components larger than 2**53 is even.
+		parity += math.Mod(c, 2)
+	}
+	return true, math.Mod(parity, 2) != 0
+}
+
+// powExponentSign returns a float64 that has the same sign and parity as the sum of the components x,
+// for the special cases of Pow.
+func powExponentSign(x []float64) float64 {
+	if _, isOdd := isIntComponents(x); isOdd {
+		return math.Copysign(1, x[0])
+	}
+	return math.Copysign(2, x[0])
+}
@@ -0,0 +1,61 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestDoubleDouble_Pow(t *testing.T) {
+	tests := []struct {
+		x, y DoubleDouble
+		want string
+	}{
+		{NewDoubleDouble(2), NewDoubleDouble(0.5), "1.41421356237309504880168872420969807856967187537694807317667973799073247"},
+		{NewDoubleDouble(-2), NewDoubleDouble(3), "-8"},
+		{NewDoubleDouble(-1.5), NewDoubleDouble(3), "-3.375"},
+		{NewDoubleDouble(1.5), NewDoubleDouble(100), "406561177535215237.397279707567041671010387890632379763429051769878756383196170137717118109"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pow(tt.y)
+		if !closeDD(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, y, want DoubleDouble
+	}{
+		{NewDoubleDoubleNaN(), NewDoubleDouble(0), NewDoubleDouble(1)},
+		{NewDoubleDouble(1), NewDoubleDoubleNaN(), NewDoubleDouble(1)},
+		{NewDoubleDoubleNaN(), NewDoubleDouble(1), NewDoubleDoubleNaN()},
+		{NewDoubleDouble(-2), NewDoubleDouble(0.5), NewDoubleDoubleNaN()},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(-3), NewDoubleDoubleInf(-1)},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(-2), NewDoubleDoubleInf(1)},
+		{NewDoubleDouble(math.Copysign(0, -1)), NewDoubleDouble(3), NewDoubleDouble(math.Copysign(0, -1))},
+		{NewDoubleDouble(0), NewDoubleDouble(0.5), NewDoubleDouble(0)},
+		{NewDoubleDouble(-1), NewDoubleDoubleInf(1), NewDoubleDouble(1)},
+		{NewDoubleDouble(2), NewDoubleDoubleInf(1), NewDoubleDoubleInf(1)},
+		{NewDoubleDouble(2), NewDoubleDoubleInf(-1), NewDoubleDouble(0)},
+		{NewDoubleDouble(0.5), NewDoubleDoubleInf(1), NewDoubleDouble(0)},
+		{NewDoubleDoubleInf(-1), NewDoubleDouble(3), NewDoubleDoubleInf(-1)},
+		{NewDoubleDoubleInf(-1), NewDoubleDouble(-3), NewDoubleDouble(math.Copysign(0, -1))},
+		{NewDoubleDoubleInf(1), NewDoubleDouble(-0.5), NewDoubleDouble(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pow(tt.y)
+		if !eqDD(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkDoubleDouble_Pow(b *testing.B) {
+	x := NewDoubleDouble(1.5)
+	y := NewDoubleDouble(2.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Pow(y))
+	}
+}
@@ -0,0 +1,72 @@
+package floats
+
+// Pown returns a**n, the base-a exponential of the integer n.
+//
+// Special cases are (in order):
+//
+//	a.Pown(0) = 1 for any a
+//	NaN.Pown(n) = NaN
+//	±0.Pown(n) = ±Inf for odd n < 0
+//	±0.Pown(n) = +Inf for even n < 0
+//	±0.Pown(n) = ±0 for odd n > 0
+//	±0.Pown(n) = +0 for even n > 0
+//	+Inf.Pown(n) = +Inf for n > 0
+//	+Inf.Pown(n) = +0 for n < 0
+//	-Inf.Pown(n) = (-0).Pown(-n)
+func (a Float128) Pown(n int) Float128 {
+	// n fits in Float128 exactly,
+	// and the special cases of Pow agree with the ones of Pown for integer exponents.
+	return a.Pow(NewFloat128FromInt64(int64(n)))
+}
+
+// Powr returns a**b, the base-a exponential of b, computed as exp(b×log(a)).
+// Unlike [Pow], a is not allowed to be negative even if b is an integer.
+//
+// Special cases are (in order):
+//
+//	a.Powr(b) = NaN for a < 0
+//	NaN.Powr(b) = NaN
+//	a.Powr(NaN) = NaN
+//	±0.Powr(±0) = NaN
+//	+Inf.Powr(±0) = NaN
+//	1.Powr(±Inf) = NaN
+//	a.Powr(±0) = 1 for finite a > 0
+//	1.Powr(b) = 1 for finite b
+//	±0.Powr(b) = +Inf for b < 0
+//	±0.Powr(b) = +0 for b > 0
+//	+Inf.Powr(b) = +0 for b < 0
+//	+Inf.Powr(b) = +Inf for b > 0
+//
+// Otherwise, it is the same as [Pow].
+func (a Float128) Powr(b Float128) Float128 {
+	var (
+		// Zero = 0
+		Zero = Float128{}
+
+		// One = 1.0
+		One = Float128(uvone128)
+	)
+
+	// special cases
+	switch {
+	case a.Lt(Zero) || a.IsNaN() || b.IsNaN():
+		return NewFloat128NaN()
+	case a.IsZero() || a.IsInf(1):
+		switch {
+		case b.IsZero():
+			return NewFloat128NaN()
+		case a.IsZero() == b.Lt(Zero):
+			return NewFloat128Inf(1)
+		default:
+			return Zero
+		}
+	case a.Eq(One):
+		if b.IsInf(0) {
+			return NewFloat128NaN()
+		}
+		return One
+	case b.IsZero():
+		return One
+	}
+	return a.Pow(b)
+}
@@ -0,0 +1,129 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat128_Pown(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		n    int
+		want string
+	}{
+		{exact128(1.5), 3, "3.375"},
+		{exact128(-1.5), 3, "-3.375"},
+		{exact128(0.75), -5, "4.213991769547325102880658436213991769547"},
+		{exact128(3), -2, "0.1111111111111111111111111111111111111111"},
+		{exact128(-2), -3, "-0.125"},
+		{exact128(1.0625), 100, "429.4314745412342923924697282885235623067"},
+		{exact128(10), 4, "10000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !close128(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		n    int
+		want Float128
+	}{
+		{exact128(math.NaN()), 0, exact128(1)},
+		{exact128(math.Inf(1)), 0, exact128(1)},
+		{exact128(0), 0, exact128(1)},
+		{exact128(math.NaN()), 3, exact128(math.NaN())},
+		{exact128(0), -3, exact128(math.Inf(1))},
+		{exact128(math.Copysign(0, -1)), -3, exact128(math.Inf(-1))},
+		{exact128(0), -2, exact128(math.Inf(1))},
+		{exact128(math.Copysign(0, -1)), -2, exact128(math.Inf(1))},
+		{exact128(0), 3, exact128(0)},
+		{exact128(math.Copysign(0, -1)), 3, exact128(math.Copysign(0, -1))},
+		{exact128(math.Copysign(0, -1)), 2, exact128(0)},
+		{exact128(math.Inf(1)), 2, exact128(math.Inf(1))},
+		{exact128(math.Inf(1)), -2, exact128(0)},
+		{exact128(math.Inf(-1)), 3, exact128(math.Inf(-1))},
+		{exact128(math.Inf(-1)), 2, exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), -3, exact128(math.Copysign(0, -1))},
+		{exact128(math.Inf(-1)), -2, exact128(0)},
+		{exact128(2), 10, exact128(1024)},
+		{exact128(-2), 3, exact128(-8)},
+		{exact128(0.5), -3, exact128(8)},
+		{exact128(3), 5, exact128(243)},
+		{exact128(-1), math.MaxInt, exact128(-1)},
+		{exact128(-1), math.MinInt, exact128(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pown(tt.n)
+		if !eq128(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Powr(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		y    Float128
+		want string
+	}{
+		{exact128(2), exact128(0.5), "1.414213562373095048801688724209698078570"},
+		{exact128(0.5), exact128(3), "0.1250000000000000000000000000000000000000"},
+		{exact128(10), exact128(-2), "0.01000000000000000000000000000000000000000"},
+		{exact128(3), exact128(1.5), "5.196152422706631880582339024517617100828"},
+		{exact128(100), exact128(0.25), "3.162277660168379331998893544432718533720"},
+		{exact128(0.75), exact128(-2.5), "2.052800957118669384921417886229182064525"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Powr(tt.y)
+		if !close128(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		y    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(-1), exact128(2), exact128(math.NaN())},
+		{exact128(math.Inf(-1)), exact128(2), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(0), exact128(math.NaN())},
+		{exact128(1), exact128(math.NaN()), exact128(math.NaN())},
+		{exact128(0), exact128(0), exact128(math.NaN())},
+		{exact128(math.Copysign(0, -1)), exact128(0), exact128(math.NaN())},
+		{exact128(math.Inf(1)), exact128(0), exact128(math.NaN())},
+		{exact128(math.Inf(1)), exact128(math.Copysign(0, -1)), exact128(math.NaN())},
+		{exact128(1), exact128(math.Inf(1)), exact128(math.NaN())},
+		{exact128(1), exact128(math.Inf(-1)), exact128(math.NaN())},
+		{exact128(2), exact128(0), exact128(1)},
+		{exact128(2), exact128(math.Copysign(0, -1)), exact128(1)},
+		{exact128(1), exact128(3), exact128(1)},
+		{exact128(0), exact128(-1), exact128(math.Inf(1))},
+		{exact128(math.Copysign(0, -1)), exact128(-1), exact128(math.Inf(1))},
+		{exact128(0), exact128(math.Inf(-1)), exact128(math.Inf(1))},
+		{exact128(0), exact128(3), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(3), exact128(0)},
+		{exact128(0), exact128(math.Inf(1)), exact128(0)},
+		{exact128(math.Inf(1)), exact128(-1), exact128(0)},
+		{exact128(math.Inf(1)), exact128(2), exact128(math.Inf(1))},
+		{exact128(2), exact128(10), exact128(1024)},
+		{exact128(4), exact128(0.5), exact128(2)},
+		{exact128(0.5), exact128(math.Inf(1)), exact128(0)},
+		{exact128(2), exact128(math.Inf(1)), exact128(math.Inf(1))},
+		{exact128(2), exact128(math.Inf(-1)), exact128(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Powr(tt.y)
+		if !eq128(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16_Pown(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		n    int
+		want float64
+	}{
+		{exact16(1.5), 3, 3.375},
+		{exact16(-1.5), 3, -3.375},
+		{exact16(0.75), -5, 4.2139917695473255},
+		{exact16(3), -2, 0.1111111111111111},
+		{exact16(-2), -3, -0.125},
+		{exact16(1.0625), 100, 429.4314745412343},
+		{exact16(10), 4, 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !close16(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		n    int
+		want Float16
+	}{
+		{exact16(math.NaN()), 0, exact16(1)},
+		{exact16(math.Inf(1)), 0, exact16(1)},
+		{exact16(0), 0, exact16(1)},
+		{exact16(math.NaN()), 3, exact16(math.NaN())},
+		{exact16(0), -3, exact16(math.Inf(1))},
+		{exact16(math.Copysign(0, -1)), -3, exact16(math.Inf(-1))},
+		{exact16(0), -2, exact16(math.Inf(1))},
+		{exact16(math.Copysign(0, -1)), -2, exact16(math.Inf(1))},
+		{exact16(0), 3, exact16(0)},
+		{exact16(math.Copysign(0, -1)), 3, exact16(math.Copysign(0, -1))},
+		{exact16(math.Copysign(0, -1)), 2, exact16(0)},
+		{exact16(math.Inf(1)), 2, exact16(math.Inf(1))},
+		{exact16(math.Inf(1)), -2, exact16(0)},
+		{exact16(math.Inf(-1)), 3, exact16(math.Inf(-1))},
+		{exact16(math.Inf(-1)), 2, exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), -3, exact16(math.Copysign(0, -1))},
+		{exact16(math.Inf(-1)), -2, exact16(0)},
+		{exact16(2), 10, exact16(1024)},
+		{exact16(-2), 3, exact16(-8)},
+		{exact16(0.5), -3, exact16(8)},
+		{exact16(3), 5, exact16(243)},
+		{exact16(-1), math.MaxInt, exact16(-1)},
+		{exact16(-1), math.MinInt, exact16(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pown(tt.n)
+		if !eq16(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Powr(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		y    Float16
+		want float64
+	}{
+		{exact16(2), exact16(0.5), 1.4142135623730951},
+		{exact16(0.5), exact16(3), 0.125},
+		{exact16(10), exact16(-2), 0.01},
+		{exact16(3), exact16(1.5), 5.196152422706632},
+		{exact16(100), exact16(0.25), 3.1622776601683795},
+		{exact16(0.75), exact16(-2.5), 2.052800957118669},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Powr(tt.y)
+		if !close16(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		y    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(-1), exact16(2), exact16(math.NaN())},
+		{exact16(math.Inf(-1)), exact16(2), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(0), exact16(math.NaN())},
+		{exact16(1), exact16(math.NaN()), exact16(math.NaN())},
+		{exact16(0), exact16(0), exact16(math.NaN())},
+		{exact16(math.Copysign(0, -1)), exact16(0), exact16(math.NaN())},
+		{exact16(math.Inf(1)), exact16(0), exact16(math.NaN())},
+		{exact16(math.Inf(1)), exact16(math.Copysign(0, -1)), exact16(math.NaN())},
+		{exact16(1), exact16(math.Inf(1)), exact16(math.NaN())},
+		{exact16(1), exact16(math.Inf(-1)), exact16(math.NaN())},
+		{exact16(2), exact16(0), exact16(1)},
+		{exact16(2), exact16(math.Copysign(0, -1)), exact16(1)},
+		{exact16(1), exact16(3), exact16(1)},
+		{exact16(0), exact16(-1), exact16(math.Inf(1))},
+		{exact16(math.Copysign(0, -1)), exact16(-1), exact16(math.Inf(1))},
+		{exact16(0), exact16(math.Inf(-1)), exact16(math.Inf(1))},
+		{exact16(0), exact16(3), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(3), exact16(0)},
+		{exact16(0), exact16(math.Inf(1)), exact16(0)},
+		{exact16(math.Inf(1)), exact16(-1), exact16(0)},
+		{exact16(math.Inf(1)), exact16(2), exact16(math.Inf(1))},
+		{exact16(2), exact16(10), exact16(1024)},
+		{exact16(4), exact16(0.5), exact16(2)},
+		{exact16(0.5), exact16(math.Inf(1)), exact16(0)},
+		{exact16(2), exact16(math.Inf(1)), exact16(math.Inf(1))},
+		{exact16(2), exact16(math.Inf(-1)), exact16(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Powr(tt.y)
+		if !eq16(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Pown_CorrectlyRounded(t *testing.T) {
+	// x has 11 significant bits, so x**n is exact in Float256 for n <= 21.
+	for _, n := range []int{-3, 2, 5, 11} {
+		for i := range 1 << 16 {
+			x := NewFloat16FromBits(uint16(i))
+			if x.IsNaN() || x.IsInf(0) {
+				continue
+			}
+			want := pownExact256(x.Float256(), n).Float16()
+			if got := x.Pown(n); !eq16(got, want) {
+				t.Errorf("Pown(%v, %d) = %v; want %v", x, n, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat256_Pown(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		n    int
+		want string
+	}{
+		{exact256(1.5), 3, "3.375"},
+		{exact256(-1.5), 3, "-3.375"},
+		{exact256(0.75), -5, "4.213991769547325102880658436213991769547325102880658436213991769547325"},
+		{exact256(3), -2, "0.1111111111111111111111111111111111111111111111111111111111111111111111"},
+		{exact256(-2), -3, "-0.125"},
+		{exact256(1.0625), 100, "429.4314745412342923924697282885235623067130246447321996434204444052219"},
+		{exact256(10), 4, "10000"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !close256(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		n    int
+		want Float256
+	}{
+		{exact256(math.NaN()), 0, exact256(1)},
+		{exact256(math.Inf(1)), 0, exact256(1)},
+		{exact256(0), 0, exact256(1)},
+		{exact256(math.NaN()), 3, exact256(math.NaN())},
+		{exact256(0), -3, exact256(math.Inf(1))},
+		{exact256(math.Copysign(0, -1)), -3, exact256(math.Inf(-1))},
+		{exact256(0), -2, exact256(math.Inf(1))},
+		{exact256(math.Copysign(0, -1)), -2, exact256(math.Inf(1))},
+		{exact256(0), 3, exact256(0)},
+		{exact256(math.Copysign(0, -1)), 3, exact256(math.Copysign(0, -1))},
+		{exact256(math.Copysign(0, -1)), 2, exact256(0)},
+		{exact256(math.Inf(1)), 2, exact256(math.Inf(1))},
+		{exact256(math.Inf(1)), -2, exact256(0)},
+		{exact256(math.Inf(-1)), 3, exact256(math.Inf(-1))},
+		{exact256(math.Inf(-1)), 2, exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), -3, exact256(math.Copysign(0, -1))},
+		{exact256(math.Inf(-1)), -2, exact256(0)},
+		{exact256(2), 10, exact256(1024)},
+		{exact256(-2), 3, exact256(-8)},
+		{exact256(0.5), -3, exact256(8)},
+		{exact256(3), 5, exact256(243)},
+		{exact256(-1), math.MaxInt, exact256(-1)},
+		{exact256(-1), math.MinInt, exact256(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pown(tt.n)
+		if !eq256(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat256_Powr(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		y    Float256
+		want string
+	}{
+		{exact256(2), exact256(0.5), "1.414213562373095048801688724209698078569671875376948073176679737990732"},
+		{exact256(0.5), exact256(3), "0.1250000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(10), exact256(-2), "0.01000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(3), exact256(1.5), "5.196152422706631880582339024517617100828415761431141884167420938355799"},
+		{exact256(100), exact256(0.25), "3.162277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(0.75), exact256(-2.5), "2.052800957118669384921417886229182064524806226738228892510586049720810"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Powr(tt.y)
+		if !close256(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		y    Float256
+		want Float256
+	}{
+		// special cases
+		{exact256(-1), exact256(2), exact256(math.NaN())},
+		{exact256(math.Inf(-1)), exact256(2), exact256(math.NaN())},
+		{exact256(math.NaN()), exact256(0), exact256(math.NaN())},
+		{exact256(1), exact256(math.NaN()), exact256(math.NaN())},
+		{exact256(0), exact256(0), exact256(math.NaN())},
+		{exact256(math.Copysign(0, -1)), exact256(0), exact256(math.NaN())},
+		{exact256(math.Inf(1)), exact256(0), exact256(math.NaN())},
+		{exact256(math.Inf(1)), exact256(math.Copysign(0, -1)), exact256(math.NaN())},
+		{exact256(1), exact256(math.Inf(1)), exact256(math.NaN())},
+		{exact256(1), exact256(math.Inf(-1)), exact256(math.NaN())},
+		{exact256(2), exact256(0), exact256(1)},
+		{exact256(2), exact256(math.Copysign(0, -1)), exact256(1)},
+		{exact256(1), exact256(3), exact256(1)},
+		{exact256(0), exact256(-1), exact256(math.Inf(1))},
+		{exact256(math.Copysign(0, -1)), exact256(-1), exact256(math.Inf(1))},
+		{exact256(0), exact256(math.Inf(-1)), exact256(math.Inf(1))},
+		{exact256(0), exact256(3), exact256(0)},
+		{exact256(math.Copysign(0, -1)), exact256(3), exact256(0)},
+		{exact256(0), exact256(math.Inf(1)), exact256(0)},
+		{exact256(math.Inf(1)), exact256(-1), exact256(0)},
+		{exact256(math.Inf(1)), exact256(2), exact256(math.Inf(1))},
+		{exact256(2), exact256(10), exact256(1024)},
+		{exact256(4), exact256(0.5), exact256(2)},
+		{exact256(0.5), exact256(math.Inf(1)), exact256(0)},
+		{exact256(2), exact256(math.Inf(1)), exact256(math.Inf(1))},
+		{exact256(2), exact256(math.Inf(-1)), exact256(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Powr(tt.y)
+		if !eq256(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat32_Pown(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		n    int
+		want float64
+	}{
+		{exact32(1.5), 3, 3.375},
+		{exact32(-1.5), 3, -3.375},
+		{exact32(0.75), -5, 4.2139917695473255},
+		{exact32(3), -2, 0.1111111111111111},
+		{exact32(-2), -3, -0.125},
+		{exact32(1.0625), 100, 429.4314745412343},
+		{exact32(10), 4, 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !close32(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		n    int
+		want Float32
+	}{
+		{exact32(math.NaN()), 0, exact32(1)},
+		{exact32(math.Inf(1)), 0, exact32(1)},
+		{exact32(0), 0, exact32(1)},
+		{exact32(math.NaN()), 3, exact32(math.NaN())},
+		{exact32(0), -3, exact32(math.Inf(1))},
+		{exact32(math.Copysign(0, -1)), -3, exact32(math.Inf(-1))},
+		{exact32(0), -2, exact32(math.Inf(1))},
+		{exact32(math.Copysign(0, -1)), -2, exact32(math.Inf(1))},
+		{exact32(0), 3, exact32(0)},
+		{exact32(math.Copysign(0, -1)), 3, exact32(math.Copysign(0, -1))},
+		{exact32(math.Copysign(0, -1)), 2, exact32(0)},
+		{exact32(math.Inf(1)), 2, exact32(math.Inf(1))},
+		{exact32(math.Inf(1)), -2, exact32(0)},
+		{exact32(math.Inf(-1)), 3, exact32(math.Inf(-1))},
+		{exact32(math.Inf(-1)), 2, exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), -3, exact32(math.Copysign(0, -1))},
+		{exact32(math.Inf(-1)), -2, exact32(0)},
+		{exact32(2), 10, exact32(1024)},
+		{exact32(-2), 3, exact32(-8)},
+		{exact32(0.5), -3, exact32(8)},
+		{exact32(3), 5, exact32(243)},
+		{exact32(-1), math.MaxInt, exact32(-1)},
+		{exact32(-1), math.MinInt, exact32(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pown(tt.n)
+		if !eq32(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Powr(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		y    Float32
+		want float64
+	}{
+		{exact32(2), exact32(0.5), 1.4142135623730951},
+		{exact32(0.5), exact32(3), 0.125},
+		{exact32(10), exact32(-2), 0.01},
+		{exact32(3), exact32(1.5), 5.196152422706632},
+		{exact32(100), exact32(0.25), 3.1622776601683795},
+		{exact32(0.75), exact32(-2.5), 2.052800957118669},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Powr(tt.y)
+		if !close32(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		y    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(-1), exact32(2), exact32(math.NaN())},
+		{exact32(math.Inf(-1)), exact32(2), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(0), exact32(math.NaN())},
+		{exact32(1), exact32(math.NaN()), exact32(math.NaN())},
+		{exact32(0), exact32(0), exact32(math.NaN())},
+		{exact32(math.Copysign(0, -1)), exact32(0), exact32(math.NaN())},
+		{exact32(math.Inf(1)), exact32(0), exact32(math.NaN())},
+		{exact32(math.Inf(1)), exact32(math.Copysign(0, -1)), exact32(math.NaN())},
+		{exact32(1), exact32(math.Inf(1)), exact32(math.NaN())},
+		{exact32(1), exact32(math.Inf(-1)), exact32(math.NaN())},
+		{exact32(2), exact32(0), exact32(1)},
+		{exact32(2), exact32(math.Copysign(0, -1)), exact32(1)},
+		{exact32(1), exact32(3), exact32(1)},
+		{exact32(0), exact32(-1), exact32(math.Inf(1))},
+		{exact32(math.Copysign(0, -1)), exact32(-1), exact32(math.Inf(1))},
+		{exact32(0), exact32(math.Inf(-1)), exact32(math.Inf(1))},
+		{exact32(0), exact32(3), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(3), exact32(0)},
+		{exact32(0), exact32(math.Inf(1)), exact32(0)},
+		{exact32(math.Inf(1)), exact32(-1), exact32(0)},
+		{exact32(math.Inf(1)), exact32(2), exact32(math.Inf(1))},
+		{exact32(2), exact32(10), exact32(1024)},
+		{exact32(4), exact32(0.5), exact32(2)},
+		{exact32(0.5), exact32(math.Inf(1)), exact32(0)},
+		{exact32(2), exact32(math.Inf(1)), exact32(math.Inf(1))},
+		{exact32(2), exact32(math.Inf(-1)), exact32(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Powr(tt.y)
+		if !eq32(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Pown_CorrectlyRounded(t *testing.T) {
+	// x has 24 significant bits, so x**n is exact in Float256 for n <= 9.
+	r := rand.New(rand.NewPCG(32, 11))
+	for _, n := range []int{-3, 2, 5, 9} {
+		for range 20000 {
+			x := Float32(randFloat32(r, -12, 12))
+			want := pownExact256(x.Float256(), n).Float32()
+			if got := x.Pown(n); !eq32(got, want) {
+				t.Errorf("Pown(%v, %d) = %v; want %v", x, n, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+)
+
+// Pown returns a**n, the base-a exponential of the integer n.
+//
+// The result is computed as e**(n×log|a|) in DoubleDouble.
+// If it can't be rounded to Float64 correctly from this approximation,
+// it is recomputed in Float256, and a**n is checked to be an exact midpoint between two Float64 values.
+// So the result is correctly rounded unless a**n is within the relative distance 2⁻¹⁶⁰
+// of a midpoint without being equal to it.
+//
+// Special cases are (in order):
+//
+//	a.Pown(0) = 1 for any a
+//	NaN.Pown(n) = NaN
+//	±0.Pown(n) = ±Inf for odd n < 0
+//	±0.Pown(n) = +Inf for even n < 0
+//	±0.Pown(n) = ±0 for odd n > 0
+//	±0.Pown(n) = +0 for even n > 0
+//	+Inf.Pown(n) = +Inf for n > 0
+//	+Inf.Pown(n) = +0 for n < 0
+//	-Inf.Pown(n) = (-0).Pown(-n)
+func (a Float64) Pown(n int) Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case n == 0:
+		return 1
+	case math.IsNaN(x):
+		return NewFloat64NaN()
+	case x == 0 || math.IsInf(x, 0):
+		// float64(n) may round an odd n to an even number.
+		y := math.Pow(x, float64(n))
+		if n&1 != 0 {
+			y = math.Copysign(y, x)
+		}
+		return NewFloat64(y)
+	}
+
+	// compute; |x|**n = exp(n×log|x|)
+	b := a.Abs().Float256()
+	y := newDoubleDoubleInt64(int64(n)).Mul(NewDoubleDouble(math.Abs(x)).Log()).Exp()
+	ret := roundPown64(y, b, n, func() Float256 { return b.Pown(n) })
+	if x < 0 && n&1 != 0 {
+		ret = -ret
+	}
+	return ret
+}
+
+// roundPown64 rounds b**n to Float64, where b > 0 and n is an integer.
+// y approximates b**n with the relative error less than 2⁻⁹⁰, unless it is less than 2**-960,
+// where the lower part of y loses its precision.
+// If y is too close to a midpoint, b**n is recomputed by pow in Float256
+// with the relative error less than 2⁻¹⁷⁰, and then it is checked to be an exact midpoint.
+// b is zero if b**n can't be a midpoint.
+func roundPown64(y DoubleDouble, b Float256, n int, pow func() Float256) Float64 {
+	if y.IsInf(1) {
+		return NewFloat64Inf(1)
+	}
+	if y[0] >= 0x1p-960 {
+		d := y.ldexp(-80)
+		if lo, hi := y.Sub(d).Float64(), y.Add(d).Float64(); lo == hi {
+			return lo
+		}
+	}
+
+	z := pow()
+	d := z.Ldexp(-160)
+	lo, hi := z.Sub(d).Float64(), z.Add(d).Float64()
+	if lo == hi {
+		return lo
+	}
+	if !b.IsZero() {
+		if m, ok := pownMidpoint64(b, n, lo, hi); ok {
+			return m.Float64()
+		}
+	}
+	return z.Float64()
+}
+
+// pownMidpoint64 reports whether b**n is exactly the midpoint m of lo and hi,
+// where b > 0 and lo and hi are adjacent Float64 values.
+// Such a case can't be decided by any approximation, so it is checked with math/big.
+func pownMidpoint64(b Float256, n int, lo, hi Float64) (m Float256, ok bool) {
+	if hi.IsInf(0) {
+		return Float256{}, false
+	}
+	m = lo.Float256().Add(hi.Float256()).Ldexp(-1)
+
+	// b = x × 2**eb and m = xm × 2**em, where x and xm are odd.
+	x, eb := oddPart(b.BigFloat(nil))
+	xm, em := oddPart(m.BigFloat(nil))
+
+	// check the exponents; eb × n = em.
+	lhs := new(big.Int).Mul(big.NewInt(int64(eb)), big.NewInt(int64(n)))
+	if lhs.Cmp(big.NewInt(int64(em))) != 0 {
+		return Float256{}, false
+	}
+
+	// check the odd parts; x**n = xm.
+	one := big.NewInt(1)
+	if x.Cmp(one) == 0 || xm.Cmp(one) == 0 {
+		return m, x.Cmp(xm) == 0
+	}
+	// x >= 3 and xm < 2⁵⁵, so x**n = xm implies 0 < n < 35.
+	if n <= 0 || n >= 35 {
+		return Float256{}, false
+	}
+	return m, lhs.Exp(x, big.NewInt(int64(n)), nil).Cmp(xm) == 0
+}
+
+// Powr returns a**b, the base-a exponential of b, computed as exp(b×log(a)).
+// Unlike [Pow], a is not allowed to be negative even if b is an integer.
+//
+// Special cases are (in order):
+//
+//	a.Powr(b) = NaN for a < 0
+//	NaN.Powr(b) = NaN
+//	a.Powr(NaN) = NaN
+//	±0.Powr(±0) = NaN
+//	+Inf.Powr(±0) = NaN
+//	1.Powr(±Inf) = NaN
+//	a.Powr(±0) = 1 for finite a > 0
+//	1.Powr(b) = 1 for finite b
+//	±0.Powr(b) = +Inf for b < 0
+//	±0.Powr(b) = +0 for b > 0
+//	+Inf.Powr(b) = +0 for b < 0
+//	+Inf.Powr(b) = +Inf for b > 0
+//
+// Otherwise, it is the same as [Pow].
+func (a Float64) Powr(b Float64) Float64 {
+	x, y := a.BuiltIn(), b.BuiltIn()
+
+	// special cases
+	switch {
+	case x < 0 || math.IsNaN(x) || math.IsNaN(y):
+		return NewFloat64NaN()
+	case x == 0 || math.IsInf(x, 1):
+		switch {
+		case y == 0:
+			return NewFloat64NaN()
+		case (x == 0) == (y < 0):
+			return NewFloat64Inf(1)
+		default:
+			return 0
+		}
+	case x == 1:
+		if math.IsInf(y, 0) {
+			return NewFloat64NaN()
+		}
+		return 1
+	case y == 0:
+		return 1
+	}
+	return a.Pow(b)
+}
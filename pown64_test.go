@@ -0,0 +1,170 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFloat64_Pown(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		n    int
+		want float64
+	}{
+		{exact64(1.5), 3, 3.375},
+		{exact64(-1.5), 3, -3.375},
+		{exact64(0.75), -5, 4.2139917695473255},
+		{exact64(3), -2, 0.1111111111111111},
+		{exact64(-2), -3, -0.125},
+		{exact64(1.0625), 100, 429.4314745412343},
+		{exact64(10), 4, 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !close64(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		n    int
+		want Float64
+	}{
+		{exact64(math.NaN()), 0, exact64(1)},
+		{exact64(math.Inf(1)), 0, exact64(1)},
+		{exact64(0), 0, exact64(1)},
+		{exact64(math.NaN()), 3, exact64(math.NaN())},
+		{exact64(0), -3, exact64(math.Inf(1))},
+		{exact64(math.Copysign(0, -1)), -3, exact64(math.Inf(-1))},
+		{exact64(0), -2, exact64(math.Inf(1))},
+		{exact64(math.Copysign(0, -1)), -2, exact64(math.Inf(1))},
+		{exact64(0), 3, exact64(0)},
+		{exact64(math.Copysign(0, -1)), 3, exact64(math.Copysign(0, -1))},
+		{exact64(math.Copysign(0, -1)), 2, exact64(0)},
+		{exact64(math.Inf(1)), 2, exact64(math.Inf(1))},
+		{exact64(math.Inf(1)), -2, exact64(0)},
+		{exact64(math.Inf(-1)), 3, exact64(math.Inf(-1))},
+		{exact64(math.Inf(-1)), 2, exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), -3, exact64(math.Copysign(0, -1))},
+		{exact64(math.Inf(-1)), -2, exact64(0)},
+		{exact64(2), 10, exact64(1024)},
+		{exact64(-2), 3, exact64(-8)},
+		{exact64(0.5), -3, exact64(8)},
+		{exact64(3), 5, exact64(243)},
+		{exact64(-1), math.MaxInt, exact64(-1)},
+		{exact64(-1), math.MinInt, exact64(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pown(tt.n)
+		if !eq64(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Pown_Midpoint(t *testing.T) {
+	// x² of a 27-bit odd integer x needs 54 bits, so it is often a midpoint.
+	for x := Float64(94906267); x < 94906267+2000; x += 2 {
+		if got, want := x.Pown(2), x.Mul(x); !eq64(got, want) {
+			t.Errorf("Pown(%v, 2) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Neg().Pown(2), x.Mul(x); !eq64(got, want) {
+			t.Errorf("Pown(%v, 2) = %v; want %v", x.Neg(), got, want)
+		}
+	}
+
+	// (2¹⁸-1)³ needs 54 bits.
+	n := big.NewInt(1<<18 - 1)
+	n3 := new(big.Int).Exp(n, big.NewInt(3), nil)
+	want3, _ := new(big.Float).SetInt(n3).Float64()
+
+	tests := []struct {
+		x    Float64
+		n    int
+		want Float64
+	}{
+		{exact64(1<<18 - 1), 3, exact64(want3)},
+		{exact64(-(1<<18 - 1)), 3, exact64(-want3)},
+		{exact64(0x1p-300 * (1<<18 - 1)), 3, exact64(0x1p-900 * want3)},
+
+		// midpoints in the subnormal range
+		{exact64(0x1p-25), 43, exact64(0)}, // 2⁻¹⁰⁷⁵
+		{exact64(-0x1p-25), 43, exact64(math.Copysign(0, -1))},
+		{exact64(0x3p-215), 5, exact64(0x7ap-1074)}, // 243×2⁻¹⁰⁷⁵
+		{exact64(2), -1075, exact64(0)},
+		{exact64(2), -1074, exact64(0x1p-1074)},
+	}
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !eq64(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Powr(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		y    Float64
+		want float64
+	}{
+		{exact64(2), exact64(0.5), 1.4142135623730951},
+		{exact64(0.5), exact64(3), 0.125},
+		{exact64(10), exact64(-2), 0.01},
+		{exact64(3), exact64(1.5), 5.196152422706632},
+		{exact64(100), exact64(0.25), 3.1622776601683795},
+		{exact64(0.75), exact64(-2.5), 2.052800957118669},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Powr(tt.y)
+		if !close64(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		y    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(-1), exact64(2), exact64(math.NaN())},
+		{exact64(math.Inf(-1)), exact64(2), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(0), exact64(math.NaN())},
+		{exact64(1), exact64(math.NaN()), exact64(math.NaN())},
+		{exact64(0), exact64(0), exact64(math.NaN())},
+		{exact64(math.Copysign(0, -1)), exact64(0), exact64(math.NaN())},
+		{exact64(math.Inf(1)), exact64(0), exact64(math.NaN())},
+		{exact64(math.Inf(1)), exact64(math.Copysign(0, -1)), exact64(math.NaN())},
+		{exact64(1), exact64(math.Inf(1)), exact64(math.NaN())},
+		{exact64(1), exact64(math.Inf(-1)), exact64(math.NaN())},
+		{exact64(2), exact64(0), exact64(1)},
+		{exact64(2), exact64(math.Copysign(0, -1)), exact64(1)},
+		{exact64(1), exact64(3), exact64(1)},
+		{exact64(0), exact64(-1), exact64(math.Inf(1))},
+		{exact64(math.Copysign(0, -1)), exact64(-1), exact64(math.Inf(1))},
+		{exact64(0), exact64(math.Inf(-1)), exact64(math.Inf(1))},
+		{exact64(0), exact64(3), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(3), exact64(0)},
+		{exact64(0), exact64(math.Inf(1)), exact64(0)},
+		{exact64(math.Inf(1)), exact64(-1), exact64(0)},
+		{exact64(math.Inf(1)), exact64(2), exact64(math.Inf(1))},
+		{exact64(2), exact64(10), exact64(1024)},
+		{exact64(4), exact64(0.5), exact64(2)},
+		{exact64(0.5), exact64(math.Inf(1)), exact64(0)},
+		{exact64(2), exact64(math.Inf(1)), exact64(math.Inf(1))},
+		{exact64(2), exact64(math.Inf(-1)), exact64(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Powr(tt.y)
+		if !eq64(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Pown(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		n    int
+		want float64
+	}{
+		{exactBF16(1.5), 3, 3.375},
+		{exactBF16(-1.5), 3, -3.375},
+		{exactBF16(0.75), -5, 4.2139917695473255},
+		{exactBF16(3), -2, 0.1111111111111111},
+		{exactBF16(-2), -3, -0.125},
+		{exactBF16(1.0625), 100, 429.4314745412343},
+		{exactBF16(10), 4, 10000.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pown(tt.n)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		n    int
+		want BFloat16
+	}{
+		{exactBF16(math.NaN()), 0, exactBF16(1)},
+		{exactBF16(math.Inf(1)), 0, exactBF16(1)},
+		{exactBF16(0), 0, exactBF16(1)},
+		{exactBF16(math.NaN()), 3, exactBF16(math.NaN())},
+		{exactBF16(0), -3, exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), -3, exactBF16(math.Inf(-1))},
+		{exactBF16(0), -2, exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), -2, exactBF16(math.Inf(1))},
+		{exactBF16(0), 3, exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), 3, exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Copysign(0, -1)), 2, exactBF16(0)},
+		{exactBF16(math.Inf(1)), 2, exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(1)), -2, exactBF16(0)},
+		{exactBF16(math.Inf(-1)), 3, exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(-1)), 2, exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), -3, exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(-1)), -2, exactBF16(0)},
+		{exactBF16(2), 10, exactBF16(1024)},
+		{exactBF16(-2), 3, exactBF16(-8)},
+		{exactBF16(0.5), -3, exactBF16(8)},
+		{exactBF16(3), 5, exactBF16(243)},
+		{exactBF16(-1), math.MaxInt, exactBF16(-1)},
+		{exactBF16(-1), math.MinInt, exactBF16(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pown(tt.n)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Pown(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Powr(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		y    BFloat16
+		want float64
+	}{
+		{exactBF16(2), exactBF16(0.5), 1.4142135623730951},
+		{exactBF16(0.5), exactBF16(3), 0.125},
+		{exactBF16(10), exactBF16(-2), 0.01},
+		{exactBF16(3), exactBF16(1.5), 5.196152422706632},
+		{exactBF16(100), exactBF16(0.25), 3.1622776601683795},
+		{exactBF16(0.75), exactBF16(-2.5), 2.052800957118669},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Powr(tt.y)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		y    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(-1), exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(2), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(0), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(0), exactBF16(0), exactBF16(math.NaN())},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(0), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(1)), exactBF16(0), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(1)), exactBF16(math.Copysign(0, -1)), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(1), exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(2), exactBF16(0), exactBF16(1)},
+		{exactBF16(2), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(1), exactBF16(3), exactBF16(1)},
+		{exactBF16(0), exactBF16(-1), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(-1), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(0), exactBF16(3), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(3), exactBF16(0)},
+		{exactBF16(0), exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(math.Inf(1)), exactBF16(-1), exactBF16(0)},
+		{exactBF16(math.Inf(1)), exactBF16(2), exactBF16(math.Inf(1))},
+		{exactBF16(2), exactBF16(10), exactBF16(1024)},
+		{exactBF16(4), exactBF16(0.5), exactBF16(2)},
+		{exactBF16(0.5), exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(2), exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(2), exactBF16(math.Inf(-1)), exactBF16(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Powr(tt.y)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Powr(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package floats
+
+import "math"
+
+// Pow returns a**b, the base-a exponential of b.
+//
+// Special cases are (in order):
+//
+//	a.Pow(±0) = 1 for any a
+//	1.Pow(b) = 1 for any b
+//	NaN.Pow(b) = NaN
+//	a.Pow(NaN) = NaN
+//	±0.Pow(b) = ±Inf for b an odd integer < 0
+//	±0.Pow(-Inf) = +Inf
+//	±0.Pow(+Inf) = +0
+//	±0.Pow(b) = +Inf for finite b < 0 and not an odd integer
+//	±0.Pow(b) = ±0 for b an odd integer > 0
+//	±0.Pow(b) = +0 for finite b > 0 and not an odd integer
+//	-1.Pow(±Inf) = 1
+//	a.Pow(+Inf) = +Inf for |a| > 1
+//	a.Pow(-Inf) = +0 for |a| > 1
+//	a.Pow(+Inf) = +0 for |a| < 1
+//	a.Pow(-Inf) = +Inf for |a| < 1
+//	+Inf.Pow(b) = +Inf for b > 0
+//	+Inf.Pow(b) = +0 for b < 0
+//	-Inf.Pow(b) = (-0).Pow(-b)
+//	a.Pow(b) = NaN for finite a < 0 and finite non-integer b
+//
+// The result is computed as exp(b*log(a)),
+// so the relative error grows with the magnitude of b*log(a).
+func (a QuadDouble) Pow(b QuadDouble) QuadDouble {
+	one := NewQuadDouble(1)
+	switch {
+	case b.IsZero() || a.Eq(one):
+		return one
+	case a.IsNaN() || b.IsNaN():
+		return NewQuadDoubleNaN()
+	case b.IsInf(0):
+		// the sign of |a| - 1 decides the result.
+		switch d := a.Abs().Sub(one); {
+		case d.IsZero():
+			return one
+		case d[0] > 0:
+			return NewQuadDouble(math.Pow(2, b[0]))
+		default:
+			return NewQuadDouble(math.Pow(0.5, b[0]))
+		}
+	case a.IsZero() || a.IsInf(0):
+		// the magnitude of b doesn't matter, but the sign and the parity do.
+		return NewQuadDouble(math.Pow(a[0], powExponentSign(b[:])))
+	}
+
+	neg := false
+	if a[0] < 0 {
+		isInt, isOdd := isIntComponents(b[:])
+		if !isInt {
+			return NewQuadDoubleNaN()
+		}
+		a = a.Neg()
+		neg = isOdd
+	}
+	ret := b.Mul(a.Log()).Exp()
+	if neg {
+		ret = ret.Neg()
+	}
+	return ret
+}
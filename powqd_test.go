@@ -0,0 +1,61 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestQuadDouble_Pow(t *testing.T) {
+	tests := []struct {
+		x, y QuadDouble
+		want string
+	}{
+		{NewQuadDouble(2), NewQuadDouble(0.5), "1.41421356237309504880168872420969807856967187537694807317667973799073247"},
+		{NewQuadDouble(-2), NewQuadDouble(3), "-8"},
+		{NewQuadDouble(-1.5), NewQuadDouble(3), "-3.375"},
+		{NewQuadDouble(1.5), NewQuadDouble(100), "406561177535215237.397279707567041671010387890632379763429051769878756383196170137717118109"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Pow(tt.y)
+		if !closeQD(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, y, want QuadDouble
+	}{
+		{NewQuadDoubleNaN(), NewQuadDouble(0), NewQuadDouble(1)},
+		{NewQuadDouble(1), NewQuadDoubleNaN(), NewQuadDouble(1)},
+		{NewQuadDoubleNaN(), NewQuadDouble(1), NewQuadDoubleNaN()},
+		{NewQuadDouble(-2), NewQuadDouble(0.5), NewQuadDoubleNaN()},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(-3), NewQuadDoubleInf(-1)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(-2), NewQuadDoubleInf(1)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(3), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDouble(0), NewQuadDouble(0.5), NewQuadDouble(0)},
+		{NewQuadDouble(-1), NewQuadDoubleInf(1), NewQuadDouble(1)},
+		{NewQuadDouble(2), NewQuadDoubleInf(1), NewQuadDoubleInf(1)},
+		{NewQuadDouble(2), NewQuadDoubleInf(-1), NewQuadDouble(0)},
+		{NewQuadDouble(0.5), NewQuadDoubleInf(1), NewQuadDouble(0)},
+		{NewQuadDoubleInf(-1), NewQuadDouble(3), NewQuadDoubleInf(-1)},
+		{NewQuadDoubleInf(-1), NewQuadDouble(-3), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDoubleInf(1), NewQuadDouble(-0.5), NewQuadDouble(0)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Pow(tt.y)
+		if !eqQD(got, tt.want) {
+			t.Errorf("Pow(%v, %v) = %v; want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Pow(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	y := NewQuadDouble(2.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Pow(y))
+	}
+}
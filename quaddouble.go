@@ -0,0 +1,398 @@
+package floats
+
+import "math"
+
+// QuadDouble is a quad-double number, the unevaluated sum of four float64 values.
+// It has about 212 bits of precision and the same exponent range as float64.
+//
+// The components are normalized so that a[i] is the sum of a[i:] rounded to float64.
+// The arithmetic is implemented with error-free transformations of float64 operations,
+// and it is faster than [Float256] in exchange for not being correctly rounded.
+// The precision gets lower near the underflow threshold,
+// because the lower components may be subnormal.
+type QuadDouble [4]float64
+
+// NewQuadDouble converts f to QuadDouble.
+func NewQuadDouble(f float64) QuadDouble {
+	return QuadDouble{f, 0, 0, 0}
+}
+
+// NewQuadDoubleNaN returns a NaN QuadDouble value.
+func NewQuadDoubleNaN() QuadDouble {
+	return QuadDouble{math.NaN(), 0, 0, 0}
+}
+
+// NewQuadDoubleInf positive infinity if sign >= 0, negative infinity if sign < 0.
+func NewQuadDoubleInf(sign int) QuadDouble {
+	return QuadDouble{math.Inf(sign), 0, 0, 0}
+}
+
+// threeSum returns the sum of a, b, and c as three non-overlapping components.
+func threeSum(a, b, c float64) (float64, float64, float64) {
+	t1, t2 := twoSum(a, b)
+	a, t3 := twoSum(c, t1)
+	b, c = twoSum(t2, t3)
+	return a, b, c
+}
+
+// threeSum2 returns the sum of a, b, and c as two components.
+func threeSum2(a, b, c float64) (float64, float64) {
+	t1, t2 := twoSum(a, b)
+	a, t3 := twoSum(c, t1)
+	return a, t2 + t3
+}
+
+// quickThreeAccum adds c to the accumulator (a, b).
+// If the accumulator overflows a component, it returns the component and shifts the accumulator.
+// Otherwise, it returns zero.
+func quickThreeAccum(a, b, c float64) (float64, float64, float64) {
+	s, b := twoSum(b, c)
+	s, a = twoSum(a, s)
+	if a != 0 && b != 0 {
+		return a, b, s
+	}
+	if b == 0 {
+		return s, a, 0
+	}
+	return s, b, 0
+}
+
+// renormQD normalizes the sum of c0, c1, c2, c3, and c4.
+func renormQD(c0, c1, c2, c3, c4 float64) QuadDouble {
+	if !isFinite(c0) {
+		return QuadDouble{c0, 0, 0, 0}
+	}
+
+	var s0, s1, s2, s3 float64
+	s0, c4 = quickTwoSum(c3, c4)
+	s0, c3 = quickTwoSum(c2, s0)
+	s0, c2 = quickTwoSum(c1, s0)
+	c0, c1 = quickTwoSum(c0, s0)
+
+	s0, s1 = c0, c1
+	if s1 != 0 {
+		s1, s2 = quickTwoSum(s1, c2)
+		if s2 != 0 {
+			s2, s3 = quickTwoSum(s2, c3)
+			if s3 != 0 {
+				s3 += c4
+			} else {
+				s2, s3 = quickTwoSum(s2, c4)
+			}
+		} else {
+			s1, s2 = quickTwoSum(s1, c3)
+			if s2 != 0 {
+				s2, s3 = quickTwoSum(s2, c4)
+			} else {
+				s1, s2 = quickTwoSum(s1, c4)
+			}
+		}
+	} else {
+		s0, s1 = quickTwoSum(s0, c2)
+		if s1 != 0 {
+			s1, s2 = quickTwoSum(s1, c3)
+			if s2 != 0 {
+				s2, s3 = quickTwoSum(s2, c4)
+			} else {
+				s1, s2 = quickTwoSum(s1, c4)
+			}
+		} else {
+			s0, s1 = quickTwoSum(s0, c3)
+			if s1 != 0 {
+				s1, s2 = quickTwoSum(s1, c4)
+			} else {
+				s0, s1 = quickTwoSum(s0, c4)
+			}
+		}
+	}
+	return QuadDouble{s0, s1, s2, s3}
+}
+
+// IsNaN reports whether a is a “not-a-number” value.
+func (a QuadDouble) IsNaN() bool {
+	return math.IsNaN(a[0])
+}
+
+// IsInf reports whether a is an infinity, according to sign.
+// If sign > 0, IsInf reports whether a is positive infinity.
+// If sign < 0, IsInf reports whether a is negative infinity.
+// If sign == 0, IsInf reports whether a is either infinity.
+func (a QuadDouble) IsInf(sign int) bool {
+	return math.IsInf(a[0], sign)
+}
+
+// Signbit reports whether x is negative or negative zero.
+func (a QuadDouble) Signbit() bool {
+	return math.Signbit(a[0])
+}
+
+// IsZero reports whether a is zero (+0 or -0).
+func (a QuadDouble) IsZero() bool {
+	return a[0] == 0
+}
+
+// Neg returns the negation of a.
+func (a QuadDouble) Neg() QuadDouble {
+	return QuadDouble{-a[0], -a[1], -a[2], -a[3]}
+}
+
+// Abs returns the absolute value of a.
+//
+// Special cases:
+//
+//	Abs(±Inf) = +Inf
+//	Abs(NaN) = NaN
+func (a QuadDouble) Abs() QuadDouble {
+	if math.Signbit(a[0]) {
+		return a.Neg()
+	}
+	return a
+}
+
+// Add returns the sum of a and b.
+func (a QuadDouble) Add(b QuadDouble) QuadDouble {
+	if s := a[0] + b[0]; !isFinite(s) {
+		return QuadDouble{s, 0, 0, 0}
+	}
+
+	// add the components in the decreasing order of magnitude.
+	var x [4]float64
+	i, j, k := 0, 0, 0
+
+	next := func() float64 {
+		var t float64
+		switch {
+		case i >= 4:
+			t = b[j]
+			j++
+		case j >= 4:
+			t = a[i]
+			i++
+		case math.Abs(a[i]) > math.Abs(b[j]):
+			t = a[i]
+			i++
+		default:
+			t = b[j]
+			j++
+		}
+		return t
+	}
+
+	u := next()
+	v := next()
+	u, v = quickTwoSum(u, v)
+	for k < 4 {
+		if i >= 4 && j >= 4 {
+			x[k] = u
+			if k < 3 {
+				k++
+				x[k] = v
+			}
+			break
+		}
+		var s float64
+		u, v, s = quickThreeAccum(u, v, next())
+		if s != 0 {
+			x[k] = s
+			k++
+		}
+	}
+
+	// add the rest.
+	for ; i < 4; i++ {
+		x[3] += a[i]
+	}
+	for ; j < 4; j++ {
+		x[3] += b[j]
+	}
+	r := renormQD(x[0], x[1], x[2], x[3], 0)
+	if r[0] == 0 {
+		// the sum is zero; its sign follows the rules of float64 addition.
+		r[0] = a[0] + b[0]
+		if r[0] != 0 {
+			r[0] = 0
+		}
+	}
+	return r
+}
+
+// Sub returns the difference of a and b.
+func (a QuadDouble) Sub(b QuadDouble) QuadDouble {
+	return a.Add(b.Neg())
+}
+
+// Mul returns the product of a and b.
+func (a QuadDouble) Mul(b QuadDouble) QuadDouble {
+	p0, q0 := twoProd(a[0], b[0])
+	if p0 == 0 || !isFinite(p0) {
+		return QuadDouble{p0, 0, 0, 0}
+	}
+	p1, q1 := twoProd(a[0], b[1])
+	p2, q2 := twoProd(a[1], b[0])
+	p3, q3 := twoProd(a[0], b[2])
+	p4, q4 := twoProd(a[1], b[1])
+	p5, q5 := twoProd(a[2], b[0])
+
+	// accumulate the terms of the same order.
+	p1, p2, q0 = threeSum(p1, p2, q0)
+	p2, q1, q2 = threeSum(p2, q1, q2)
+	p3, p4, p5 = threeSum(p3, p4, p5)
+
+	// (s0, s1, s2) = (p2, q1, q2) + (p3, p4, p5)
+	s0, t0 := twoSum(p2, p3)
+	s1, t1 := twoSum(q1, p4)
+	s2 := q2 + p5
+	s1, t0 = twoSum(s1, t0)
+	s2 += t0 + t1
+
+	// O(eps³) order terms
+	s1 += a[0]*b[3] + a[1]*b[2] + a[2]*b[1] + a[3]*b[0] + q0 + q3 + q4 + q5
+	return renormQD(p0, p1, s0, s1, s2)
+}
+
+// mulFloat64 returns the product of a and b.
+func (a QuadDouble) mulFloat64(b float64) QuadDouble {
+	p0, q0 := twoProd(a[0], b)
+	if p0 == 0 || !isFinite(p0) {
+		return QuadDouble{p0, 0, 0, 0}
+	}
+	p1, q1 := twoProd(a[1], b)
+	p2, q2 := twoProd(a[2], b)
+	p3 := a[3] * b
+
+	s0 := p0
+	s1, s2 := twoSum(q0, p1)
+	s2, q1, p2 = threeSum(s2, q1, p2)
+	q1, q2 = threeSum2(q1, q2, p3)
+	s3 := q1
+	s4 := q2 + p2
+	return renormQD(s0, s1, s2, s3, s4)
+}
+
+// Quo returns the quotient of a and b.
+func (a QuadDouble) Quo(b QuadDouble) QuadDouble {
+	q0 := a[0] / b[0]
+	if q0 == 0 || !isFinite(q0) {
+		return QuadDouble{q0, 0, 0, 0}
+	}
+
+	// compute the quotient digit by digit, and sum them up.
+	r := a.Sub(b.mulFloat64(q0))
+	if !isFinite(r[0]) {
+		// b * q0 overflows
+		return QuadDouble{q0, 0, 0, 0}
+	}
+	q1 := r[0] / b[0]
+	r = r.Sub(b.mulFloat64(q1))
+	q2 := r[0] / b[0]
+	r = r.Sub(b.mulFloat64(q2))
+	q3 := r[0] / b[0]
+	r = r.Sub(b.mulFloat64(q3))
+	q4 := r[0] / b[0]
+	return renormQD(q0, q1, q2, q3, q4)
+}
+
+// Sqrt returns the square root of a.
+//
+// Special cases are:
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func (a QuadDouble) Sqrt() QuadDouble {
+	if a[0] <= 0 || !isFinite(a[0]) {
+		return QuadDouble{math.Sqrt(a[0]), 0, 0, 0}
+	}
+
+	// reduce; a = f * 2**(2k), 1/4 <= f < 1
+	// so that the lower components of x² don't underflow.
+	_, exp := math.Frexp(a[0])
+	k := (exp + 1) >> 1
+	f := a.ldexp(-2 * k)
+
+	// Newton's iteration x' = x + (1 - f*x²) * x / 2 converges to 1/sqrt(f).
+	// It doubles the number of correct bits, so three iterations are enough from float64.
+	x := NewQuadDouble(1 / math.Sqrt(f[0]))
+	h := f.ldexp(-1)
+	half := NewQuadDouble(0.5)
+	for range 3 {
+		x = x.Add(half.Sub(h.Mul(x.Mul(x))).Mul(x))
+	}
+	return x.Mul(f).ldexp(k)
+}
+
+// ldexp returns a × 2**exp.
+func (a QuadDouble) ldexp(exp int) QuadDouble {
+	return QuadDouble{
+		math.Ldexp(a[0], exp),
+		math.Ldexp(a[1], exp),
+		math.Ldexp(a[2], exp),
+		math.Ldexp(a[3], exp),
+	}
+}
+
+// Eq returns a == b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a QuadDouble) Eq(b QuadDouble) bool {
+	return a[0] == b[0] && a[1] == b[1] && a[2] == b[2] && a[3] == b[3]
+}
+
+// Ne returns a != b.
+// NaNs are not equal to anything, including NaN.
+// -0.0 and 0.0 are equal.
+func (a QuadDouble) Ne(b QuadDouble) bool {
+	return !a.Eq(b)
+}
+
+// Lt returns a < b.
+//
+// Special cases are:
+//
+//	Lt(NaN, x) == false
+//	Lt(x, NaN) == false
+func (a QuadDouble) Lt(b QuadDouble) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
+
+// Gt returns a > b.
+//
+// Special cases are:
+//
+//	Gt(x, NaN) == false
+//	Gt(NaN, x) == false
+func (a QuadDouble) Gt(b QuadDouble) bool {
+	return b.Lt(a)
+}
+
+// Le returns a <= b.
+//
+// Special cases are:
+//
+//	Le(x, NaN) == false
+//	Le(NaN, x) == false
+func (a QuadDouble) Le(b QuadDouble) bool {
+	if a.IsNaN() || b.IsNaN() {
+		return false
+	}
+	return !b.Lt(a)
+}
+
+// Ge returns a >= b.
+//
+// Special cases are:
+//
+//	Ge(x, NaN) == false
+//	Ge(NaN, x) == false
+func (a QuadDouble) Ge(b QuadDouble) bool {
+	return b.Le(a)
+}
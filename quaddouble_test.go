@@ -0,0 +1,237 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"testing"
+)
+
+func TestQuadDouble_Add(t *testing.T) {
+	tests := []struct {
+		a, b, want QuadDouble
+	}{
+		{NewQuadDouble(1), NewQuadDouble(2), NewQuadDouble(3)},
+		{NewQuadDouble(1), NewQuadDouble(-1), NewQuadDouble(0)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(math.Copysign(0, -1))},
+
+		// the rounding error of float64 is kept in the second component
+		{NewQuadDouble(1), NewQuadDouble(0x1p-80), QuadDouble{1, 0x1p-80, 0, 0}},
+		{QuadDouble{1, 0x1p-80, 0, 0}, NewQuadDouble(-1), NewQuadDouble(0x1p-80)},
+		{QuadDouble{1, 0x1p-80, 0, 0}, QuadDouble{1, 0x1p-80, 0, 0}, QuadDouble{2, 0x1p-79, 0, 0}},
+
+		// handling infinity
+		{NewQuadDoubleInf(1), NewQuadDoubleInf(-1), NewQuadDoubleNaN()},
+		{NewQuadDoubleInf(1), NewQuadDouble(1), NewQuadDoubleInf(1)},
+		{NewQuadDouble(math.MaxFloat64), NewQuadDouble(math.MaxFloat64), NewQuadDoubleInf(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Add(tt.b)
+		if !eqQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Add(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestQuadDouble_Sub(t *testing.T) {
+	tests := []struct {
+		a, b, want QuadDouble
+	}{
+		{NewQuadDouble(1), NewQuadDouble(2), NewQuadDouble(-1)},
+		{NewQuadDouble(1), NewQuadDouble(1), NewQuadDouble(0)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(0), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDouble(1), NewQuadDouble(0x1p-80), QuadDouble{1, -0x1p-80, 0, 0}},
+
+		// handling infinity
+		{NewQuadDoubleInf(1), NewQuadDoubleInf(1), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sub(tt.b)
+		if !eqQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Sub(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestQuadDouble_Mul(t *testing.T) {
+	tests := []struct {
+		a, b, want QuadDouble
+	}{
+		{NewQuadDouble(1), NewQuadDouble(0), NewQuadDouble(0)},
+		{NewQuadDouble(1.5), NewQuadDouble(-3), NewQuadDouble(-4.5)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(1), NewQuadDouble(math.Copysign(0, -1))},
+
+		// (1 + 2**-52)² = 1 + 2**-51 + 2**-104
+		{NewQuadDouble(1 + 0x1p-52), NewQuadDouble(1 + 0x1p-52), QuadDouble{1 + 0x1p-51, 0x1p-104, 0, 0}},
+
+		// handling infinity and NaN
+		{NewQuadDoubleInf(1), NewQuadDouble(-1), NewQuadDoubleInf(-1)},
+		{NewQuadDoubleInf(1), NewQuadDouble(0), NewQuadDoubleNaN()},
+		{NewQuadDoubleNaN(), NewQuadDouble(1), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Mul(tt.b)
+		if !eqQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Mul(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Mul(b *testing.B) {
+	x := NewQuadDouble(1).Quo(NewQuadDouble(3))
+	y := NewQuadDouble(2).Sqrt()
+	for b.Loop() {
+		runtime.KeepAlive(x.Mul(y))
+	}
+}
+
+func TestQuadDouble_Quo(t *testing.T) {
+	tests := []struct {
+		a, b QuadDouble
+		want string
+	}{
+		{NewQuadDouble(1), NewQuadDouble(3), "0.33333333333333333333333333333333333333333333333333333333333333333333333333333333"},
+		{NewQuadDouble(2), NewQuadDouble(7), "0.28571428571428571428571428571428571428571428571428571428571428571428571428571429"},
+		{NewQuadDouble(-1e300), NewQuadDouble(3e-5), "-3.3333333333333334239018983048659976190642138151943659920633588378593836609493542e+304"},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Quo(tt.b)
+		if !closeQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Quo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		a, b, want QuadDouble
+	}{
+		{NewQuadDouble(1), NewQuadDouble(0), NewQuadDoubleInf(1)},
+		{NewQuadDouble(0), NewQuadDouble(0), NewQuadDoubleNaN()},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(1), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDouble(1), NewQuadDoubleInf(1), NewQuadDouble(0)},
+		{NewQuadDouble(math.MaxFloat64), NewQuadDouble(0.5), NewQuadDoubleInf(1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.a.Quo(tt.b)
+		if !eqQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Quo(%v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestQuadDouble_Sqrt(t *testing.T) {
+	tests := []struct {
+		a    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(2), "1.4142135623730950488016887242096980785696718753769480731766797379907324784621070"},
+		{NewQuadDouble(0.5), "0.70710678118654752440084436210484903928483593768847403658833986899536623923105352"},
+		{NewQuadDouble(1e300), "1.0000000000000000262523801276022097797585031084923714583594248836846514143338127e+150"},
+	}
+
+	for _, tt := range tests {
+		got := tt.a.Sqrt()
+		if !closeQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Sqrt() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		a, want QuadDouble
+	}{
+		{NewQuadDouble(4), NewQuadDouble(2)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDouble(-1), NewQuadDoubleNaN()},
+		{NewQuadDoubleInf(1), NewQuadDoubleInf(1)},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.a.Sqrt()
+		if !eqQD(got, tt.want) {
+			t.Errorf("QuadDouble(%v).Sqrt() = %v, want %v", tt.a, got, tt.want)
+		}
+	}
+}
+
+// TestQuadDouble_Accuracy compares the arithmetic with Float256.
+func TestQuadDouble_Accuracy(t *testing.T) {
+	// the relative error of quad-double arithmetic is a few units of 2**-212.
+	// Float256 has 237 bits, so its rounding error is negligible.
+	const tolerance = 0x1p-206
+
+	r := rand.New(rand.NewPCG(1, 2))
+	random := func() QuadDouble {
+		x := QuadDouble{math.Ldexp(r.Float64()*2-1, r.IntN(64)-32), 0, 0, 0}
+		for i := 1; i < 4; i++ {
+			x = x.Add(NewQuadDouble(x[i-1] * (r.Float64() - 0.5) * 0x1p-53))
+		}
+		return x
+	}
+	relErr := func(got QuadDouble, want Float256) float64 {
+		d := got.Float256().Sub(want)
+		if !want.IsZero() {
+			d = d.Quo(want)
+		}
+		return math.Abs(float64(d.Float64()))
+	}
+
+	for range 1000 {
+		a, b := random(), random()
+		fa, fb := a.Float256(), b.Float256()
+		if got, want := a.Add(b), fa.Add(fb); relErr(got, want) > tolerance {
+			t.Errorf("QuadDouble(%v).Add(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Sub(b), fa.Sub(fb); relErr(got, want) > tolerance {
+			t.Errorf("QuadDouble(%v).Sub(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Mul(b), fa.Mul(fb); relErr(got, want) > tolerance {
+			t.Errorf("QuadDouble(%v).Mul(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Quo(b), fa.Quo(fb); relErr(got, want) > tolerance {
+			t.Errorf("QuadDouble(%v).Quo(%v) = %v, want %v", a, b, got, want)
+		}
+		if got, want := a.Abs().Sqrt(), fa.Abs().Sqrt(); relErr(got, want) > tolerance {
+			t.Errorf("QuadDouble(%v).Sqrt() = %v, want %v", a.Abs(), got, want)
+		}
+	}
+}
+
+func TestQuadDouble_Compare(t *testing.T) {
+	tests := []struct {
+		a, b               QuadDouble
+		eq, lt, gt, le, ge bool
+	}{
+		{NewQuadDouble(1), NewQuadDouble(1), true, false, false, true, true},
+		{NewQuadDouble(1), QuadDouble{1, 0x1p-80, 0, 0}, false, true, false, true, false},
+		{QuadDouble{1, -0x1p-80, 0, 0}, NewQuadDouble(1), false, true, false, true, false},
+		{NewQuadDouble(0), NewQuadDouble(math.Copysign(0, -1)), true, false, false, true, true},
+		{NewQuadDoubleNaN(), NewQuadDouble(1), false, false, false, false, false},
+		{NewQuadDouble(1), NewQuadDoubleNaN(), false, false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Eq(tt.b); got != tt.eq {
+			t.Errorf("QuadDouble(%v).Eq(%v) = %v, want %v", tt.a, tt.b, got, tt.eq)
+		}
+		if got := tt.a.Ne(tt.b); got != !tt.eq {
+			t.Errorf("QuadDouble(%v).Ne(%v) = %v, want %v", tt.a, tt.b, got, !tt.eq)
+		}
+		if got := tt.a.Lt(tt.b); got != tt.lt {
+			t.Errorf("QuadDouble(%v).Lt(%v) = %v, want %v", tt.a, tt.b, got, tt.lt)
+		}
+		if got := tt.a.Gt(tt.b); got != tt.gt {
+			t.Errorf("QuadDouble(%v).Gt(%v) = %v, want %v", tt.a, tt.b, got, tt.gt)
+		}
+		if got := tt.a.Le(tt.b); got != tt.le {
+			t.Errorf("QuadDouble(%v).Le(%v) = %v, want %v", tt.a, tt.b, got, tt.le)
+		}
+		if got := tt.a.Ge(tt.b); got != tt.ge {
+			t.Errorf("QuadDouble(%v).Ge(%v) = %v, want %v", tt.a, tt.b, got, tt.ge)
+		}
+	}
+}
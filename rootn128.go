@@ -0,0 +1,18 @@
+package floats
+
+// Rootn returns the n-th root of a.
+//
+// Special cases are (in order):
+//
+//	a.Rootn(0) = NaN
+//	NaN.Rootn(n) = NaN
+//	±0.Rootn(n) = ±Inf for odd n < 0
+//	±0.Rootn(n) = +Inf for even n < 0
+//	±0.Rootn(n) = ±0 for odd n > 0
+//	±0.Rootn(n) = +0 for even n > 0
+//	(a < 0).Rootn(n) = NaN for even n
+//	±Inf.Rootn(n) = ±Inf for n > 0
+//	±Inf.Rootn(n) = ±0 for n < 0
+func (a Float128) Rootn(n int) Float128 {
+	return a.Float256().Rootn(n).Float128()
+}
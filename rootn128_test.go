@@ -0,0 +1,65 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat128_Rootn(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		n    int
+		want string
+	}{
+		{exact128(2), 3, "1.259921049894873164767210607278228350570"},
+		{exact128(-2), 3, "-1.259921049894873164767210607278228350570"},
+		{exact128(10), 5, "1.584893192461113485202101373391507013269"},
+		{exact128(0.5), -2, "1.414213562373095048801688724209698078570"},
+		{exact128(100), 4, "3.162277660168379331998893544432718533720"},
+		{exact128(-1000), -3, "-0.1000000000000000000000000000000000000000"},
+		{exact128(3), 7, "1.169930812758686886462975725513734667699"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rootn(tt.n)
+		if !close128(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		n    int
+		want Float128
+	}{
+		{exact128(2), 0, exact128(math.NaN())},
+		{exact128(math.NaN()), 3, exact128(math.NaN())},
+		{exact128(0), -3, exact128(math.Inf(1))},
+		{exact128(math.Copysign(0, -1)), -3, exact128(math.Inf(-1))},
+		{exact128(0), -2, exact128(math.Inf(1))},
+		{exact128(math.Copysign(0, -1)), -2, exact128(math.Inf(1))},
+		{exact128(0), 3, exact128(0)},
+		{exact128(math.Copysign(0, -1)), 3, exact128(math.Copysign(0, -1))},
+		{exact128(math.Copysign(0, -1)), 2, exact128(0)},
+		{exact128(-1), 2, exact128(math.NaN())},
+		{exact128(math.Inf(-1)), 2, exact128(math.NaN())},
+		{exact128(math.Inf(-1)), -2, exact128(math.NaN())},
+		{exact128(math.Inf(1)), 3, exact128(math.Inf(1))},
+		{exact128(math.Inf(-1)), 3, exact128(math.Inf(-1))},
+		{exact128(math.Inf(1)), -3, exact128(0)},
+		{exact128(math.Inf(-1)), -3, exact128(math.Copysign(0, -1))},
+		{exact128(8), 3, exact128(2)},
+		{exact128(-8), 3, exact128(-2)},
+		{exact128(16), 4, exact128(2)},
+		{exact128(0.25), -2, exact128(2)},
+		{exact128(1024), 10, exact128(2)},
+		{exact128(-1), math.MaxInt, exact128(-1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rootn(tt.n)
+		if !eq128(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFloat16_Rootn(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		n    int
+		want float64
+	}{
+		{exact16(2), 3, 1.2599210498948732},
+		{exact16(-2), 3, -1.2599210498948732},
+		{exact16(10), 5, 1.5848931924611134},
+		{exact16(0.5), -2, 1.4142135623730951},
+		{exact16(100), 4, 3.1622776601683795},
+		{exact16(-1000), -3, -0.1},
+		{exact16(3), 7, 1.169930812758687},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rootn(tt.n)
+		if !close16(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		n    int
+		want Float16
+	}{
+		{exact16(2), 0, exact16(math.NaN())},
+		{exact16(math.NaN()), 3, exact16(math.NaN())},
+		{exact16(0), -3, exact16(math.Inf(1))},
+		{exact16(math.Copysign(0, -1)), -3, exact16(math.Inf(-1))},
+		{exact16(0), -2, exact16(math.Inf(1))},
+		{exact16(math.Copysign(0, -1)), -2, exact16(math.Inf(1))},
+		{exact16(0), 3, exact16(0)},
+		{exact16(math.Copysign(0, -1)), 3, exact16(math.Copysign(0, -1))},
+		{exact16(math.Copysign(0, -1)), 2, exact16(0)},
+		{exact16(-1), 2, exact16(math.NaN())},
+		{exact16(math.Inf(-1)), 2, exact16(math.NaN())},
+		{exact16(math.Inf(-1)), -2, exact16(math.NaN())},
+		{exact16(math.Inf(1)), 3, exact16(math.Inf(1))},
+		{exact16(math.Inf(-1)), 3, exact16(math.Inf(-1))},
+		{exact16(math.Inf(1)), -3, exact16(0)},
+		{exact16(math.Inf(-1)), -3, exact16(math.Copysign(0, -1))},
+		{exact16(8), 3, exact16(2)},
+		{exact16(-8), 3, exact16(-2)},
+		{exact16(16), 4, exact16(2)},
+		{exact16(0.25), -2, exact16(2)},
+		{exact16(1024), 10, exact16(2)},
+		{exact16(-1), math.MaxInt, exact16(-1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rootn(tt.n)
+		if !eq16(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Rootn_CorrectlyRounded(t *testing.T) {
+	for _, n := range []int{-5, 3, 7} {
+		name := fmt.Sprintf("Rootn(x, %d)", n)
+		f := func(x Float16) Float16 { return x.Rootn(n) }
+		testCorrectlyRounded16(t, name, f, rootnRef(n))
+	}
+}
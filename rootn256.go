@@ -0,0 +1,72 @@
+package floats
+
+// Rootn returns the n-th root of a.
+//
+// Special cases are (in order):
+//
+//	a.Rootn(0) = NaN
+//	NaN.Rootn(n) = NaN
+//	±0.Rootn(n) = ±Inf for odd n < 0
+//	±0.Rootn(n) = +Inf for even n < 0
+//	±0.Rootn(n) = ±0 for odd n > 0
+//	±0.Rootn(n) = +0 for even n > 0
+//	(a < 0).Rootn(n) = NaN for even n
+//	±Inf.Rootn(n) = ±Inf for n > 0
+//	±Inf.Rootn(n) = ±0 for n < 0
+func (a Float256) Rootn(n int) Float256 {
+	var One = Float256(uvone256)
+	odd := n&1 != 0
+
+	// special cases
+	switch {
+	case n == 0 || a.IsNaN():
+		return NewFloat256NaN()
+	case a.IsZero():
+		if n < 0 {
+			if odd {
+				return NewFloat256Inf(1).Copysign(a)
+			}
+			return NewFloat256Inf(1)
+		}
+		if odd {
+			return a
+		}
+		return Float256{}
+	case a.Signbit() && !odd:
+		return NewFloat256NaN()
+	case a.IsInf(0):
+		if n > 0 {
+			return a
+		}
+		return Float256{}.Copysign(a)
+	case n == 1:
+		return a
+	case n == 2:
+		return a.Sqrt()
+	case n == -1:
+		return One.Quo(a)
+	}
+
+	// reduce; |a| = f × 2**(q×n + r), 0 <= r < |n|
+	// so that log(f × 2**r)/n is small, and the error of exp doesn't grow.
+	f, exp := a.Abs().Frexp()
+	q := 0
+	if m := n; -1<<20 <= m && m <= 1<<20 {
+		if m < 0 {
+			m = -m
+		}
+		q = exp / m
+		if exp%m < 0 {
+			q--
+		}
+		exp -= q * m
+		if n < 0 {
+			q = -q
+		}
+	}
+	f = f.Ldexp(exp)
+
+	// compute; a**(1/n) = 2**q × exp(log(f × 2**r)/n)
+	y := f.Log().Quo(NewFloat256FromInt64(int64(n))).Exp().Ldexp(q)
+	return y.Copysign(a)
+}
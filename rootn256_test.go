@@ -0,0 +1,65 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat256_Rootn(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		n    int
+		want string
+	}{
+		{exact256(2), 3, "1.259921049894873164767210607278228350570251464701507980081975112155300"},
+		{exact256(-2), 3, "-1.259921049894873164767210607278228350570251464701507980081975112155300"},
+		{exact256(10), 5, "1.584893192461113485202101373391507013269442133825039068316296812316657"},
+		{exact256(0.5), -2, "1.414213562373095048801688724209698078569671875376948073176679737990732"},
+		{exact256(100), 4, "3.162277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(-1000), -3, "-0.1000000000000000000000000000000000000000000000000000000000000000000000"},
+		{exact256(3), 7, "1.169930812758686886462975725513734667699404196420934209030218965589334"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rootn(tt.n)
+		if !close256(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		n    int
+		want Float256
+	}{
+		{exact256(2), 0, exact256(math.NaN())},
+		{exact256(math.NaN()), 3, exact256(math.NaN())},
+		{exact256(0), -3, exact256(math.Inf(1))},
+		{exact256(math.Copysign(0, -1)), -3, exact256(math.Inf(-1))},
+		{exact256(0), -2, exact256(math.Inf(1))},
+		{exact256(math.Copysign(0, -1)), -2, exact256(math.Inf(1))},
+		{exact256(0), 3, exact256(0)},
+		{exact256(math.Copysign(0, -1)), 3, exact256(math.Copysign(0, -1))},
+		{exact256(math.Copysign(0, -1)), 2, exact256(0)},
+		{exact256(-1), 2, exact256(math.NaN())},
+		{exact256(math.Inf(-1)), 2, exact256(math.NaN())},
+		{exact256(math.Inf(-1)), -2, exact256(math.NaN())},
+		{exact256(math.Inf(1)), 3, exact256(math.Inf(1))},
+		{exact256(math.Inf(-1)), 3, exact256(math.Inf(-1))},
+		{exact256(math.Inf(1)), -3, exact256(0)},
+		{exact256(math.Inf(-1)), -3, exact256(math.Copysign(0, -1))},
+		{exact256(8), 3, exact256(2)},
+		{exact256(-8), 3, exact256(-2)},
+		{exact256(16), 4, exact256(2)},
+		{exact256(0.25), -2, exact256(2)},
+		{exact256(1024), 10, exact256(2)},
+		{exact256(-1), math.MaxInt, exact256(-1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rootn(tt.n)
+		if !eq256(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
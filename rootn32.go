@@ -0,0 +1,21 @@
+package floats
+
+// Rootn returns the n-th root of a.
+//
+// Special cases are (in order):
+//
+//	a.Rootn(0) = NaN
+//	NaN.Rootn(n) = NaN
+//	±0.Rootn(n) = ±Inf for odd n < 0
+//	±0.Rootn(n) = +Inf for even n < 0
+//	±0.Rootn(n) = ±0 for odd n > 0
+//	±0.Rootn(n) = +0 for even n > 0
+//	(a < 0).Rootn(n) = NaN for even n
+//	±Inf.Rootn(n) = ±Inf for n > 0
+//	±Inf.Rootn(n) = ±0 for n < 0
+func (a Float32) Rootn(n int) Float32 {
+	if ret, ok := roundFloat32(a.Float64().Rootn(n).BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Rootn(n).Float32()
+}
@@ -0,0 +1,74 @@
+package floats
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFloat32_Rootn(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		n    int
+		want float64
+	}{
+		{exact32(2), 3, 1.2599210498948732},
+		{exact32(-2), 3, -1.2599210498948732},
+		{exact32(10), 5, 1.5848931924611134},
+		{exact32(0.5), -2, 1.4142135623730951},
+		{exact32(100), 4, 3.1622776601683795},
+		{exact32(-1000), -3, -0.1},
+		{exact32(3), 7, 1.169930812758687},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rootn(tt.n)
+		if !close32(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		n    int
+		want Float32
+	}{
+		{exact32(2), 0, exact32(math.NaN())},
+		{exact32(math.NaN()), 3, exact32(math.NaN())},
+		{exact32(0), -3, exact32(math.Inf(1))},
+		{exact32(math.Copysign(0, -1)), -3, exact32(math.Inf(-1))},
+		{exact32(0), -2, exact32(math.Inf(1))},
+		{exact32(math.Copysign(0, -1)), -2, exact32(math.Inf(1))},
+		{exact32(0), 3, exact32(0)},
+		{exact32(math.Copysign(0, -1)), 3, exact32(math.Copysign(0, -1))},
+		{exact32(math.Copysign(0, -1)), 2, exact32(0)},
+		{exact32(-1), 2, exact32(math.NaN())},
+		{exact32(math.Inf(-1)), 2, exact32(math.NaN())},
+		{exact32(math.Inf(-1)), -2, exact32(math.NaN())},
+		{exact32(math.Inf(1)), 3, exact32(math.Inf(1))},
+		{exact32(math.Inf(-1)), 3, exact32(math.Inf(-1))},
+		{exact32(math.Inf(1)), -3, exact32(0)},
+		{exact32(math.Inf(-1)), -3, exact32(math.Copysign(0, -1))},
+		{exact32(8), 3, exact32(2)},
+		{exact32(-8), 3, exact32(-2)},
+		{exact32(16), 4, exact32(2)},
+		{exact32(0.25), -2, exact32(2)},
+		{exact32(1024), 10, exact32(2)},
+		{exact32(-1), math.MaxInt, exact32(-1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rootn(tt.n)
+		if !eq32(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Rootn_CorrectlyRounded(t *testing.T) {
+	for _, n := range []int{-5, 3, 7} {
+		name := fmt.Sprintf("Rootn(x, %d)", n)
+		f := func(x Float32) Float32 { return x.Rootn(n) }
+		testCorrectlyRounded32(t, name, f, rootnRef(n), -149, 128, 0x1p-149, -0x1p-149)
+	}
+}
@@ -0,0 +1,56 @@
+package floats
+
+import "math"
+
+// Rootn returns the n-th root of a.
+//
+// Special cases are (in order):
+//
+//	a.Rootn(0) = NaN
+//	NaN.Rootn(n) = NaN
+//	±0.Rootn(n) = ±Inf for odd n < 0
+//	±0.Rootn(n) = +Inf for even n < 0
+//	±0.Rootn(n) = ±0 for odd n > 0
+//	±0.Rootn(n) = +0 for even n > 0
+//	(a < 0).Rootn(n) = NaN for even n
+//	±Inf.Rootn(n) = ±Inf for n > 0
+//	±Inf.Rootn(n) = ±0 for n < 0
+func (a Float64) Rootn(n int) Float64 {
+	x := a.BuiltIn()
+	odd := n&1 != 0
+
+	// special cases
+	switch {
+	case n == 0 || math.IsNaN(x):
+		return NewFloat64NaN()
+	case x == 0:
+		if n < 0 {
+			if odd {
+				return NewFloat64(math.Copysign(math.Inf(1), x))
+			}
+			return NewFloat64Inf(1)
+		}
+		if odd {
+			return a
+		}
+		return 0
+	case x < 0 && !odd:
+		return NewFloat64NaN()
+	case math.IsInf(x, 0):
+		if n > 0 {
+			return a
+		}
+		return NewFloat64(math.Copysign(0, x))
+	case n == 1:
+		return a
+	case n == 2:
+		return NewFloat64(math.Sqrt(x))
+	}
+
+	// compute; |x|**(1/n) = exp(log|x|/n)
+	y := NewDoubleDouble(math.Abs(x)).Log().Quo(newDoubleDoubleInt64(int64(n))).Exp().Float64()
+	if x < 0 {
+		y = -y
+	}
+	return y
+}
@@ -0,0 +1,65 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat64_Rootn(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		n    int
+		want float64
+	}{
+		{exact64(2), 3, 1.2599210498948732},
+		{exact64(-2), 3, -1.2599210498948732},
+		{exact64(10), 5, 1.5848931924611134},
+		{exact64(0.5), -2, 1.4142135623730951},
+		{exact64(100), 4, 3.1622776601683795},
+		{exact64(-1000), -3, -0.1},
+		{exact64(3), 7, 1.169930812758687},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rootn(tt.n)
+		if !close64(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		n    int
+		want Float64
+	}{
+		{exact64(2), 0, exact64(math.NaN())},
+		{exact64(math.NaN()), 3, exact64(math.NaN())},
+		{exact64(0), -3, exact64(math.Inf(1))},
+		{exact64(math.Copysign(0, -1)), -3, exact64(math.Inf(-1))},
+		{exact64(0), -2, exact64(math.Inf(1))},
+		{exact64(math.Copysign(0, -1)), -2, exact64(math.Inf(1))},
+		{exact64(0), 3, exact64(0)},
+		{exact64(math.Copysign(0, -1)), 3, exact64(math.Copysign(0, -1))},
+		{exact64(math.Copysign(0, -1)), 2, exact64(0)},
+		{exact64(-1), 2, exact64(math.NaN())},
+		{exact64(math.Inf(-1)), 2, exact64(math.NaN())},
+		{exact64(math.Inf(-1)), -2, exact64(math.NaN())},
+		{exact64(math.Inf(1)), 3, exact64(math.Inf(1))},
+		{exact64(math.Inf(-1)), 3, exact64(math.Inf(-1))},
+		{exact64(math.Inf(1)), -3, exact64(0)},
+		{exact64(math.Inf(-1)), -3, exact64(math.Copysign(0, -1))},
+		{exact64(8), 3, exact64(2)},
+		{exact64(-8), 3, exact64(-2)},
+		{exact64(16), 4, exact64(2)},
+		{exact64(0.25), -2, exact64(2)},
+		{exact64(1024), 10, exact64(2)},
+		{exact64(-1), math.MaxInt, exact64(-1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rootn(tt.n)
+		if !eq64(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
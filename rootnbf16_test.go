@@ -0,0 +1,65 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Rootn(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		n    int
+		want float64
+	}{
+		{exactBF16(2), 3, 1.2599210498948732},
+		{exactBF16(-2), 3, -1.2599210498948732},
+		{exactBF16(10), 5, 1.5848931924611134},
+		{exactBF16(0.5), -2, 1.4142135623730951},
+		{exactBF16(100), 4, 3.1622776601683795},
+		{exactBF16(-1000), -3, -0.1},
+		{exactBF16(3), 7, 1.169930812758687},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rootn(tt.n)
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		n    int
+		want BFloat16
+	}{
+		{exactBF16(2), 0, exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), 3, exactBF16(math.NaN())},
+		{exactBF16(0), -3, exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), -3, exactBF16(math.Inf(-1))},
+		{exactBF16(0), -2, exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), -2, exactBF16(math.Inf(1))},
+		{exactBF16(0), 3, exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), 3, exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Copysign(0, -1)), 2, exactBF16(0)},
+		{exactBF16(-1), 2, exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), 2, exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), -2, exactBF16(math.NaN())},
+		{exactBF16(math.Inf(1)), 3, exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), 3, exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(1)), -3, exactBF16(0)},
+		{exactBF16(math.Inf(-1)), -3, exactBF16(math.Copysign(0, -1))},
+		{exactBF16(8), 3, exactBF16(2)},
+		{exactBF16(-8), 3, exactBF16(-2)},
+		{exactBF16(16), 4, exactBF16(2)},
+		{exactBF16(0.25), -2, exactBF16(2)},
+		{exactBF16(1024), 10, exactBF16(2)},
+		{exactBF16(-1), math.MaxInt, exactBF16(-1)},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rootn(tt.n)
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Rootn(%v, %d) = %v; want %v", tt.x, tt.n, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package floats
+
+import (
+	"math"
+	"strconv"
+)
+
+// RoundingMode determines how a floating-point value is rounded
+// to the nearest representable value of the destination format.
+type RoundingMode byte
+
+// These constants define supported rounding modes.
+// The names and the order follow [math/big.RoundingMode].
+const (
+	ToNearestEven RoundingMode = iota // == IEEE 754 roundTiesToEven
+	ToNearestAway                     // == IEEE 754 roundTiesToAway
+	ToZero                            // == IEEE 754 roundTowardZero
+	AwayFromZero                      // no IEEE 754 equivalent
+	ToNegativeInf                     // == IEEE 754 roundTowardNegative
+	ToPositiveInf                     // == IEEE 754 roundTowardPositive
+)
+
+// toOdd truncates the result and sets the least significant bit
+// if the result is inexact.
+// Rounding to odd with at least two extra bits of precision,
+// followed by a second rounding to the destination format,
+// gives the same result as a single rounding.
+// It is used internally to evaluate narrower formats in Float256.
+//
+// toOdd is combined with one of the exported modes,
+// which then only determines the sign of exact zero sums.
+const toOdd RoundingMode = 0x80
+
+// String returns the name of mode.
+func (mode RoundingMode) String() string {
+	switch mode {
+	case ToNearestEven:
+		return "ToNearestEven"
+	case ToNearestAway:
+		return "ToNearestAway"
+	case ToZero:
+		return "ToZero"
+	case AwayFromZero:
+		return "AwayFromZero"
+	case ToNegativeInf:
+		return "ToNegativeInf"
+	case ToPositiveInf:
+		return "ToPositiveInf"
+	}
+	if mode&toOdd != 0 {
+		return (mode &^ toOdd).String() + "|toOdd"
+	}
+	return "RoundingMode(" + strconv.Itoa(int(mode)) + ")"
+}
+
+// roundUp reports whether the truncated magnitude must be incremented by one ulp.
+// odd is the least significant bit of the truncated magnitude,
+// half is the first discarded bit, and sticky reports whether any other discarded bit is set.
+func (mode RoundingMode) roundUp(neg, odd, half, sticky bool) bool {
+	if mode&toOdd != 0 {
+		return !odd && (half || sticky)
+	}
+	switch mode {
+	case ToNearestEven:
+		return half && (sticky || odd)
+	case ToNearestAway:
+		return half
+	case AwayFromZero:
+		return half || sticky
+	case ToNegativeInf:
+		return neg && (half || sticky)
+	case ToPositiveInf:
+		return !neg && (half || sticky)
+	}
+	// ToZero
+	return false
+}
+
+// overflowToInf reports whether a result that is too large
+// to be represented in the destination format is rounded to infinity.
+// If it is not, the result is the largest finite value with the same sign.
+func (mode RoundingMode) overflowToInf(neg bool) bool {
+	switch mode {
+	case ToNearestEven, ToNearestAway, AwayFromZero:
+		return true
+	case ToNegativeInf:
+		return neg
+	case ToPositiveInf:
+		return !neg
+	}
+	// ToZero, toOdd
+	return false
+}
+
+// negativeZero reports whether an exact zero sum of two operands with opposite signs is -0.
+// It is -0 when rounding toward negative infinity, +0 otherwise.
+func (mode RoundingMode) negativeZero() bool {
+	return mode&^toOdd == ToNegativeInf
+}
+
+// approxErr64 bounds the relative error of the float64 approximations
+// that the Float16 and Float32 elementary functions start from.
+// The functions of package math are accurate to a few ulps of float64,
+// and 2⁻⁴⁰ is 2¹² ulps.
+const approxErr64 = 0x1p-40
+
+// roundFloat16 rounds y, an approximation of a real number x
+// with a relative error less than approxErr64, to a Float16.
+// It reports whether the result is also the correctly rounded value of x.
+// The result can be wrong only if x is close to the midpoint of two Float16 values,
+// which is rare enough to be recomputed in Float256.
+func roundFloat16(y float64) (Float16, bool) {
+	lo, hi := NewFloat16(y*(1-approxErr64)), NewFloat16(y*(1+approxErr64))
+	return lo, lo == hi
+}
+
+// roundFloat32 is the Float32 version of [roundFloat16].
+func roundFloat32(y float64) (Float32, bool) {
+	lo, hi := float32(y*(1-approxErr64)), float32(y*(1+approxErr64))
+	return Float32(lo), math.Float32bits(lo) == math.Float32bits(hi)
+}
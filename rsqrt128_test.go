@@ -0,0 +1,51 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat128_Rsqrt(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.5), "1.414213562373095048801688724209698078570"},
+		{exact128(2), "0.7071067811865475244008443621048490392848"},
+		{exact128(3), "0.5773502691896257645091487805019574556476"},
+		{exact128(5), "0.4472135954999579392818347337462552470881"},
+		{exact128(10), "0.3162277660168379331998893544432718533720"},
+		{exact128(100), "0.1"},
+		{exact128(1000), "0.03162277660168379331998893544432718533720"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rsqrt()
+		if !close128(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		{exact128(1), exact128(1)},
+		{exact128(4), exact128(0.5)},
+		{exact128(0.25), exact128(2)},
+		{exact128(64), exact128(0.125)},
+		{exact128(0), exact128(math.Inf(1))},
+		{exact128(math.Copysign(0, -1)), exact128(math.Inf(-1))},
+		{exact128(math.Inf(1)), exact128(0)},
+		{exact128(-1), exact128(math.NaN())},
+		{exact128(math.Inf(-1)), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rsqrt()
+		if !eq128(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package floats
+
+// Rsqrt returns the reciprocal of the square root of a.
+//
+// Special cases are:
+//
+//	+Inf.Rsqrt() = +0
+//	±0.Rsqrt() = ±Inf
+//	(a < 0).Rsqrt() = NaN
+//	NaN.Rsqrt() = NaN
+func (a Float16) Rsqrt() Float16 {
+	if ret, ok := roundFloat16(a.Float64().Rsqrt().BuiltIn()); ok {
+		return ret
+	}
+	return a.Float256().Rsqrt().Float16()
+}
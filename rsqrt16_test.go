@@ -0,0 +1,55 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16_Rsqrt(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.5), 1.4142135623730951},
+		{exact16(2), 0.7071067811865476},
+		{exact16(3), 0.5773502691896257},
+		{exact16(5), 0.4472135954999579},
+		{exact16(10), 0.31622776601683794},
+		{exact16(100), 0.1},
+		{exact16(1000), 0.03162277660168379},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rsqrt()
+		if !close16(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		{exact16(1), exact16(1)},
+		{exact16(4), exact16(0.5)},
+		{exact16(0.25), exact16(2)},
+		{exact16(64), exact16(0.125)},
+		{exact16(0), exact16(math.Inf(1))},
+		{exact16(math.Copysign(0, -1)), exact16(math.Inf(-1))},
+		{exact16(math.Inf(1)), exact16(0)},
+		{exact16(-1), exact16(math.NaN())},
+		{exact16(math.Inf(-1)), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rsqrt()
+		if !eq16(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Rsqrt_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Rsqrt", Float16.Rsqrt, rsqrtRef)
+}
@@ -0,0 +1,22 @@
+package floats
+
+// Rsqrt returns the reciprocal of the square root of a.
+//
+// Special cases are:
+//
+//	+Inf.Rsqrt() = +0
+//	±0.Rsqrt() = ±Inf
+//	(a < 0).Rsqrt() = NaN
+//	NaN.Rsqrt() = NaN
+func (a Float256) Rsqrt() Float256 {
+	// special cases
+	switch {
+	case a.Lt(Float256{}) || a.IsNaN():
+		return NewFloat256NaN()
+	case a.IsZero():
+		return NewFloat256Inf(1).Copysign(a)
+	case a.IsInf(1):
+		return Float256{}
+	}
+	return Float256(uvone256).Quo(a.Sqrt())
+}
@@ -0,0 +1,51 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat256_Rsqrt(t *testing.T) {
+	tests := []struct {
+		x    Float256
+		want string
+	}{
+		{exact256(0.5), "1.414213562373095048801688724209698078569671875376948073176679737990732"},
+		{exact256(2), "0.7071067811865475244008443621048490392848359376884740365883398689953662"},
+		{exact256(3), "0.5773502691896257645091487805019574556476017512701268760186023264839777"},
+		{exact256(5), "0.4472135954999579392818347337462552470881236719223051448541794490821042"},
+		{exact256(10), "0.3162277660168379331998893544432718533719555139325216826857504852792594"},
+		{exact256(100), "0.1"},
+		{exact256(1000), "0.03162277660168379331998893544432718533719555139325216826857504852792594"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rsqrt()
+		if !close256(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float256
+		want Float256
+	}{
+		{exact256(1), exact256(1)},
+		{exact256(4), exact256(0.5)},
+		{exact256(0.25), exact256(2)},
+		{exact256(64), exact256(0.125)},
+		{exact256(0), exact256(math.Inf(1))},
+		{exact256(math.Copysign(0, -1)), exact256(math.Inf(-1))},
+		{exact256(math.Inf(1)), exact256(0)},
+		{exact256(-1), exact256(math.NaN())},
+		{exact256(math.Inf(-1)), exact256(math.NaN())},
+		{exact256(math.NaN()), exact256(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rsqrt()
+		if !eq256(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat32_Rsqrt(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.5), 1.4142135623730951},
+		{exact32(2), 0.7071067811865476},
+		{exact32(3), 0.5773502691896257},
+		{exact32(5), 0.4472135954999579},
+		{exact32(10), 0.31622776601683794},
+		{exact32(100), 0.1},
+		{exact32(1000), 0.03162277660168379},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rsqrt()
+		if !close32(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		{exact32(1), exact32(1)},
+		{exact32(4), exact32(0.5)},
+		{exact32(0.25), exact32(2)},
+		{exact32(64), exact32(0.125)},
+		{exact32(0), exact32(math.Inf(1))},
+		{exact32(math.Copysign(0, -1)), exact32(math.Inf(-1))},
+		{exact32(math.Inf(1)), exact32(0)},
+		{exact32(-1), exact32(math.NaN())},
+		{exact32(math.Inf(-1)), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rsqrt()
+		if !eq32(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Rsqrt_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Rsqrt", Float32.Rsqrt, rsqrtRef, -149, 128, 0x1p-149)
+}
@@ -0,0 +1,26 @@
+package floats
+
+import "math"
+
+// Rsqrt returns the reciprocal of the square root of a.
+//
+// Special cases are:
+//
+//	+Inf.Rsqrt() = +0
+//	±0.Rsqrt() = ±Inf
+//	(a < 0).Rsqrt() = NaN
+//	NaN.Rsqrt() = NaN
+func (a Float64) Rsqrt() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x < 0 || math.IsNaN(x):
+		return NewFloat64NaN()
+	case x == 0:
+		return NewFloat64(math.Copysign(math.Inf(1), x))
+	case math.IsInf(x, 1):
+		return 0
+	}
+	return NewDoubleDouble(1).Quo(NewDoubleDouble(x).Sqrt()).Float64()
+}
@@ -0,0 +1,51 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat64_Rsqrt(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.5), 1.4142135623730951},
+		{exact64(2), 0.7071067811865476},
+		{exact64(3), 0.5773502691896257},
+		{exact64(5), 0.4472135954999579},
+		{exact64(10), 0.31622776601683794},
+		{exact64(100), 0.1},
+		{exact64(1000), 0.03162277660168379},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rsqrt()
+		if !close64(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		{exact64(1), exact64(1)},
+		{exact64(4), exact64(0.5)},
+		{exact64(0.25), exact64(2)},
+		{exact64(64), exact64(0.125)},
+		{exact64(0), exact64(math.Inf(1))},
+		{exact64(math.Copysign(0, -1)), exact64(math.Inf(-1))},
+		{exact64(math.Inf(1)), exact64(0)},
+		{exact64(-1), exact64(math.NaN())},
+		{exact64(math.Inf(-1)), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rsqrt()
+		if !eq64(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
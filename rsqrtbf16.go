@@ -0,0 +1,13 @@
+package floats
+
+// Rsqrt returns the reciprocal of the square root of a.
+//
+// Special cases are:
+//
+//	+Inf.Rsqrt() = +0
+//	±0.Rsqrt() = ±Inf
+//	(a < 0).Rsqrt() = NaN
+//	NaN.Rsqrt() = NaN
+func (a BFloat16) Rsqrt() BFloat16 {
+	return NewBFloat16(a.Float64().Rsqrt().BuiltIn())
+}
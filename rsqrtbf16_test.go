@@ -0,0 +1,51 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Rsqrt(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.5), 1.4142135623730951},
+		{exactBF16(2), 0.7071067811865476},
+		{exactBF16(3), 0.5773502691896257},
+		{exactBF16(5), 0.4472135954999579},
+		{exactBF16(10), 0.31622776601683794},
+		{exactBF16(100), 0.1},
+		{exactBF16(1000), 0.03162277660168379},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Rsqrt()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(1), exactBF16(1)},
+		{exactBF16(4), exactBF16(0.5)},
+		{exactBF16(0.25), exactBF16(2)},
+		{exactBF16(64), exactBF16(0.125)},
+		{exactBF16(0), exactBF16(math.Inf(1))},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(1)), exactBF16(0)},
+		{exactBF16(-1), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Rsqrt()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Rsqrt(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
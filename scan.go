@@ -0,0 +1,164 @@
+package floats
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// scanToken reads a floating-point number from state into p.
+// It is the implementation of the Scan methods of [fmt.Scanner].
+func scanToken[T Float[T]](p *T, state fmt.ScanState, verb rune) error {
+	if !strings.ContainsRune("vgGeEfFxX", verb) {
+		return fmt.Errorf("floats: bad verb '%%%c' for %T", verb, *p)
+	}
+
+	state.SkipSpace()
+	if _, _, err := state.ReadRune(); err != nil {
+		return err
+	}
+	if err := state.UnreadRune(); err != nil {
+		return err
+	}
+
+	r := tokenReader{state: state}
+	r.readFloat()
+	ret, err := parseFloat[T](string(r.buf))
+	if err != nil {
+		return err
+	}
+	*p = ret
+	return nil
+}
+
+var _ fmt.Scanner = (*Float16)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads a floating-point number, and rounds it to nearest even.
+//
+// The verbs %v, %g, %G, %e, %E, %f, %F, %x and %X are accepted, and all of them read
+// every syntax that [ParseFloat16] accepts, such as "1e10", "0x1p-2", "Inf" and "NaN".
+// It consumes only the characters that can be part of the number,
+// and the rest of the input is left for the next operand.
+func (a *Float16) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+var _ fmt.Scanner = (*BFloat16)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads the same syntax as [Float16.Scan], and rounds the value to nearest even.
+func (a *BFloat16) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+var _ fmt.Scanner = (*Float32)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads the same syntax as [Float16.Scan], and rounds the value to nearest even.
+func (a *Float32) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+var _ fmt.Scanner = (*Float64)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads the same syntax as [Float16.Scan], and rounds the value to nearest even.
+func (a *Float64) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+var _ fmt.Scanner = (*Float80)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads the same syntax as [Float16.Scan], and rounds the value to nearest even.
+func (a *Float80) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+var _ fmt.Scanner = (*Float128)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads the same syntax as [Float16.Scan], and rounds the value to nearest even.
+func (a *Float128) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+var _ fmt.Scanner = (*Float256)(nil)
+
+// Scan implements [fmt.Scanner].
+// It reads the same syntax as [Float16.Scan], and rounds the value to nearest even.
+func (a *Float256) Scan(state fmt.ScanState, verb rune) error {
+	return scanToken(a, state, verb)
+}
+
+// tokenReader reads a floating-point number from [fmt.ScanState] rune by rune.
+type tokenReader struct {
+	state fmt.ScanState
+	buf   []byte
+}
+
+// accept consumes the next rune if it is an ASCII character that satisfies ok.
+func (r *tokenReader) accept(ok func(c byte) bool) bool {
+	ch, _, err := r.state.ReadRune()
+	if err != nil {
+		return false
+	}
+	if ch < utf8.RuneSelf && ok(byte(ch)) {
+		r.buf = append(r.buf, byte(ch))
+		return true
+	}
+	r.state.UnreadRune()
+	return false
+}
+
+// acceptByte consumes the next rune if it is c, ignoring the case.
+func (r *tokenReader) acceptByte(c byte) bool {
+	return r.accept(func(b byte) bool { return lower(b) == c })
+}
+
+// acceptWord consumes the longest prefix of word, ignoring the case.
+// The word must be all lower-case.
+func (r *tokenReader) acceptWord(word string) {
+	for i := 0; i < len(word) && r.acceptByte(word[i]); i++ {
+	}
+}
+
+// readFloat reads the longest prefix of the input that follows the grammar of readFloat and special.
+// The result may be an incomplete number such as "1e+";
+// it is reported as a syntax error by the parser.
+func (r *tokenReader) readFloat() {
+	sign := r.accept(func(c byte) bool { return c == '+' || c == '-' })
+
+	// inf, infinity and nan
+	if r.acceptByte('i') {
+		r.acceptWord("nfinity")
+		return
+	}
+	if !sign && r.acceptByte('n') {
+		r.acceptWord("an")
+		return
+	}
+
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' || c == '_' }
+	expChar := byte('e')
+	if r.accept(func(c byte) bool { return c == '0' }) && r.acceptByte('x') {
+		isDigit = func(c byte) bool { return '0' <= c && c <= '9' || 'a' <= lower(c) && lower(c) <= 'f' || c == '_' }
+		expChar = 'p'
+	}
+
+	// mantissa
+	for r.accept(isDigit) {
+	}
+	if r.accept(func(c byte) bool { return c == '.' }) {
+		for r.accept(isDigit) {
+		}
+	}
+
+	// exponent
+	if r.acceptByte(expChar) {
+		r.accept(func(c byte) bool { return c == '+' || c == '-' })
+		for r.accept(func(c byte) bool { return '0' <= c && c <= '9' || c == '_' }) {
+		}
+	}
+}
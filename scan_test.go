@@ -0,0 +1,120 @@
+package floats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	var f16 Float16
+	var f32 Float32
+	var f64 Float64
+	var f128 Float128
+	var f256 Float256
+
+	// a table of mixed widths is read by a single Fscan call.
+	r := strings.NewReader("0.1 -2.5e3\n0x1.8p+1\tinf   NaN\n")
+	n, err := fmt.Fscan(r, &f16, &f32, &f64, &f128, &f256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Errorf("Fscan returns %d; want 5", n)
+	}
+	if want := NewFloat16(0.1); f16 != want {
+		t.Errorf("Float16: got %v; want %v", f16, want)
+	}
+	if f32 != -2500 {
+		t.Errorf("Float32: got %v; want %v", f32, -2500)
+	}
+	if f64 != 3 {
+		t.Errorf("Float64: got %v; want %v", f64, 3)
+	}
+	if !f128.IsInf(1) {
+		t.Errorf("Float128: got %v; want +Inf", f128)
+	}
+	if !f256.IsNaN() {
+		t.Errorf("Float256: got %v; want NaN", f256)
+	}
+}
+
+func TestScan_Exact(t *testing.T) {
+	// Float128 and Float256 don't go through float64.
+	var f128 Float128
+	var f256 Float256
+	if _, err := fmt.Sscan("0.1 0.1", &f128, &f256); err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := ParseFloat128("0.1"); !eq128(f128, want) {
+		t.Errorf("Float128: got %v; want %v", f128, want)
+	}
+	if want, _ := ParseFloat256("0.1"); !eq256(f256, want) {
+		t.Errorf("Float256: got %v; want %v", f256, want)
+	}
+}
+
+func TestScan_Verbs(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		want   string
+		rest   string
+	}{
+		{"%v%s", "1.5abc", "1.5", "abc"},
+		{"%g%s", "-0x1p-2,", "-0.25", ","},
+		{"%e%s", "1e+2e", "100", "e"},
+		{"%f%s", "12.5.5", "12.5", ".5"},
+		{"%x%s", "0x1.8p1z", "3", "z"},
+		{"%G %s", "+Infinity x", "+Inf", "x"},
+		{"%3g%s", "12345", "123", "45"},
+		{"%v%s", "0x1_0p0x", "16", "x"},
+	}
+	for _, tt := range tests {
+		var got Float64
+		var rest string
+		if _, err := fmt.Sscanf(tt.input, tt.format, &got, &rest); err != nil {
+			t.Errorf("Sscanf(%q, %q) returns error: %v", tt.input, tt.format, err)
+			continue
+		}
+		want, _ := ParseFloat64(tt.want)
+		if got != want || rest != tt.rest {
+			t.Errorf("Sscanf(%q, %q) = %v, %q; want %v, %q", tt.input, tt.format, got, rest, want, tt.rest)
+		}
+	}
+}
+
+func TestScan_Error(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		err    error
+	}{
+		{"%v", "", io.ErrUnexpectedEOF},
+		{"%v", "   ", io.ErrUnexpectedEOF},
+		{"%v", "abc", strconv.ErrSyntax},
+		{"%v", "1e", strconv.ErrSyntax},
+		{"%v", "0x1", strconv.ErrSyntax},
+		{"%v", "infin", strconv.ErrSyntax},
+		{"%v", "-nan", strconv.ErrSyntax},
+		{"%v", "1e5000", strconv.ErrRange},
+	}
+	for _, tt := range tests {
+		got := NewFloat128(42)
+		_, err := fmt.Sscanf(tt.input, tt.format, &got)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Sscanf(%q, %q) returns %v; want %v", tt.input, tt.format, err, tt.err)
+		}
+		if !got.Eq(NewFloat128(42)) {
+			t.Errorf("Sscanf(%q, %q) modifies the operand: %v", tt.input, tt.format, got)
+		}
+	}
+
+	var f16 Float16
+	if _, err := fmt.Sscanf("1", "%d", &f16); err == nil {
+		t.Errorf("Sscanf with %%d doesn't return error")
+	}
+}
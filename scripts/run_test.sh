@@ -5,6 +5,9 @@ set -eux
 SEED=${GITHUB_RUN_ID:-$(date +%s)}
 echo "$SEED"
 
+# near_even, near_maxMag, minMag, min, or max
+ROUNDING_MODE=${ROUNDING_MODE:-near_even}
+
 # shellcheck disable=SC2206
 TEST_NAMES=(${1//,/ })
 
@@ -13,10 +16,10 @@ cd "$ROOT"
 
 for TEST_NAME in "${TEST_NAMES[@]}"; do
   if [[ $TEST_NAME =~ _to_u?i64$ ]]; then
-    "$ROOT/bin/testfloat_gen" -level 2 -seed "$SEED" -rminMag "$TEST_NAME" | go run ./internal/cmd/float_test "$TEST_NAME"
+    "$ROOT/bin/testfloat_gen" -level 2 -seed "$SEED" -exact "-r$ROUNDING_MODE" "$TEST_NAME" | go run ./internal/cmd/float_test "$TEST_NAME" "$ROUNDING_MODE"
   elif [[ $TEST_NAME =~ ^f(16|32|64|128)_to_f(16|32|64|128)$ ]]; then
-    "$ROOT/bin/testfloat_gen" -level 2 -seed "$SEED" "$TEST_NAME" | go run ./internal/cmd/float_test "$TEST_NAME"
+    "$ROOT/bin/testfloat_gen" -level 2 -seed "$SEED" -tininessafter "-r$ROUNDING_MODE" "$TEST_NAME" | go run ./internal/cmd/float_test "$TEST_NAME" "$ROUNDING_MODE"
   else
-    "$ROOT/bin/testfloat_gen" -seed "$SEED" "$TEST_NAME" | go run ./internal/cmd/float_test "$TEST_NAME"
+    "$ROOT/bin/testfloat_gen" -seed "$SEED" -tininessafter "-r$ROUNDING_MODE" "$TEST_NAME" | go run ./internal/cmd/float_test "$TEST_NAME" "$ROUNDING_MODE"
   fi
 done
@@ -10,7 +10,10 @@ import "math"
 //	±Inf.Sin() = NaN
 //	NaN.Sin() = NaN
 func (a Float16) Sin() Float16 {
-	return NewFloat16(math.Sin(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Sin(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Sin().Float16()
 }
 
 // Cos returns the cosine of the radian argument a.
@@ -20,7 +23,10 @@ func (a Float16) Sin() Float16 {
 //	±Inf.Cos() = NaN
 //	NaN.Cos() = NaN
 func (a Float16) Cos() Float16 {
-	return NewFloat16(math.Cos(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Cos(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Cos().Float16()
 }
 
 // Sincos returns Sin(a), Cos(a).
@@ -32,7 +38,13 @@ func (a Float16) Cos() Float16 {
 //	NaN.Sincos() = NaN, NaN
 func (a Float16) Sincos() (sin, cos Float16) {
 	s, c := math.Sincos(a.Float64().BuiltIn())
-	return NewFloat16(s), NewFloat16(c)
+	sin, ok1 := roundFloat16(s)
+	cos, ok2 := roundFloat16(c)
+	if ok1 && ok2 {
+		return sin, cos
+	}
+	s256, c256 := a.Float256().Sincos()
+	return s256.Float16(), c256.Float16()
 }
 
 // Tan returns the tangent of the radian argument a.
@@ -43,5 +55,8 @@ func (a Float16) Sincos() (sin, cos Float16) {
 //	±Inf.Tan() = NaN
 //	NaN.Tan() = NaN
 func (a Float16) Tan() Float16 {
-	return NewFloat16(math.Tan(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat16(math.Tan(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Tan().Float16()
 }
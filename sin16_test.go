@@ -155,3 +155,21 @@ func TestFloat16_Tan(t *testing.T) {
 		}
 	}
 }
+
+func TestFloat16_Sin_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Sin", Float16.Sin, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Sin()
+	})
+}
+
+func TestFloat16_Cos_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Cos", Float16.Cos, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Cos()
+	})
+}
+
+func TestFloat16_Tan_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded16(t, "Tan", Float16.Tan, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Tan()
+	})
+}
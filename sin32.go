@@ -12,7 +12,10 @@ import (
 //	±Inf.Sin() = NaN
 //	NaN.Sin() = NaN
 func (a Float32) Sin() Float32 {
-	return NewFloat32(math.Sin(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat32(math.Sin(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Sin().Float32()
 }
 
 // Cos returns the cosine of the radian argument a.
@@ -22,7 +25,10 @@ func (a Float32) Sin() Float32 {
 //	±Inf.Cos() = NaN
 //	NaN.Cos() = NaN
 func (a Float32) Cos() Float32 {
-	return NewFloat32(math.Cos(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat32(math.Cos(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Cos().Float32()
 }
 
 // Sincos returns Sin(a), Cos(a).
@@ -34,7 +40,13 @@ func (a Float32) Cos() Float32 {
 //	NaN.Sincos() = NaN, NaN
 func (a Float32) Sincos() (sin, cos Float32) {
 	s, c := math.Sincos(a.Float64().BuiltIn())
-	return NewFloat32(s), NewFloat32(c)
+	sin, ok1 := roundFloat32(s)
+	cos, ok2 := roundFloat32(c)
+	if ok1 && ok2 {
+		return sin, cos
+	}
+	s256, c256 := a.Float256().Sincos()
+	return s256.Float32(), c256.Float32()
 }
 
 // Tan returns the tangent of the radian argument a.
@@ -45,5 +57,8 @@ func (a Float32) Sincos() (sin, cos Float32) {
 //	±Inf.Tan() = NaN
 //	NaN.Tan() = NaN
 func (a Float32) Tan() Float32 {
-	return NewFloat32(math.Tan(a.Float64().BuiltIn()))
+	if ret, ok := roundFloat32(math.Tan(a.Float64().BuiltIn())); ok {
+		return ret
+	}
+	return a.Float256().Tan().Float32()
 }
@@ -2,6 +2,7 @@ package floats
 
 import (
 	"math"
+	"runtime"
 	"testing"
 )
 
@@ -45,6 +46,13 @@ func TestFloat32_Sin(t *testing.T) {
 	}
 }
 
+func BenchmarkFloat32_Sin(b *testing.B) {
+	x := exact32(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Sin())
+	}
+}
+
 func TestFloat32_Cos(t *testing.T) {
 	tests := []struct {
 		x    Float32
@@ -79,6 +87,13 @@ func TestFloat32_Cos(t *testing.T) {
 	}
 }
 
+func BenchmarkFloat32_Cos(b *testing.B) {
+	x := exact32(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Cos())
+	}
+}
+
 func TestFloat32_Sincos(t *testing.T) {
 	tests := []struct {
 		x   Float32
@@ -159,3 +174,39 @@ func TestFloat32_Tan(t *testing.T) {
 		}
 	}
 }
+
+func BenchmarkFloat32_Tan(b *testing.B) {
+	x := exact32(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Tan())
+	}
+}
+
+// float32NearPiOver2 returns the Float32 values nearest to the multiples of π/2,
+// where the argument reduction loses accuracy.
+func float32NearPiOver2() []float32 {
+	var ret []float32
+	for k := 1; k < 2000; k++ {
+		x := float32(float64(k) * (math.Pi / 2))
+		ret = append(ret, x, math.Nextafter32(x, 0), math.Nextafter32(x, math.MaxFloat32))
+	}
+	return ret
+}
+
+func TestFloat32_Sin_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Sin", Float32.Sin, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Sin()
+	}, -30, 30, float32NearPiOver2()...)
+}
+
+func TestFloat32_Cos_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Cos", Float32.Cos, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Cos()
+	}, -30, 30, float32NearPiOver2()...)
+}
+
+func TestFloat32_Tan_CorrectlyRounded(t *testing.T) {
+	testCorrectlyRounded32(t, "Tan", Float32.Tan, func(x float64) DoubleDouble {
+		return NewDoubleDouble(x).Tan()
+	}, -30, 30, float32NearPiOver2()...)
+}
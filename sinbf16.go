@@ -0,0 +1,47 @@
+package floats
+
+import "math"
+
+// Sin returns the sine of the radian argument a.
+//
+// Special cases are:
+//
+//	±0.Sin() = ±0
+//	±Inf.Sin() = NaN
+//	NaN.Sin() = NaN
+func (a BFloat16) Sin() BFloat16 {
+	return NewBFloat16(math.Sin(a.Float64().BuiltIn()))
+}
+
+// Cos returns the cosine of the radian argument a.
+//
+// Special cases are:
+//
+//	±Inf.Cos() = NaN
+//	NaN.Cos() = NaN
+func (a BFloat16) Cos() BFloat16 {
+	return NewBFloat16(math.Cos(a.Float64().BuiltIn()))
+}
+
+// Sincos returns Sin(a), Cos(a).
+//
+// Special cases are:
+//
+//	±0.Sincos() = ±0, 1
+//	±Inf.Sincos() = NaN, NaN
+//	NaN.Sincos() = NaN, NaN
+func (a BFloat16) Sincos() (sin, cos BFloat16) {
+	s, c := math.Sincos(a.Float64().BuiltIn())
+	return NewBFloat16(s), NewBFloat16(c)
+}
+
+// Tan returns the tangent of the radian argument a.
+//
+// Special cases are:
+//
+//	±0.Tan() = ±0
+//	±Inf.Tan() = NaN
+//	NaN.Tan() = NaN
+func (a BFloat16) Tan() BFloat16 {
+	return NewBFloat16(math.Tan(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,157 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Sin(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Sin(1)},
+		{exactBF16(2), math.Sin(2)},
+		{exactBF16(3), math.Sin(3)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sin()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Sin(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+	for _, tt := range strictTests {
+		got := tt.x.Sin()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Sin(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Cos(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Cos(1)},
+		{exactBF16(2), math.Cos(2)},
+		{exactBF16(3), math.Cos(3)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cos()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Cos(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+	for _, tt := range strictTests {
+		got := tt.x.Cos()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Cos(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Sincos(t *testing.T) {
+	tests := []struct {
+		x   BFloat16
+		sin float64
+		cos float64
+	}{
+		{exactBF16(1), math.Sin(1), math.Cos(1)},
+		{exactBF16(2), math.Sin(2), math.Cos(2)},
+		{exactBF16(3), math.Sin(3), math.Cos(3)},
+	}
+
+	for _, tt := range tests {
+		sin, cos := tt.x.Sincos()
+		if !closeBF16(sin, tt.sin) {
+			t.Errorf("Sincos(%v) sin = %v; want %v", tt.x, sin, tt.sin)
+		}
+		if !closeBF16(cos, tt.cos) {
+			t.Errorf("Sincos(%v) cos = %v; want %v", tt.x, cos, tt.cos)
+		}
+	}
+
+	strictTests := []struct {
+		x   BFloat16
+		sin BFloat16
+		cos BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0), exactBF16(1)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN()), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		sin, cos := tt.x.Sincos()
+		if !eqBF16(sin, tt.sin) {
+			t.Errorf("Sincos(%v) sin = %v; want %v", tt.x, sin, tt.sin)
+		}
+		if !eqBF16(cos, tt.cos) {
+			t.Errorf("Sincos(%v) cos = %v; want %v", tt.x, cos, tt.cos)
+		}
+	}
+}
+
+func TestBFloat16_Tan(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(1), math.Tan(1)},
+		{exactBF16(2), math.Tan(2)},
+		{exactBF16(3), math.Tan(3)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tan()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Tan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+	for _, tt := range strictTests {
+		got := tt.x.Tan()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Tan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
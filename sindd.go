@@ -0,0 +1,99 @@
+package floats
+
+import "math"
+
+var (
+	// piDD = Pi ~ 3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798
+	piDD = DoubleDouble{0x1.921fb54442d18p+1, 0x1.1a62633145c07p-53}
+)
+
+// Sin returns the sine of the radian argument a.
+//
+// Special cases are:
+//
+//	±0.Sin() = ±0
+//	±Inf.Sin() = NaN
+//	NaN.Sin() = NaN
+func (a DoubleDouble) Sin() DoubleDouble {
+	sin, _ := a.Sincos()
+	return sin
+}
+
+// Cos returns the cosine of the radian argument a.
+//
+// Special cases are:
+//
+//	±Inf.Cos() = NaN
+//	NaN.Cos() = NaN
+func (a DoubleDouble) Cos() DoubleDouble {
+	_, cos := a.Sincos()
+	return cos
+}
+
+// Tan returns the tangent of the radian argument a.
+//
+// Special cases are:
+//
+//	±0.Tan() = ±0
+//	±Inf.Tan() = NaN
+//	NaN.Tan() = NaN
+func (a DoubleDouble) Tan() DoubleDouble {
+	sin, cos := a.Sincos()
+	return sin.Quo(cos)
+}
+
+// Sincos returns Sin(a), Cos(a).
+//
+// Special cases are:
+//
+//	±0.Sincos() = ±0, 1
+//	±Inf.Sincos() = NaN, NaN
+//	NaN.Sincos() = NaN, NaN
+func (a DoubleDouble) Sincos() (sin, cos DoubleDouble) {
+	// special cases
+	switch {
+	case a.IsZero():
+		return a, NewDoubleDouble(1)
+	case a.IsNaN() || a.IsInf(0):
+		return NewDoubleDoubleNaN(), NewDoubleDoubleNaN()
+	}
+
+	// reduce; a = k*(Pi/2) + r, |r| <= Pi/4
+	// Pi/2 in QuadDouble precision keeps r accurate for large k.
+	k := math.Round(a[0] / (piDD[0] / 2))
+	r := a.QuadDouble().Sub(piQD.ldexp(-1).mulFloat64(k))
+	sin, cos = sincosDD(DoubleDouble{r[0], r[1]})
+
+	j := int(math.Mod(k, 4))
+	if j < 0 {
+		j += 4
+	}
+	switch j {
+	case 1:
+		sin, cos = cos, sin.Neg()
+	case 2:
+		sin, cos = sin.Neg(), cos.Neg()
+	case 3:
+		sin, cos = cos.Neg(), sin
+	}
+	return
+}
+
+// sincosDD returns sin(r) and cos(r) for |r| <= Pi/4.
+func sincosDD(r DoubleDouble) (sin, cos DoubleDouble) {
+	// sin(r) = r - r³/3! + r⁵/5! - ...
+	// cos(r) = 1 - r²/2! + r⁴/4! - ...
+	r2 := r.Mul(r).Neg()
+	sin, cos = r, NewDoubleDouble(1)
+	s, c := r, NewDoubleDouble(1)
+	for n := 1.0; n < 60; n += 2 {
+		c = c.Mul(r2).Quo(NewDoubleDouble(n * (n + 1)))
+		s = s.Mul(r2).Quo(NewDoubleDouble((n + 1) * (n + 2)))
+		cos = cos.Add(c)
+		sin = sin.Add(s)
+		if math.Abs(c[0]) <= 0x1p-110 {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,151 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestBFloat16_Sinh(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Sinh(0)},
+		{exactBF16(0.5), math.Sinh(0.5)},
+		{exactBF16(1), math.Sinh(1)},
+
+		{exactBF16(-0), -math.Sinh(0)},
+		{exactBF16(-0.5), -math.Sinh(0.5)},
+		{exactBF16(-1), -math.Sinh(1)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sinh()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Sinh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(90), exactBF16(math.Inf(1))},
+
+		// special cases
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(-1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sinh()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Sinh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Sinh(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Sinh())
+	}
+}
+
+func TestBFloat16_Cosh(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Cosh(0)},
+		{exactBF16(1), math.Cosh(1)},
+		{exactBF16(2), math.Cosh(2)},
+		{exactBF16(3), math.Cosh(3)},
+		{exactBF16(4), math.Cosh(4)},
+		{exactBF16(11), math.Cosh(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cosh()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Cosh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(1)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(math.Inf(1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.Inf(-1)), exactBF16(math.Inf(1))},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cosh()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Cosh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Cosh(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Cosh())
+	}
+}
+
+func TestBFloat16_Tanh(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0), math.Tanh(0)},
+		{exactBF16(1), math.Tanh(1)},
+		{exactBF16(2), math.Tanh(2)},
+		{exactBF16(3), math.Tanh(3)},
+		{exactBF16(4), math.Tanh(4)},
+		{exactBF16(11), math.Tanh(11)},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tanh()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Tanh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(1)},
+		{exactBF16(math.Inf(-1)), exactBF16(-1)},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tanh()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Tanh(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkBFloat16_Tanh(b *testing.B) {
+	x := exactBF16(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Tanh())
+	}
+}
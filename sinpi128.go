@@ -0,0 +1,43 @@
+package floats
+
+// Sinpi returns sin(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	±0.Sinpi() = ±0
+//	n.Sinpi() = +0 for positive integers n
+//	n.Sinpi() = -0 for negative integers n
+//	±Inf.Sinpi() = NaN
+//	NaN.Sinpi() = NaN
+func (a Float128) Sinpi() Float128 {
+	return a.Float256().Sinpi().Float128()
+}
+
+// Cospi returns cos(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	(n+0.5).Cospi() = +0 for integers n
+//	±Inf.Cospi() = NaN
+//	NaN.Cospi() = NaN
+func (a Float128) Cospi() Float128 {
+	return a.Float256().Cospi().Float128()
+}
+
+// Tanpi returns tan(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	±0.Tanpi() = ±0
+//	n.Tanpi() = +0 for positive even and negative odd integers n
+//	n.Tanpi() = -0 for positive odd and negative even integers n
+//	(n+0.5).Tanpi() = +Inf for even integers n
+//	(n+0.5).Tanpi() = -Inf for odd integers n
+//	±Inf.Tanpi() = NaN
+//	NaN.Tanpi() = NaN
+func (a Float128) Tanpi() Float128 {
+	return a.Float256().Tanpi().Float128()
+}
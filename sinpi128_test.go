@@ -0,0 +1,172 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat128_Sinpi(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.125), "0.38268343236508977172845998403039886676134456248562704143380063562754603396008969"},
+		{exact128(0.375), "0.92387953251128675612818318939678828682241662586364248611509773128053500750110236"},
+		{exact128(-0.625), "-0.92387953251128675612818318939678828682241662586364248611509773128053500750110236"},
+		{exact128(1.75), "-0.70710678118654752440084436210484903928483593768847403658833986899536623923105352"},
+		{exact128(0.1), "0.30901699437494744068809373224131520439356608184890738665525786730157665419809707"},
+		{exact128(2.3), "0.80901699437494709608346700678701101050944428230785659800132582473974541994090321"},
+		{exact128(0x1p-30), "2.9258361585343193579282304690689559020175857150074409101526900881788181761210675E-9"},
+		{exact128(100.2), "0.58778525229248035283562188167016809743263527328547210218965741998896298490219345"},
+		{exact128(0x1p50 + 0.25), "0.70710678118654752440084436210484903928483593768847403658833986899536623923105352"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sinpi()
+		if !close128(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(0)},
+		{exact128(-1), exact128(math.Copysign(0, -1))},
+		{exact128(2), exact128(0)},
+		{exact128(-3), exact128(math.Copysign(0, -1))},
+		{exact128(0.5), exact128(1)},
+		{exact128(-0.5), exact128(-1)},
+		{exact128(1.5), exact128(-1)},
+		{exact128(1e300), exact128(0)},
+		{exact128(-1e300), exact128(math.Copysign(0, -1))},
+		{exact128(math.Inf(1)), exact128(math.NaN())},
+		{exact128(math.Inf(-1)), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sinpi()
+		if !eq128(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Cospi(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.125), "0.92387953251128675612818318939678828682241662586364248611509773128053500750110236"},
+		{exact128(0.375), "0.38268343236508977172845998403039886676134456248562704143380063562754603396008969"},
+		{exact128(-0.625), "-0.38268343236508977172845998403039886676134456248562704143380063562754603396008969"},
+		{exact128(1.75), "0.70710678118654752440084436210484903928483593768847403658833986899536623923105352"},
+		{exact128(0.1), "0.95105651629515356672738613323093337595568836344662852025820629949519236172168221"},
+		{exact128(2.3), "0.58778525229247358064788820007988913048750756798016714885567519689240956420136482"},
+		{exact128(0x1p-30), "0.99999999999999999571974138670656861135118383817602588112620695626271448298922879"},
+		{exact128(100.2), "0.80901699437494217580107085081965604491738676755022495817052120688178687022482487"},
+		{exact128(0x1p50 + 0.25), "0.70710678118654752440084436210484903928483593768847403658833986899536623923105352"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cospi()
+		if !close128(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(0), exact128(1)},
+		{exact128(math.Copysign(0, -1)), exact128(1)},
+		{exact128(0.5), exact128(0)},
+		{exact128(-0.5), exact128(0)},
+		{exact128(1.5), exact128(0)},
+		{exact128(1), exact128(-1)},
+		{exact128(2), exact128(1)},
+		{exact128(1e300), exact128(1)},
+		{exact128(math.Inf(1)), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cospi()
+		if !eq128(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_Tanpi(t *testing.T) {
+	tests := []struct {
+		x    Float128
+		want string
+	}{
+		{exact128(0.125), "0.41421356237309504880168872420969807856967187537694807317667973799073247846210704"},
+		{exact128(0.375), "2.4142135623730950488016887242096980785696718753769480731766797379907324784621070"},
+		{exact128(-0.625), "2.4142135623730950488016887242096980785696718753769480731766797379907324784621070"},
+		{exact128(0.1), "0.32491969623290634543633427604667197351571823785245153636857330635772191434040040"},
+		{exact128(0.49), "31.820515953773929758439561977965755948428726419532071124422053774775067335554395"},
+		{exact128(2.3), "1.3763819204711719229461824989969094763901343428060842647231226097928775707078311"},
+		{exact128(0x1p-30), "2.9258361585343193704515658877208420104432814702744927815538814511076499401022233E-9"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tanpi()
+		if !close128(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float128
+		want Float128
+	}{
+		// special cases
+		{exact128(0), exact128(0)},
+		{exact128(math.Copysign(0, -1)), exact128(math.Copysign(0, -1))},
+		{exact128(1), exact128(math.Copysign(0, -1))},
+		{exact128(-1), exact128(0)},
+		{exact128(2), exact128(0)},
+		{exact128(-2), exact128(math.Copysign(0, -1))},
+		{exact128(0.25), exact128(1)},
+		{exact128(-0.25), exact128(-1)},
+		{exact128(0.75), exact128(-1)},
+		{exact128(0.5), exact128(math.Inf(1))},
+		{exact128(1.5), exact128(math.Inf(-1))},
+		{exact128(-0.5), exact128(math.Inf(-1))},
+		{exact128(math.Inf(1)), exact128(math.NaN())},
+		{exact128(math.NaN()), exact128(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tanpi()
+		if !eq128(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat128_SinpiLargeArgument(t *testing.T) {
+	large := exact128(0x1p100)
+	for _, f := range []float64{0.125, 0.375, -0.25, 1.75} {
+		x := large.Add(exact128(f))
+		if got, want := x.Sinpi(), exact128(f).Sinpi(); !eq128(got, want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Cospi(), exact128(f).Cospi(); !eq128(got, want) {
+			t.Errorf("Cospi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Tanpi(), exact128(f).Tanpi(); !eq128(got, want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat16_Sinpi(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.125), 0.3826834323650898},
+		{exact16(0.375), 0.9238795325112867},
+		{exact16(-0.625), -0.9238795325112867},
+		{exact16(1.75), -0.7071067811865476},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sinpi()
+		if !close16(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(0)},
+		{exact16(-1), exact16(math.Copysign(0, -1))},
+		{exact16(2), exact16(0)},
+		{exact16(-3), exact16(math.Copysign(0, -1))},
+		{exact16(0.5), exact16(1)},
+		{exact16(-0.5), exact16(-1)},
+		{exact16(1.5), exact16(-1)},
+		{exact16(65504), exact16(0)},
+		{exact16(-65504), exact16(math.Copysign(0, -1))},
+		{exact16(math.Inf(1)), exact16(math.NaN())},
+		{exact16(math.Inf(-1)), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sinpi()
+		if !eq16(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Cospi(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.125), 0.9238795325112867},
+		{exact16(0.375), 0.3826834323650898},
+		{exact16(-0.625), -0.3826834323650898},
+		{exact16(1.75), 0.7071067811865476},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cospi()
+		if !close16(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(0), exact16(1)},
+		{exact16(math.Copysign(0, -1)), exact16(1)},
+		{exact16(0.5), exact16(0)},
+		{exact16(-0.5), exact16(0)},
+		{exact16(1.5), exact16(0)},
+		{exact16(1), exact16(-1)},
+		{exact16(2), exact16(1)},
+		{exact16(65504), exact16(1)},
+		{exact16(math.Inf(1)), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cospi()
+		if !eq16(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Tanpi(t *testing.T) {
+	tests := []struct {
+		x    Float16
+		want float64
+	}{
+		{exact16(0.125), 0.41421356237309503},
+		{exact16(0.375), 2.414213562373095},
+		{exact16(-0.625), 2.414213562373095},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tanpi()
+		if !close16(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float16
+		want Float16
+	}{
+		// special cases
+		{exact16(0), exact16(0)},
+		{exact16(math.Copysign(0, -1)), exact16(math.Copysign(0, -1))},
+		{exact16(1), exact16(math.Copysign(0, -1))},
+		{exact16(-1), exact16(0)},
+		{exact16(2), exact16(0)},
+		{exact16(-2), exact16(math.Copysign(0, -1))},
+		{exact16(0.25), exact16(1)},
+		{exact16(-0.25), exact16(-1)},
+		{exact16(0.75), exact16(-1)},
+		{exact16(0.5), exact16(math.Inf(1))},
+		{exact16(1.5), exact16(math.Inf(-1))},
+		{exact16(-0.5), exact16(math.Inf(-1))},
+		{exact16(math.Inf(1)), exact16(math.NaN())},
+		{exact16(math.NaN()), exact16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tanpi()
+		if !eq16(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat16_Sinpi_CorrectlyRounded(t *testing.T) {
+	for i := 0; i < 1<<16; i += 31 {
+		x := NewFloat16FromBits(uint16(i))
+		if x.IsNaN() || x.IsInf(0) {
+			continue
+		}
+		x256 := x.Float256()
+		if got, want := x.Sinpi(), x256.Sinpi().Float16(); !eq16(got, want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Cospi(), x256.Cospi().Float16(); !eq16(got, want) {
+			t.Errorf("Cospi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Tanpi(), x256.Tanpi().Float16(); !eq16(got, want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
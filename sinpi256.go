@@ -0,0 +1,179 @@
+package floats
+
+// Sinpi returns sin(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	±0.Sinpi() = ±0
+//	n.Sinpi() = +0 for positive integers n
+//	n.Sinpi() = -0 for negative integers n
+//	±Inf.Sinpi() = NaN
+//	NaN.Sinpi() = NaN
+func (a Float256) Sinpi() Float256 {
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(0):
+		return NewFloat256NaN()
+	}
+
+	n, f := reducePi256(a)
+	sin, cos := sincospi256(f)
+	var y Float256
+	switch n {
+	case 0:
+		y = sin
+	case 1:
+		y = cos
+	case 2:
+		y = sin.Neg()
+	case 3:
+		y = cos.Neg()
+	}
+	if y.IsZero() {
+		return Float256{}.Copysign(a)
+	}
+	return y
+}
+
+// Cospi returns cos(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	(n+0.5).Cospi() = +0 for integers n
+//	±Inf.Cospi() = NaN
+//	NaN.Cospi() = NaN
+func (a Float256) Cospi() Float256 {
+	// special cases
+	switch {
+	case a.IsNaN() || a.IsInf(0):
+		return NewFloat256NaN()
+	}
+
+	n, f := reducePi256(a)
+	sin, cos := sincospi256(f)
+	var y Float256
+	switch n {
+	case 0:
+		y = cos
+	case 1:
+		y = sin.Neg()
+	case 2:
+		y = cos.Neg()
+	case 3:
+		y = sin
+	}
+	if y.IsZero() {
+		return Float256{}
+	}
+	return y
+}
+
+// Tanpi returns tan(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	±0.Tanpi() = ±0
+//	n.Tanpi() = +0 for positive even and negative odd integers n
+//	n.Tanpi() = -0 for positive odd and negative even integers n
+//	(n+0.5).Tanpi() = +Inf for even integers n
+//	(n+0.5).Tanpi() = -Inf for odd integers n
+//	±Inf.Tanpi() = NaN
+//	NaN.Tanpi() = NaN
+func (a Float256) Tanpi() Float256 {
+	var (
+		// Half = 0.5
+		Half = Float256{0x3fff_e000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}
+
+		// Quarter = 0.25
+		Quarter = Float256{0x3fff_d000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}
+	)
+
+	// special cases
+	switch {
+	case a.IsZero():
+		return a
+	case a.IsNaN() || a.IsInf(0):
+		return NewFloat256NaN()
+	}
+
+	// reduce; a = k + f, |f| <= 1/2
+	k := a.Round()
+	f := a.Sub(k)
+	odd := isOddInt256(k)
+	switch {
+	case f.IsZero():
+		if a.Signbit() != odd {
+			return Float256{}.Neg()
+		}
+		return Float256{}
+	case f.Abs().Eq(Half):
+		// a = m + 1/2, where m = k or k-1
+		if odd == f.Gt(Float256{}) {
+			return NewFloat256Inf(-1)
+		}
+		return NewFloat256Inf(1)
+	}
+
+	// compute; tan(Pi×f) = 1/tan(Pi×(1/2-f))
+	neg := f.Signbit()
+	f = f.Abs()
+	var y Float256
+	if f.Le(Quarter) {
+		sin, cos := sincospi256(f)
+		y = sin.Quo(cos)
+	} else {
+		sin, cos := sincospi256(Half.Sub(f))
+		y = cos.Quo(sin)
+	}
+	if neg {
+		y = y.Neg()
+	}
+	return y
+}
+
+// reducePi256 returns n in [0, 3] and f in [-1/4, 1/4] such that a = 2k + n/2 + f for some integer k.
+// The reduction is exact.
+func reducePi256(a Float256) (n int, f Float256) {
+	// r = a - 2×round(a/2) is in [-1, 1]
+	r := a.Sub(a.Ldexp(-1).Round().Ldexp(1))
+	q := r.Ldexp(1).Round()
+	f = r.Sub(q.Ldexp(-1))
+	n = int(q.Int64()) & 3
+	return
+}
+
+// sincospi256 returns sin(Pi×f) and cos(Pi×f) for |f| <= 1/4.
+func sincospi256(f Float256) (sin, cos Float256) {
+	var (
+		One = Float256(uvone256)
+
+		// Pi = Pi
+		Pi = Float256{
+			0x4000_0921_fb54_442d, 0x1846_9898_cc51_701b,
+			0x839a_2520_49c1_114c, 0xf98e_8041_77d4_c762,
+		}
+
+		// Eps = 2**-240
+		Eps = Float256{0x3ff0_f000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000, 0x0000_0000_0000_0000}
+	)
+
+	// sin(z) = z - z³/3! + z⁵/5! - ...
+	// cos(z) = 1 - z²/2! + z⁴/4! - ...
+	z := Pi.Mul(f)
+	z2 := z.Mul(z).Neg()
+	sin, cos = z, One
+	s, c := z, One
+	for n := 1; ; n += 2 {
+		c = c.Mul(z2).Quo(NewFloat256(float64(n * (n + 1))))
+		s = s.Mul(z2).Quo(NewFloat256(float64((n + 1) * (n + 2))))
+		cos = cos.Add(c)
+		sin = sin.Add(s)
+		if c.Abs().Lt(Eps) {
+			break
+		}
+	}
+	return
+}
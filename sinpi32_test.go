@@ -0,0 +1,163 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat32_Sinpi(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.125), 0.3826834323650898},
+		{exact32(0.375), 0.9238795325112867},
+		{exact32(-0.625), -0.9238795325112867},
+		{exact32(1.75), -0.7071067811865476},
+		{exact32(0x1p-30), 2.9258361585343192e-09},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sinpi()
+		if !close32(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(0)},
+		{exact32(-1), exact32(math.Copysign(0, -1))},
+		{exact32(2), exact32(0)},
+		{exact32(-3), exact32(math.Copysign(0, -1))},
+		{exact32(0.5), exact32(1)},
+		{exact32(-0.5), exact32(-1)},
+		{exact32(1.5), exact32(-1)},
+		{exact32(0x1p100), exact32(0)},
+		{exact32(-0x1p100), exact32(math.Copysign(0, -1))},
+		{exact32(math.Inf(1)), exact32(math.NaN())},
+		{exact32(math.Inf(-1)), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sinpi()
+		if !eq32(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Cospi(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.125), 0.9238795325112867},
+		{exact32(0.375), 0.3826834323650898},
+		{exact32(-0.625), -0.3826834323650898},
+		{exact32(1.75), 0.7071067811865476},
+		{exact32(0x1p-30), 1.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cospi()
+		if !close32(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(0), exact32(1)},
+		{exact32(math.Copysign(0, -1)), exact32(1)},
+		{exact32(0.5), exact32(0)},
+		{exact32(-0.5), exact32(0)},
+		{exact32(1.5), exact32(0)},
+		{exact32(1), exact32(-1)},
+		{exact32(2), exact32(1)},
+		{exact32(0x1p100), exact32(1)},
+		{exact32(math.Inf(1)), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cospi()
+		if !eq32(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Tanpi(t *testing.T) {
+	tests := []struct {
+		x    Float32
+		want float64
+	}{
+		{exact32(0.125), 0.41421356237309503},
+		{exact32(0.375), 2.414213562373095},
+		{exact32(-0.625), 2.414213562373095},
+		{exact32(0x1p-30), 2.9258361585343192e-09},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tanpi()
+		if !close32(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float32
+		want Float32
+	}{
+		// special cases
+		{exact32(0), exact32(0)},
+		{exact32(math.Copysign(0, -1)), exact32(math.Copysign(0, -1))},
+		{exact32(1), exact32(math.Copysign(0, -1))},
+		{exact32(-1), exact32(0)},
+		{exact32(2), exact32(0)},
+		{exact32(-2), exact32(math.Copysign(0, -1))},
+		{exact32(0.25), exact32(1)},
+		{exact32(-0.25), exact32(-1)},
+		{exact32(0.75), exact32(-1)},
+		{exact32(0.5), exact32(math.Inf(1))},
+		{exact32(1.5), exact32(math.Inf(-1))},
+		{exact32(-0.5), exact32(math.Inf(-1))},
+		{exact32(math.Inf(1)), exact32(math.NaN())},
+		{exact32(math.NaN()), exact32(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tanpi()
+		if !eq32(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat32_Sinpi_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(16, 32))
+	for range 500 {
+		x := NewFloat32(math.Ldexp(r.Float64()-0.5, r.IntN(60)-30))
+		x256 := x.Float256()
+		if got, want := x.Sinpi(), x256.Sinpi().Float32(); !eq32(got, want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Cospi(), x256.Cospi().Float32(); !eq32(got, want) {
+			t.Errorf("Cospi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Tanpi(), x256.Tanpi().Float32(); !eq32(got, want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
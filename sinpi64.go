@@ -0,0 +1,144 @@
+package floats
+
+import "math"
+
+// Sinpi returns sin(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	±0.Sinpi() = ±0
+//	n.Sinpi() = +0 for positive integers n
+//	n.Sinpi() = -0 for negative integers n
+//	±Inf.Sinpi() = NaN
+//	NaN.Sinpi() = NaN
+func (a Float64) Sinpi() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return NewFloat64NaN()
+	}
+
+	n, f := reducePi64(x)
+	sin, cos := sincosDD(piDD.mulFloat64(f))
+	var y DoubleDouble
+	switch n {
+	case 0:
+		y = sin
+	case 1:
+		y = cos
+	case 2:
+		y = sin.Neg()
+	case 3:
+		y = cos.Neg()
+	}
+	if y.IsZero() {
+		return Float64(math.Copysign(0, x))
+	}
+	return y.Float64()
+}
+
+// Cospi returns cos(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	(n+0.5).Cospi() = +0 for integers n
+//	±Inf.Cospi() = NaN
+//	NaN.Cospi() = NaN
+func (a Float64) Cospi() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return NewFloat64NaN()
+	}
+
+	n, f := reducePi64(x)
+	sin, cos := sincosDD(piDD.mulFloat64(f))
+	var y DoubleDouble
+	switch n {
+	case 0:
+		y = cos
+	case 1:
+		y = sin.Neg()
+	case 2:
+		y = cos.Neg()
+	case 3:
+		y = sin
+	}
+	if y.IsZero() {
+		return 0
+	}
+	return y.Float64()
+}
+
+// Tanpi returns tan(Pi×a).
+// The argument reduction is exact, so it is accurate even for large a.
+//
+// Special cases are:
+//
+//	±0.Tanpi() = ±0
+//	n.Tanpi() = +0 for positive even and negative odd integers n
+//	n.Tanpi() = -0 for positive odd and negative even integers n
+//	(n+0.5).Tanpi() = +Inf for even integers n
+//	(n+0.5).Tanpi() = -Inf for odd integers n
+//	±Inf.Tanpi() = NaN
+//	NaN.Tanpi() = NaN
+func (a Float64) Tanpi() Float64 {
+	x := a.BuiltIn()
+
+	// special cases
+	switch {
+	case x == 0:
+		return a
+	case math.IsNaN(x) || math.IsInf(x, 0):
+		return NewFloat64NaN()
+	}
+
+	// reduce; x = k + f, |f| <= 1/2
+	k := math.Round(x)
+	f := x - k
+	odd := math.Mod(k, 2) != 0
+	switch {
+	case f == 0:
+		if math.Signbit(x) != odd {
+			return Float64(math.Copysign(0, -1))
+		}
+		return 0
+	case math.Abs(f) == 0.5:
+		// x = m + 1/2, where m = k or k-1
+		if odd == (f > 0) {
+			return Float64(math.Inf(-1))
+		}
+		return Float64(math.Inf(1))
+	}
+
+	// compute; tan(Pi×f) = 1/tan(Pi×(1/2-f))
+	var y DoubleDouble
+	if g := math.Abs(f); g <= 0.25 {
+		sin, cos := sincosDD(piDD.mulFloat64(g))
+		y = sin.Quo(cos)
+	} else {
+		sin, cos := sincosDD(piDD.mulFloat64(0.5 - g))
+		y = cos.Quo(sin)
+	}
+	if f < 0 {
+		y = y.Neg()
+	}
+	return y.Float64()
+}
+
+// reducePi64 returns n in [0, 3] and f in [-1/4, 1/4] such that x = 2k + n/2 + f for some integer k.
+// The reduction is exact.
+func reducePi64(x float64) (n int, f float64) {
+	// r = x - 2×round(x/2) is in [-1, 1]
+	r := x - 2*math.Round(x/2)
+	q := math.Round(2 * r)
+	f = r - q/2
+	n = int(q) & 3
+	return
+}
@@ -0,0 +1,174 @@
+package floats
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestFloat64_Sinpi(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.125), 0.3826834323650898},
+		{exact64(0.375), 0.9238795325112867},
+		{exact64(-0.625), -0.9238795325112867},
+		{exact64(1.75), -0.7071067811865476},
+		{exact64(0.1), 0.30901699437494745},
+		{exact64(2.3), 0.8090169943749471},
+		{exact64(0x1p-30), 2.9258361585343192e-09},
+		{exact64(100.2), 0.5877852522924804},
+		{exact64(0x1p50 + 0.25), 0.7071067811865476},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sinpi()
+		if !close64(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(0)},
+		{exact64(-1), exact64(math.Copysign(0, -1))},
+		{exact64(2), exact64(0)},
+		{exact64(-3), exact64(math.Copysign(0, -1))},
+		{exact64(0.5), exact64(1)},
+		{exact64(-0.5), exact64(-1)},
+		{exact64(1.5), exact64(-1)},
+		{exact64(1e300), exact64(0)},
+		{exact64(-1e300), exact64(math.Copysign(0, -1))},
+		{exact64(math.Inf(1)), exact64(math.NaN())},
+		{exact64(math.Inf(-1)), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sinpi()
+		if !eq64(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Cospi(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.125), 0.9238795325112867},
+		{exact64(0.375), 0.3826834323650898},
+		{exact64(-0.625), -0.3826834323650898},
+		{exact64(1.75), 0.7071067811865476},
+		{exact64(0.1), 0.9510565162951535},
+		{exact64(2.3), 0.5877852522924736},
+		{exact64(0x1p-30), 1.0},
+		{exact64(100.2), 0.8090169943749421},
+		{exact64(0x1p50 + 0.25), 0.7071067811865476},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cospi()
+		if !close64(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(0), exact64(1)},
+		{exact64(math.Copysign(0, -1)), exact64(1)},
+		{exact64(0.5), exact64(0)},
+		{exact64(-0.5), exact64(0)},
+		{exact64(1.5), exact64(0)},
+		{exact64(1), exact64(-1)},
+		{exact64(2), exact64(1)},
+		{exact64(1e300), exact64(1)},
+		{exact64(math.Inf(1)), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cospi()
+		if !eq64(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Tanpi(t *testing.T) {
+	tests := []struct {
+		x    Float64
+		want float64
+	}{
+		{exact64(0.125), 0.41421356237309503},
+		{exact64(0.375), 2.414213562373095},
+		{exact64(-0.625), 2.414213562373095},
+		{exact64(0.1), 0.32491969623290634},
+		{exact64(0.49), 31.82051595377393},
+		{exact64(2.3), 1.376381920471172},
+		{exact64(0x1p-30), 2.9258361585343192e-09},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tanpi()
+		if !close64(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    Float64
+		want Float64
+	}{
+		// special cases
+		{exact64(0), exact64(0)},
+		{exact64(math.Copysign(0, -1)), exact64(math.Copysign(0, -1))},
+		{exact64(1), exact64(math.Copysign(0, -1))},
+		{exact64(-1), exact64(0)},
+		{exact64(2), exact64(0)},
+		{exact64(-2), exact64(math.Copysign(0, -1))},
+		{exact64(0.25), exact64(1)},
+		{exact64(-0.25), exact64(-1)},
+		{exact64(0.75), exact64(-1)},
+		{exact64(0.5), exact64(math.Inf(1))},
+		{exact64(1.5), exact64(math.Inf(-1))},
+		{exact64(-0.5), exact64(math.Inf(-1))},
+		{exact64(math.Inf(1)), exact64(math.NaN())},
+		{exact64(math.NaN()), exact64(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tanpi()
+		if !eq64(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestFloat64_Sinpi_CorrectlyRounded(t *testing.T) {
+	r := rand.New(rand.NewPCG(16, 64))
+	for range 500 {
+		x := NewFloat64(math.Ldexp(r.Float64()-0.5, r.IntN(60)-30))
+		x256 := x.Float256()
+		if got, want := x.Sinpi(), x256.Sinpi().Float64(); !eq64(got, want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Cospi(), x256.Cospi().Float64(); !eq64(got, want) {
+			t.Errorf("Cospi(%v) = %v; want %v", x, got, want)
+		}
+		if got, want := x.Tanpi(), x256.Tanpi().Float64(); !eq64(got, want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", x, got, want)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package floats
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBFloat16_Sinpi(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.125), 0.3826834323650898},
+		{exactBF16(0.375), 0.9238795325112867},
+		{exactBF16(-0.625), -0.9238795325112867},
+		{exactBF16(1.75), -0.7071067811865476},
+		{exactBF16(0x1p-30), 2.9258361585343192e-09},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sinpi()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(0)},
+		{exactBF16(-1), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(2), exactBF16(0)},
+		{exactBF16(-3), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(0.5), exactBF16(1)},
+		{exactBF16(-0.5), exactBF16(-1)},
+		{exactBF16(1.5), exactBF16(-1)},
+		{exactBF16(0x1p100), exactBF16(0)},
+		{exactBF16(-0x1p100), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.Inf(-1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sinpi()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Sinpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Cospi(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.125), 0.9238795325112867},
+		{exactBF16(0.375), 0.3826834323650898},
+		{exactBF16(-0.625), -0.3826834323650898},
+		{exactBF16(1.75), 0.7071067811865476},
+		{exactBF16(0x1p-30), 1.0},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cospi()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(1)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(1)},
+		{exactBF16(0.5), exactBF16(0)},
+		{exactBF16(-0.5), exactBF16(0)},
+		{exactBF16(1.5), exactBF16(0)},
+		{exactBF16(1), exactBF16(-1)},
+		{exactBF16(2), exactBF16(1)},
+		{exactBF16(0x1p100), exactBF16(1)},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cospi()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Cospi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestBFloat16_Tanpi(t *testing.T) {
+	tests := []struct {
+		x    BFloat16
+		want float64
+	}{
+		{exactBF16(0.125), 0.41421356237309503},
+		{exactBF16(0.375), 2.414213562373095},
+		{exactBF16(-0.625), 2.414213562373095},
+		{exactBF16(0x1p-30), 2.9258361585343192e-09},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tanpi()
+		if !closeBF16(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x    BFloat16
+		want BFloat16
+	}{
+		// special cases
+		{exactBF16(0), exactBF16(0)},
+		{exactBF16(math.Copysign(0, -1)), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(1), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(-1), exactBF16(0)},
+		{exactBF16(2), exactBF16(0)},
+		{exactBF16(-2), exactBF16(math.Copysign(0, -1))},
+		{exactBF16(0.25), exactBF16(1)},
+		{exactBF16(-0.25), exactBF16(-1)},
+		{exactBF16(0.75), exactBF16(-1)},
+		{exactBF16(0.5), exactBF16(math.Inf(1))},
+		{exactBF16(1.5), exactBF16(math.Inf(-1))},
+		{exactBF16(-0.5), exactBF16(math.Inf(-1))},
+		{exactBF16(math.Inf(1)), exactBF16(math.NaN())},
+		{exactBF16(math.NaN()), exactBF16(math.NaN())},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tanpi()
+		if !eqBF16(got, tt.want) {
+			t.Errorf("Tanpi(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
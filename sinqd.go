@@ -0,0 +1,98 @@
+package floats
+
+import "math"
+
+var (
+	// piQD = Pi ~ 3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798
+	piQD = QuadDouble{0x1.921fb54442d18p+1, 0x1.1a62633145c07p-53, -0x1.f1976b7ed8fbcp-109, 0x1.4cf98e804177dp-163}
+)
+
+// Sin returns the sine of the radian argument a.
+//
+// Special cases are:
+//
+//	±0.Sin() = ±0
+//	±Inf.Sin() = NaN
+//	NaN.Sin() = NaN
+func (a QuadDouble) Sin() QuadDouble {
+	sin, _ := a.Sincos()
+	return sin
+}
+
+// Cos returns the cosine of the radian argument a.
+//
+// Special cases are:
+//
+//	±Inf.Cos() = NaN
+//	NaN.Cos() = NaN
+func (a QuadDouble) Cos() QuadDouble {
+	_, cos := a.Sincos()
+	return cos
+}
+
+// Tan returns the tangent of the radian argument a.
+//
+// Special cases are:
+//
+//	±0.Tan() = ±0
+//	±Inf.Tan() = NaN
+//	NaN.Tan() = NaN
+func (a QuadDouble) Tan() QuadDouble {
+	sin, cos := a.Sincos()
+	return sin.Quo(cos)
+}
+
+// Sincos returns Sin(a), Cos(a).
+//
+// Special cases are:
+//
+//	±0.Sincos() = ±0, 1
+//	±Inf.Sincos() = NaN, NaN
+//	NaN.Sincos() = NaN, NaN
+func (a QuadDouble) Sincos() (sin, cos QuadDouble) {
+	// special cases
+	switch {
+	case a.IsZero():
+		return a, NewQuadDouble(1)
+	case a.IsNaN() || a.IsInf(0):
+		return NewQuadDoubleNaN(), NewQuadDoubleNaN()
+	}
+
+	// reduce; a = k*(Pi/2) + r, |r| <= Pi/4
+	k := math.Round(a[0] / (piQD[0] / 2))
+	r := a.Sub(piQD.ldexp(-1).mulFloat64(k))
+	sin, cos = sincosQD(r)
+
+	j := int(math.Mod(k, 4))
+	if j < 0 {
+		j += 4
+	}
+	switch j {
+	case 1:
+		sin, cos = cos, sin.Neg()
+	case 2:
+		sin, cos = sin.Neg(), cos.Neg()
+	case 3:
+		sin, cos = cos.Neg(), sin
+	}
+	return
+}
+
+// sincosQD returns sin(r) and cos(r) for |r| <= Pi/4.
+func sincosQD(r QuadDouble) (sin, cos QuadDouble) {
+	// sin(r) = r - r³/3! + r⁵/5! - ...
+	// cos(r) = 1 - r²/2! + r⁴/4! - ...
+	r2 := r.Mul(r).Neg()
+	sin, cos = r, NewQuadDouble(1)
+	s, c := r, NewQuadDouble(1)
+	for n := 1.0; n < 100; n += 2 {
+		c = c.Mul(r2).Quo(NewQuadDouble(n * (n + 1)))
+		s = s.Mul(r2).Quo(NewQuadDouble((n + 1) * (n + 2)))
+		cos = cos.Add(c)
+		sin = sin.Add(s)
+		if math.Abs(c[0]) <= 0x1p-215 {
+			break
+		}
+	}
+	return
+}
@@ -0,0 +1,138 @@
+package floats
+
+import (
+	"math"
+	"runtime"
+	"testing"
+)
+
+func TestQuadDouble_Sin(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0.5), "0.479425538604203000273287935215571388081803367940600675188616613125535"},
+		{NewQuadDouble(1), "0.841470984807896506652502321630298999622563060798371065672751709991910405"},
+		{NewQuadDouble(10), "-0.54402111088936981340474766185137728168364301291622389157418401261675721"},
+		{NewQuadDouble(100), "-0.506365641109758793656557610459785432065032721290657323443392473594357915"},
+		{NewQuadDouble(-3), "-0.14112000805986722210074480280811027984693326425226558415188264123242201"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Sin()
+		if !closeQD(got, tt.want) {
+			t.Errorf("Sin(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want QuadDouble
+	}{
+		{NewQuadDouble(0), NewQuadDouble(0)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDoubleInf(1), NewQuadDoubleNaN()},
+		{NewQuadDoubleInf(-1), NewQuadDoubleNaN()},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Sin()
+		if !eqQD(got, tt.want) {
+			t.Errorf("Sin(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Sin(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Sin())
+	}
+}
+
+func TestQuadDouble_Cos(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0.5), "0.87758256189037271611628158260382965199164519710974405299761086831595076"},
+		{NewQuadDouble(1), "0.540302305868139717400936607442976603732310420617922227670097255381100394"},
+		{NewQuadDouble(10), "-0.839071529076452452258863947824064834519930165133168546835953731048792586"},
+		{NewQuadDouble(100), "0.862318872287683934101938513950842535510084008535510829280162112692721085"},
+		{NewQuadDouble(-3), "-0.989992496600445457271572794731261302393679096615588328814085932928329197"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Cos()
+		if !closeQD(got, tt.want) {
+			t.Errorf("Cos(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want QuadDouble
+	}{
+		{NewQuadDouble(0), NewQuadDouble(1)},
+		{NewQuadDoubleInf(1), NewQuadDoubleNaN()},
+		{NewQuadDoubleInf(-1), NewQuadDoubleNaN()},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Cos()
+		if !eqQD(got, tt.want) {
+			t.Errorf("Cos(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Cos(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Cos())
+	}
+}
+
+func TestQuadDouble_Tan(t *testing.T) {
+	tests := []struct {
+		x    QuadDouble
+		want string
+	}{
+		{NewQuadDouble(0.5), "0.54630248984379051325517946578028538329755172017979124616409138593290751"},
+		{NewQuadDouble(1), "1.5574077246549022305069748074583601730872507723815200383839466056988614"},
+		{NewQuadDouble(10), "0.6483608274590866712591249330098086768168743429837249756336279673958556"},
+		{NewQuadDouble(100), "-0.587213915156929076677809635644587894258765986872919544126639683609894017"},
+		{NewQuadDouble(-3), "0.142546543074277805295635410533913493226092284901804647633238976688858594"},
+	}
+
+	for _, tt := range tests {
+		got := tt.x.Tan()
+		if !closeQD(got, tt.want) {
+			t.Errorf("Tan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+
+	strictTests := []struct {
+		x, want QuadDouble
+	}{
+		{NewQuadDouble(0), NewQuadDouble(0)},
+		{NewQuadDouble(math.Copysign(0, -1)), NewQuadDouble(math.Copysign(0, -1))},
+		{NewQuadDoubleInf(1), NewQuadDoubleNaN()},
+		{NewQuadDoubleInf(-1), NewQuadDoubleNaN()},
+		{NewQuadDoubleNaN(), NewQuadDoubleNaN()},
+	}
+
+	for _, tt := range strictTests {
+		got := tt.x.Tan()
+		if !eqQD(got, tt.want) {
+			t.Errorf("Tan(%v) = %v; want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkQuadDouble_Tan(b *testing.B) {
+	x := NewQuadDouble(1.5)
+	for b.Loop() {
+		runtime.KeepAlive(x.Tan())
+	}
+}
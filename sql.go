@@ -0,0 +1,136 @@
+package floats
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+)
+
+// scanFloat is the implementation of the Scan method of [SQL].
+// It converts src to T, rounding to nearest even;
+// the texts are parsed directly, so exact decimal values in NUMERIC columns don't go through float64.
+func scanFloat[T Float[T]](dst *T, src any) error {
+	var ret T
+	var err error
+	switch v := src.(type) {
+	case float64:
+		ret = FromFloat64[T](v)
+	case int64:
+		ret = FromInt64[T](v)
+	case []byte:
+		ret, err = parseFloat[T](string(v))
+	case string:
+		ret, err = parseFloat[T](v)
+	case nil:
+		return fmt.Errorf("floats: cannot scan NULL into %T", ret)
+	default:
+		return fmt.Errorf("floats: cannot scan %T into %T", src, ret)
+	}
+	if err != nil {
+		return err
+	}
+	*dst = ret
+	return nil
+}
+
+// SQL returns a [sql.Scanner] that reads a column into p,
+// so that T can be read by [sql.Rows.Scan] and [sql.Row.Scan]:
+//
+//	var a floats.Float128
+//	err := db.QueryRow("SELECT price FROM items").Scan(floats.SQL(&a))
+//
+// The types can't implement [sql.Scanner] themselves,
+// because the method name Scan is used by [fmt.Scanner].
+//
+// It accepts float64, int64, []byte and string, and rounds the value to nearest even.
+// []byte and string are parsed by [ParseFloat16] and its friends,
+// so NUMERIC and DECIMAL columns are read exactly if possible.
+// NULL is an error.
+func SQL[T Float[T]](p *T) sql.Scanner {
+	return sqlScanner[T]{p}
+}
+
+type sqlScanner[T Float[T]] struct {
+	p *T
+}
+
+// Scan implements [sql.Scanner].
+func (x sqlScanner[T]) Scan(src any) error {
+	return scanFloat(x.p, src)
+}
+
+// textValue returns the shortest decimal representation of x that round-trips,
+// with the spellings of NaN and infinities that SQL databases accept.
+func textValue[T Float[T]](x T) string {
+	switch {
+	case x.IsNaN():
+		return "NaN"
+	case x.IsInf(1):
+		return "Infinity"
+	case x.IsInf(-1):
+		return "-Infinity"
+	}
+	return string(x.Append(nil, 'g', -1))
+}
+
+var _ driver.Valuer = Float16(0)
+
+// Value implements [driver.Valuer].
+// It returns a float64, which represents a exactly.
+func (a Float16) Value() (driver.Value, error) {
+	return a.Float64().BuiltIn(), nil
+}
+
+var _ driver.Valuer = BFloat16(0)
+
+// Value implements [driver.Valuer].
+// It returns a float64, which represents a exactly.
+func (a BFloat16) Value() (driver.Value, error) {
+	return a.Float64().BuiltIn(), nil
+}
+
+var _ driver.Valuer = Float32(0)
+
+// Value implements [driver.Valuer].
+// It returns a float64, which represents a exactly.
+func (a Float32) Value() (driver.Value, error) {
+	return float64(a), nil
+}
+
+var _ driver.Valuer = Float64(0)
+
+// Value implements [driver.Valuer].
+// It returns a float64.
+func (a Float64) Value() (driver.Value, error) {
+	return float64(a), nil
+}
+
+var _ driver.Valuer = Float80{}
+
+// Value implements [driver.Valuer].
+// It returns the shortest decimal string that round-trips,
+// because float64 can't represent a exactly.
+// NaN and ±Inf are "NaN", "Infinity" and "-Infinity".
+func (a Float80) Value() (driver.Value, error) {
+	return textValue(a), nil
+}
+
+var _ driver.Valuer = Float128{}
+
+// Value implements [driver.Valuer].
+// It returns the shortest decimal string that round-trips,
+// so the value is stored in NUMERIC and DECIMAL columns without going through float64.
+// NaN and ±Inf are "NaN", "Infinity" and "-Infinity".
+func (a Float128) Value() (driver.Value, error) {
+	return textValue(a), nil
+}
+
+var _ driver.Valuer = Float256{}
+
+// Value implements [driver.Valuer].
+// It returns the shortest decimal string that round-trips,
+// so the value is stored in NUMERIC and DECIMAL columns without going through float64.
+// NaN and ±Inf are "NaN", "Infinity" and "-Infinity".
+func (a Float256) Value() (driver.Value, error) {
+	return textValue(a), nil
+}
@@ -0,0 +1,253 @@
+package floats
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is a tiny in-memory database driver for testing.
+// It stores the values as they are passed by database/sql,
+// like a database with a dynamic typed column.
+//
+//	INSERT: appends the argument to the table.
+//	SELECT: returns all the values in the table.
+//	DELETE: removes all the values from the table.
+type fakeDriver struct {
+	mu     sync.Mutex
+	values []driver.Value
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{d: d}, nil
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{d: c.d, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("fake: transactions are not supported")
+}
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	if s.query == "INSERT" {
+		return 1
+	}
+	return 0
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	switch s.query {
+	case "INSERT":
+		s.d.values = append(s.d.values, args[0])
+	case "DELETE":
+		s.d.values = nil
+	default:
+		return nil, errors.New("fake: unknown query")
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if s.query != "SELECT" {
+		return nil, errors.New("fake: unknown query")
+	}
+	s.d.mu.Lock()
+	defer s.d.mu.Unlock()
+	return &fakeRows{values: append([]driver.Value(nil), s.d.values...)}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.values[0]
+	r.values = r.values[1:]
+	return nil
+}
+
+var fakeDB = sync.OnceValue(func() *sql.DB {
+	sql.Register("floats-fake", &fakeDriver{})
+	db, err := sql.Open("floats-fake", "")
+	if err != nil {
+		panic(err)
+	}
+	return db
+})
+
+// roundTripSQL stores v into the fake database, and scans it into dst.
+func roundTripSQL(t *testing.T, v any, dst sql.Scanner) {
+	t.Helper()
+	db := fakeDB()
+	if _, err := db.Exec("DELETE"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("INSERT", v); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow("SELECT").Scan(dst); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSQL_RoundTrip(t *testing.T) {
+	tenth128, _ := ParseFloat128("0.1")
+	tenth256, _ := ParseFloat256("0.1")
+
+	var f16 Float16
+	roundTripSQL(t, NewFloat16(0.1), SQL(&f16))
+	if f16 != NewFloat16(0.1) {
+		t.Errorf("Float16: got %v; want %v", f16, NewFloat16(0.1))
+	}
+
+	var bf16 BFloat16
+	roundTripSQL(t, NewBFloat16(0.1), SQL(&bf16))
+	if bf16 != NewBFloat16(0.1) {
+		t.Errorf("BFloat16: got %v; want %v", bf16, NewBFloat16(0.1))
+	}
+
+	var f32 Float32
+	roundTripSQL(t, Float32(0.1), SQL(&f32))
+	if f32 != 0.1 {
+		t.Errorf("Float32: got %v; want %v", f32, Float32(0.1))
+	}
+
+	var f64 Float64
+	roundTripSQL(t, Float64(0.1), SQL(&f64))
+	if f64 != 0.1 {
+		t.Errorf("Float64: got %v; want %v", f64, Float64(0.1))
+	}
+
+	var f80 Float80
+	want80, _ := ParseFloat80("0.1")
+	roundTripSQL(t, want80, SQL(&f80))
+	if !eq80(f80, want80) {
+		t.Errorf("Float80: got %v; want %v", f80, want80)
+	}
+
+	// Float128 and Float256 don't go through float64.
+	var f128 Float128
+	roundTripSQL(t, tenth128, SQL(&f128))
+	if !eq128(f128, tenth128) {
+		t.Errorf("Float128: got %v; want %v", f128, tenth128)
+	}
+
+	var f256 Float256
+	roundTripSQL(t, tenth256, SQL(&f256))
+	if !eq256(f256, tenth256) {
+		t.Errorf("Float256: got %v; want %v", f256, tenth256)
+	}
+
+	for _, x := range []Float128{NewFloat128NaN(), NewFloat128Inf(1), NewFloat128Inf(-1), NewFloat128(math.Copysign(0, -1))} {
+		var got Float128
+		roundTripSQL(t, x, SQL(&got))
+		if !eq128(got, x) {
+			t.Errorf("Float128: got %v; want %v", got, x)
+		}
+	}
+}
+
+func TestSQL_Value(t *testing.T) {
+	tests := []struct {
+		v    driver.Valuer
+		want driver.Value
+	}{
+		{NewFloat16(1.5), 1.5},
+		{NewBFloat16(1.5), 1.5},
+		{Float32(1.5), 1.5},
+		{Float64(1.5), 1.5},
+		{NewFloat80(1.5), "1.5"},
+		{NewFloat128(1.5), "1.5"},
+		{NewFloat256(1.5), "1.5"},
+		{NewFloat256(1e100), "1.00000000000000001590289110975991804683608085639452813897813275577478388e+100"},
+		{NewFloat128Inf(-1), "-Infinity"},
+	}
+	for _, tt := range tests {
+		got, err := tt.v.Value()
+		if err != nil {
+			t.Errorf("%T.Value() returns error: %v", tt.v, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%T.Value() = %#v; want %#v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestSQL_Scan(t *testing.T) {
+	tests := []struct {
+		src  any
+		want Float128
+	}{
+		{float64(1.5), NewFloat128(1.5)},
+		{int64(-3), NewFloat128(-3)},
+		{int64(math.MaxInt64), NewFloat128FromInt64(math.MaxInt64)},
+		{[]byte("0.25"), NewFloat128(0.25)},
+		{"1e-4000", func() Float128 { f, _ := ParseFloat128("1e-4000"); return f }()},
+		{"NaN", NewFloat128NaN()},
+		{"-Infinity", NewFloat128Inf(-1)},
+	}
+	for _, tt := range tests {
+		var got Float128
+		if err := SQL(&got).Scan(tt.src); err != nil {
+			t.Errorf("Scan(%#v) returns error: %v", tt.src, err)
+			continue
+		}
+		if !eq128(got, tt.want) {
+			t.Errorf("Scan(%#v) = %v; want %v", tt.src, got, tt.want)
+		}
+	}
+
+	// errors don't modify the receiver.
+	for _, src := range []any{nil, true, "abc", []byte("1.5x"), "1e5000"} {
+		got := NewFloat128(42)
+		if err := SQL(&got).Scan(src); err == nil {
+			t.Errorf("Scan(%#v) doesn't return error", src)
+		}
+		if !got.Eq(NewFloat128(42)) {
+			t.Errorf("Scan(%#v) modifies the destination: %v", src, got)
+		}
+	}
+
+	// the values are rounded to nearest even.
+	var f16 Float16
+	if err := SQL(&f16).Scan("65519.99"); err != nil || f16 != Float16Max {
+		t.Errorf("SQL(&f16).Scan(%q) = %v, %v; want %v", "65519.99", f16, err, Float16Max)
+	}
+}
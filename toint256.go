@@ -0,0 +1,165 @@
+package floats
+
+import (
+	"math"
+
+	"github.com/shogo82148/ints"
+)
+
+// ToInt64 converts a to an int64, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+//
+// It is the convertToIntegerExact operation of IEEE 754:
+// Inexact is raised if a is not an integer,
+// and Invalid is raised if a is NaN or the rounded value is out of the range of int64.
+// Ignoring Inexact gives the convertToInteger operation.
+// So the conversion succeeded if flags&Invalid == 0, and it was exact if flags == 0.
+// If Invalid is raised, the result is 0 for NaN,
+// and math.MinInt64 or math.MaxInt64 by the sign of a otherwise.
+func (a Float256) ToInt64(mode RoundingMode) (int64, Flags) {
+	neg, mag, flags := a.toInt(mode, ints.Uint256{0, 0, 0, 1 << 63}, ints.Uint256{0, 0, 0, math.MaxInt64})
+	switch {
+	case flags&Invalid == 0 && neg:
+		return -int64(mag[3]), flags
+	case flags&Invalid == 0:
+		return int64(mag[3]), flags
+	case a.IsNaN():
+		return 0, flags
+	case neg:
+		return math.MinInt64, flags
+	}
+	return math.MaxInt64, flags
+}
+
+// ToUint64 converts a to a uint64, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+//
+// The exception flags are raised in the same way as [Float256.ToInt64].
+// Negative values that round to zero are valid.
+// If Invalid is raised, the result is 0 for NaN and negative values,
+// and math.MaxUint64 otherwise.
+func (a Float256) ToUint64(mode RoundingMode) (uint64, Flags) {
+	neg, mag, flags := a.toInt(mode, ints.Uint256{}, ints.Uint256{0, 0, 0, math.MaxUint64})
+	switch {
+	case flags&Invalid == 0:
+		return mag[3], flags
+	case a.IsNaN() || neg:
+		return 0, flags
+	}
+	return math.MaxUint64, flags
+}
+
+// ToInt128 converts a to an ints.Int128, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+//
+// The exception flags are raised in the same way as [Float256.ToInt64].
+// If Invalid is raised, the result is 0 for NaN,
+// and the minimum or maximum value of ints.Int128 by the sign of a otherwise.
+func (a Float256) ToInt128(mode RoundingMode) (ints.Int128, Flags) {
+	neg, mag, flags := a.toInt(mode, ints.Uint256{0, 0, 1 << 63, 0}, ints.Uint256{0, 0, math.MaxInt64, math.MaxUint64})
+	switch {
+	case flags&Invalid == 0 && neg:
+		return ints.Int128(mag.Uint128()).Neg(), flags
+	case flags&Invalid == 0:
+		return ints.Int128(mag.Uint128()), flags
+	case a.IsNaN():
+		return ints.Int128{}, flags
+	case neg:
+		return ints.Int128{1 << 63, 0}, flags
+	}
+	return ints.Int128{math.MaxInt64, math.MaxUint64}, flags
+}
+
+// ToUint128 converts a to an ints.Uint128, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+//
+// The exception flags are raised in the same way as [Float256.ToUint64].
+// If Invalid is raised, the result is 0 for NaN and negative values,
+// and the maximum value of ints.Uint128 otherwise.
+func (a Float256) ToUint128(mode RoundingMode) (ints.Uint128, Flags) {
+	neg, mag, flags := a.toInt(mode, ints.Uint256{}, ints.Uint256{0, 0, math.MaxUint64, math.MaxUint64})
+	switch {
+	case flags&Invalid == 0:
+		return mag.Uint128(), flags
+	case a.IsNaN() || neg:
+		return ints.Uint128{}, flags
+	}
+	return ints.Uint128{math.MaxUint64, math.MaxUint64}, flags
+}
+
+// ToInt256 converts a to an ints.Int256, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+//
+// The exception flags are raised in the same way as [Float256.ToInt64].
+// If Invalid is raised, the result is 0 for NaN,
+// and the minimum or maximum value of ints.Int256 by the sign of a otherwise.
+func (a Float256) ToInt256(mode RoundingMode) (ints.Int256, Flags) {
+	neg, mag, flags := a.toInt(mode, ints.Uint256{1 << 63, 0, 0, 0}, ints.Uint256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64})
+	switch {
+	case flags&Invalid == 0 && neg:
+		return ints.Int256(mag).Neg(), flags
+	case flags&Invalid == 0:
+		return ints.Int256(mag), flags
+	case a.IsNaN():
+		return ints.Int256{}, flags
+	case neg:
+		return ints.Int256{1 << 63, 0, 0, 0}, flags
+	}
+	return ints.Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, flags
+}
+
+// ToUint256 converts a to an ints.Uint256, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+//
+// The exception flags are raised in the same way as [Float256.ToUint64].
+// If Invalid is raised, the result is 0 for NaN and negative values,
+// and the maximum value of ints.Uint256 otherwise.
+func (a Float256) ToUint256(mode RoundingMode) (ints.Uint256, Flags) {
+	neg, mag, flags := a.toInt(mode, ints.Uint256{}, ints.Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64})
+	switch {
+	case flags&Invalid == 0:
+		return mag, flags
+	case a.IsNaN() || neg:
+		return ints.Uint256{}, flags
+	}
+	return ints.Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, flags
+}
+
+// toInt rounds a to an integer according to mode.
+// It returns the sign and the magnitude of the result, and the raised exception flags.
+// Invalid is raised if the magnitude is larger than min for negative values, or max otherwise.
+func (a Float256) toInt(mode RoundingMode, min, max ints.Uint256) (neg bool, mag ints.Uint256, flags Flags) {
+	neg, mag, flags = a.roundToInt(mode)
+	limit := max
+	if neg {
+		limit = min
+	}
+	if flags&Invalid == 0 && mag.Cmp(limit) > 0 {
+		flags = Invalid
+	}
+	return neg, mag, flags
+}
+
+// roundToInt rounds a to an integer according to mode.
+// It returns the sign and the magnitude of the result, and the raised exception flags.
+// Invalid is raised if a is NaN, an infinity, or the magnitude does not fit in 256 bits.
+func (a Float256) roundToInt(mode RoundingMode) (neg bool, mag ints.Uint256, flags Flags) {
+	neg = a.Signbit()
+	if a.IsNaN() || a.IsInf(0) {
+		return neg, ints.Uint256{}, Invalid
+	}
+
+	_, exp, frac := a.split()
+	if exp >= shift256 {
+		s := uint(exp - shift256)
+		if frac.BitLen()+int(s) > 256 {
+			return neg, ints.Uint256{}, Invalid
+		}
+		return neg, frac.Lsh(s), 0
+	}
+	q, inexact := round512(frac.Uint512(), uint(shift256-exp), neg, mode)
+	if inexact {
+		flags = Inexact
+	}
+	return neg, q.Uint256(), flags
+}
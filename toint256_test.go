@@ -0,0 +1,201 @@
+package floats
+
+import (
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+func TestFloat256_ToInt64(t *testing.T) {
+	tests := []struct {
+		mode  RoundingMode
+		x     float64
+		want  int64
+		flags Flags
+	}{
+		// ties
+		{ToNearestEven, 2.5, 2, Inexact},
+		{ToNearestAway, 2.5, 3, Inexact},
+		{ToZero, 2.5, 2, Inexact},
+		{AwayFromZero, 2.5, 3, Inexact},
+		{ToNegativeInf, 2.5, 2, Inexact},
+		{ToPositiveInf, 2.5, 3, Inexact},
+		{ToNearestEven, -2.5, -2, Inexact},
+		{ToNearestAway, -2.5, -3, Inexact},
+		{ToZero, -2.5, -2, Inexact},
+		{AwayFromZero, -2.5, -3, Inexact},
+		{ToNegativeInf, -2.5, -3, Inexact},
+		{ToPositiveInf, -2.5, -2, Inexact},
+
+		// exact
+		{ToNearestEven, 0, 0, 0},
+		{ToNearestEven, math.Copysign(0, -1), 0, 0},
+		{ToNegativeInf, -42, -42, 0},
+		{ToNearestEven, -0x1p63, math.MinInt64, 0},
+
+		// out of range
+		{ToNearestEven, 0x1p63, math.MaxInt64, Invalid},
+		{ToNearestEven, -0x1p64, math.MinInt64, Invalid},
+		{ToNearestEven, 0x1p300, math.MaxInt64, Invalid},
+		{ToNearestEven, math.Inf(1), math.MaxInt64, Invalid},
+		{ToNearestEven, math.Inf(-1), math.MinInt64, Invalid},
+		{ToNearestEven, math.NaN(), 0, Invalid},
+	}
+
+	for _, tt := range tests {
+		got, flags := NewFloat256(tt.x).ToInt64(tt.mode)
+		if got != tt.want || flags != tt.flags {
+			t.Errorf("Float256(%g).ToInt64(%v) = %d, %v, want %d, %v", tt.x, tt.mode, got, flags, tt.want, tt.flags)
+		}
+	}
+}
+
+func TestFloat256_ToUint64(t *testing.T) {
+	tests := []struct {
+		mode  RoundingMode
+		x     float64
+		want  uint64
+		flags Flags
+	}{
+		{ToNearestEven, 0.5, 0, Inexact},
+		{ToNearestEven, 1.5, 2, Inexact},
+		{ToNearestEven, 0x1p63, 1 << 63, 0},
+		{ToNearestEven, 0x1.fffffffffffffp63, 0xffff_ffff_ffff_f800, 0},
+
+		// negative values that round to zero are valid.
+		{ToNearestEven, -0.25, 0, Inexact},
+		{ToZero, -0.75, 0, Inexact},
+		{ToPositiveInf, -0.75, 0, Inexact},
+		{ToNegativeInf, -0.25, 0, Invalid},
+		{ToNearestEven, -1, 0, Invalid},
+
+		{ToNearestEven, 0x1p64, math.MaxUint64, Invalid},
+		{ToNearestEven, math.NaN(), 0, Invalid},
+	}
+
+	for _, tt := range tests {
+		got, flags := NewFloat256(tt.x).ToUint64(tt.mode)
+		if got != tt.want || flags != tt.flags {
+			t.Errorf("Float256(%g).ToUint64(%v) = %d, %v, want %d, %v", tt.x, tt.mode, got, flags, tt.want, tt.flags)
+		}
+	}
+}
+
+func TestFloat256_ToInt256(t *testing.T) {
+	one := NewFloat256(1)
+	tests := []struct {
+		mode  RoundingMode
+		x     Float256
+		want  ints.Int256
+		flags Flags
+	}{
+		{ToNearestEven, one.Ldexp(255).Neg(), ints.Int256{1 << 63, 0, 0, 0}, 0},
+		{ToNearestEven, one.Ldexp(255), ints.Int256{math.MaxInt64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Invalid},
+		{ToNearestEven, one.Ldexp(200).Add(NewFloat256(0.5)), ints.Int256{0x100, 0, 0, 0}, Inexact},
+		{ToNearestEven, one.Ldexp(-300), ints.Int256{}, Inexact},
+		{ToPositiveInf, one.Ldexp(-300), ints.Int256{0, 0, 0, 1}, Inexact},
+		{ToNegativeInf, one.Ldexp(-300).Neg(), ints.Int256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}, Inexact},
+	}
+
+	for _, tt := range tests {
+		got, flags := tt.x.ToInt256(tt.mode)
+		if got != tt.want || flags != tt.flags {
+			t.Errorf("Float256(%v).ToInt256(%v) = %x, %v, want %x, %v", tt.x, tt.mode, got, flags, tt.want, tt.flags)
+		}
+	}
+
+	// the largest Uint256 is not representable, but 2**256 - 2**19 is.
+	x := one.Ldexp(256).Sub(one.Ldexp(19))
+	if got, flags := x.ToUint256(ToNearestEven); got != (ints.Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64 &^ (1<<19 - 1)}) || flags != 0 {
+		t.Errorf("Float256(%v).ToUint256() = %x, %v", x, got, flags)
+	}
+	if got, flags := one.Ldexp(256).ToUint256(ToNearestEven); got != (ints.Uint256{math.MaxUint64, math.MaxUint64, math.MaxUint64, math.MaxUint64}) || flags != Invalid {
+		t.Errorf("Float256(2**256).ToUint256() = %x, %v, want the maximum value with Invalid", got, flags)
+	}
+}
+
+func TestFloat256_ToInt(t *testing.T) {
+	// the reference rounding to an integer by math/big.
+	round := func(x *big.Rat, mode RoundingMode) *big.Int {
+		q, r := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+		if r.Sign() == 0 {
+			return q
+		}
+		// compare |r/den| with 1/2
+		cmp := new(big.Int).Lsh(new(big.Int).Abs(r), 1).Cmp(x.Denom())
+		neg := x.Sign() < 0
+		var up bool // away from zero
+		switch mode {
+		case ToNearestEven:
+			up = cmp > 0 || cmp == 0 && q.Bit(0) == 1
+		case ToNearestAway:
+			up = cmp >= 0
+		case AwayFromZero:
+			up = true
+		case ToNegativeInf:
+			up = neg
+		case ToPositiveInf:
+			up = !neg
+		}
+		if up {
+			if neg {
+				q.Sub(q, big.NewInt(1))
+			} else {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+		return q
+	}
+	modes := []RoundingMode{ToNearestEven, ToNearestAway, ToZero, AwayFromZero, ToNegativeInf, ToPositiveInf}
+	minInt128 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+	maxInt128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	maxUint128 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 128), big.NewInt(1))
+
+	r := rand.New(rand.NewPCG(12, 1))
+	for range 2000 {
+		x := NewFloat256FromBits(ints.Uint256{r.Uint64(), r.Uint64(), r.Uint64(), r.Uint64()})
+		frac, _ := x.Frexp()
+		x = frac.Ldexp(r.IntN(140) - 8)
+		if x.IsNaN() {
+			continue
+		}
+		rat, _ := x.Rat(nil)
+		for _, mode := range modes {
+			want := round(rat, mode)
+			var wantFlags Flags
+			if !rat.IsInt() {
+				wantFlags = Inexact
+			}
+
+			got, flags := x.ToInt128(mode)
+			valid := want.Cmp(minInt128) >= 0 && want.Cmp(maxInt128) <= 0
+			if valid {
+				gotBig := bigIntFromUint256(new(big.Int), ints.Uint128(got).Uint256())
+				if got.Sign() < 0 {
+					gotBig.Sub(gotBig, new(big.Int).Lsh(big.NewInt(1), 128))
+				}
+				if gotBig.Cmp(want) != 0 {
+					t.Errorf("Float256(%v).ToInt128(%v) = %x, want %s", x, mode, got, want)
+				}
+				if flags != wantFlags {
+					t.Errorf("Float256(%v).ToInt128(%v) raised %v, want %v", x, mode, flags, wantFlags)
+				}
+			} else if flags != Invalid {
+				t.Errorf("Float256(%v).ToInt128(%v) raised %v, want Invalid", x, mode, flags)
+			}
+
+			gotU, flags := x.ToUint128(mode)
+			valid = want.Sign() >= 0 && want.Cmp(maxUint128) <= 0
+			if valid {
+				if bigIntFromUint256(new(big.Int), gotU.Uint256()).Cmp(want) != 0 {
+					t.Errorf("Float256(%v).ToUint128(%v) = %x, want %s", x, mode, gotU, want)
+				}
+			} else if flags != Invalid {
+				t.Errorf("Float256(%v).ToUint128(%v) raised %v, want Invalid", x, mode, flags)
+			}
+		}
+	}
+}
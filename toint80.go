@@ -0,0 +1,45 @@
+package floats
+
+import "github.com/shogo82148/ints"
+
+// ToInt64 converts a to an int64, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+// See [Float256.ToInt64] for the details.
+func (a Float80) ToInt64(mode RoundingMode) (int64, Flags) {
+	return a.Float256().ToInt64(mode)
+}
+
+// ToUint64 converts a to a uint64, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+// See [Float256.ToUint64] for the details.
+func (a Float80) ToUint64(mode RoundingMode) (uint64, Flags) {
+	return a.Float256().ToUint64(mode)
+}
+
+// ToInt128 converts a to an ints.Int128, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+// See [Float256.ToInt128] for the details.
+func (a Float80) ToInt128(mode RoundingMode) (ints.Int128, Flags) {
+	return a.Float256().ToInt128(mode)
+}
+
+// ToUint128 converts a to an ints.Uint128, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+// See [Float256.ToUint128] for the details.
+func (a Float80) ToUint128(mode RoundingMode) (ints.Uint128, Flags) {
+	return a.Float256().ToUint128(mode)
+}
+
+// ToInt256 converts a to an ints.Int256, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+// See [Float256.ToInt256] for the details.
+func (a Float80) ToInt256(mode RoundingMode) (ints.Int256, Flags) {
+	return a.Float256().ToInt256(mode)
+}
+
+// ToUint256 converts a to an ints.Uint256, rounding according to mode,
+// and returns the exception flags raised by the conversion.
+// See [Float256.ToUint256] for the details.
+func (a Float80) ToUint256(mode RoundingMode) (ints.Uint256, Flags) {
+	return a.Float256().ToUint256(mode)
+}
@@ -0,0 +1,64 @@
+package floats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shogo82148/ints"
+)
+
+// testToInt checks the integer conversions of T with the values that every format can represent.
+func testToInt[T interface {
+	Float[T]
+	ToInt64(mode RoundingMode) (int64, Flags)
+	ToUint64(mode RoundingMode) (uint64, Flags)
+	ToInt256(mode RoundingMode) (ints.Int256, Flags)
+}](t *testing.T) {
+	tests := []struct {
+		mode  RoundingMode
+		x     float64
+		want  int64
+		flags Flags
+	}{
+		{ToNearestEven, 2.5, 2, Inexact},
+		{ToNearestAway, 2.5, 3, Inexact},
+		{ToZero, -2.5, -2, Inexact},
+		{ToNegativeInf, -2.5, -3, Inexact},
+		{ToPositiveInf, 0.125, 1, Inexact},
+		{ToNearestEven, -256, -256, 0},
+		{ToNearestEven, math.Copysign(0, -1), 0, 0},
+		{ToNearestEven, 0x1p100, math.MaxInt64, Invalid},
+		{ToNearestEven, math.Inf(-1), math.MinInt64, Invalid},
+		{ToNearestEven, math.NaN(), 0, Invalid},
+	}
+	for _, tt := range tests {
+		x := FromFloat64[T](tt.x)
+		if got, flags := x.ToInt64(tt.mode); got != tt.want || flags != tt.flags {
+			t.Errorf("%v.ToInt64(%v) = %d, %v; want %d, %v", x, tt.mode, got, flags, tt.want, tt.flags)
+		}
+	}
+
+	x := FromFloat64[T](-0x1p15)
+	if got, flags := x.ToInt256(ToNearestEven); got != (ints.Int256{}).Sub(ints.Int256{0, 0, 0, 1 << 15}) || flags != 0 {
+		t.Errorf("%v.ToInt256() = %x, %v; want -2**15, 0", x, got, flags)
+	}
+
+	// negative values are invalid for unsigned integers, unless they round to zero.
+	x = FromFloat64[T](-0.5)
+	if got, flags := x.ToUint64(ToNearestEven); got != 0 || flags != Inexact {
+		t.Errorf("%v.ToUint64(ToNearestEven) = %d, %v; want 0, Inexact", x, got, flags)
+	}
+	if got, flags := x.ToUint64(ToNegativeInf); got != 0 || flags != Invalid {
+		t.Errorf("%v.ToUint64(ToNegativeInf) = %d, %v; want 0, Invalid", x, got, flags)
+	}
+}
+
+func TestToInt(t *testing.T) {
+	t.Run("Float16", testToInt[Float16])
+	t.Run("BFloat16", testToInt[BFloat16])
+	t.Run("Float32", testToInt[Float32])
+	t.Run("Float64", testToInt[Float64])
+	t.Run("Float80", testToInt[Float80])
+	t.Run("Float128", testToInt[Float128])
+	t.Run("Float256", testToInt[Float256])
+}
@@ -130,6 +130,48 @@ func roundToNearestEven512(x ints.Uint512, shift uint) ints.Uint512 {
 	return x.Add(mask).Add(x.Rsh(uint(shift)).And(one))
 }
 
+// round256 rounds x to a multiple of 2**shift according to mode and returns x >> shift.
+// neg is the sign of the value that x represents.
+// inexact reports whether any nonzero bit is discarded.
+func round256(x ints.Uint256, shift uint, neg bool, mode RoundingMode) (q ints.Uint256, inexact bool) {
+	if shift == 0 {
+		return x, false
+	}
+	if shift > 256 {
+		x = nonzero256(x)
+		shift = 2
+	}
+	one := ints.Uint256{0, 0, 0, 1}
+	q = x.Rsh(shift)
+	half := !x.Rsh(shift - 1).And(one).IsZero()
+	sticky := !x.And(one.Lsh(shift - 1).Sub(one)).IsZero()
+	if mode.roundUp(neg, q[3]&1 != 0, half, sticky) {
+		q = q.Add(one)
+	}
+	return q, half || sticky
+}
+
+// round512 rounds x to a multiple of 2**shift according to mode and returns x >> shift.
+// neg is the sign of the value that x represents.
+// inexact reports whether any nonzero bit is discarded.
+func round512(x ints.Uint512, shift uint, neg bool, mode RoundingMode) (q ints.Uint512, inexact bool) {
+	if shift == 0 {
+		return x, false
+	}
+	if shift > 512 {
+		x = nonzero512(x)
+		shift = 2
+	}
+	one := ints.Uint512{0, 0, 0, 0, 0, 0, 0, 1}
+	q = x.Rsh(shift)
+	half := !x.Rsh(shift - 1).And(one).IsZero()
+	sticky := !x.And(one.Lsh(shift - 1).Sub(one)).IsZero()
+	if mode.roundUp(neg, q[7]&1 != 0, half, sticky) {
+		q = q.Add(one)
+	}
+	return q, half || sticky
+}
+
 // power128 computes x**n
 func power128(x Float128, n int) Float128 {
 	result := Float128(uvone128)
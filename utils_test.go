@@ -3,8 +3,32 @@ package floats
 import (
 	"cmp"
 	"fmt"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
 )
 
+// exactE4M3 returns the Float8E4M3 representation of f.
+// It panics if f does not have an exact Float8E4M3 representation.
+func exactE4M3(f float64) Float8E4M3 {
+	ret := Float64(f).Float8E4M3()
+	if cmp.Compare(ret.Float64(), Float64(f)) != 0 {
+		panic(fmt.Sprintf("%f doesn't have exact float8 e4m3 representation", f))
+	}
+	return ret
+}
+
+// exactE5M2 returns the Float8E5M2 representation of f.
+// It panics if f does not have an exact Float8E5M2 representation.
+func exactE5M2(f float64) Float8E5M2 {
+	ret := Float64(f).Float8E5M2()
+	if cmp.Compare(ret.Float64(), Float64(f)) != 0 {
+		panic(fmt.Sprintf("%f doesn't have exact float8 e5m2 representation", f))
+	}
+	return ret
+}
+
 // exact16 returns the Float16 representation of f.
 // It panics if f does not have an exact Float16 representation.
 func exact16(f float64) Float16 {
@@ -15,6 +39,16 @@ func exact16(f float64) Float16 {
 	return ret
 }
 
+// exactBF16 returns the BFloat16 representation of f.
+// It panics if f does not have an exact BFloat16 representation.
+func exactBF16(f float64) BFloat16 {
+	ret := Float64(f).BFloat16()
+	if cmp.Compare(ret.Float64(), Float64(f)) != 0 {
+		panic(fmt.Sprintf("%f doesn't have exact bfloat16 representation", f))
+	}
+	return ret
+}
+
 // exact32 returns the Float32 representation of f.
 // It panics if f does not have an exact Float32 representation.
 func exact32(f float64) Float32 {
@@ -30,6 +64,11 @@ func exact64(f float64) Float64 {
 	return Float64(f)
 }
 
+// exact80 returns the Float80 representation of f.
+func exact80(f float64) Float80 {
+	return Float64(f).Float80()
+}
+
 // exact128 returns the Float128 representation of f.
 func exact128(f float64) Float128 {
 	return Float64(f).Float128()
@@ -63,6 +102,11 @@ func close16(a Float16, b float64) bool {
 	return tolerance(a.Float64().BuiltIn(), b, 1e-3)
 }
 
+// closeBF16 reports whether a is close to b within tolerance 1e-2.
+func closeBF16(a BFloat16, b float64) bool {
+	return tolerance(a.Float64().BuiltIn(), b, 1e-2)
+}
+
 // close32 reports whether a is close to b within tolerance 1e-6.
 func close32(a Float32, b float64) bool {
 	return tolerance(float64(a), b, 1e-6)
@@ -131,3 +175,339 @@ func close256(a Float256, b string) bool {
 	}
 	return d.Lt(e)
 }
+
+// closeDD reports whether a is close to b within tolerance 1e-30.
+func closeDD(a DoubleDouble, b string) bool {
+	return closeRel256(a.Float256(), b, "1e-30")
+}
+
+// closeQD reports whether a is close to b within tolerance 1e-62.
+func closeQD(a QuadDouble, b string) bool {
+	return closeRel256(a.Float256(), b, "1e-62")
+}
+
+// closeRel256 reports whether a is close to b within the relative tolerance tol.
+func closeRel256(a Float256, b, tol string) bool {
+	e, err := ParseFloat256(tol)
+	if err != nil {
+		panic(err)
+	}
+
+	fb, err := ParseFloat256(b)
+	if err != nil {
+		panic(err)
+	}
+
+	if a.Eq(fb) {
+		return true
+	}
+	d := a.Sub(fb).Abs()
+	if !fb.IsZero() {
+		e = e.Mul(fb).Abs()
+	}
+	return d.Lt(e)
+}
+
+// roundDD16 rounds r, an approximation of a real number x
+// with a relative error less than 2**-80, to a Float16.
+// It reports false if the result might not be the correctly rounded value of x.
+// If r is exactly the midpoint of two Float16 values, it is assumed to be exact.
+func roundDD16(r DoubleDouble) (Float16, bool) {
+	x := r.Float256()
+	if x.IsNaN() {
+		return NewFloat16NaN(), true
+	}
+	lo, hi := roundDDBounds(x)
+	if lo.Float16() == hi.Float16() {
+		return x.Float16(), true
+	}
+	mid := lo.Float16().Float256().Add(hi.Float16().Float256()).Ldexp(-1)
+	return x.Float16(), mid.Eq(x)
+}
+
+// roundDD32 is the Float32 version of roundDD16.
+func roundDD32(r DoubleDouble) (Float32, bool) {
+	x := r.Float256()
+	if x.IsNaN() {
+		return NewFloat32NaN(), true
+	}
+	lo, hi := roundDDBounds(x)
+	if lo.Float32() == hi.Float32() {
+		return x.Float32(), true
+	}
+	mid := lo.Float32().Float256().Add(hi.Float32().Float256()).Ldexp(-1)
+	return x.Float32(), mid.Eq(x)
+}
+
+func roundDDBounds(x Float256) (lo, hi Float256) {
+	one := NewFloat256(1)
+	eps := one.Ldexp(-80)
+	return x.Mul(one.Sub(eps)), x.Mul(one.Add(eps))
+}
+
+// testCorrectlyRounded16 checks that f returns the correctly rounded value of ref
+// for all finite Float16 values.
+func testCorrectlyRounded16(t *testing.T, name string, f func(Float16) Float16, ref func(x float64) DoubleDouble) {
+	t.Helper()
+	for i := range 1 << 16 {
+		x := NewFloat16FromBits(uint16(i))
+		if x.IsNaN() || x.IsInf(0) {
+			continue
+		}
+		want, ok := roundDD16(ref(x.Float64().BuiltIn()))
+		if !ok {
+			t.Errorf("%s(%v): the reference value is too close to a midpoint", name, x)
+			continue
+		}
+		if got := f(x); !eq16(got, want) {
+			t.Errorf("%s(%v) = %v, want %v", name, x, got, want)
+		}
+	}
+}
+
+// testCorrectlyRounded32 checks that f returns the correctly rounded value of ref
+// for random Float32 values with the exponent in [minExp, maxExp),
+// and for the given inputs.
+func testCorrectlyRounded32(t *testing.T, name string, f func(Float32) Float32, ref func(x float64) DoubleDouble, minExp, maxExp int, inputs ...float32) {
+	t.Helper()
+	r := rand.New(rand.NewPCG(32, uint64(len(name))))
+	for range 20000 {
+		inputs = append(inputs, randFloat32(r, minExp, maxExp))
+	}
+	for _, x := range inputs {
+		want, ok := roundDD32(ref(float64(x)))
+		if !ok {
+			t.Errorf("%s(%v): the reference value is too close to a midpoint", name, x)
+			continue
+		}
+		if got := f(Float32(x)); !eq32(got, want) {
+			t.Errorf("%s(%v) = %v, want %v", name, x, got, want)
+		}
+	}
+}
+
+// exp2Ref returns 2**x in DoubleDouble precision.
+// It is exact if x is an integer.
+func exp2Ref(x float64) DoubleDouble {
+	if x == math.Trunc(x) {
+		return NewDoubleDouble(math.Exp2(x))
+	}
+	return NewDoubleDouble(x).Mul(ln2DD).Exp()
+}
+
+// expm1Ref returns exp(x)-1 in DoubleDouble precision.
+// exp(x)-1 cancels for small x, so the Taylor series is used for them.
+func expm1Ref(x float64) DoubleDouble {
+	return expm1ScaledRef(x, NewDoubleDouble(1))
+}
+
+// log1pRef returns log(1+x) in DoubleDouble precision.
+func log1pRef(x float64) DoubleDouble {
+	if x == 0 || x <= -1 || math.IsInf(x, 0) {
+		return NewDoubleDouble(math.Log1p(x))
+	}
+	one := NewDoubleDouble(1)
+	if math.Abs(x) < 0x1p-8 {
+		// log(1+x) = x - x²/2 + x³/3 - ...
+		var s DoubleDouble
+		t := one
+		for n := 1; n <= 16; n++ {
+			t = t.Mul(NewDoubleDouble(-x))
+			s = s.Sub(t.Quo(NewDoubleDouble(float64(n))))
+		}
+		return s
+	}
+	// one step of Newton's iteration for exp(y) = 1+x
+	y := NewDoubleDouble(math.Log1p(x))
+	return y.Add(one.Add(NewDoubleDouble(x)).Mul(y.Neg().Exp())).Sub(one)
+}
+
+// exp10Ref returns 10**x in DoubleDouble precision.
+// It is exact if x is an integer in [0, 22].
+func exp10Ref(x float64) DoubleDouble {
+	if x == math.Trunc(x) && 0 <= x && x <= 22 {
+		return NewDoubleDouble(math.Pow10(int(x)))
+	}
+	return NewDoubleDouble(x).Mul(ln10DD).Exp()
+}
+
+// expm1ScaledRef returns exp(x×ln)-1 in DoubleDouble precision.
+func expm1ScaledRef(x float64, ln DoubleDouble) DoubleDouble {
+	if x == 0 {
+		return NewDoubleDouble(x)
+	}
+	t := NewDoubleDouble(x).Mul(ln)
+	if math.Abs(x) < 0x1p-8 {
+		// exp(t)-1 = t + t²/2! + t³/3! + ...
+		var s DoubleDouble
+		u := NewDoubleDouble(1)
+		for n := 1; n <= 16; n++ {
+			u = u.Mul(t).Quo(NewDoubleDouble(float64(n)))
+			s = s.Add(u)
+		}
+		return s
+	}
+	return t.Exp().Sub(NewDoubleDouble(1))
+}
+
+// exp2m1Ref returns 2**x-1 in DoubleDouble precision.
+// It is exact if x is an integer.
+func exp2m1Ref(x float64) DoubleDouble {
+	if x == 0 {
+		return NewDoubleDouble(x)
+	}
+	if x == math.Trunc(x) {
+		return NewDoubleDouble(math.Exp2(x)).Sub(NewDoubleDouble(1))
+	}
+	return expm1ScaledRef(x, ln2DD)
+}
+
+// exp10m1Ref returns 10**x-1 in DoubleDouble precision.
+// It is exact if x is an integer in [0, 22].
+func exp10m1Ref(x float64) DoubleDouble {
+	if x == 0 {
+		return NewDoubleDouble(x)
+	}
+	if x == math.Trunc(x) && 0 <= x && x <= 22 {
+		return NewDoubleDouble(math.Pow10(int(x))).Sub(NewDoubleDouble(1))
+	}
+	return expm1ScaledRef(x, ln10DD)
+}
+
+// log2p1Ref returns log2(1+x) in DoubleDouble precision.
+func log2p1Ref(x float64) DoubleDouble {
+	return log1pRef(x).Quo(ln2DD)
+}
+
+// log10p1Ref returns log10(1+x) in DoubleDouble precision.
+func log10p1Ref(x float64) DoubleDouble {
+	return log1pRef(x).Quo(ln10DD)
+}
+
+// rsqrtRef returns 1/sqrt(x) in DoubleDouble precision.
+func rsqrtRef(x float64) DoubleDouble {
+	if x <= 0 || math.IsInf(x, 0) {
+		return NewDoubleDouble(1 / math.Sqrt(x))
+	}
+	return NewDoubleDouble(1).Quo(NewDoubleDouble(x).Sqrt())
+}
+
+// rootnRef returns a function that computes the n-th root of x in DoubleDouble precision.
+// n must be odd.
+func rootnRef(n int) func(x float64) DoubleDouble {
+	return func(x float64) DoubleDouble {
+		if x == 0 {
+			if n < 0 {
+				return NewDoubleDouble(math.Copysign(math.Inf(1), x))
+			}
+			return NewDoubleDouble(x)
+		}
+		y := NewDoubleDouble(math.Abs(x)).Log().Quo(NewDoubleDouble(float64(n))).Exp()
+		if x < 0 {
+			y = y.Neg()
+		}
+		return y
+	}
+}
+
+// pownExact256 returns x**n by repeated multiplication.
+// It is exact for n > 0 if the result fits in 237 bits,
+// and correctly rounded to Float256 for n < 0.
+func pownExact256(x Float256, n int) Float256 {
+	one := NewFloat256(1)
+	p := one
+	for range max(n, -n) {
+		p = p.Mul(x)
+	}
+	if n < 0 {
+		return one.Quo(p)
+	}
+	return p
+}
+
+// randFloat32 returns a random Float32 with the exponent in [minExp, maxExp) and a random sign.
+func randFloat32(r *rand.Rand, minExp, maxExp int) float32 {
+	frac := 1 + r.Float64()
+	x := float32(math.Ldexp(frac, minExp+r.IntN(maxExp-minExp)))
+	if r.IntN(2) == 0 {
+		x = -x
+	}
+	return x
+}
+
+// bigPrec is the precision of the math/big references of Float128 functions.
+// It is large enough to round them to Float128 correctly, unless they are very hard cases.
+const bigPrec = 512
+
+// ln2Big is ln(2) in bigPrec bits.
+// It is computed as 2×atanh(1/3) = 2×(1/3 + 1/(3×3³) + 1/(5×3⁵) + ...)
+var ln2Big = func() *big.Float {
+	const prec = bigPrec + 32
+	s := new(big.Float).SetPrec(prec)
+	p := new(big.Float).SetPrec(prec).Quo(big.NewFloat(1), big.NewFloat(3))
+	for n := int64(1); n < bigPrec; n += 2 {
+		t := new(big.Float).SetPrec(prec).Quo(p, new(big.Float).SetInt64(n))
+		s.Add(s, t)
+		p.Quo(p, big.NewFloat(9))
+	}
+	return s.Mul(s, big.NewFloat(2))
+}()
+
+// expBig returns e**x in bigPrec bits.
+func expBig(x *big.Float) *big.Float {
+	const prec = bigPrec + 32
+	const n = 16
+
+	// reduce; x = k×ln(2) + r
+	f, _ := x.Float64()
+	k := math.Round(f / math.Ln2)
+	r := new(big.Float).SetPrec(prec).Mul(ln2Big, big.NewFloat(k))
+	r.Sub(x, r)
+	r.SetMantExp(r, -n)
+
+	// e**r = 1 + r + r²/2! + ...
+	s := new(big.Float).SetPrec(prec).SetInt64(1)
+	t := new(big.Float).SetPrec(prec).SetInt64(1)
+	for i := int64(1); t.Sign() != 0 && t.MantExp(nil) > -prec; i++ {
+		t.Mul(t, r)
+		t.Quo(t, new(big.Float).SetInt64(i))
+		s.Add(s, t)
+	}
+	for range n {
+		s.Mul(s, s)
+	}
+	return s.SetMantExp(s, int(k))
+}
+
+// logBig returns the natural logarithm of x > 0 in bigPrec bits.
+func logBig(x *big.Float) *big.Float {
+	const prec = bigPrec + 32
+
+	// initial guess in float64
+	mant := new(big.Float)
+	exp := x.MantExp(mant)
+	m, _ := mant.Float64()
+	y := new(big.Float).SetPrec(prec).SetFloat64(math.Log(m) + float64(exp)*math.Ln2)
+
+	// Newton's iteration; y = y + x×e**-y - 1
+	one := big.NewFloat(1)
+	for range 4 {
+		t := expBig(new(big.Float).Neg(y))
+		t.Mul(t, x)
+		t.Sub(t, one)
+		y.Add(y, t)
+	}
+	return y
+}
+
+// round128 rounds x to the nearest Float128.
+func round128(x *big.Float) Float128 {
+	ret, _ := NewFloat128FromBigFloat(x)
+	return ret
+}
+
+// randFloat128 returns a random Float128 in [2**minExp, 2**maxExp).
+func randFloat128(r *rand.Rand, minExp, maxExp int) Float128 {
+	frac := Float128{0x3fff_0000_0000_0000 | r.Uint64()&(1<<48-1), r.Uint64()} // [1, 2)
+	return frac.Ldexp(minExp + r.IntN(maxExp-minExp))
+}
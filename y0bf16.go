@@ -0,0 +1,15 @@
+package floats
+
+import "math"
+
+// Y0 returns the order-zero Bessel function of the second kind.
+//
+// Special cases are:
+//
+//	Y0(+Inf) = 0
+//	Y0(0) = -Inf
+//	Y0(x < 0) = NaN
+//	Y0(NaN) = NaN
+func (a BFloat16) Y0() BFloat16 {
+	return NewBFloat16(math.Y0(a.Float64().BuiltIn()))
+}
@@ -0,0 +1,16 @@
+package floats
+
+import "math"
+
+// Yn returns the order-n Bessel function of the second kind.
+//
+// Special cases are:
+//
+//	Yn(n, +Inf) = 0
+//	Yn(n >= 0, 0) = -Inf
+//	Yn(n < 0, 0) = +Inf if n is odd, -Inf if n is even
+//	Yn(n, x < 0) = NaN
+//	Yn(n, NaN) = NaN
+func (a BFloat16) Yn(n int) BFloat16 {
+	return NewBFloat16(math.Yn(n, a.Float64().BuiltIn()))
+}